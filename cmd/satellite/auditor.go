@@ -76,6 +76,7 @@ func cmdAuditorRun(cmd *cobra.Command, args []string) (err error) {
 		db.NodeEvents(),
 		db.Reputation(),
 		db.Containment(),
+		db.AuditCoverage(),
 		rollupsWriteCache,
 		version.Build,
 		&runCfg.Config,
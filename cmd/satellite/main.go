@@ -294,6 +294,55 @@ var (
 		Args:  cobra.RangeArgs(1, 2),
 		RunE:  cmdRepairSegment,
 	}
+	placementCmd = &cobra.Command{
+		Use:   "placement",
+		Short: "Commands for the placement rules",
+	}
+	placementTestCmd = &cobra.Command{
+		Use:   "test <placement-number> or <node-filter-expression>",
+		Short: "Validate the placement rules and list the nodes matching a placement",
+		Long:  "Validate the placement rules configured by overlay.placement and list the upload eligible nodes which match the given placement number or node filter expression, e.g. 'country(\"DE\") && !asn(16509)'.",
+		Args:  cobra.ExactArgs(1),
+		RunE:  cmdPlacementTest,
+	}
+	placementReportCmd = &cobra.Command{
+		Use:   "report <project-id> <bucket-name> [<bucket-name>...]",
+		Short: "Report how much of the buckets is stored on nodes matching the placement",
+		Long:  "Report per bucket the number of segments and pieces stored on nodes which don't match the placement of their segments, which are migrated by the repairer.",
+		Args:  cobra.MinimumNArgs(2),
+		RunE:  cmdPlacementReport,
+	}
+	repairQueueCmd = &cobra.Command{
+		Use:   "repair-queue",
+		Short: "Inspect and manage the repair queue",
+	}
+	repairQueueListCmd = &cobra.Command{
+		Use:   "list",
+		Short: "List the segments in the repair queue",
+		Long:  "List the segments in the repair queue, ordered by stream ID and position. Use --cursor with the printed cursor to list the next page.",
+		Args:  cobra.NoArgs,
+		RunE:  cmdRepairQueueList,
+	}
+	repairQueueEnqueueCmd = &cobra.Command{
+		Use:   "enqueue <stream-id> <position> [priority]",
+		Short: "Add a segment to the repair queue",
+		Long:  "Add a segment to the repair queue. Segments with a lower priority are repaired first, the default priority 0 puts the segment in front of the segments queued by the checker.",
+		Args:  cobra.RangeArgs(2, 3),
+		RunE:  cmdRepairQueueEnqueue,
+	}
+	repairQueueBumpCmd = &cobra.Command{
+		Use:   "bump <stream-id> <position> <priority>",
+		Short: "Set the priority of a queued segment",
+		Long:  "Set the priority of a queued segment. A segment which is being repaired isn't repaired again until its repair attempt expires. The checker replaces the priority when it checks the segment again.",
+		Args:  cobra.ExactArgs(3),
+		RunE:  cmdRepairQueueBump,
+	}
+	repairQueueRemoveCmd = &cobra.Command{
+		Use:   "remove <stream-id> <position>",
+		Short: "Remove a segment from the repair queue",
+		Args:  cobra.ExactArgs(2),
+		RunE:  cmdRepairQueueRemove,
+	}
 
 	runCfg   Satellite
 	setupCfg Satellite
@@ -333,6 +382,18 @@ var (
 		Database string `help:"satellite database connection string" releaseDefault:"postgres://" devDefault:"postgres://"`
 		Before   string `help:"select only exited nodes before this UTC date formatted like YYYY-MM. Date cannot be newer than the current time (required)"`
 	}
+	repairQueueCfg struct {
+		Database string `help:"satellite database connection string" releaseDefault:"postgres://" devDefault:"postgres://"`
+		Metainfo struct {
+			DatabaseURL string `help:"the database connection string to use" default:"postgres://"`
+		}
+
+		Limit       int    `help:"maximum number of segments to list" default:"100"`
+		Cursor      string `help:"list the segments after the cursor printed by the previous listing" default:""`
+		Placement   int    `help:"list only the segments with the placement, -1 lists all placements" default:"-1"`
+		NodeID      string `help:"list only the segments with a piece on the node" default:""`
+		ScanObjects bool   `help:"include the locations of the objects, WARNING: this scans the whole objects table of the metabase" default:"false"`
+	}
 
 	confDir     string
 	identityDir string
@@ -363,6 +424,14 @@ func init() {
 	rootCmd.AddCommand(registerLostSegments)
 	rootCmd.AddCommand(fetchPiecesCmd)
 	rootCmd.AddCommand(repairSegmentCmd)
+	rootCmd.AddCommand(placementCmd)
+	placementCmd.AddCommand(placementTestCmd)
+	placementCmd.AddCommand(placementReportCmd)
+	rootCmd.AddCommand(repairQueueCmd)
+	repairQueueCmd.AddCommand(repairQueueListCmd)
+	repairQueueCmd.AddCommand(repairQueueEnqueueCmd)
+	repairQueueCmd.AddCommand(repairQueueBumpCmd)
+	repairQueueCmd.AddCommand(repairQueueRemoveCmd)
 	reportsCmd.AddCommand(nodeUsageCmd)
 	reportsCmd.AddCommand(partnerAttributionCmd)
 	reportsCmd.AddCommand(reportsGracefulExitCmd)
@@ -392,6 +461,12 @@ func init() {
 	process.Bind(registerLostSegments, &runCfg, defaults, cfgstruct.ConfDir(confDir), cfgstruct.IdentityDir(identityDir))
 	process.Bind(fetchPiecesCmd, &runCfg, defaults, cfgstruct.ConfDir(confDir), cfgstruct.IdentityDir(identityDir))
 	process.Bind(repairSegmentCmd, &runCfg, defaults, cfgstruct.ConfDir(confDir), cfgstruct.IdentityDir(identityDir))
+	process.Bind(placementTestCmd, &runCfg, defaults, cfgstruct.ConfDir(confDir), cfgstruct.IdentityDir(identityDir))
+	process.Bind(placementReportCmd, &runCfg, defaults, cfgstruct.ConfDir(confDir), cfgstruct.IdentityDir(identityDir))
+	process.Bind(repairQueueListCmd, &repairQueueCfg, defaults, cfgstruct.ConfDir(confDir), cfgstruct.IdentityDir(identityDir))
+	process.Bind(repairQueueEnqueueCmd, &repairQueueCfg, defaults, cfgstruct.ConfDir(confDir), cfgstruct.IdentityDir(identityDir))
+	process.Bind(repairQueueBumpCmd, &repairQueueCfg, defaults, cfgstruct.ConfDir(confDir), cfgstruct.IdentityDir(identityDir))
+	process.Bind(repairQueueRemoveCmd, &repairQueueCfg, defaults, cfgstruct.ConfDir(confDir), cfgstruct.IdentityDir(identityDir))
 	process.Bind(setupCmd, &setupCfg, defaults, cfgstruct.ConfDir(confDir), cfgstruct.IdentityDir(identityDir), cfgstruct.SetupMode())
 	process.Bind(qdiagCmd, &qdiagCfg, defaults, cfgstruct.ConfDir(confDir), cfgstruct.IdentityDir(identityDir))
 	process.Bind(nodeUsageCmd, &nodeUsageCfg, defaults, cfgstruct.ConfDir(confDir), cfgstruct.IdentityDir(identityDir))
@@ -0,0 +1,153 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package main
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/common/storj"
+	"storj.io/common/uuid"
+	"storj.io/private/process"
+	"storj.io/storj/satellite/metabase"
+	"storj.io/storj/satellite/nodeselection/uploadselection"
+	"storj.io/storj/satellite/overlay"
+	"storj.io/storj/satellite/repair/repairer"
+	"storj.io/storj/satellite/satellitedb"
+)
+
+// cmdPlacementTest validates the configured placement rules and lists the
+// upload eligible nodes matching a placement or a node filter expression.
+func cmdPlacementTest(cmd *cobra.Command, args []string) (err error) {
+	ctx, _ := process.Ctx(cmd)
+	log := zap.L()
+
+	rules, err := uploadselection.LoadPlacementRules(runCfg.Overlay.Placement)
+	if err != nil {
+		return errs.New("invalid placement rules: %+v", err)
+	}
+
+	filter, description, err := placementFilter(rules, args[0])
+	if err != nil {
+		return err
+	}
+
+	db, err := satellitedb.Open(ctx, log.Named("db"), runCfg.Database, satellitedb.Options{ApplicationName: "satellite-placement-test"})
+	if err != nil {
+		return errs.New("Error creating satellite database connection: %+v", err)
+	}
+	defer func() {
+		err = errs.Combine(err, db.Close())
+	}()
+
+	reputable, new, err := db.OverlayCache().SelectAllStorageNodesUpload(ctx, runCfg.Overlay.Node)
+	if err != nil {
+		return errs.New("unable to select nodes: %+v", err)
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintf(w, "Placement:\t%s\n\n", description)
+	fmt.Fprintln(w, "Node ID\tAddress\tCountry\tContinent\tASN\tVersion\tVetted")
+
+	matching := 0
+	for _, nodes := range []struct {
+		nodes  []*overlay.SelectedNode
+		vetted bool
+	}{{reputable, true}, {new, false}} {
+		for _, node := range nodes.nodes {
+			if !node.MatchFilter(filter) {
+				continue
+			}
+			matching++
+			fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%d\t%s\t%t\n",
+				node.ID, node.Address.Address, node.CountryCode, uploadselection.Continent(node.CountryCode),
+				node.ASN, node.Version.String(), nodes.vetted)
+		}
+	}
+	fmt.Fprintf(w, "\n%d of %d upload eligible nodes match\n", matching, len(reputable)+len(new))
+
+	return errs.Wrap(w.Flush())
+}
+
+// cmdPlacementReport reports the placement compliance of the buckets.
+func cmdPlacementReport(cmd *cobra.Command, args []string) (err error) {
+	ctx, _ := process.Ctx(cmd)
+	log := zap.L()
+
+	projectID, err := uuid.FromString(args[0])
+	if err != nil {
+		return errs.New("invalid project-id (should be in UUID form): %+v", err)
+	}
+
+	db, err := satellitedb.Open(ctx, log.Named("db"), runCfg.Database, satellitedb.Options{ApplicationName: "satellite-placement-report"})
+	if err != nil {
+		return errs.New("Error creating satellite database connection: %+v", err)
+	}
+	defer func() {
+		err = errs.Combine(err, db.Close())
+	}()
+
+	metabaseDB, err := metabase.Open(ctx, log.Named("metabase"), runCfg.Metainfo.DatabaseURL,
+		runCfg.Config.Metainfo.Metabase("satellite-placement-report"))
+	if err != nil {
+		return errs.New("Error creating metabase connection: %+v", err)
+	}
+	defer func() {
+		err = errs.Combine(err, metabaseDB.Close())
+	}()
+
+	// mail service is nil
+	overlayService, err := overlay.NewService(log.Named("overlay"), db.OverlayCache(), db.NodeEvents(), nil, runCfg.Console.ExternalAddress, runCfg.Console.SatelliteName, runCfg.Overlay)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		err = errs.Combine(err, overlayService.Close())
+	}()
+
+	reporter := repairer.NewPlacementReporter(metabaseDB, overlayService)
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "Bucket\tSegments\tCompliant\tPieces\tOut of Placement\tProgress")
+	for _, bucketName := range args[1:] {
+		compliance, err := reporter.BucketCompliance(ctx, metabase.BucketLocation{
+			ProjectID:  projectID,
+			BucketName: bucketName,
+		})
+		if err != nil {
+			return errs.New("unable to report bucket %q: %+v", bucketName, err)
+		}
+		fmt.Fprintf(w, "%s\t%d\t%d\t%d\t%d\t%.2f%%\n",
+			compliance.BucketName, compliance.Segments, compliance.CompliantSegments,
+			compliance.Pieces, compliance.PiecesOutOfPlacement, compliance.Progress()*100)
+	}
+
+	return errs.Wrap(w.Flush())
+}
+
+// placementFilter returns the node filter of a placement number defined by
+// the rules, or of a node filter expression.
+func placementFilter(rules *uploadselection.PlacementRules, arg string) (_ uploadselection.NodeFilter, description string, err error) {
+	if id, err := strconv.ParseUint(arg, 10, 16); err == nil {
+		placement := storj.PlacementConstraint(id)
+		filter, ok := rules.Filter(placement)
+		if !ok {
+			return nil, "", errs.New("placement %d is not defined", placement)
+		}
+		definition, _ := rules.Definition(placement)
+		return filter, fmt.Sprintf("%d: %s", placement, definition), nil
+	}
+
+	filter, err := uploadselection.ParseNodeFilter(arg)
+	if err != nil {
+		return nil, "", errs.New("invalid node filter: %+v", err)
+	}
+	return filter, arg, nil
+}
@@ -0,0 +1,185 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/common/storj"
+	"storj.io/common/uuid"
+	"storj.io/private/process"
+	"storj.io/storj/satellite/metabase"
+	"storj.io/storj/satellite/repair/queue"
+	"storj.io/storj/satellite/satellitedb"
+)
+
+func cmdRepairQueueList(cmd *cobra.Command, args []string) (err error) {
+	ctx, _ := process.Ctx(cmd)
+
+	cursor, err := queue.ParseListCursor(repairQueueCfg.Cursor)
+	if err != nil {
+		return err
+	}
+	opts := queue.InspectOptions{
+		Cursor:         cursor,
+		Limit:          repairQueueCfg.Limit,
+		IncludeObjects: repairQueueCfg.ScanObjects,
+	}
+	if opts.IncludeObjects {
+		fmt.Fprintln(os.Stderr, "WARNING: listing the objects scans the whole objects table of the metabase")
+	}
+	if repairQueueCfg.Placement >= 0 {
+		placement := storj.PlacementConstraint(repairQueueCfg.Placement)
+		opts.Placement = &placement
+	}
+	if repairQueueCfg.NodeID != "" {
+		opts.NodeID, err = storj.NodeIDFromString(repairQueueCfg.NodeID)
+		if err != nil {
+			return errs.New("invalid node ID: %+v", err)
+		}
+	}
+
+	return withRepairQueueInspector(ctx, func(inspector *queue.Inspector) error {
+		result, err := inspector.List(ctx, opts)
+		if err != nil {
+			return err
+		}
+
+		w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+		header := "Stream ID\tPosition\tPriority\tHealth\tNode Risk\tTier\tInserted\tAttempted\tPlacement\tPieces"
+		if opts.IncludeObjects {
+			header += "\tProject ID\tBucket"
+		}
+		fmt.Fprintln(w, header)
+
+		for _, segment := range result.Segments {
+			attempted := "-"
+			if segment.AttemptedAt != nil {
+				attempted = segment.AttemptedAt.Format("2006-01-02 15:04")
+			}
+			placement, pieces := strconv.Itoa(int(segment.Placement)), strconv.Itoa(len(segment.Nodes))
+			if segment.Missing {
+				placement, pieces = "-", "missing"
+			}
+			fmt.Fprintf(w, "%s\t%d\t%g\t%g\t%g\t%g\t%s\t%s\t%s\t%s",
+				segment.StreamID, segment.Position.Encode(),
+				segment.Priority, segment.SegmentHealth, segment.NodeRisk, segment.Tier,
+				segment.InsertedAt.Format("2006-01-02 15:04"), attempted, placement, pieces)
+			if opts.IncludeObjects {
+				if segment.Object != nil {
+					fmt.Fprintf(w, "\t%s\t%s", segment.Object.ProjectID, segment.Object.BucketName)
+				} else {
+					fmt.Fprint(w, "\t-\t-")
+				}
+			}
+			fmt.Fprintln(w)
+		}
+		if err := w.Flush(); err != nil {
+			return err
+		}
+
+		if result.More {
+			fmt.Printf("\nMore segments may be queued, continue with --cursor %s\n", result.Cursor)
+		}
+		return nil
+	})
+}
+
+func cmdRepairQueueEnqueue(cmd *cobra.Command, args []string) (err error) {
+	ctx, _ := process.Ctx(cmd)
+
+	streamID, position, err := parseSegmentArgs(args[0], args[1])
+	if err != nil {
+		return err
+	}
+	var priority float64
+	if len(args) > 2 {
+		priority, err = strconv.ParseFloat(args[2], 64)
+		if err != nil {
+			return errs.New("priority must be a number: %+v", err)
+		}
+	}
+
+	return withRepairQueueInspector(ctx, func(inspector *queue.Inspector) error {
+		return inspector.Enqueue(ctx, streamID, position, priority)
+	})
+}
+
+func cmdRepairQueueBump(cmd *cobra.Command, args []string) (err error) {
+	ctx, _ := process.Ctx(cmd)
+
+	streamID, position, err := parseSegmentArgs(args[0], args[1])
+	if err != nil {
+		return err
+	}
+	priority, err := strconv.ParseFloat(args[2], 64)
+	if err != nil {
+		return errs.New("priority must be a number: %+v", err)
+	}
+
+	return withRepairQueueInspector(ctx, func(inspector *queue.Inspector) error {
+		return inspector.SetPriority(ctx, streamID, position, priority)
+	})
+}
+
+func cmdRepairQueueRemove(cmd *cobra.Command, args []string) (err error) {
+	ctx, _ := process.Ctx(cmd)
+
+	streamID, position, err := parseSegmentArgs(args[0], args[1])
+	if err != nil {
+		return err
+	}
+
+	return withRepairQueueInspector(ctx, func(inspector *queue.Inspector) error {
+		return inspector.Remove(ctx, streamID, position)
+	})
+}
+
+// withRepairQueueInspector opens the databases and calls fn with a repair
+// queue inspector.
+func withRepairQueueInspector(ctx context.Context, fn func(*queue.Inspector) error) (err error) {
+	log := zap.L()
+
+	db, err := satellitedb.Open(ctx, log.Named("db"), repairQueueCfg.Database, satellitedb.Options{ApplicationName: "satellite-repair-queue"})
+	if err != nil {
+		return errs.New("Error creating satellite database connection: %+v", err)
+	}
+	defer func() {
+		err = errs.Combine(err, db.Close())
+	}()
+
+	metabaseDB, err := metabase.Open(ctx, log.Named("metabase"), repairQueueCfg.Metainfo.DatabaseURL, metabase.Config{
+		ApplicationName: "satellite-repair-queue",
+	})
+	if err != nil {
+		return errs.New("Error creating metabase connection: %+v", err)
+	}
+	defer func() {
+		err = errs.Combine(err, metabaseDB.Close())
+	}()
+
+	return fn(queue.NewInspector(db.RepairQueue(), metabaseDB))
+}
+
+// parseSegmentArgs parses the stream id and the encoded position of a segment.
+func parseSegmentArgs(streamIDString, positionString string) (uuid.UUID, metabase.SegmentPosition, error) {
+	streamID, err := uuid.FromString(strings.TrimSpace(streamIDString))
+	if err != nil {
+		return uuid.UUID{}, metabase.SegmentPosition{}, errs.New("invalid stream-id (should be in UUID form): %w", err)
+	}
+	position, err := strconv.ParseUint(strings.TrimSpace(positionString), 10, 64)
+	if err != nil {
+		return uuid.UUID{}, metabase.SegmentPosition{}, errs.New("stream position must be a number: %w", err)
+	}
+	return streamID, metabase.SegmentPositionFromEncoded(position), nil
+}
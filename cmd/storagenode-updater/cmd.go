@@ -65,6 +65,12 @@ var (
 
 		BinaryLocation string `help:"the storage node executable binary location" default:"storagenode"`
 		ServiceName    string `help:"storage node OS service name" default:"storagenode"`
+
+		Channel         string        `help:"release channel to follow, stable or beta, unless changed from the dashboard" default:"stable"`
+		UpdateWindow    string        `help:"daily local time window in which the storage node is updated, e.g. 02:00-05:00, unless changed from the dashboard; any time when empty" default:""`
+		SettingsPath    string        `help:"path to the file with the update settings changed from the storage node dashboard" default:"$CONFDIR/updater-settings.json"`
+		HealthCheckURL  string        `help:"storage node health check url used to verify an update" default:"http://127.0.0.1:28967/health/live"`
+		RollbackTimeout time.Duration `help:"how long an updated storage node has to pass its health check before the previous binary is restored, 0 disables the rollback" default:"0s"`
 		// deprecated
 		Log string `help:"deprecated, use --log.output" default:""`
 	}
@@ -114,6 +120,10 @@ func cmdRun(cmd *cobra.Command, args []string) (err error) {
 		zap.L().Fatal("Empty node ID.")
 	}
 
+	if err := (checker.UpdateSettings{Channel: runCfg.Channel, Window: runCfg.UpdateWindow}).Validate(); err != nil {
+		zap.L().Fatal("Invalid update settings.", zap.Error(err))
+	}
+
 	zap.L().Info("Running on version",
 		zap.String("Service", updaterServiceName),
 		zap.String("Version", version.Build.Version.String()),
@@ -129,6 +129,81 @@ func TestAutoUpdater(t *testing.T) {
 	require.NotZero(t, backupUpdaterInfo.Size())
 }
 
+func TestAutoUpdaterRollback(t *testing.T) {
+	ctx := testcontext.New(t)
+	defer ctx.Cleanup()
+
+	oldSemVer, err := version.NewSemVer(oldVersion)
+	require.NoError(t, err)
+
+	newSemVer, err := version.NewSemVer(newVersion)
+	require.NoError(t, err)
+
+	oldBin := CompileWithVersion(ctx, "storj.io/storj/cmd/storagenode-updater", version.Info{
+		Timestamp: time.Now(),
+		Version:   oldSemVer,
+	})
+	storagenodePath := ctx.File("fake", "storagenode.exe")
+	copyBin(ctx, t, oldBin, storagenodePath)
+
+	updaterPath := ctx.File("fake", "storagenode-updater.exe")
+	move(t, oldBin, updaterPath)
+
+	newBin := CompileWithVersion(ctx, "storj.io/storj/cmd/storagenode-updater", version.Info{
+		Timestamp: time.Now(),
+		Version:   newSemVer,
+	})
+
+	versionControlPeer, cleanupVersionControl := testVersionControlWithUpdates(ctx, t, map[string]string{
+		"storagenode":         newBin,
+		"storagenode-updater": newBin,
+	})
+	defer cleanupVersionControl()
+
+	// the updated storage node never becomes healthy.
+	health := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer health.Close()
+
+	logPath := ctx.File("storagenode-updater.log")
+	identConfig := testIdentityFiles(ctx, t)
+
+	args := []string{"run",
+		"--config-dir", ctx.Dir(),
+		"--version.server-address", "http://" + versionControlPeer.Addr(),
+		"--binary-location", storagenodePath,
+		"--version.check-interval", "0s",
+		"--identity.cert-path", identConfig.CertPath,
+		"--identity.key-path", identConfig.KeyPath,
+		"--health-check-url", health.URL,
+		"--rollback-timeout", "1s",
+		"--log", logPath,
+	}
+
+	out, err := exec.Command(updaterPath, args...).CombinedOutput()
+	logData, logErr := os.ReadFile(logPath)
+	if assert.NoError(t, logErr) {
+		logStr := string(logData)
+		t.Log(logStr)
+		assert.Contains(t, logStr, `Updated service failed its health check, restoring the previous version.`)
+		assert.Contains(t, logStr, `Previous version restored.	{"Process": "storagenode-updater", "Service": "storagenode", "Version": "`+oldVersion+`"}`)
+	} else {
+		t.Log(string(out))
+	}
+	require.NoError(t, err)
+
+	// the previous version is running again and the new one isn't retried.
+	out, err = exec.Command(storagenodePath, "version").CombinedOutput()
+	require.NoError(t, err)
+	require.Contains(t, string(out), "Version: "+oldVersion)
+
+	failedStoragenode := ctx.File("fake", "storagenode.failed."+newVersion+".exe")
+	failedStoragenodeInfo, err := os.Stat(failedStoragenode)
+	require.NoError(t, err)
+	require.NotZero(t, failedStoragenodeInfo.Size())
+}
+
 // CompileWithVersion compiles the specified package with the version variables set
 // to the passed version info values and returns the executable name.
 func CompileWithVersion(ctx *testcontext.Context, pkg string, info version.Info) string {
@@ -10,6 +10,7 @@ import (
 	"context"
 	"os"
 	"os/exec"
+	"time"
 
 	"github.com/zeebo/errs"
 	"go.uber.org/zap"
@@ -28,12 +29,36 @@ func loopFunc(ctx context.Context) error {
 		return nil
 	}
 
-	if err := update(ctx, runCfg.ServiceName, runCfg.BinaryLocation, all.Processes.Storagenode); err != nil {
-		// don't finish loop in case of error just wait for another execution
-		zap.L().Error("Error updating service.", zap.String("Service", runCfg.ServiceName), zap.Error(err))
+	channel, window, err := updateSettings()
+	if err != nil {
+		zap.L().Error("Error loading update settings.", zap.Error(err))
+		return nil
+	}
+
+	storagenodeProcess, err := checker.ChannelProcess(all.Processes.Storagenode, channel)
+	if err != nil {
+		zap.L().Error("Error selecting release channel.", zap.Error(err))
+		return nil
+	}
+	updaterProcess, err := checker.ChannelProcess(all.Processes.StoragenodeUpdater, channel)
+	if err != nil {
+		zap.L().Error("Error selecting release channel.", zap.Error(err))
+		return nil
+	}
+
+	if window.Contains(time.Now()) {
+		if err := update(ctx, runCfg.ServiceName, runCfg.BinaryLocation, storagenodeProcess); err != nil {
+			// don't finish loop in case of error just wait for another execution
+			zap.L().Error("Error updating service.", zap.String("Service", runCfg.ServiceName), zap.Error(err))
+		}
+	} else {
+		zap.L().Info("Outside of the update window, the update is postponed.",
+			zap.String("Service", runCfg.ServiceName),
+			zap.Stringer("Update Window", window),
+		)
 	}
 
-	if err := updateSelf(ctx, updaterBinaryPath, all.Processes.StoragenodeUpdater); err != nil {
+	if err := updateSelf(ctx, updaterBinaryPath, updaterProcess); err != nil {
 		// don't finish loop in case of error just wait for another execution
 		zap.L().Error("Error updating service.", zap.String("Service", updaterServiceName), zap.Error(err))
 	}
@@ -8,15 +8,24 @@ package main
 
 import (
 	"context"
+	"errors"
 	"os"
 	"os/exec"
 	"strconv"
 	"strings"
+	"syscall"
+	"time"
 
 	"github.com/spf13/cobra"
 	"github.com/zeebo/errs"
+
+	"storj.io/common/sync2"
 )
 
+// stopTimeout is how long the stopped service has to exit, e.g. to finish its
+// graceful shutdown, before restarting it is considered failed.
+const stopTimeout = 5 * time.Minute
+
 func cmdRestart(cmd *cobra.Command, args []string) error {
 	return nil
 }
@@ -34,7 +43,7 @@ func restartService(ctx context.Context, service, binaryLocation, newVersionPath
 		os.Exit(1)
 	}
 
-	if err := stopProcess(service); err != nil {
+	if err := stopProcess(ctx, service); err != nil {
 		err = errs.New("error stopping %s service: %v", service, err)
 		return errs.Combine(err, os.Rename(backupPath, binaryLocation))
 	}
@@ -42,7 +51,10 @@ func restartService(ctx context.Context, service, binaryLocation, newVersionPath
 	return nil
 }
 
-func stopProcess(service string) (err error) {
+// stopProcess interrupts the main process of the service, which is restarted
+// by systemd, and waits for it to exit. Otherwise the health check of the new
+// binary could reach the old process while it's shutting down.
+func stopProcess(ctx context.Context, service string) (err error) {
 	pid, err := getServicePID(service)
 	if err != nil {
 		return err
@@ -51,8 +63,25 @@ func stopProcess(service string) (err error) {
 	if err != nil {
 		return err
 	}
-	return p.Signal(os.Interrupt)
+	if err := p.Signal(os.Interrupt); err != nil {
+		return err
+	}
+	return waitExited(ctx, pid, stopTimeout)
+}
 
+// waitExited waits until the process with the pid doesn't exist anymore.
+func waitExited(ctx context.Context, pid int, timeout time.Duration) error {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	for {
+		if err := syscall.Kill(pid, 0); errors.Is(err, syscall.ESRCH) {
+			return nil
+		}
+		if !sync2.Sleep(ctx, time.Second) {
+			return errs.New("process %d didn't exit: %v", pid, ctx.Err())
+		}
+	}
 }
 
 func getServicePID(service string) (int, error) {
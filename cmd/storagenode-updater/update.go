@@ -5,14 +5,47 @@ package main
 
 import (
 	"context"
+	"io"
+	"net/http"
 	"os"
+	"time"
 
 	"github.com/zeebo/errs"
 	"go.uber.org/zap"
 
 	"storj.io/private/version"
+	"storj.io/storj/private/version/checker"
 )
 
+// healthCheckInterval is how often the health of an updated service is
+// checked until it passes or the rollback timeout is reached.
+const healthCheckInterval = 15 * time.Second
+
+// updateSettings returns the release channel and the update window of the
+// configuration, overridden by the settings changed from the dashboard.
+func updateSettings() (channel string, window checker.UpdateWindow, err error) {
+	settings, err := checker.LoadUpdateSettings(runCfg.SettingsPath)
+	if err != nil {
+		return "", checker.UpdateWindow{}, errs.Wrap(err)
+	}
+
+	channel = runCfg.Channel
+	if settings.Channel != "" {
+		channel = settings.Channel
+	}
+
+	windowValue := runCfg.UpdateWindow
+	if settings.Window != "" {
+		windowValue = settings.Window
+	}
+	window, err = checker.ParseUpdateWindow(windowValue)
+	if err != nil {
+		return "", checker.UpdateWindow{}, errs.Wrap(err)
+	}
+
+	return channel, window, nil
+}
+
 func update(ctx context.Context, serviceName, binaryLocation string, ver version.Process) error {
 	currentVersion, err := binaryVersion(binaryLocation)
 	if err != nil {
@@ -34,6 +67,16 @@ func update(ctx context.Context, serviceName, binaryLocation string, ver version
 		return nil
 	}
 
+	failedVersionPath := prependExtension(binaryLocation, "failed."+newVersion.Version)
+	if fileExists(failedVersionPath) {
+		zap.L().Info("New version failed its health check before, skipping it.",
+			zap.String("Service", serviceName),
+			zap.String("Version", newVersion.Version),
+			zap.String("Failed Binary", failedVersionPath),
+		)
+		return nil
+	}
+
 	newVersionPath := prependExtension(binaryLocation, newVersion.Version)
 
 	if err = downloadBinary(ctx, parseDownloadURL(newVersion.URL), newVersionPath); err != nil {
@@ -70,5 +113,90 @@ func update(ctx context.Context, serviceName, binaryLocation string, ver version
 	}
 
 	zap.L().Info("Service restarted successfully.", zap.String("Service", serviceName))
+
+	if serviceName == updaterServiceName || runCfg.RollbackTimeout <= 0 {
+		return nil
+	}
+
+	if err := waitHealthy(ctx, runCfg.HealthCheckURL, runCfg.RollbackTimeout); err != nil {
+		zap.L().Error("Updated service failed its health check, restoring the previous version.",
+			zap.String("Service", serviceName),
+			zap.String("Version", newVersion.Version),
+			zap.Error(err),
+		)
+
+		// NB: restart the service with a copy of the backup, since restartService
+		// removes the new binary when it fails.
+		rollbackPath := prependExtension(binaryLocation, "rollback."+currentVersion.String())
+		if err := copyBinary(backupPath, rollbackPath); err != nil {
+			return errs.New("unable to restore the previous version: %w", err)
+		}
+		if err := restartService(ctx, serviceName, binaryLocation, rollbackPath, failedVersionPath); err != nil {
+			return errs.New("unable to restore the previous version: %w", err)
+		}
+
+		zap.L().Info("Previous version restored.",
+			zap.String("Service", serviceName),
+			zap.String("Version", currentVersion.String()),
+		)
+		return nil
+	}
+
+	zap.L().Info("Updated service passed its health check.", zap.String("Service", serviceName))
+	return nil
+}
+
+// waitHealthy waits until the health check at url succeeds, or fails once
+// the timeout is reached.
+func waitHealthy(ctx context.Context, url string, timeout time.Duration) (err error) {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	ticker := time.NewTicker(healthCheckInterval)
+	defer ticker.Stop()
+
+	for {
+		err = checkHealth(ctx, url)
+		if err == nil {
+			return nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return err
+		case <-ticker.C:
+		}
+	}
+}
+
+// copyBinary copies the executable binary at src to dst.
+func copyBinary(src, dst string) (err error) {
+	source, err := os.Open(src)
+	if err != nil {
+		return errs.Wrap(err)
+	}
+	defer func() { err = errs.Combine(err, source.Close()) }()
+
+	target, err := os.OpenFile(dst, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, os.FileMode(0755))
+	if err != nil {
+		return errs.Wrap(err)
+	}
+	defer func() { err = errs.Combine(err, target.Close()) }()
+
+	_, err = io.Copy(target, source)
+	return errs.Wrap(err)
+}
+
+// checkHealth returns an error when the health check at url doesn't succeed.
+func checkHealth(ctx context.Context, url string) (err error) {
+	resp, err := httpGet(ctx, url)
+	if err != nil {
+		return errs.Wrap(err)
+	}
+	defer func() { err = errs.Combine(err, resp.Body.Close()) }()
+
+	if resp.StatusCode != http.StatusOK {
+		return errs.New("bad status: %s", resp.Status)
+	}
 	return nil
 }
@@ -0,0 +1,93 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package main
+
+import (
+	"fmt"
+	"os"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/common/memory"
+	"storj.io/private/process"
+	"storj.io/storj/storage/filestore"
+	"storj.io/storj/storagenode/fsck"
+	"storj.io/storj/storagenode/storagenodedb"
+)
+
+func cmdFsck(cmd *cobra.Command, args []string) (err error) {
+	ctx, _ := process.Ctx(cmd)
+	log := zap.L()
+
+	dbConfig := fsckCfg.DatabaseConfig()
+
+	db, err := storagenodedb.OpenExisting(ctx, log.Named("db"), dbConfig)
+	if err != nil {
+		return errs.New("Error opening storagenode database: %+v", err)
+	}
+	defer func() {
+		err = errs.Combine(err, db.Close())
+	}()
+
+	dir, err := filestore.OpenDir(log.Named("filestore"), dbConfig.Pieces)
+	if err != nil {
+		return errs.New("Error opening storage directory: %+v", err)
+	}
+	blobs := filestore.New(log.Named("filestore"), dir, dbConfig.Filestore)
+	defer func() {
+		err = errs.Combine(err, blobs.Close())
+	}()
+
+	config := fsck.Config{
+		Repair:         fsckCfg.Repair,
+		TrashRetention: fsckCfg.TrashRetention,
+	}
+
+	checker := fsck.NewChecker(log.Named("fsck"), config, dir, blobs, db.V0PieceInfo(), db.PieceExpirationDB())
+	report, checkErr := checker.Check(ctx)
+	if report == nil {
+		return checkErr
+	}
+
+	if fsckCfg.Verbose {
+		w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+		fmt.Fprintln(w, "Problem\tSatellite ID\tPiece ID\tPath\tDetails\tRepaired\t")
+		for _, problem := range report.Problems {
+			satelliteID, pieceID := "", ""
+			if !problem.SatelliteID.IsZero() {
+				satelliteID = problem.SatelliteID.String()
+				pieceID = problem.PieceID.String()
+			}
+			fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%t\t\n", problem.Kind, satelliteID, pieceID, problem.Path, problem.Details, problem.Repaired)
+		}
+		if err := w.Flush(); err != nil {
+			return errs.Combine(checkErr, err)
+		}
+		fmt.Println()
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "Problem\tCount\tSize\t")
+	for _, kind := range fsck.ProblemKinds {
+		count, size := report.Count(kind)
+		fmt.Fprintf(w, "%s\t%d\t%s\t\n", kind, count, memory.Size(size).Base10String())
+	}
+	if err := w.Flush(); err != nil {
+		return errs.Combine(checkErr, err)
+	}
+
+	switch {
+	case len(report.Problems) == 0:
+		fmt.Println("\nNo problems found.")
+	case fsckCfg.Repair:
+		fmt.Println("\nProblems were repaired. Space usage will be recalculated on the next start of the node.")
+	default:
+		fmt.Println("\nRun with --repair to fix the problems.")
+	}
+
+	return checkErr
+}
@@ -16,6 +16,7 @@ import (
 	"github.com/zeebo/errs"
 	"go.uber.org/zap"
 
+	"storj.io/common/context2"
 	"storj.io/common/fpath"
 	"storj.io/common/memory"
 	"storj.io/common/storj"
@@ -27,6 +28,7 @@ import (
 	_ "storj.io/storj/private/version" // This attaches version information during release builds.
 	"storj.io/storj/storagenode"
 	"storj.io/storj/storagenode/apikeys"
+	"storj.io/storj/storagenode/piecemigration"
 	"storj.io/storj/storagenode/storagenodedb"
 )
 
@@ -88,6 +90,89 @@ var (
 		RunE:        cmdGracefulExitStatus,
 		Annotations: map[string]string{"type": "helper"},
 	}
+	shrinkInitCmd = &cobra.Command{
+		Use:   "shrink-satellite <satellite id> <amount>",
+		Short: "Initiate moving part of the data off the node",
+		Long: "Initiate moving the given amount of data stored for a satellite to other nodes, e.g. 500GB.\n" +
+			"Unlike graceful exit, the node keeps receiving new uploads from the satellite.",
+		RunE:        cmdShrinkInit,
+		Args:        cobra.ExactArgs(2),
+		Annotations: map[string]string{"type": "helper"},
+	}
+	shrinkStatusCmd = &cobra.Command{
+		Use:         "shrink-status",
+		Short:       "Display the status of moving part of the data off the node",
+		RunE:        cmdShrinkStatus,
+		Args:        cobra.NoArgs,
+		Annotations: map[string]string{"type": "helper"},
+	}
+	fsckCmd = &cobra.Command{
+		Use:   "fsck",
+		Short: "Check consistency of the stored pieces",
+		Long: "Check the consistency between the stored pieces and the storage node databases.\n" +
+			"The storage node must not be running while the check is in progress. " +
+			"Problems are only reported unless --repair is specified.",
+		RunE:        cmdFsck,
+		Args:        cobra.NoArgs,
+		Annotations: map[string]string{"type": "helper"},
+	}
+	maintenanceCmd = &cobra.Command{
+		Use:   "maintenance [on|off]",
+		Short: "Display or change the maintenance mode",
+		Long: "Display or change the maintenance mode of a running storage node.\n" +
+			"While in maintenance mode the node does not accept new uploads, " +
+			"but it still serves downloads, audits and garbage collection.",
+		RunE:        cmdMaintenance,
+		Args:        cobra.RangeArgs(0, 1),
+		Annotations: map[string]string{"type": "helper"},
+	}
+	migrateStorageCmd = &cobra.Command{
+		Use:   "migrate-storage [destination]",
+		Short: "Move the pieces to a new storage directory",
+		Long: "Move the pieces of a running storage node to a new storage directory, or display the progress of the move.\n" +
+			"The pieces are copied while the node keeps running. Once all of them are copied, " +
+			"restarting the node copies the last changes and switches it to the new storage directory.",
+		RunE:        cmdMigrateStorage,
+		Args:        cobra.RangeArgs(0, 1),
+		Annotations: map[string]string{"type": "helper"},
+	}
+	ordersCmd = &cobra.Command{
+		Use:   "orders",
+		Short: "Inspect or repair the unsent orders files",
+	}
+	ordersInspectCmd = &cobra.Command{
+		Use:   "inspect",
+		Short: "Display the unsent orders per satellite and window",
+		Long: "Display the number and the total amount of the unsent orders per satellite and window, " +
+			"and the number of corrupt entries in the orders files.",
+		RunE:        cmdOrdersInspect,
+		Args:        cobra.NoArgs,
+		Annotations: map[string]string{"type": "helper"},
+	}
+	ordersRepairCmd = &cobra.Command{
+		Use:   "repair",
+		Short: "Rewrite the unsent orders files with corrupt entries",
+		Long: "Rewrite the unsent orders files with corrupt entries, so that they only contain their readable orders.\n" +
+			"The storage node must not be running while the files are repaired.",
+		RunE:        cmdOrdersRepair,
+		Args:        cobra.NoArgs,
+		Annotations: map[string]string{"type": "helper"},
+	}
+	payoutsCmd = &cobra.Command{
+		Use:   "payouts",
+		Short: "Export the payout statements",
+	}
+	payoutsExportCmd = &cobra.Command{
+		Use:   "export <start period> [end period]",
+		Short: "Export the payout statements per satellite and period",
+		Long: "Export the payout statements per satellite and period of a running storage node, " +
+			"with the held and disposed amounts, the surge percentages, the payment receipts and the yearly totals.\n" +
+			"Periods are formatted as yyyy-mm, the end period defaults to the start period. " +
+			"The periods without paystub yet are estimated and excluded from the yearly totals.",
+		RunE:        cmdPayoutsExport,
+		Args:        cobra.RangeArgs(1, 2),
+		Annotations: map[string]string{"type": "helper"},
+	}
 	issueAPITokenCmd = &cobra.Command{
 		Use:   "issue-apikey",
 		Short: "Issue apikey for multinode",
@@ -126,6 +211,13 @@ $ storagenode info --json --config-dir '<path/to/config-dir>' --identity-dir '<p
 
 		JSON bool `default:"false" help:"print node info in JSON format"`
 	}
+	fsckCfg struct {
+		storagenode.Config
+
+		Repair         bool          `default:"false" help:"fix the found problems instead of only reporting them"`
+		TrashRetention time.Duration `default:"168h0m0s" help:"how long pieces are kept in the trash before they are deleted"`
+		Verbose        bool          `default:"false" help:"print every found problem"`
+	}
 	dashboardCfg struct {
 		Address string `default:"127.0.0.1:7778" help:"address for dashboard service"`
 	}
@@ -156,6 +248,16 @@ func init() {
 	rootCmd.AddCommand(dashboardCmd)
 	rootCmd.AddCommand(gracefulExitInitCmd)
 	rootCmd.AddCommand(gracefulExitStatusCmd)
+	rootCmd.AddCommand(shrinkInitCmd)
+	rootCmd.AddCommand(shrinkStatusCmd)
+	rootCmd.AddCommand(fsckCmd)
+	rootCmd.AddCommand(maintenanceCmd)
+	rootCmd.AddCommand(migrateStorageCmd)
+	rootCmd.AddCommand(ordersCmd)
+	ordersCmd.AddCommand(ordersInspectCmd)
+	ordersCmd.AddCommand(ordersRepairCmd)
+	rootCmd.AddCommand(payoutsCmd)
+	payoutsCmd.AddCommand(payoutsExportCmd)
 	rootCmd.AddCommand(issueAPITokenCmd)
 	rootCmd.AddCommand(nodeInfoCmd)
 	process.Bind(runCmd, &runCfg, defaults, cfgstruct.ConfDir(confDir), cfgstruct.IdentityDir(identityDir))
@@ -165,6 +267,14 @@ func init() {
 	process.Bind(dashboardCmd, &dashboardCfg, defaults, cfgstruct.ConfDir(defaultDiagDir))
 	process.Bind(gracefulExitInitCmd, &diagCfg, defaults, cfgstruct.ConfDir(defaultDiagDir))
 	process.Bind(gracefulExitStatusCmd, &diagCfg, defaults, cfgstruct.ConfDir(defaultDiagDir))
+	process.Bind(shrinkInitCmd, &diagCfg, defaults, cfgstruct.ConfDir(defaultDiagDir))
+	process.Bind(shrinkStatusCmd, &diagCfg, defaults, cfgstruct.ConfDir(defaultDiagDir))
+	process.Bind(fsckCmd, &fsckCfg, defaults, cfgstruct.ConfDir(confDir), cfgstruct.IdentityDir(identityDir))
+	process.Bind(maintenanceCmd, &diagCfg, defaults, cfgstruct.ConfDir(confDir), cfgstruct.IdentityDir(identityDir))
+	process.Bind(migrateStorageCmd, &diagCfg, defaults, cfgstruct.ConfDir(confDir), cfgstruct.IdentityDir(identityDir))
+	process.Bind(ordersInspectCmd, &diagCfg, defaults, cfgstruct.ConfDir(confDir), cfgstruct.IdentityDir(identityDir))
+	process.Bind(ordersRepairCmd, &diagCfg, defaults, cfgstruct.ConfDir(confDir), cfgstruct.IdentityDir(identityDir))
+	process.Bind(payoutsExportCmd, &payoutsExportCfg, defaults, cfgstruct.ConfDir(confDir), cfgstruct.IdentityDir(identityDir))
 	process.Bind(issueAPITokenCmd, &diagCfg, defaults, cfgstruct.ConfDir(confDir), cfgstruct.IdentityDir(identityDir))
 	process.Bind(nodeInfoCmd, &nodeInfoCfg, defaults, cfgstruct.ConfDir(confDir), cfgstruct.IdentityDir(identityDir))
 }
@@ -179,6 +289,16 @@ func cmdRun(cmd *cobra.Command, args []string) (err error) {
 
 	mapDeprecatedConfigs(log)
 
+	storagePath, err := piecemigration.StoragePath(runCfg.StorageMigration, runCfg.Storage.Path)
+	if err != nil {
+		return errs.New("Error checking storage migration: %+v", err)
+	}
+	if storagePath != runCfg.Storage.Path {
+		log.Warn("Pieces were moved to a new storage directory, using it instead of the configured one. Update storage.path in the config file.",
+			zap.String("Configured", runCfg.Storage.Path), zap.String("Storage Path", storagePath))
+		runCfg.Storage.Path = storagePath
+	}
+
 	identity, err := runCfg.Identity.Load()
 	if err != nil {
 		log.Error("Failed to load identity.", zap.Error(err))
@@ -190,6 +310,15 @@ func cmdRun(cmd *cobra.Command, args []string) (err error) {
 		return err
 	}
 
+	// the storage migration is finalized after the databases are closed, so
+	// they can be copied safely.
+	var migration *piecemigration.Service
+	defer func() {
+		if migration != nil {
+			err = errs.Combine(err, migration.Finalize(context2.WithoutCancellation(ctx)))
+		}
+	}()
+
 	db, err := storagenodedb.OpenExisting(ctx, log.Named("db"), runCfg.DatabaseConfig())
 	if err != nil {
 		return errs.New("Error starting master database on storagenode: %+v", err)
@@ -211,6 +340,7 @@ func cmdRun(cmd *cobra.Command, args []string) (err error) {
 	if err != nil {
 		return err
 	}
+	migration = peer.Storage2.Migration
 
 	// okay, start doing stuff ====
 
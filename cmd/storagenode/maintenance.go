@@ -0,0 +1,94 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/spf13/cobra"
+	"github.com/zeebo/errs"
+
+	"storj.io/private/process"
+	"storj.io/storj/storagenode/maintenance"
+)
+
+// maintenanceURL returns the console api url used to manage the maintenance mode.
+func maintenanceURL(consoleAddress string) string {
+	return fmt.Sprintf("http://%s/api/sno/maintenance", consoleAddress)
+}
+
+func cmdMaintenance(cmd *cobra.Command, args []string) (err error) {
+	ctx, _ := process.Ctx(cmd)
+
+	var state maintenance.State
+	switch {
+	case len(args) == 0:
+		state, err = getMaintenanceState(ctx, diagCfg.Console.Address)
+	case args[0] == "on":
+		state, err = setMaintenanceState(ctx, diagCfg.Console.Address, true)
+	case args[0] == "off":
+		state, err = setMaintenanceState(ctx, diagCfg.Console.Address, false)
+	default:
+		return errs.New("unknown argument %q, expected on or off", args[0])
+	}
+	if err != nil {
+		return err
+	}
+
+	if !state.Enabled {
+		fmt.Println("Maintenance mode is disabled, the node accepts new uploads.")
+		return nil
+	}
+	fmt.Printf("Maintenance mode is enabled since %s, the node does not accept new uploads.\n", state.Since.Local().Format("2006-01-02 15:04:05"))
+	return nil
+}
+
+func getMaintenanceState(ctx context.Context, consoleAddress string) (state maintenance.State, err error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, maintenanceURL(consoleAddress), nil)
+	if err != nil {
+		return state, errs.Wrap(err)
+	}
+	return doMaintenanceRequest(req)
+}
+
+func setMaintenanceState(ctx context.Context, consoleAddress string, enabled bool) (state maintenance.State, err error) {
+	body, err := json.Marshal(struct {
+		Enabled bool `json:"enabled"`
+	}{Enabled: enabled})
+	if err != nil {
+		return state, errs.Wrap(err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, maintenanceURL(consoleAddress), bytes.NewReader(body))
+	if err != nil {
+		return state, errs.Wrap(err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	return doMaintenanceRequest(req)
+}
+
+func doMaintenanceRequest(req *http.Request) (state maintenance.State, err error) {
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return state, errs.New("unable to reach the storage node, is it running? %w", err)
+	}
+	defer func() { err = errs.Combine(err, resp.Body.Close()) }()
+
+	if resp.StatusCode != http.StatusOK {
+		var response struct {
+			Error string `json:"error"`
+		}
+		_ = json.NewDecoder(resp.Body).Decode(&response)
+		return state, errs.New("unexpected status %d: %s", resp.StatusCode, response.Error)
+	}
+
+	if err := json.NewDecoder(resp.Body).Decode(&state); err != nil {
+		return state, errs.Wrap(err)
+	}
+	return state, nil
+}
@@ -0,0 +1,71 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package main
+
+import (
+	"fmt"
+	"os"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/common/memory"
+	"storj.io/private/process"
+	"storj.io/storj/storagenode/orders"
+)
+
+func cmdOrdersInspect(cmd *cobra.Command, args []string) (err error) {
+	return runOrdersCommand(cmd, false)
+}
+
+func cmdOrdersRepair(cmd *cobra.Command, args []string) (err error) {
+	return runOrdersCommand(cmd, true)
+}
+
+func runOrdersCommand(cmd *cobra.Command, repair bool) (err error) {
+	ctx, _ := process.Ctx(cmd)
+	log := zap.L()
+
+	store, err := orders.NewFileStore(log.Named("ordersfilestore"), diagCfg.Storage2.Orders.Path, diagCfg.Storage2.OrderLimitGracePeriod)
+	if err != nil {
+		return errs.New("Error opening orders directory: %+v", err)
+	}
+
+	var summaries []orders.UnsentFileSummary
+	if repair {
+		summaries, err = store.RepairUnsent(ctx)
+	} else {
+		summaries, err = store.InspectUnsent(ctx)
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "Satellite ID\tWindow\tVersion\tOrders\tAmount\tCorrupt Entries\tRepaired\t")
+	corrupted := 0
+	for _, summary := range summaries {
+		if summary.CorruptEntries > 0 && !summary.Repaired {
+			corrupted++
+		}
+		fmt.Fprintf(w, "%s\t%s\t%s\t%d\t%s\t%d\t%t\t\n",
+			summary.SatelliteID,
+			summary.CreatedAtHour.UTC().Format("2006-01-02 15:04"),
+			summary.Version,
+			summary.Orders,
+			memory.Size(summary.Amount).Base10String(),
+			summary.CorruptEntries,
+			summary.Repaired)
+	}
+	if flushErr := w.Flush(); flushErr != nil {
+		return errs.Combine(err, flushErr)
+	}
+
+	switch {
+	case len(summaries) == 0:
+		fmt.Println("\nNo unsent orders found.")
+	case corrupted > 0:
+		fmt.Printf("\n%d files have corrupt entries. Stop the node and run 'storagenode orders repair' to rewrite them.\n", corrupted)
+	}
+	return err
+}
@@ -0,0 +1,90 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+
+	"github.com/spf13/cobra"
+	"github.com/zeebo/errs"
+
+	"storj.io/common/storj"
+	"storj.io/private/process"
+	"storj.io/storj/storagenode"
+)
+
+var payoutsExportCfg struct {
+	storagenode.Config
+
+	Satellite string `help:"export only the statements of this satellite ID" default:""`
+	Format    string `help:"format of the export, csv or json" default:"csv"`
+	Output    string `help:"file to write the export to, standard output when empty" default:""`
+}
+
+// payoutStatementsURL returns the console api url used to export the payout statements.
+func payoutStatementsURL(consoleAddress, start, end, satellite, format string) string {
+	query := url.Values{}
+	query.Set("format", format)
+	if satellite != "" {
+		query.Set("id", satellite)
+	}
+	return fmt.Sprintf("http://%s/api/heldamount/statements/%s/%s?%s",
+		consoleAddress, url.PathEscape(start), url.PathEscape(end), query.Encode())
+}
+
+func cmdPayoutsExport(cmd *cobra.Command, args []string) (err error) {
+	ctx, _ := process.Ctx(cmd)
+
+	start, end := args[0], args[0]
+	if len(args) > 1 {
+		end = args[1]
+	}
+
+	if payoutsExportCfg.Format != "csv" && payoutsExportCfg.Format != "json" {
+		return errs.New("unknown format %q, expected csv or json", payoutsExportCfg.Format)
+	}
+	if payoutsExportCfg.Satellite != "" {
+		if _, err := storj.NodeIDFromString(payoutsExportCfg.Satellite); err != nil {
+			return errs.New("invalid satellite ID %q: %w", payoutsExportCfg.Satellite, err)
+		}
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet,
+		payoutStatementsURL(payoutsExportCfg.Console.Address, start, end, payoutsExportCfg.Satellite, payoutsExportCfg.Format), nil)
+	if err != nil {
+		return errs.Wrap(err)
+	}
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return errs.New("unable to reach the storage node, is it running? %w", err)
+	}
+	defer func() { err = errs.Combine(err, resp.Body.Close()) }()
+
+	if resp.StatusCode != http.StatusOK {
+		var response struct {
+			Error string `json:"error"`
+		}
+		_ = json.NewDecoder(resp.Body).Decode(&response)
+		return errs.New("unexpected status %d: %s", resp.StatusCode, response.Error)
+	}
+
+	var out io.Writer = os.Stdout
+	if payoutsExportCfg.Output != "" {
+		file, err := os.Create(payoutsExportCfg.Output)
+		if err != nil {
+			return errs.Wrap(err)
+		}
+		defer func() { err = errs.Combine(err, file.Close()) }()
+		out = file
+	}
+
+	_, err = io.Copy(out, resp.Body)
+	return errs.Wrap(err)
+}
@@ -0,0 +1,112 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package main
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/common/memory"
+	"storj.io/common/storj"
+	"storj.io/private/process"
+	"storj.io/storj/storagenode/internalpb"
+)
+
+func cmdShrinkInit(cmd *cobra.Command, args []string) (err error) {
+	ctx, _ := process.Ctx(cmd)
+
+	satelliteID, err := storj.NodeIDFromString(args[0])
+	if err != nil {
+		return errs.New("invalid satellite ID %q: %w", args[0], err)
+	}
+	amount, err := memory.ParseString(args[1])
+	if err != nil {
+		return errs.New("invalid amount %q: %w", args[1], err)
+	}
+	if amount <= 0 {
+		return errs.New("invalid amount %q, expected a positive size", args[1])
+	}
+
+	client, err := dialGracefulExitClient(ctx, diagCfg.Server.PrivateAddress)
+	if err != nil {
+		return errs.Wrap(err)
+	}
+	defer func() {
+		if err := client.close(); err != nil {
+			zap.L().Debug("Closing graceful exit client failed.", zap.Error(err))
+		}
+	}()
+
+	status, err := internalpb.NewDRPCNodeGracefulExitClient(client.conn).InitiateShrink(ctx, &internalpb.InitiateShrinkRequest{
+		NodeId: satelliteID,
+		Bytes:  amount,
+	})
+	if err != nil {
+		fmt.Println("Failed to initialize shrink. Please try again later.")
+		return errs.Wrap(err)
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	displayShrinkStatus(w, []*internalpb.ShrinkStatus{status})
+	return errs.Wrap(w.Flush())
+}
+
+func cmdShrinkStatus(cmd *cobra.Command, args []string) (err error) {
+	ctx, _ := process.Ctx(cmd)
+
+	client, err := dialGracefulExitClient(ctx, diagCfg.Server.PrivateAddress)
+	if err != nil {
+		return errs.Wrap(err)
+	}
+	defer func() {
+		if err := client.close(); err != nil {
+			zap.L().Debug("Closing graceful exit client failed.", zap.Error(err))
+		}
+	}()
+
+	resp, err := internalpb.NewDRPCNodeGracefulExitClient(client.conn).GetShrinkStatus(ctx, &internalpb.GetShrinkStatusRequest{})
+	if err != nil {
+		return errs.Wrap(err)
+	}
+
+	if len(resp.GetStatuses()) < 1 {
+		fmt.Println("No shrink was requested.")
+		return nil
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	displayShrinkStatus(w, resp.GetStatuses())
+	return errs.Wrap(w.Flush())
+}
+
+func displayShrinkStatus(w io.Writer, statuses []*internalpb.ShrinkStatus) {
+	fmt.Fprintln(w, "\nDomain Name\tNode ID\tRequested\tSelected\tTransferred\tPieces Transferred\tPieces Failed\tState\t")
+
+	for _, status := range statuses {
+		state := "waiting for selection"
+		switch {
+		case status.Finished:
+			state = "finished"
+		case status.Selected:
+			state = "transferring"
+		}
+
+		selected := "N/A"
+		if status.Selected {
+			selected = memory.Size(status.SelectedBytes).Base10String()
+		}
+
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%d\t%d\t%s\t\n",
+			status.GetDomainName(), status.NodeId.String(),
+			memory.Size(status.RequestedBytes).Base10String(), selected,
+			memory.Size(status.BytesTransferred).Base10String(),
+			status.PiecesTransferred, status.PiecesFailed, state)
+	}
+}
@@ -0,0 +1,105 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"path/filepath"
+
+	"github.com/spf13/cobra"
+	"github.com/zeebo/errs"
+
+	"storj.io/common/memory"
+	"storj.io/private/process"
+	"storj.io/storj/storagenode/piecemigration"
+)
+
+// storageMigrationURL returns the console api url used to manage the storage migration.
+func storageMigrationURL(consoleAddress string) string {
+	return fmt.Sprintf("http://%s/api/sno/storage-migration", consoleAddress)
+}
+
+func cmdMigrateStorage(cmd *cobra.Command, args []string) (err error) {
+	ctx, _ := process.Ctx(cmd)
+
+	var progress piecemigration.Progress
+	if len(args) == 0 {
+		progress, err = getStorageMigration(ctx, diagCfg.Console.Address)
+	} else {
+		var destination string
+		destination, err = filepath.Abs(args[0])
+		if err != nil {
+			return errs.Wrap(err)
+		}
+		progress, err = startStorageMigration(ctx, diagCfg.Console.Address, destination)
+	}
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("Moving pieces from %s to %s, started at %s.\n", progress.Source, progress.Destination, progress.StartedAt.Local().Format("2006-01-02 15:04:05"))
+	fmt.Printf("Copied %d files (%s), %d changes pending.\n", progress.CopiedFiles, memory.Size(progress.CopiedBytes).Base10String(), progress.PendingChanges)
+	if progress.LastError != "" {
+		fmt.Printf("Last error: %s\n", progress.LastError)
+	}
+
+	switch progress.Status {
+	case piecemigration.StatusCopying:
+		fmt.Println("Pieces are being copied, the node keeps running in the meantime.")
+	case piecemigration.StatusSynced:
+		fmt.Println("All pieces were copied. Restart the node to complete the migration.")
+	case piecemigration.StatusCompleted:
+		fmt.Printf("The migration completed at %s. Update storage.path in the config file to %s.\n", progress.CompletedAt.Local().Format("2006-01-02 15:04:05"), progress.Destination)
+	}
+	return nil
+}
+
+func getStorageMigration(ctx context.Context, consoleAddress string) (progress piecemigration.Progress, err error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, storageMigrationURL(consoleAddress), nil)
+	if err != nil {
+		return progress, errs.Wrap(err)
+	}
+	return doStorageMigrationRequest(req)
+}
+
+func startStorageMigration(ctx context.Context, consoleAddress, destination string) (progress piecemigration.Progress, err error) {
+	body, err := json.Marshal(struct {
+		Destination string `json:"destination"`
+	}{Destination: destination})
+	if err != nil {
+		return progress, errs.Wrap(err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, storageMigrationURL(consoleAddress), bytes.NewReader(body))
+	if err != nil {
+		return progress, errs.Wrap(err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	return doStorageMigrationRequest(req)
+}
+
+func doStorageMigrationRequest(req *http.Request) (progress piecemigration.Progress, err error) {
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return progress, errs.New("unable to reach the storage node, is it running? %w", err)
+	}
+	defer func() { err = errs.Combine(err, resp.Body.Close()) }()
+
+	if resp.StatusCode != http.StatusOK {
+		var response struct {
+			Error string `json:"error"`
+		}
+		_ = json.NewDecoder(resp.Body).Decode(&response)
+		return progress, errs.New("unexpected status %d: %s", resp.StatusCode, response.Error)
+	}
+
+	if err := json.NewDecoder(resp.Body).Decode(&progress); err != nil {
+		return progress, errs.Wrap(err)
+	}
+	return progress, nil
+}
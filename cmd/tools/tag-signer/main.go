@@ -0,0 +1,76 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+// tag-signer signs node tags with the identity of the node operator. The
+// output can be used as the contact.tags configuration of the storage node.
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"storj.io/common/identity"
+	"storj.io/common/storj"
+	"storj.io/storj/private/nodetag"
+)
+
+func usage() {
+	fmt.Fprintf(os.Stderr, "usage: %s -identity-dir <dir> -node-id <node-id> <name>[=<value>]...\n\n", os.Args[0])
+	flag.PrintDefaults()
+	os.Exit(1)
+}
+
+func main() {
+	identityDir := flag.String("identity-dir", "", "directory of the identity, which signs the tags")
+	nodeIDString := flag.String("node-id", "", "id of the node, which the tags are declared for")
+	flag.Usage = usage
+	flag.Parse()
+
+	if *identityDir == "" || *nodeIDString == "" || flag.NArg() == 0 {
+		usage()
+	}
+
+	encoded, err := sign(context.Background(), *identityDir, *nodeIDString, flag.Args())
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "%v\n", err)
+		os.Exit(1)
+	}
+	fmt.Println(encoded)
+}
+
+// sign signs the tags declared for the node with the identity in the
+// directory, and returns them encoded.
+func sign(ctx context.Context, identityDir, nodeIDString string, args []string) (string, error) {
+	nodeID, err := storj.NodeIDFromString(nodeIDString)
+	if err != nil {
+		return "", fmt.Errorf("invalid node id: %w", err)
+	}
+
+	signer, err := identity.Config{
+		CertPath: filepath.Join(identityDir, "identity.cert"),
+		KeyPath:  filepath.Join(identityDir, "identity.key"),
+	}.Load()
+	if err != nil {
+		return "", fmt.Errorf("unable to load identity: %w", err)
+	}
+
+	set := nodetag.TagSet{
+		NodeID:   nodeID,
+		SignedAt: time.Now().UTC().Truncate(time.Second),
+	}
+	for _, arg := range args {
+		name, value, _ := strings.Cut(arg, "=")
+		set.Tags = append(set.Tags, nodetag.Tag{Name: name, Value: value})
+	}
+
+	signed, err := nodetag.Sign(ctx, set, signer)
+	if err != nil {
+		return "", err
+	}
+	return nodetag.Encode([]*nodetag.SignedTagSet{signed})
+}
@@ -6,6 +6,7 @@ package controllers
 import (
 	"encoding/json"
 	"net/http"
+	"time"
 
 	"github.com/gorilla/mux"
 	"github.com/zeebo/errs"
@@ -76,6 +77,62 @@ func (controller *Reputation) Stats(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
+// History handles retrieval of nodes reputation history for particular satellite.
+//
+// The range is given by the optional from and to query parameters formatted as
+// RFC 3339 timestamps, by default the last 30 days are returned.
+func (controller *Reputation) History(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	w.Header().Add("Content-Type", "application/json")
+	segments := mux.Vars(r)
+
+	satelliteIDEnc, ok := segments["satelliteID"]
+	if !ok {
+		controller.serveError(w, http.StatusBadRequest, ErrReputation.New("could not retrieve satellite id segment"))
+		return
+	}
+	satelliteID, err := storj.NodeIDFromString(satelliteIDEnc)
+	if err != nil {
+		controller.serveError(w, http.StatusBadRequest, ErrReputation.Wrap(err))
+		return
+	}
+
+	to := time.Now()
+	if param := r.URL.Query().Get("to"); param != "" {
+		to, err = time.Parse(time.RFC3339, param)
+		if err != nil {
+			controller.serveError(w, http.StatusBadRequest, ErrReputation.Wrap(err))
+			return
+		}
+	}
+	from := to.AddDate(0, 0, -30)
+	if param := r.URL.Query().Get("from"); param != "" {
+		from, err = time.Parse(time.RFC3339, param)
+		if err != nil {
+			controller.serveError(w, http.StatusBadRequest, ErrReputation.Wrap(err))
+			return
+		}
+	}
+
+	history, err := controller.service.History(ctx, satelliteID, from, to)
+	if err != nil {
+		controller.log.Error("reputation history internal error", zap.Error(ErrReputation.Wrap(err)))
+		controller.serveError(w, http.StatusInternalServerError, ErrReputation.Wrap(err))
+		return
+	}
+
+	if len(history) == 0 {
+		history = make([]reputation.History, 0)
+	}
+	if err = json.NewEncoder(w).Encode(history); err != nil {
+		controller.log.Error("failed to write json response", zap.Error(ErrReputation.Wrap(err)))
+		return
+	}
+}
+
 // serveError set http statuses and send json error.
 func (controller *Reputation) serveError(w http.ResponseWriter, status int, err error) {
 	w.WriteHeader(status)
@@ -132,6 +132,7 @@ func NewServer(log *zap.Logger, listener net.Listener, assets fs.FS, services Se
 	reputationController := controllers.NewReputation(server.log, server.reputation)
 	reputationRouter := apiRouter.PathPrefix("/reputation").Subrouter()
 	reputationRouter.HandleFunc("/satellites/{satelliteID}", reputationController.Stats)
+	reputationRouter.HandleFunc("/satellites/{satelliteID}/history", reputationController.History)
 
 	staticServer := http.FileServer(http.FS(server.assets))
 	router.PathPrefix("/static").Handler(web.CacheHandler(staticServer))
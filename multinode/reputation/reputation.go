@@ -43,3 +43,21 @@ type Stats struct {
 	UpdatedAt            time.Time    `json:"updatedAt"`
 	JoinedAt             time.Time    `json:"joinedAt"`
 }
+
+// Snapshot is the reputation of a node at a point in time.
+type Snapshot struct {
+	Timestamp          time.Time  `json:"timestamp"`
+	AuditScore         float64    `json:"auditScore"`
+	SuspensionScore    float64    `json:"suspensionScore"`
+	OnlineScore        float64    `json:"onlineScore"`
+	DisqualifiedAt     *time.Time `json:"disqualifiedAt"`
+	SuspendedAt        *time.Time `json:"suspendedAt"`
+	OfflineSuspendedAt *time.Time `json:"offlineSuspendedAt"`
+}
+
+// History encapsulates reputation history of a node.
+type History struct {
+	NodeID    storj.NodeID `json:"nodeId"`
+	NodeName  string       `json:"nodeName"`
+	Snapshots []Snapshot   `json:"snapshots"`
+}
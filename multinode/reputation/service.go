@@ -5,6 +5,7 @@ package reputation
 
 import (
 	"context"
+	"time"
 
 	"github.com/spacemonkeygo/monkit/v3"
 	"github.com/zeebo/errs"
@@ -73,6 +74,36 @@ func (service *Service) Stats(ctx context.Context, satelliteID storj.NodeID) (_
 	return statsList, nil
 }
 
+// History retrieves reputation history of every node for satellite between from (inclusive) and to (exclusive).
+func (service *Service) History(ctx context.Context, satelliteID storj.NodeID, from, to time.Time) (_ []History, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	nodeList, err := service.nodes.List(ctx)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	var historyList []History
+	for _, node := range nodeList {
+		history, err := service.dialHistory(ctx, node, satelliteID, from, to)
+		if err != nil {
+			if nodes.ErrNodeNotReachable.Has(err) {
+				continue
+			}
+
+			return nil, Error.Wrap(err)
+		}
+
+		if len(history.Snapshots) == 0 {
+			continue
+		}
+
+		historyList = append(historyList, history)
+	}
+
+	return historyList, nil
+}
+
 // dialStats dials node and retrieves reputation stats for particular satellite.
 func (service *Service) dialStats(ctx context.Context, node nodes.Node, satelliteID storj.NodeID) (_ Stats, err error) {
 	defer mon.Task()(&ctx)(&err)
@@ -137,3 +168,51 @@ func (service *Service) dialStats(ctx context.Context, node nodes.Node, satellit
 		JoinedAt:             resp.JoinedAt,
 	}, nil
 }
+
+// dialHistory dials node and retrieves reputation history for particular satellite.
+func (service *Service) dialHistory(ctx context.Context, node nodes.Node, satelliteID storj.NodeID, from, to time.Time) (_ History, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	conn, err := service.dialer.DialNodeURL(ctx, storj.NodeURL{
+		ID:      node.ID,
+		Address: node.PublicAddress,
+	})
+	if err != nil {
+		return History{}, nodes.ErrNodeNotReachable.Wrap(err)
+	}
+	defer func() {
+		err = errs.Combine(err, conn.Close())
+	}()
+
+	nodeClient := multinodepb.NewDRPCNodeClient(conn)
+
+	resp, err := nodeClient.ReputationHistory(ctx, &multinodepb.ReputationHistoryRequest{
+		Header: &multinodepb.RequestHeader{
+			ApiKey: node.APISecret[:],
+		},
+		SatelliteId: satelliteID,
+		From:        from,
+		To:          to,
+	})
+	if err != nil {
+		return History{}, Error.Wrap(err)
+	}
+
+	history := History{
+		NodeID:   node.ID,
+		NodeName: node.Name,
+	}
+	for _, snapshot := range resp.Snapshots {
+		history.Snapshots = append(history.Snapshots, Snapshot{
+			Timestamp:          snapshot.Timestamp,
+			AuditScore:         snapshot.AuditScore,
+			SuspensionScore:    snapshot.SuspensionScore,
+			OnlineScore:        snapshot.OnlineScore,
+			DisqualifiedAt:     snapshot.DisqualifiedAt,
+			SuspendedAt:        snapshot.SuspendedAt,
+			OfflineSuspendedAt: snapshot.OfflineSuspendedAt,
+		})
+	}
+
+	return history, nil
+}
@@ -0,0 +1,186 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: audit.proto
+
+package auditpb
+
+import (
+	fmt "fmt"
+	math "math"
+
+	proto "github.com/gogo/protobuf/proto"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// PieceChallenge requests a range of the content of a piece.
+type PieceChallenge struct {
+	PieceId              PieceID  `protobuf:"bytes,1,opt,name=piece_id,json=pieceId,proto3,customtype=PieceID" json:"piece_id"`
+	Offset               int64    `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
+	Length               int64    `protobuf:"varint,3,opt,name=length,proto3" json:"length,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PieceChallenge) Reset()         { *m = PieceChallenge{} }
+func (m *PieceChallenge) String() string { return proto.CompactTextString(m) }
+func (*PieceChallenge) ProtoMessage()    {}
+func (*PieceChallenge) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5594839dd8e38a1b, []int{0}
+}
+func (m *PieceChallenge) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PieceChallenge.Unmarshal(m, b)
+}
+func (m *PieceChallenge) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PieceChallenge.Marshal(b, m, deterministic)
+}
+func (m *PieceChallenge) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PieceChallenge.Merge(m, src)
+}
+func (m *PieceChallenge) XXX_Size() int {
+	return xxx_messageInfo_PieceChallenge.Size(m)
+}
+func (m *PieceChallenge) XXX_DiscardUnknown() {
+	xxx_messageInfo_PieceChallenge.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PieceChallenge proto.InternalMessageInfo
+
+func (m *PieceChallenge) GetOffset() int64 {
+	if m != nil {
+		return m.Offset
+	}
+	return 0
+}
+
+func (m *PieceChallenge) GetLength() int64 {
+	if m != nil {
+		return m.Length
+	}
+	return 0
+}
+
+type ChallengeRequest struct {
+	// nonce is hashed ahead of the ranges, so the hash can't be precomputed.
+	Nonce                []byte            `protobuf:"bytes,1,opt,name=nonce,proto3" json:"nonce,omitempty"`
+	Challenges           []*PieceChallenge `protobuf:"bytes,2,rep,name=challenges,proto3" json:"challenges,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *ChallengeRequest) Reset()         { *m = ChallengeRequest{} }
+func (m *ChallengeRequest) String() string { return proto.CompactTextString(m) }
+func (*ChallengeRequest) ProtoMessage()    {}
+func (*ChallengeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5594839dd8e38a1b, []int{1}
+}
+func (m *ChallengeRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ChallengeRequest.Unmarshal(m, b)
+}
+func (m *ChallengeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ChallengeRequest.Marshal(b, m, deterministic)
+}
+func (m *ChallengeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChallengeRequest.Merge(m, src)
+}
+func (m *ChallengeRequest) XXX_Size() int {
+	return xxx_messageInfo_ChallengeRequest.Size(m)
+}
+func (m *ChallengeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChallengeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ChallengeRequest proto.InternalMessageInfo
+
+func (m *ChallengeRequest) GetNonce() []byte {
+	if m != nil {
+		return m.Nonce
+	}
+	return nil
+}
+
+func (m *ChallengeRequest) GetChallenges() []*PieceChallenge {
+	if m != nil {
+		return m.Challenges
+	}
+	return nil
+}
+
+type ChallengeResponse struct {
+	// hash is the SHA-256 of the nonce followed by the requested ranges, in
+	// the order of the challenges.
+	Hash                 []byte   `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ChallengeResponse) Reset()         { *m = ChallengeResponse{} }
+func (m *ChallengeResponse) String() string { return proto.CompactTextString(m) }
+func (*ChallengeResponse) ProtoMessage()    {}
+func (*ChallengeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5594839dd8e38a1b, []int{2}
+}
+func (m *ChallengeResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ChallengeResponse.Unmarshal(m, b)
+}
+func (m *ChallengeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ChallengeResponse.Marshal(b, m, deterministic)
+}
+func (m *ChallengeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChallengeResponse.Merge(m, src)
+}
+func (m *ChallengeResponse) XXX_Size() int {
+	return xxx_messageInfo_ChallengeResponse.Size(m)
+}
+func (m *ChallengeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChallengeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ChallengeResponse proto.InternalMessageInfo
+
+func (m *ChallengeResponse) GetHash() []byte {
+	if m != nil {
+		return m.Hash
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*PieceChallenge)(nil), "audit.PieceChallenge")
+	proto.RegisterType((*ChallengeRequest)(nil), "audit.ChallengeRequest")
+	proto.RegisterType((*ChallengeResponse)(nil), "audit.ChallengeResponse")
+}
+
+func init() { proto.RegisterFile("audit.proto", fileDescriptor_5594839dd8e38a1b) }
+
+var fileDescriptor_5594839dd8e38a1b = []byte{
+	// 267 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0xe2, 0x4e, 0x2c, 0x4d, 0xc9,
+	0x2c, 0xd1, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x62, 0x05, 0x73, 0xa4, 0xb8, 0xd2, 0xf3, 0xd3,
+	0xf3, 0x21, 0x42, 0x4a, 0x39, 0x5c, 0x7c, 0x01, 0x99, 0xa9, 0xc9, 0xa9, 0xce, 0x19, 0x89, 0x39,
+	0x39, 0xa9, 0x79, 0xe9, 0xa9, 0x42, 0x5a, 0x5c, 0x1c, 0x05, 0x20, 0x91, 0xf8, 0xcc, 0x14, 0x09,
+	0x46, 0x05, 0x46, 0x0d, 0x1e, 0x27, 0xfe, 0x13, 0xf7, 0xe4, 0x19, 0x6e, 0xdd, 0x93, 0x67, 0x07,
+	0xab, 0xf4, 0x74, 0x09, 0x62, 0x07, 0x2b, 0xf0, 0x4c, 0x11, 0x12, 0xe3, 0x62, 0xcb, 0x4f, 0x4b,
+	0x2b, 0x4e, 0x2d, 0x91, 0x60, 0x52, 0x60, 0xd4, 0x60, 0x0e, 0x82, 0xf2, 0x40, 0xe2, 0x20, 0xc3,
+	0x4a, 0x32, 0x24, 0x98, 0x21, 0xe2, 0x10, 0x9e, 0x52, 0x3c, 0x97, 0x00, 0xdc, 0xa2, 0xa0, 0xd4,
+	0xc2, 0xd2, 0xd4, 0xe2, 0x12, 0x21, 0x11, 0x2e, 0xd6, 0xbc, 0xfc, 0xbc, 0xe4, 0x54, 0x88, 0x65,
+	0x41, 0x10, 0x8e, 0x90, 0x29, 0x17, 0x57, 0x32, 0x4c, 0x65, 0xb1, 0x04, 0x93, 0x02, 0xb3, 0x06,
+	0xb7, 0x91, 0xa8, 0x1e, 0xc4, 0x33, 0xa8, 0x0e, 0x0e, 0x42, 0x52, 0xa8, 0xa4, 0xce, 0x25, 0x88,
+	0x90, 0x48, 0x2d, 0x2e, 0xc8, 0xcf, 0x2b, 0x4e, 0x15, 0x12, 0xe2, 0x62, 0xc9, 0x48, 0x2c, 0xce,
+	0x80, 0x5a, 0x00, 0x66, 0x1b, 0xf9, 0x70, 0x71, 0x81, 0x8d, 0x71, 0x04, 0x99, 0x28, 0x64, 0xc7,
+	0xc5, 0x89, 0x08, 0x00, 0x71, 0xa8, 0x35, 0xe8, 0x2e, 0x95, 0x92, 0xc0, 0x94, 0x80, 0xd8, 0xe0,
+	0xa4, 0x10, 0x25, 0x57, 0x5c, 0x92, 0x5f, 0x94, 0xa5, 0x97, 0x99, 0xaf, 0x0f, 0x66, 0xe8, 0x17,
+	0x14, 0x65, 0x96, 0x25, 0x96, 0xa4, 0xea, 0x83, 0x75, 0x14, 0x24, 0x25, 0xb1, 0x81, 0x83, 0xdb,
+	0x18, 0x30, 0x00, 0x66, 0x7c, 0x36, 0xbe, 0x90, 0x01, 0x00, 0x00,
+}
@@ -0,0 +1,8 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+// Package auditpb contains protobuf definitions for the batched piece audits
+// served by storage nodes.
+package auditpb
+
+//go:generate go run gen.go
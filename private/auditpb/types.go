@@ -0,0 +1,9 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package auditpb
+
+import "storj.io/common/storj"
+
+// PieceID is an alias to storj.PieceID for use in generated protobuf code.
+type PieceID = storj.PieceID
@@ -1785,6 +1785,185 @@ func (m *ReputationResponse_Audit) GetHistory() []*AuditWindow {
 	return nil
 }
 
+type ReputationHistoryRequest struct {
+	Header               *RequestHeader `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	SatelliteId          NodeID         `protobuf:"bytes,2,opt,name=satellite_id,json=satelliteId,proto3,customtype=NodeID" json:"satellite_id"`
+	From                 time.Time      `protobuf:"bytes,3,opt,name=from,proto3,stdtime" json:"from"`
+	To                   time.Time      `protobuf:"bytes,4,opt,name=to,proto3,stdtime" json:"to"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *ReputationHistoryRequest) Reset()         { *m = ReputationHistoryRequest{} }
+func (m *ReputationHistoryRequest) String() string { return proto.CompactTextString(m) }
+func (*ReputationHistoryRequest) ProtoMessage()    {}
+func (*ReputationHistoryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9a45fd79b06f3a1b, []int{36}
+}
+func (m *ReputationHistoryRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReputationHistoryRequest.Unmarshal(m, b)
+}
+func (m *ReputationHistoryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReputationHistoryRequest.Marshal(b, m, deterministic)
+}
+func (m *ReputationHistoryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReputationHistoryRequest.Merge(m, src)
+}
+func (m *ReputationHistoryRequest) XXX_Size() int {
+	return xxx_messageInfo_ReputationHistoryRequest.Size(m)
+}
+func (m *ReputationHistoryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReputationHistoryRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReputationHistoryRequest proto.InternalMessageInfo
+
+func (m *ReputationHistoryRequest) GetHeader() *RequestHeader {
+	if m != nil {
+		return m.Header
+	}
+	return nil
+}
+
+func (m *ReputationHistoryRequest) GetFrom() time.Time {
+	if m != nil {
+		return m.From
+	}
+	return time.Time{}
+}
+
+func (m *ReputationHistoryRequest) GetTo() time.Time {
+	if m != nil {
+		return m.To
+	}
+	return time.Time{}
+}
+
+type ReputationHistoryResponse struct {
+	Snapshots            []*ReputationHistoryResponse_Snapshot `protobuf:"bytes,1,rep,name=snapshots,proto3" json:"snapshots,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                              `json:"-"`
+	XXX_unrecognized     []byte                                `json:"-"`
+	XXX_sizecache        int32                                 `json:"-"`
+}
+
+func (m *ReputationHistoryResponse) Reset()         { *m = ReputationHistoryResponse{} }
+func (m *ReputationHistoryResponse) String() string { return proto.CompactTextString(m) }
+func (*ReputationHistoryResponse) ProtoMessage()    {}
+func (*ReputationHistoryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9a45fd79b06f3a1b, []int{37}
+}
+func (m *ReputationHistoryResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReputationHistoryResponse.Unmarshal(m, b)
+}
+func (m *ReputationHistoryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReputationHistoryResponse.Marshal(b, m, deterministic)
+}
+func (m *ReputationHistoryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReputationHistoryResponse.Merge(m, src)
+}
+func (m *ReputationHistoryResponse) XXX_Size() int {
+	return xxx_messageInfo_ReputationHistoryResponse.Size(m)
+}
+func (m *ReputationHistoryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReputationHistoryResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReputationHistoryResponse proto.InternalMessageInfo
+
+func (m *ReputationHistoryResponse) GetSnapshots() []*ReputationHistoryResponse_Snapshot {
+	if m != nil {
+		return m.Snapshots
+	}
+	return nil
+}
+
+type ReputationHistoryResponse_Snapshot struct {
+	Timestamp            time.Time  `protobuf:"bytes,1,opt,name=timestamp,proto3,stdtime" json:"timestamp"`
+	AuditScore           float64    `protobuf:"fixed64,2,opt,name=audit_score,json=auditScore,proto3" json:"audit_score,omitempty"`
+	SuspensionScore      float64    `protobuf:"fixed64,3,opt,name=suspension_score,json=suspensionScore,proto3" json:"suspension_score,omitempty"`
+	OnlineScore          float64    `protobuf:"fixed64,4,opt,name=online_score,json=onlineScore,proto3" json:"online_score,omitempty"`
+	DisqualifiedAt       *time.Time `protobuf:"bytes,5,opt,name=disqualified_at,json=disqualifiedAt,proto3,stdtime" json:"disqualified_at,omitempty"`
+	SuspendedAt          *time.Time `protobuf:"bytes,6,opt,name=suspended_at,json=suspendedAt,proto3,stdtime" json:"suspended_at,omitempty"`
+	OfflineSuspendedAt   *time.Time `protobuf:"bytes,7,opt,name=offline_suspended_at,json=offlineSuspendedAt,proto3,stdtime" json:"offline_suspended_at,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_unrecognized     []byte     `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
+}
+
+func (m *ReputationHistoryResponse_Snapshot) Reset()         { *m = ReputationHistoryResponse_Snapshot{} }
+func (m *ReputationHistoryResponse_Snapshot) String() string { return proto.CompactTextString(m) }
+func (*ReputationHistoryResponse_Snapshot) ProtoMessage()    {}
+func (*ReputationHistoryResponse_Snapshot) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9a45fd79b06f3a1b, []int{37, 0}
+}
+func (m *ReputationHistoryResponse_Snapshot) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReputationHistoryResponse_Snapshot.Unmarshal(m, b)
+}
+func (m *ReputationHistoryResponse_Snapshot) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReputationHistoryResponse_Snapshot.Marshal(b, m, deterministic)
+}
+func (m *ReputationHistoryResponse_Snapshot) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReputationHistoryResponse_Snapshot.Merge(m, src)
+}
+func (m *ReputationHistoryResponse_Snapshot) XXX_Size() int {
+	return xxx_messageInfo_ReputationHistoryResponse_Snapshot.Size(m)
+}
+func (m *ReputationHistoryResponse_Snapshot) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReputationHistoryResponse_Snapshot.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReputationHistoryResponse_Snapshot proto.InternalMessageInfo
+
+func (m *ReputationHistoryResponse_Snapshot) GetTimestamp() time.Time {
+	if m != nil {
+		return m.Timestamp
+	}
+	return time.Time{}
+}
+
+func (m *ReputationHistoryResponse_Snapshot) GetAuditScore() float64 {
+	if m != nil {
+		return m.AuditScore
+	}
+	return 0
+}
+
+func (m *ReputationHistoryResponse_Snapshot) GetSuspensionScore() float64 {
+	if m != nil {
+		return m.SuspensionScore
+	}
+	return 0
+}
+
+func (m *ReputationHistoryResponse_Snapshot) GetOnlineScore() float64 {
+	if m != nil {
+		return m.OnlineScore
+	}
+	return 0
+}
+
+func (m *ReputationHistoryResponse_Snapshot) GetDisqualifiedAt() *time.Time {
+	if m != nil {
+		return m.DisqualifiedAt
+	}
+	return nil
+}
+
+func (m *ReputationHistoryResponse_Snapshot) GetSuspendedAt() *time.Time {
+	if m != nil {
+		return m.SuspendedAt
+	}
+	return nil
+}
+
+func (m *ReputationHistoryResponse_Snapshot) GetOfflineSuspendedAt() *time.Time {
+	if m != nil {
+		return m.OfflineSuspendedAt
+	}
+	return nil
+}
+
 type TrustedSatellitesRequest struct {
 	Header               *RequestHeader `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
@@ -1796,7 +1975,7 @@ func (m *TrustedSatellitesRequest) Reset()         { *m = TrustedSatellitesReque
 func (m *TrustedSatellitesRequest) String() string { return proto.CompactTextString(m) }
 func (*TrustedSatellitesRequest) ProtoMessage()    {}
 func (*TrustedSatellitesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9a45fd79b06f3a1b, []int{36}
+	return fileDescriptor_9a45fd79b06f3a1b, []int{38}
 }
 func (m *TrustedSatellitesRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_TrustedSatellitesRequest.Unmarshal(m, b)
@@ -1834,7 +2013,7 @@ func (m *TrustedSatellitesResponse) Reset()         { *m = TrustedSatellitesResp
 func (m *TrustedSatellitesResponse) String() string { return proto.CompactTextString(m) }
 func (*TrustedSatellitesResponse) ProtoMessage()    {}
 func (*TrustedSatellitesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9a45fd79b06f3a1b, []int{37}
+	return fileDescriptor_9a45fd79b06f3a1b, []int{39}
 }
 func (m *TrustedSatellitesResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_TrustedSatellitesResponse.Unmarshal(m, b)
@@ -1873,7 +2052,7 @@ func (m *TrustedSatellitesResponse_NodeURL) Reset()         { *m = TrustedSatell
 func (m *TrustedSatellitesResponse_NodeURL) String() string { return proto.CompactTextString(m) }
 func (*TrustedSatellitesResponse_NodeURL) ProtoMessage()    {}
 func (*TrustedSatellitesResponse_NodeURL) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9a45fd79b06f3a1b, []int{37, 0}
+	return fileDescriptor_9a45fd79b06f3a1b, []int{39, 0}
 }
 func (m *TrustedSatellitesResponse_NodeURL) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_TrustedSatellitesResponse_NodeURL.Unmarshal(m, b)
@@ -1911,7 +2090,7 @@ func (m *OperatorRequest) Reset()         { *m = OperatorRequest{} }
 func (m *OperatorRequest) String() string { return proto.CompactTextString(m) }
 func (*OperatorRequest) ProtoMessage()    {}
 func (*OperatorRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9a45fd79b06f3a1b, []int{38}
+	return fileDescriptor_9a45fd79b06f3a1b, []int{40}
 }
 func (m *OperatorRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_OperatorRequest.Unmarshal(m, b)
@@ -1951,7 +2130,7 @@ func (m *OperatorResponse) Reset()         { *m = OperatorResponse{} }
 func (m *OperatorResponse) String() string { return proto.CompactTextString(m) }
 func (*OperatorResponse) ProtoMessage()    {}
 func (*OperatorResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9a45fd79b06f3a1b, []int{39}
+	return fileDescriptor_9a45fd79b06f3a1b, []int{41}
 }
 func (m *OperatorResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_OperatorResponse.Unmarshal(m, b)
@@ -2004,7 +2183,7 @@ func (m *EstimatedPayoutSatelliteRequest) Reset()         { *m = EstimatedPayout
 func (m *EstimatedPayoutSatelliteRequest) String() string { return proto.CompactTextString(m) }
 func (*EstimatedPayoutSatelliteRequest) ProtoMessage()    {}
 func (*EstimatedPayoutSatelliteRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9a45fd79b06f3a1b, []int{40}
+	return fileDescriptor_9a45fd79b06f3a1b, []int{42}
 }
 func (m *EstimatedPayoutSatelliteRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_EstimatedPayoutSatelliteRequest.Unmarshal(m, b)
@@ -2042,7 +2221,7 @@ func (m *EstimatedPayoutSatelliteResponse) Reset()         { *m = EstimatedPayou
 func (m *EstimatedPayoutSatelliteResponse) String() string { return proto.CompactTextString(m) }
 func (*EstimatedPayoutSatelliteResponse) ProtoMessage()    {}
 func (*EstimatedPayoutSatelliteResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9a45fd79b06f3a1b, []int{41}
+	return fileDescriptor_9a45fd79b06f3a1b, []int{43}
 }
 func (m *EstimatedPayoutSatelliteResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_EstimatedPayoutSatelliteResponse.Unmarshal(m, b)
@@ -2080,7 +2259,7 @@ func (m *EstimatedPayoutRequest) Reset()         { *m = EstimatedPayoutRequest{}
 func (m *EstimatedPayoutRequest) String() string { return proto.CompactTextString(m) }
 func (*EstimatedPayoutRequest) ProtoMessage()    {}
 func (*EstimatedPayoutRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9a45fd79b06f3a1b, []int{42}
+	return fileDescriptor_9a45fd79b06f3a1b, []int{44}
 }
 func (m *EstimatedPayoutRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_EstimatedPayoutRequest.Unmarshal(m, b)
@@ -2118,7 +2297,7 @@ func (m *EstimatedPayoutResponse) Reset()         { *m = EstimatedPayoutResponse
 func (m *EstimatedPayoutResponse) String() string { return proto.CompactTextString(m) }
 func (*EstimatedPayoutResponse) ProtoMessage()    {}
 func (*EstimatedPayoutResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9a45fd79b06f3a1b, []int{43}
+	return fileDescriptor_9a45fd79b06f3a1b, []int{45}
 }
 func (m *EstimatedPayoutResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_EstimatedPayoutResponse.Unmarshal(m, b)
@@ -2156,7 +2335,7 @@ func (m *SummaryRequest) Reset()         { *m = SummaryRequest{} }
 func (m *SummaryRequest) String() string { return proto.CompactTextString(m) }
 func (*SummaryRequest) ProtoMessage()    {}
 func (*SummaryRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9a45fd79b06f3a1b, []int{44}
+	return fileDescriptor_9a45fd79b06f3a1b, []int{46}
 }
 func (m *SummaryRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SummaryRequest.Unmarshal(m, b)
@@ -2194,7 +2373,7 @@ func (m *SummaryResponse) Reset()         { *m = SummaryResponse{} }
 func (m *SummaryResponse) String() string { return proto.CompactTextString(m) }
 func (*SummaryResponse) ProtoMessage()    {}
 func (*SummaryResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9a45fd79b06f3a1b, []int{45}
+	return fileDescriptor_9a45fd79b06f3a1b, []int{47}
 }
 func (m *SummaryResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SummaryResponse.Unmarshal(m, b)
@@ -2233,7 +2412,7 @@ func (m *SummaryPeriodRequest) Reset()         { *m = SummaryPeriodRequest{} }
 func (m *SummaryPeriodRequest) String() string { return proto.CompactTextString(m) }
 func (*SummaryPeriodRequest) ProtoMessage()    {}
 func (*SummaryPeriodRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9a45fd79b06f3a1b, []int{46}
+	return fileDescriptor_9a45fd79b06f3a1b, []int{48}
 }
 func (m *SummaryPeriodRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SummaryPeriodRequest.Unmarshal(m, b)
@@ -2278,7 +2457,7 @@ func (m *SummaryPeriodResponse) Reset()         { *m = SummaryPeriodResponse{} }
 func (m *SummaryPeriodResponse) String() string { return proto.CompactTextString(m) }
 func (*SummaryPeriodResponse) ProtoMessage()    {}
 func (*SummaryPeriodResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9a45fd79b06f3a1b, []int{47}
+	return fileDescriptor_9a45fd79b06f3a1b, []int{49}
 }
 func (m *SummaryPeriodResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SummaryPeriodResponse.Unmarshal(m, b)
@@ -2317,7 +2496,7 @@ func (m *SummarySatelliteRequest) Reset()         { *m = SummarySatelliteRequest
 func (m *SummarySatelliteRequest) String() string { return proto.CompactTextString(m) }
 func (*SummarySatelliteRequest) ProtoMessage()    {}
 func (*SummarySatelliteRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9a45fd79b06f3a1b, []int{48}
+	return fileDescriptor_9a45fd79b06f3a1b, []int{50}
 }
 func (m *SummarySatelliteRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SummarySatelliteRequest.Unmarshal(m, b)
@@ -2355,7 +2534,7 @@ func (m *SummarySatelliteResponse) Reset()         { *m = SummarySatelliteRespon
 func (m *SummarySatelliteResponse) String() string { return proto.CompactTextString(m) }
 func (*SummarySatelliteResponse) ProtoMessage()    {}
 func (*SummarySatelliteResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9a45fd79b06f3a1b, []int{49}
+	return fileDescriptor_9a45fd79b06f3a1b, []int{51}
 }
 func (m *SummarySatelliteResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SummarySatelliteResponse.Unmarshal(m, b)
@@ -2395,7 +2574,7 @@ func (m *SummarySatellitePeriodRequest) Reset()         { *m = SummarySatelliteP
 func (m *SummarySatellitePeriodRequest) String() string { return proto.CompactTextString(m) }
 func (*SummarySatellitePeriodRequest) ProtoMessage()    {}
 func (*SummarySatellitePeriodRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9a45fd79b06f3a1b, []int{50}
+	return fileDescriptor_9a45fd79b06f3a1b, []int{52}
 }
 func (m *SummarySatellitePeriodRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SummarySatellitePeriodRequest.Unmarshal(m, b)
@@ -2440,7 +2619,7 @@ func (m *SummarySatellitePeriodResponse) Reset()         { *m = SummarySatellite
 func (m *SummarySatellitePeriodResponse) String() string { return proto.CompactTextString(m) }
 func (*SummarySatellitePeriodResponse) ProtoMessage()    {}
 func (*SummarySatellitePeriodResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9a45fd79b06f3a1b, []int{51}
+	return fileDescriptor_9a45fd79b06f3a1b, []int{53}
 }
 func (m *SummarySatellitePeriodResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SummarySatellitePeriodResponse.Unmarshal(m, b)
@@ -2478,7 +2657,7 @@ func (m *EarnedRequest) Reset()         { *m = EarnedRequest{} }
 func (m *EarnedRequest) String() string { return proto.CompactTextString(m) }
 func (*EarnedRequest) ProtoMessage()    {}
 func (*EarnedRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9a45fd79b06f3a1b, []int{52}
+	return fileDescriptor_9a45fd79b06f3a1b, []int{54}
 }
 func (m *EarnedRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_EarnedRequest.Unmarshal(m, b)
@@ -2516,7 +2695,7 @@ func (m *EarnedResponse) Reset()         { *m = EarnedResponse{} }
 func (m *EarnedResponse) String() string { return proto.CompactTextString(m) }
 func (*EarnedResponse) ProtoMessage()    {}
 func (*EarnedResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9a45fd79b06f3a1b, []int{53}
+	return fileDescriptor_9a45fd79b06f3a1b, []int{55}
 }
 func (m *EarnedResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_EarnedResponse.Unmarshal(m, b)
@@ -2554,7 +2733,7 @@ func (m *EarnedSatelliteRequest) Reset()         { *m = EarnedSatelliteRequest{}
 func (m *EarnedSatelliteRequest) String() string { return proto.CompactTextString(m) }
 func (*EarnedSatelliteRequest) ProtoMessage()    {}
 func (*EarnedSatelliteRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9a45fd79b06f3a1b, []int{54}
+	return fileDescriptor_9a45fd79b06f3a1b, []int{56}
 }
 func (m *EarnedSatelliteRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_EarnedSatelliteRequest.Unmarshal(m, b)
@@ -2592,7 +2771,7 @@ func (m *EarnedSatelliteResponse) Reset()         { *m = EarnedSatelliteResponse
 func (m *EarnedSatelliteResponse) String() string { return proto.CompactTextString(m) }
 func (*EarnedSatelliteResponse) ProtoMessage()    {}
 func (*EarnedSatelliteResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9a45fd79b06f3a1b, []int{55}
+	return fileDescriptor_9a45fd79b06f3a1b, []int{57}
 }
 func (m *EarnedSatelliteResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_EarnedSatelliteResponse.Unmarshal(m, b)
@@ -2631,7 +2810,7 @@ func (m *EarnedSatellite) Reset()         { *m = EarnedSatellite{} }
 func (m *EarnedSatellite) String() string { return proto.CompactTextString(m) }
 func (*EarnedSatellite) ProtoMessage()    {}
 func (*EarnedSatellite) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9a45fd79b06f3a1b, []int{56}
+	return fileDescriptor_9a45fd79b06f3a1b, []int{58}
 }
 func (m *EarnedSatellite) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_EarnedSatellite.Unmarshal(m, b)
@@ -2669,7 +2848,7 @@ func (m *UndistributedRequest) Reset()         { *m = UndistributedRequest{} }
 func (m *UndistributedRequest) String() string { return proto.CompactTextString(m) }
 func (*UndistributedRequest) ProtoMessage()    {}
 func (*UndistributedRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9a45fd79b06f3a1b, []int{57}
+	return fileDescriptor_9a45fd79b06f3a1b, []int{59}
 }
 func (m *UndistributedRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_UndistributedRequest.Unmarshal(m, b)
@@ -2707,7 +2886,7 @@ func (m *UndistributedResponse) Reset()         { *m = UndistributedResponse{} }
 func (m *UndistributedResponse) String() string { return proto.CompactTextString(m) }
 func (*UndistributedResponse) ProtoMessage()    {}
 func (*UndistributedResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9a45fd79b06f3a1b, []int{58}
+	return fileDescriptor_9a45fd79b06f3a1b, []int{60}
 }
 func (m *UndistributedResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_UndistributedResponse.Unmarshal(m, b)
@@ -2746,7 +2925,7 @@ func (m *PaystubSatelliteRequest) Reset()         { *m = PaystubSatelliteRequest
 func (m *PaystubSatelliteRequest) String() string { return proto.CompactTextString(m) }
 func (*PaystubSatelliteRequest) ProtoMessage()    {}
 func (*PaystubSatelliteRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9a45fd79b06f3a1b, []int{59}
+	return fileDescriptor_9a45fd79b06f3a1b, []int{61}
 }
 func (m *PaystubSatelliteRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_PaystubSatelliteRequest.Unmarshal(m, b)
@@ -2784,7 +2963,7 @@ func (m *PaystubSatelliteResponse) Reset()         { *m = PaystubSatelliteRespon
 func (m *PaystubSatelliteResponse) String() string { return proto.CompactTextString(m) }
 func (*PaystubSatelliteResponse) ProtoMessage()    {}
 func (*PaystubSatelliteResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9a45fd79b06f3a1b, []int{60}
+	return fileDescriptor_9a45fd79b06f3a1b, []int{62}
 }
 func (m *PaystubSatelliteResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_PaystubSatelliteResponse.Unmarshal(m, b)
@@ -2822,7 +3001,7 @@ func (m *PaystubRequest) Reset()         { *m = PaystubRequest{} }
 func (m *PaystubRequest) String() string { return proto.CompactTextString(m) }
 func (*PaystubRequest) ProtoMessage()    {}
 func (*PaystubRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9a45fd79b06f3a1b, []int{61}
+	return fileDescriptor_9a45fd79b06f3a1b, []int{63}
 }
 func (m *PaystubRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_PaystubRequest.Unmarshal(m, b)
@@ -2860,7 +3039,7 @@ func (m *PaystubResponse) Reset()         { *m = PaystubResponse{} }
 func (m *PaystubResponse) String() string { return proto.CompactTextString(m) }
 func (*PaystubResponse) ProtoMessage()    {}
 func (*PaystubResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9a45fd79b06f3a1b, []int{62}
+	return fileDescriptor_9a45fd79b06f3a1b, []int{64}
 }
 func (m *PaystubResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_PaystubResponse.Unmarshal(m, b)
@@ -2899,7 +3078,7 @@ func (m *PaystubPeriodRequest) Reset()         { *m = PaystubPeriodRequest{} }
 func (m *PaystubPeriodRequest) String() string { return proto.CompactTextString(m) }
 func (*PaystubPeriodRequest) ProtoMessage()    {}
 func (*PaystubPeriodRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9a45fd79b06f3a1b, []int{63}
+	return fileDescriptor_9a45fd79b06f3a1b, []int{65}
 }
 func (m *PaystubPeriodRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_PaystubPeriodRequest.Unmarshal(m, b)
@@ -2944,7 +3123,7 @@ func (m *PaystubPeriodResponse) Reset()         { *m = PaystubPeriodResponse{} }
 func (m *PaystubPeriodResponse) String() string { return proto.CompactTextString(m) }
 func (*PaystubPeriodResponse) ProtoMessage()    {}
 func (*PaystubPeriodResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9a45fd79b06f3a1b, []int{64}
+	return fileDescriptor_9a45fd79b06f3a1b, []int{66}
 }
 func (m *PaystubPeriodResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_PaystubPeriodResponse.Unmarshal(m, b)
@@ -2984,7 +3163,7 @@ func (m *PaystubSatellitePeriodRequest) Reset()         { *m = PaystubSatelliteP
 func (m *PaystubSatellitePeriodRequest) String() string { return proto.CompactTextString(m) }
 func (*PaystubSatellitePeriodRequest) ProtoMessage()    {}
 func (*PaystubSatellitePeriodRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9a45fd79b06f3a1b, []int{65}
+	return fileDescriptor_9a45fd79b06f3a1b, []int{67}
 }
 func (m *PaystubSatellitePeriodRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_PaystubSatellitePeriodRequest.Unmarshal(m, b)
@@ -3029,7 +3208,7 @@ func (m *PaystubSatellitePeriodResponse) Reset()         { *m = PaystubSatellite
 func (m *PaystubSatellitePeriodResponse) String() string { return proto.CompactTextString(m) }
 func (*PaystubSatellitePeriodResponse) ProtoMessage()    {}
 func (*PaystubSatellitePeriodResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9a45fd79b06f3a1b, []int{66}
+	return fileDescriptor_9a45fd79b06f3a1b, []int{68}
 }
 func (m *PaystubSatellitePeriodResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_PaystubSatellitePeriodResponse.Unmarshal(m, b)
@@ -3068,7 +3247,7 @@ func (m *PayoutInfo) Reset()         { *m = PayoutInfo{} }
 func (m *PayoutInfo) String() string { return proto.CompactTextString(m) }
 func (*PayoutInfo) ProtoMessage()    {}
 func (*PayoutInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9a45fd79b06f3a1b, []int{67}
+	return fileDescriptor_9a45fd79b06f3a1b, []int{69}
 }
 func (m *PayoutInfo) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_PayoutInfo.Unmarshal(m, b)
@@ -3124,7 +3303,7 @@ func (m *Paystub) Reset()         { *m = Paystub{} }
 func (m *Paystub) String() string { return proto.CompactTextString(m) }
 func (*Paystub) ProtoMessage()    {}
 func (*Paystub) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9a45fd79b06f3a1b, []int{68}
+	return fileDescriptor_9a45fd79b06f3a1b, []int{70}
 }
 func (m *Paystub) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Paystub.Unmarshal(m, b)
@@ -3239,7 +3418,7 @@ func (m *HeldAmountHistoryRequest) Reset()         { *m = HeldAmountHistoryReque
 func (m *HeldAmountHistoryRequest) String() string { return proto.CompactTextString(m) }
 func (*HeldAmountHistoryRequest) ProtoMessage()    {}
 func (*HeldAmountHistoryRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9a45fd79b06f3a1b, []int{69}
+	return fileDescriptor_9a45fd79b06f3a1b, []int{71}
 }
 func (m *HeldAmountHistoryRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_HeldAmountHistoryRequest.Unmarshal(m, b)
@@ -3277,7 +3456,7 @@ func (m *HeldAmountHistoryResponse) Reset()         { *m = HeldAmountHistoryResp
 func (m *HeldAmountHistoryResponse) String() string { return proto.CompactTextString(m) }
 func (*HeldAmountHistoryResponse) ProtoMessage()    {}
 func (*HeldAmountHistoryResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9a45fd79b06f3a1b, []int{70}
+	return fileDescriptor_9a45fd79b06f3a1b, []int{72}
 }
 func (m *HeldAmountHistoryResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_HeldAmountHistoryResponse.Unmarshal(m, b)
@@ -3316,7 +3495,7 @@ func (m *HeldAmountHistoryResponse_HeldAmount) Reset()         { *m = HeldAmount
 func (m *HeldAmountHistoryResponse_HeldAmount) String() string { return proto.CompactTextString(m) }
 func (*HeldAmountHistoryResponse_HeldAmount) ProtoMessage()    {}
 func (*HeldAmountHistoryResponse_HeldAmount) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9a45fd79b06f3a1b, []int{70, 0}
+	return fileDescriptor_9a45fd79b06f3a1b, []int{72, 0}
 }
 func (m *HeldAmountHistoryResponse_HeldAmount) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_HeldAmountHistoryResponse_HeldAmount.Unmarshal(m, b)
@@ -3366,7 +3545,7 @@ func (m *HeldAmountHistoryResponse_HeldAmountHistory) String() string {
 }
 func (*HeldAmountHistoryResponse_HeldAmountHistory) ProtoMessage() {}
 func (*HeldAmountHistoryResponse_HeldAmountHistory) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9a45fd79b06f3a1b, []int{70, 1}
+	return fileDescriptor_9a45fd79b06f3a1b, []int{72, 1}
 }
 func (m *HeldAmountHistoryResponse_HeldAmountHistory) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_HeldAmountHistoryResponse_HeldAmountHistory.Unmarshal(m, b)
@@ -3404,7 +3583,7 @@ func (m *EstimatedPayoutTotalRequest) Reset()         { *m = EstimatedPayoutTota
 func (m *EstimatedPayoutTotalRequest) String() string { return proto.CompactTextString(m) }
 func (*EstimatedPayoutTotalRequest) ProtoMessage()    {}
 func (*EstimatedPayoutTotalRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9a45fd79b06f3a1b, []int{71}
+	return fileDescriptor_9a45fd79b06f3a1b, []int{73}
 }
 func (m *EstimatedPayoutTotalRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_EstimatedPayoutTotalRequest.Unmarshal(m, b)
@@ -3442,7 +3621,7 @@ func (m *EstimatedPayoutTotalResponse) Reset()         { *m = EstimatedPayoutTot
 func (m *EstimatedPayoutTotalResponse) String() string { return proto.CompactTextString(m) }
 func (*EstimatedPayoutTotalResponse) ProtoMessage()    {}
 func (*EstimatedPayoutTotalResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9a45fd79b06f3a1b, []int{72}
+	return fileDescriptor_9a45fd79b06f3a1b, []int{74}
 }
 func (m *EstimatedPayoutTotalResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_EstimatedPayoutTotalResponse.Unmarshal(m, b)
@@ -3480,7 +3659,7 @@ func (m *AllSatellitesSummaryRequest) Reset()         { *m = AllSatellitesSummar
 func (m *AllSatellitesSummaryRequest) String() string { return proto.CompactTextString(m) }
 func (*AllSatellitesSummaryRequest) ProtoMessage()    {}
 func (*AllSatellitesSummaryRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9a45fd79b06f3a1b, []int{73}
+	return fileDescriptor_9a45fd79b06f3a1b, []int{75}
 }
 func (m *AllSatellitesSummaryRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_AllSatellitesSummaryRequest.Unmarshal(m, b)
@@ -3518,7 +3697,7 @@ func (m *AllSatellitesSummaryResponse) Reset()         { *m = AllSatellitesSumma
 func (m *AllSatellitesSummaryResponse) String() string { return proto.CompactTextString(m) }
 func (*AllSatellitesSummaryResponse) ProtoMessage()    {}
 func (*AllSatellitesSummaryResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9a45fd79b06f3a1b, []int{74}
+	return fileDescriptor_9a45fd79b06f3a1b, []int{76}
 }
 func (m *AllSatellitesSummaryResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_AllSatellitesSummaryResponse.Unmarshal(m, b)
@@ -3557,7 +3736,7 @@ func (m *AllSatellitesPeriodSummaryRequest) Reset()         { *m = AllSatellites
 func (m *AllSatellitesPeriodSummaryRequest) String() string { return proto.CompactTextString(m) }
 func (*AllSatellitesPeriodSummaryRequest) ProtoMessage()    {}
 func (*AllSatellitesPeriodSummaryRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9a45fd79b06f3a1b, []int{75}
+	return fileDescriptor_9a45fd79b06f3a1b, []int{77}
 }
 func (m *AllSatellitesPeriodSummaryRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_AllSatellitesPeriodSummaryRequest.Unmarshal(m, b)
@@ -3602,7 +3781,7 @@ func (m *AllSatellitesPeriodSummaryResponse) Reset()         { *m = AllSatellite
 func (m *AllSatellitesPeriodSummaryResponse) String() string { return proto.CompactTextString(m) }
 func (*AllSatellitesPeriodSummaryResponse) ProtoMessage()    {}
 func (*AllSatellitesPeriodSummaryResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9a45fd79b06f3a1b, []int{76}
+	return fileDescriptor_9a45fd79b06f3a1b, []int{78}
 }
 func (m *AllSatellitesPeriodSummaryResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_AllSatellitesPeriodSummaryResponse.Unmarshal(m, b)
@@ -3641,7 +3820,7 @@ func (m *SatelliteSummaryRequest) Reset()         { *m = SatelliteSummaryRequest
 func (m *SatelliteSummaryRequest) String() string { return proto.CompactTextString(m) }
 func (*SatelliteSummaryRequest) ProtoMessage()    {}
 func (*SatelliteSummaryRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9a45fd79b06f3a1b, []int{77}
+	return fileDescriptor_9a45fd79b06f3a1b, []int{79}
 }
 func (m *SatelliteSummaryRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SatelliteSummaryRequest.Unmarshal(m, b)
@@ -3679,7 +3858,7 @@ func (m *SatelliteSummaryResponse) Reset()         { *m = SatelliteSummaryRespon
 func (m *SatelliteSummaryResponse) String() string { return proto.CompactTextString(m) }
 func (*SatelliteSummaryResponse) ProtoMessage()    {}
 func (*SatelliteSummaryResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9a45fd79b06f3a1b, []int{78}
+	return fileDescriptor_9a45fd79b06f3a1b, []int{80}
 }
 func (m *SatelliteSummaryResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SatelliteSummaryResponse.Unmarshal(m, b)
@@ -3719,7 +3898,7 @@ func (m *SatellitePeriodSummaryRequest) Reset()         { *m = SatellitePeriodSu
 func (m *SatellitePeriodSummaryRequest) String() string { return proto.CompactTextString(m) }
 func (*SatellitePeriodSummaryRequest) ProtoMessage()    {}
 func (*SatellitePeriodSummaryRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9a45fd79b06f3a1b, []int{79}
+	return fileDescriptor_9a45fd79b06f3a1b, []int{81}
 }
 func (m *SatellitePeriodSummaryRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SatellitePeriodSummaryRequest.Unmarshal(m, b)
@@ -3764,7 +3943,7 @@ func (m *SatellitePeriodSummaryResponse) Reset()         { *m = SatellitePeriodS
 func (m *SatellitePeriodSummaryResponse) String() string { return proto.CompactTextString(m) }
 func (*SatellitePeriodSummaryResponse) ProtoMessage()    {}
 func (*SatellitePeriodSummaryResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9a45fd79b06f3a1b, []int{80}
+	return fileDescriptor_9a45fd79b06f3a1b, []int{82}
 }
 func (m *SatellitePeriodSummaryResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SatellitePeriodSummaryResponse.Unmarshal(m, b)
@@ -3802,7 +3981,7 @@ func (m *EarnedPerSatelliteRequest) Reset()         { *m = EarnedPerSatelliteReq
 func (m *EarnedPerSatelliteRequest) String() string { return proto.CompactTextString(m) }
 func (*EarnedPerSatelliteRequest) ProtoMessage()    {}
 func (*EarnedPerSatelliteRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9a45fd79b06f3a1b, []int{81}
+	return fileDescriptor_9a45fd79b06f3a1b, []int{83}
 }
 func (m *EarnedPerSatelliteRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_EarnedPerSatelliteRequest.Unmarshal(m, b)
@@ -3840,7 +4019,7 @@ func (m *EarnedPerSatelliteResponse) Reset()         { *m = EarnedPerSatelliteRe
 func (m *EarnedPerSatelliteResponse) String() string { return proto.CompactTextString(m) }
 func (*EarnedPerSatelliteResponse) ProtoMessage()    {}
 func (*EarnedPerSatelliteResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9a45fd79b06f3a1b, []int{82}
+	return fileDescriptor_9a45fd79b06f3a1b, []int{84}
 }
 func (m *EarnedPerSatelliteResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_EarnedPerSatelliteResponse.Unmarshal(m, b)
@@ -3879,7 +4058,7 @@ func (m *SatellitePaystubRequest) Reset()         { *m = SatellitePaystubRequest
 func (m *SatellitePaystubRequest) String() string { return proto.CompactTextString(m) }
 func (*SatellitePaystubRequest) ProtoMessage()    {}
 func (*SatellitePaystubRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9a45fd79b06f3a1b, []int{83}
+	return fileDescriptor_9a45fd79b06f3a1b, []int{85}
 }
 func (m *SatellitePaystubRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SatellitePaystubRequest.Unmarshal(m, b)
@@ -3917,7 +4096,7 @@ func (m *SatellitePaystubResponse) Reset()         { *m = SatellitePaystubRespon
 func (m *SatellitePaystubResponse) String() string { return proto.CompactTextString(m) }
 func (*SatellitePaystubResponse) ProtoMessage()    {}
 func (*SatellitePaystubResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9a45fd79b06f3a1b, []int{84}
+	return fileDescriptor_9a45fd79b06f3a1b, []int{86}
 }
 func (m *SatellitePaystubResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SatellitePaystubResponse.Unmarshal(m, b)
@@ -3956,7 +4135,7 @@ func (m *PeriodPaystubRequest) Reset()         { *m = PeriodPaystubRequest{} }
 func (m *PeriodPaystubRequest) String() string { return proto.CompactTextString(m) }
 func (*PeriodPaystubRequest) ProtoMessage()    {}
 func (*PeriodPaystubRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9a45fd79b06f3a1b, []int{85}
+	return fileDescriptor_9a45fd79b06f3a1b, []int{87}
 }
 func (m *PeriodPaystubRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_PeriodPaystubRequest.Unmarshal(m, b)
@@ -4001,7 +4180,7 @@ func (m *PeriodPaystubResponse) Reset()         { *m = PeriodPaystubResponse{} }
 func (m *PeriodPaystubResponse) String() string { return proto.CompactTextString(m) }
 func (*PeriodPaystubResponse) ProtoMessage()    {}
 func (*PeriodPaystubResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9a45fd79b06f3a1b, []int{86}
+	return fileDescriptor_9a45fd79b06f3a1b, []int{88}
 }
 func (m *PeriodPaystubResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_PeriodPaystubResponse.Unmarshal(m, b)
@@ -4041,7 +4220,7 @@ func (m *SatellitePeriodPaystubRequest) Reset()         { *m = SatellitePeriodPa
 func (m *SatellitePeriodPaystubRequest) String() string { return proto.CompactTextString(m) }
 func (*SatellitePeriodPaystubRequest) ProtoMessage()    {}
 func (*SatellitePeriodPaystubRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9a45fd79b06f3a1b, []int{87}
+	return fileDescriptor_9a45fd79b06f3a1b, []int{89}
 }
 func (m *SatellitePeriodPaystubRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SatellitePeriodPaystubRequest.Unmarshal(m, b)
@@ -4086,7 +4265,7 @@ func (m *SatellitePeriodPaystubResponse) Reset()         { *m = SatellitePeriodP
 func (m *SatellitePeriodPaystubResponse) String() string { return proto.CompactTextString(m) }
 func (*SatellitePeriodPaystubResponse) ProtoMessage()    {}
 func (*SatellitePeriodPaystubResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9a45fd79b06f3a1b, []int{88}
+	return fileDescriptor_9a45fd79b06f3a1b, []int{90}
 }
 func (m *SatellitePeriodPaystubResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SatellitePeriodPaystubResponse.Unmarshal(m, b)
@@ -4152,6 +4331,9 @@ func init() {
 	proto.RegisterType((*ReputationResponse)(nil), "multinode.ReputationResponse")
 	proto.RegisterType((*ReputationResponse_Online)(nil), "multinode.ReputationResponse.Online")
 	proto.RegisterType((*ReputationResponse_Audit)(nil), "multinode.ReputationResponse.Audit")
+	proto.RegisterType((*ReputationHistoryRequest)(nil), "multinode.ReputationHistoryRequest")
+	proto.RegisterType((*ReputationHistoryResponse)(nil), "multinode.ReputationHistoryResponse")
+	proto.RegisterType((*ReputationHistoryResponse_Snapshot)(nil), "multinode.ReputationHistoryResponse.Snapshot")
 	proto.RegisterType((*TrustedSatellitesRequest)(nil), "multinode.TrustedSatellitesRequest")
 	proto.RegisterType((*TrustedSatellitesResponse)(nil), "multinode.TrustedSatellitesResponse")
 	proto.RegisterType((*TrustedSatellitesResponse_NodeURL)(nil), "multinode.TrustedSatellitesResponse.NodeURL")
@@ -4213,186 +4395,192 @@ func init() {
 func init() { proto.RegisterFile("multinode.proto", fileDescriptor_9a45fd79b06f3a1b) }
 
 var fileDescriptor_9a45fd79b06f3a1b = []byte{
-	// 2886 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x5a, 0xcb, 0x6f, 0x1c, 0xc7,
-	0xd1, 0xff, 0x86, 0x8f, 0x5d, 0x6e, 0xed, 0xf2, 0xd5, 0xe2, 0x63, 0x39, 0xe2, 0x4b, 0x43, 0x7d,
-	0x12, 0x15, 0x4b, 0x94, 0x4d, 0x1b, 0x4e, 0xec, 0xd8, 0x88, 0x97, 0x92, 0x6c, 0xd2, 0x96, 0x2c,
-	0x66, 0x28, 0x39, 0x86, 0x15, 0x78, 0x3d, 0xe4, 0x34, 0xc9, 0xb1, 0x67, 0x67, 0xd6, 0x33, 0xbd,
-	0x64, 0x08, 0x04, 0x46, 0x0e, 0x89, 0x73, 0x0a, 0x90, 0xb3, 0x11, 0xe4, 0x9a, 0x5b, 0x0e, 0xb9,
-	0xe4, 0x98, 0x5b, 0x60, 0x20, 0xff, 0x41, 0x0e, 0x0e, 0x90, 0x5b, 0x2e, 0xb9, 0xe4, 0x96, 0x53,
-	0xd0, 0x8f, 0x79, 0xf4, 0xbc, 0x96, 0x9c, 0x95, 0x41, 0xdf, 0xa6, 0xab, 0xab, 0x7e, 0x5d, 0x55,
-	0xdd, 0x5d, 0xd3, 0x55, 0xdd, 0x30, 0xd9, 0xe9, 0xd9, 0xc4, 0x72, 0x5c, 0x13, 0x6f, 0x74, 0x3d,
-	0x97, 0xb8, 0xa8, 0x16, 0x12, 0x54, 0x38, 0x72, 0x8f, 0x5c, 0x4e, 0x56, 0x57, 0x8e, 0x5c, 0xf7,
-	0xc8, 0xc6, 0x77, 0x59, 0x6b, 0xbf, 0x77, 0x78, 0x97, 0x58, 0x1d, 0xec, 0x13, 0xa3, 0xd3, 0xe5,
-	0x0c, 0xda, 0x3a, 0x8c, 0xeb, 0xf8, 0xf3, 0x1e, 0xf6, 0xc9, 0x36, 0x36, 0x4c, 0xec, 0xa1, 0x79,
-	0xa8, 0x1a, 0x5d, 0xab, 0xfd, 0x19, 0x3e, 0x6b, 0x2a, 0xab, 0xca, 0x7a, 0x43, 0xaf, 0x18, 0x5d,
-	0xeb, 0x3d, 0x7c, 0xa6, 0xdd, 0x87, 0xa9, 0xfb, 0x96, 0xff, 0xd9, 0x5e, 0xd7, 0x38, 0xc0, 0x42,
-	0x04, 0xbd, 0x08, 0x95, 0x63, 0x26, 0xc6, 0x78, 0xeb, 0x9b, 0xcd, 0x8d, 0x48, 0x2f, 0x09, 0x56,
-	0x17, 0x7c, 0xda, 0x5f, 0x14, 0x98, 0x8e, 0xc1, 0xf8, 0x5d, 0xd7, 0xf1, 0x31, 0x5a, 0x84, 0x9a,
-	0x61, 0xdb, 0xee, 0x81, 0x41, 0xb0, 0xc9, 0xa0, 0x86, 0xf5, 0x88, 0x80, 0x56, 0xa0, 0xde, 0xf3,
-	0xb1, 0xd9, 0xee, 0x5a, 0xf8, 0x00, 0xfb, 0xcd, 0x21, 0xd6, 0x0f, 0x94, 0xb4, 0xcb, 0x28, 0x68,
-	0x09, 0x58, 0xab, 0x4d, 0x3c, 0xc3, 0x3f, 0x6e, 0x0e, 0x73, 0x79, 0x4a, 0x79, 0x42, 0x09, 0x08,
-	0xc1, 0xc8, 0xa1, 0x87, 0x71, 0x73, 0x84, 0x75, 0xb0, 0x6f, 0x36, 0xe2, 0x89, 0x61, 0xd9, 0xc6,
-	0xbe, 0x8d, 0x9b, 0xa3, 0x62, 0xc4, 0x80, 0x80, 0x54, 0x18, 0x73, 0x4f, 0xb0, 0x47, 0x21, 0x9a,
-	0x15, 0xd6, 0x19, 0xb6, 0xb5, 0x3f, 0x2a, 0xd0, 0xd8, 0x23, 0xae, 0x67, 0x1c, 0xe1, 0xa7, 0xbe,
-	0x71, 0x84, 0x91, 0x06, 0xe3, 0x06, 0x69, 0x7b, 0xd8, 0x27, 0x6d, 0xe2, 0x12, 0xc3, 0x66, 0x06,
-	0x28, 0x7a, 0xdd, 0x20, 0x3a, 0xf6, 0xc9, 0x13, 0x4a, 0x42, 0xef, 0xc1, 0x84, 0xe5, 0x10, 0xec,
-	0x9d, 0x18, 0x76, 0xdb, 0x27, 0x86, 0x47, 0x98, 0x15, 0xf5, 0x4d, 0x75, 0x83, 0x4f, 0xd0, 0x46,
-	0x30, 0x41, 0x1b, 0x4f, 0x82, 0x09, 0xda, 0x1a, 0xfb, 0xfa, 0x9b, 0x95, 0xff, 0xfb, 0xed, 0x3f,
-	0x56, 0x14, 0x7d, 0x3c, 0x90, 0xdd, 0xa3, 0xa2, 0xe8, 0x0e, 0x5c, 0x91, 0x06, 0x6c, 0xef, 0x9f,
-	0x11, 0xec, 0x33, 0xbb, 0x15, 0x7d, 0x2a, 0x36, 0xec, 0x16, 0xa5, 0x6b, 0x7f, 0x56, 0xe0, 0x4a,
-	0x5c, 0xe1, 0xd2, 0x93, 0x87, 0x7e, 0x40, 0x1d, 0xe9, 0x76, 0x2e, 0xa4, 0x3b, 0x93, 0x40, 0xaf,
-	0xc0, 0x10, 0x71, 0x99, 0x86, 0xe7, 0x95, 0x1b, 0x22, 0xae, 0xf6, 0x7b, 0x05, 0x66, 0x64, 0xcd,
-	0xc5, 0x7a, 0x79, 0x03, 0xc6, 0x7d, 0x4e, 0x6f, 0xf7, 0x68, 0x47, 0x53, 0x59, 0x1d, 0x5e, 0xaf,
-	0x6f, 0xce, 0xc7, 0x2c, 0x90, 0xe4, 0x1a, 0x7e, 0x7c, 0xc2, 0x9a, 0x50, 0xf5, 0x7b, 0x9d, 0x8e,
-	0xe1, 0x9d, 0x31, 0x4b, 0x14, 0x3d, 0x68, 0xa2, 0x0d, 0xb8, 0x62, 0x9c, 0xe0, 0x08, 0x57, 0xf2,
-	0xec, 0xb4, 0xe8, 0x62, 0x20, 0xdc, 0xb5, 0xff, 0x51, 0x60, 0x31, 0x3e, 0xd0, 0x9e, 0x41, 0xb0,
-	0x6d, 0x5b, 0x64, 0x00, 0x1f, 0xbf, 0x04, 0x0d, 0x3f, 0x40, 0x69, 0x5b, 0x26, 0xd3, 0xb0, 0xb1,
-	0x35, 0x41, 0xfd, 0xf2, 0xf7, 0x6f, 0x56, 0x2a, 0xef, 0xbb, 0x26, 0xde, 0xb9, 0xaf, 0xd7, 0x43,
-	0x9e, 0x1d, 0x33, 0x9c, 0x96, 0xe1, 0x92, 0xd3, 0x32, 0x72, 0xc1, 0x69, 0xf9, 0x83, 0x02, 0x4b,
-	0x39, 0x56, 0x7f, 0xc7, 0xe6, 0x67, 0x17, 0x16, 0xb7, 0x0c, 0xc7, 0x3c, 0xb5, 0x4c, 0x72, 0xfc,
-	0xc8, 0x75, 0xc8, 0xf1, 0x1e, 0x07, 0x2a, 0x1f, 0xbf, 0x5e, 0x86, 0xa5, 0x1c, 0x44, 0x61, 0x3a,
-	0x82, 0x11, 0x16, 0x36, 0x78, 0x14, 0x63, 0xdf, 0xda, 0xaf, 0x15, 0x58, 0x0d, 0xa5, 0x84, 0xc0,
-	0xa5, 0x2c, 0x15, 0xed, 0x4d, 0xb8, 0x56, 0xa0, 0x88, 0x30, 0x21, 0xe6, 0x7f, 0x6e, 0x45, 0xd0,
-	0xd4, 0xde, 0x83, 0xf9, 0xa4, 0x78, 0x79, 0x57, 0xbe, 0x02, 0xcd, 0x34, 0x58, 0x5f, 0x15, 0x7e,
-	0xa9, 0xc0, 0xd2, 0x83, 0x23, 0x0f, 0xfb, 0xfe, 0xa5, 0x3a, 0xf2, 0x75, 0x58, 0xce, 0xd3, 0xa2,
-	0xaf, 0x09, 0xdb, 0x30, 0x23, 0xc9, 0x96, 0x77, 0xe1, 0x4b, 0x30, 0x9b, 0x40, 0xea, 0x3b, 0xf8,
-	0xaf, 0x14, 0x58, 0xde, 0x71, 0x2e, 0xdf, 0x81, 0x3f, 0x84, 0x95, 0x5c, 0x35, 0xfa, 0x1a, 0xb1,
-	0x03, 0xb3, 0xb2, 0x70, 0x79, 0x17, 0x6e, 0xc2, 0x5c, 0x12, 0xaa, 0xef, 0xf0, 0x3f, 0x87, 0xd9,
-	0xfb, 0x86, 0x65, 0x5f, 0x92, 0xe7, 0xf6, 0x60, 0x2e, 0x39, 0xba, 0xd0, 0xf8, 0x35, 0x68, 0xf0,
-	0xb0, 0xe8, 0xb9, 0xb6, 0xdd, 0xeb, 0x8a, 0xa8, 0x3b, 0x17, 0x53, 0x82, 0x87, 0x5b, 0xd6, 0xab,
-	0xd7, 0x7b, 0x51, 0x43, 0x7b, 0x0b, 0x1a, 0x0c, 0xb4, 0xbc, 0x23, 0xdf, 0x85, 0x71, 0x81, 0x30,
-	0xb8, 0x36, 0x7f, 0x53, 0xa0, 0x1e, 0xeb, 0x44, 0xb7, 0xa0, 0x82, 0xd9, 0x1c, 0x09, 0x6d, 0xa6,
-	0x63, 0x20, 0x7c, 0x03, 0xe8, 0x82, 0x01, 0xdd, 0x86, 0xaa, 0xc5, 0xe7, 0x53, 0x1c, 0x53, 0x50,
-	0x8c, 0x57, 0xcc, 0xb4, 0x1e, 0xb0, 0xa0, 0x39, 0xa8, 0x98, 0xd8, 0xc6, 0x04, 0x8b, 0x53, 0xa3,
-	0x68, 0x65, 0x9c, 0xd7, 0x46, 0x4a, 0x9f, 0xd7, 0xb4, 0x87, 0x50, 0x79, 0x10, 0x0e, 0xe7, 0xe1,
-	0xae, 0x61, 0x79, 0x62, 0x45, 0x89, 0x16, 0x9a, 0x81, 0x51, 0xa3, 0x67, 0x5a, 0x44, 0x9c, 0x6d,
-	0x79, 0x83, 0x52, 0xf9, 0xdf, 0x93, 0xeb, 0xc6, 0x1b, 0xda, 0xf7, 0xa1, 0xba, 0xe3, 0xc8, 0x70,
-	0xa6, 0x04, 0x67, 0x46, 0x82, 0x43, 0x71, 0xc1, 0x2d, 0x98, 0xf8, 0x00, 0x7b, 0xbe, 0xe5, 0x3a,
-	0xe5, 0x27, 0xf9, 0x05, 0x98, 0x0c, 0x31, 0xa2, 0x6d, 0x72, 0xc2, 0x49, 0x0c, 0xa5, 0xa6, 0x07,
-	0x4d, 0xed, 0x6d, 0x40, 0x0f, 0x0d, 0x9f, 0xdc, 0x73, 0x1d, 0x62, 0x1c, 0x90, 0xf2, 0x83, 0x7e,
-	0x0c, 0x57, 0x24, 0x1c, 0x31, 0xf0, 0x3b, 0xd0, 0xb0, 0x0d, 0x9f, 0xb4, 0x0f, 0x38, 0x5d, 0xc0,
-	0x9d, 0x6f, 0x86, 0xea, 0x76, 0x04, 0xa8, 0xfd, 0x0c, 0xa6, 0x75, 0xdc, 0xed, 0x11, 0x83, 0x0c,
-	0xe2, 0x9b, 0x32, 0x5b, 0xf9, 0x2b, 0x05, 0xea, 0x2d, 0x3a, 0xd7, 0x3f, 0xb1, 0x1c, 0xd3, 0x3d,
-	0xa5, 0x26, 0x9d, 0xb2, 0x2f, 0xb1, 0xe8, 0x2e, 0x64, 0x12, 0x97, 0xe4, 0x29, 0xc2, 0x35, 0x68,
-	0xb8, 0x8e, 0x6d, 0x39, 0xb8, 0x7d, 0xe0, 0xf6, 0x1c, 0xbe, 0xae, 0x46, 0xf5, 0x3a, 0xa7, 0xdd,
-	0xa3, 0x24, 0x9a, 0x55, 0xf1, 0xec, 0x81, 0x73, 0x0c, 0x33, 0x0e, 0x60, 0x24, 0xc6, 0xa0, 0xfd,
-	0xb7, 0x0a, 0x28, 0xee, 0x97, 0xf0, 0x6c, 0x57, 0xe1, 0x30, 0x42, 0xbb, 0xeb, 0x92, 0x63, 0x92,
-	0xec, 0x1b, 0x8f, 0x19, 0xaf, 0x2e, 0x64, 0xd0, 0x6b, 0xf1, 0x95, 0x5e, 0xdf, 0x5c, 0x2b, 0x16,
-	0x66, 0xbe, 0x09, 0xb6, 0xc3, 0x23, 0x98, 0x34, 0x2d, 0xff, 0xf3, 0x9e, 0x61, 0x5b, 0x87, 0x16,
-	0x36, 0xdb, 0x06, 0x39, 0xe7, 0x89, 0x57, 0x61, 0xfe, 0x99, 0x88, 0x0b, 0xb7, 0x08, 0xf5, 0xb5,
-	0xdf, 0xf3, 0xbb, 0xd8, 0x31, 0x39, 0xd6, 0xc8, 0x05, 0xb0, 0xea, 0xa1, 0x64, 0x8b, 0xa0, 0x0f,
-	0x60, 0xc6, 0x3d, 0x3c, 0x64, 0xce, 0x96, 0x00, 0x47, 0x2f, 0x00, 0x88, 0x04, 0xc2, 0x5e, 0x0c,
-	0xf7, 0x19, 0xcc, 0x07, 0xb8, 0x3d, 0xc7, 0xc4, 0x5e, 0xdb, 0xc3, 0x27, 0x16, 0x3e, 0xa5, 0xd0,
-	0x95, 0x0b, 0x40, 0x07, 0xca, 0x3d, 0xa5, 0x18, 0x3a, 0x83, 0x68, 0x11, 0xd4, 0x82, 0xda, 0x09,
-	0x26, 0x84, 0x6b, 0x5a, 0xbb, 0x00, 0xdc, 0x18, 0x17, 0x6b, 0x11, 0x74, 0x0f, 0xa0, 0xd7, 0x35,
-	0x0d, 0x81, 0x51, 0xbd, 0xc0, 0x52, 0xad, 0x09, 0x39, 0xae, 0xc7, 0xa7, 0xae, 0xe5, 0x70, 0x8c,
-	0xb1, 0x0b, 0x60, 0x8c, 0x71, 0xb1, 0x16, 0x51, 0x97, 0xa1, 0xc2, 0x17, 0x19, 0x8d, 0x7b, 0xfe,
-	0x81, 0xeb, 0x61, 0x91, 0x81, 0xf3, 0x86, 0xfa, 0xa7, 0x21, 0x18, 0x6d, 0x05, 0x01, 0x35, 0xdd,
-	0x8f, 0x6e, 0xc1, 0x14, 0x9f, 0x37, 0x1a, 0xb4, 0xda, 0x9c, 0x81, 0xe7, 0x1d, 0x93, 0x11, 0x7d,
-	0x8f, 0xb1, 0x66, 0xec, 0x99, 0xe1, 0xf8, 0x9e, 0x41, 0x6b, 0x30, 0xee, 0xf7, 0x0e, 0x0e, 0xb0,
-	0xef, 0x0b, 0x16, 0x5e, 0x73, 0x68, 0x08, 0x22, 0x67, 0xa2, 0xd1, 0xde, 0xee, 0x1e, 0x1b, 0x6c,
-	0x85, 0x28, 0x3a, 0x6f, 0xd0, 0xc4, 0x61, 0x1f, 0x13, 0x83, 0xcd, 0xad, 0xa2, 0xb3, 0x6f, 0x0a,
-	0xd7, 0x73, 0x3e, 0x73, 0xdc, 0x53, 0xa7, 0xcd, 0x25, 0xaa, 0xac, 0xb3, 0x21, 0x88, 0x2d, 0x26,
-	0x78, 0x0d, 0x82, 0x76, 0x9b, 0x01, 0x8c, 0xf1, 0xf2, 0x83, 0xa0, 0x6d, 0x51, 0x9c, 0x17, 0xa1,
-	0x7a, 0x6c, 0xd1, 0x1c, 0xeb, 0xac, 0x59, 0x4b, 0xfd, 0x85, 0x63, 0x01, 0x48, 0x0f, 0xd8, 0xb4,
-	0x87, 0xd0, 0x7c, 0xe2, 0xf5, 0x7c, 0x82, 0xcd, 0xf0, 0x98, 0xe1, 0x97, 0x8f, 0xe0, 0x7f, 0x55,
-	0x60, 0x21, 0x03, 0x4e, 0x44, 0x94, 0x67, 0x80, 0x08, 0xef, 0x6c, 0x87, 0xc1, 0xd1, 0x17, 0xc7,
-	0x85, 0xdb, 0x31, 0xec, 0x5c, 0x84, 0x0d, 0x1a, 0x5b, 0x9f, 0xea, 0x0f, 0xf5, 0x69, 0x92, 0x64,
-	0x51, 0x1f, 0x42, 0x55, 0xf4, 0xa2, 0x9b, 0x50, 0xa5, 0x38, 0x6d, 0xf1, 0xbf, 0x4c, 0xc7, 0xe6,
-	0x0a, 0xed, 0xde, 0x31, 0xe9, 0x2f, 0xcd, 0x30, 0xcd, 0xf0, 0x0c, 0x51, 0xd3, 0x83, 0xa6, 0x76,
-	0x0f, 0x26, 0x1f, 0x77, 0xb1, 0x67, 0x10, 0xd7, 0x2b, 0xef, 0x0d, 0x0b, 0xa6, 0x22, 0x10, 0xe1,
-	0x83, 0x19, 0x18, 0xc5, 0x1d, 0xc3, 0xb2, 0xc5, 0x3f, 0x94, 0x37, 0xe8, 0x0f, 0xfe, 0xd4, 0xb0,
-	0x6d, 0x4c, 0x84, 0x1e, 0xa2, 0x85, 0x6e, 0xc2, 0x24, 0xff, 0x6a, 0x1f, 0x62, 0x83, 0xf4, 0x3c,
-	0x96, 0x03, 0x0f, 0xaf, 0xd7, 0xf4, 0x09, 0x4e, 0x7e, 0x5b, 0x50, 0xb5, 0x2f, 0x15, 0x58, 0x79,
-	0xe0, 0x13, 0xab, 0x43, 0xb7, 0xdb, 0xae, 0x71, 0xe6, 0xf6, 0xc8, 0xe5, 0x1c, 0x5a, 0x7f, 0x0c,
-	0xab, 0xf9, 0x7a, 0x08, 0x1f, 0xdc, 0x01, 0x84, 0x03, 0x9e, 0x36, 0x36, 0x3c, 0xc7, 0x72, 0x8e,
-	0x7c, 0x71, 0xb4, 0x99, 0x0e, 0x7b, 0x1e, 0x88, 0x0e, 0xed, 0x5d, 0x98, 0x4b, 0x40, 0x96, 0x9f,
-	0x92, 0x6d, 0x98, 0x4f, 0x61, 0x95, 0xd3, 0x6a, 0x0b, 0x26, 0x06, 0xce, 0x49, 0x76, 0x60, 0x32,
-	0x99, 0x8c, 0xbc, 0x0a, 0xf5, 0x2e, 0xd3, 0xab, 0x6d, 0x39, 0x87, 0xae, 0x40, 0x9a, 0x8d, 0x21,
-	0x71, 0xad, 0x77, 0x9c, 0x43, 0x57, 0x87, 0x6e, 0xf8, 0xad, 0x7d, 0x02, 0x33, 0x02, 0x6a, 0x17,
-	0x7b, 0x96, 0x6b, 0x96, 0x9f, 0xf4, 0x39, 0xa8, 0x74, 0x19, 0x44, 0xb0, 0x16, 0x79, 0x4b, 0x7b,
-	0x0c, 0xb3, 0x89, 0x11, 0x06, 0x54, 0xf9, 0x0b, 0x98, 0xbf, 0xd4, 0xcc, 0x54, 0x87, 0x66, 0x6e,
-	0x4a, 0x5a, 0xd6, 0xa6, 0xdf, 0x29, 0xb0, 0x94, 0x04, 0x1d, 0x74, 0x42, 0x4a, 0x54, 0x0a, 0xa3,
-	0x39, 0x1c, 0x96, 0xe6, 0xf0, 0x43, 0x58, 0xce, 0xd3, 0x6e, 0x40, 0xc3, 0x5b, 0x30, 0x4e, 0xb7,
-	0x06, 0x2e, 0x6f, 0xa7, 0x76, 0x03, 0x26, 0x02, 0x88, 0x28, 0x58, 0x46, 0x95, 0xf6, 0x61, 0x9d,
-	0x37, 0x58, 0x3c, 0x60, 0x7c, 0x83, 0x2f, 0x1b, 0xed, 0x13, 0x98, 0x4f, 0x61, 0x89, 0xc1, 0x1f,
-	0xc0, 0x14, 0x66, 0x5d, 0xd1, 0xcf, 0x4a, 0xfc, 0xab, 0xd4, 0x78, 0x56, 0x9a, 0x90, 0x9e, 0xc4,
-	0x32, 0x41, 0xfb, 0x08, 0x26, 0x13, 0x3c, 0xd9, 0x66, 0x95, 0x59, 0xc1, 0xdb, 0x30, 0xf3, 0xd4,
-	0x31, 0x2d, 0x9f, 0x78, 0xd6, 0x7e, 0x8f, 0x0c, 0xe2, 0xfb, 0x3b, 0x30, 0x9b, 0x40, 0x2a, 0x9c,
-	0x82, 0x2f, 0x60, 0x7e, 0xd7, 0x38, 0xf3, 0x49, 0x6f, 0xff, 0x72, 0xb6, 0xee, 0x36, 0x34, 0xd3,
-	0xe3, 0x0b, 0x8d, 0x6f, 0x43, 0xb5, 0xcb, 0xfb, 0x84, 0x06, 0x48, 0x5e, 0xbd, 0xb4, 0x47, 0x0f,
-	0x58, 0x68, 0x18, 0x0f, 0x68, 0xa5, 0x9d, 0xf7, 0x23, 0x98, 0x0c, 0x31, 0x4a, 0x29, 0xf1, 0x09,
-	0xcc, 0x08, 0xda, 0xb7, 0x15, 0xbc, 0x1f, 0xc0, 0x6c, 0x62, 0x84, 0x52, 0x8a, 0xd2, 0xf0, 0x96,
-	0x74, 0xfc, 0x77, 0x28, 0xbc, 0xbd, 0x0f, 0xcb, 0x79, 0xda, 0x95, 0x32, 0xf7, 0x15, 0x80, 0x28,
-	0xdc, 0xd1, 0x83, 0xfb, 0x31, 0xb6, 0xc3, 0x8a, 0x3f, 0xfd, 0xa6, 0x34, 0x56, 0x97, 0xe1, 0x05,
-	0x18, 0xf6, 0xad, 0xfd, 0x66, 0x18, 0xaa, 0x02, 0x0a, 0x69, 0x30, 0xce, 0x6b, 0x63, 0xe2, 0x22,
-	0x2f, 0xb8, 0x33, 0x64, 0xc4, 0x16, 0xbb, 0xc1, 0x43, 0x57, 0xa1, 0xc6, 0x79, 0x8e, 0x70, 0x50,
-	0x18, 0x1a, 0x63, 0x84, 0x77, 0x30, 0x41, 0xeb, 0x30, 0x15, 0x76, 0xb6, 0x45, 0x4d, 0x89, 0xa7,
-	0x23, 0x13, 0x01, 0x8f, 0xce, 0x6b, 0x4b, 0x37, 0x60, 0x32, 0xe2, 0xe4, 0xb9, 0x37, 0x4f, 0x4a,
-	0xc6, 0x03, 0x46, 0x9e, 0x1c, 0xad, 0x42, 0xe3, 0xc0, 0xed, 0x74, 0x43, 0x8d, 0xf8, 0xa5, 0x28,
-	0x50, 0x9a, 0x50, 0x68, 0x01, 0xc6, 0x18, 0x07, 0xd5, 0x87, 0xdf, 0x8a, 0x56, 0x69, 0x9b, 0xaa,
-	0x73, 0x03, 0x26, 0x83, 0xae, 0x40, 0x9b, 0x2a, 0x1f, 0x44, 0x70, 0x08, 0x65, 0xae, 0xc3, 0x44,
-	0xc8, 0xc7, 0x75, 0x19, 0xe3, 0x09, 0x92, 0x60, 0xe3, 0xaa, 0x04, 0x1e, 0xad, 0x65, 0x78, 0x14,
-	0x22, 0x8f, 0xa2, 0x55, 0xa8, 0xc7, 0x62, 0x53, 0xb3, 0xce, 0xba, 0xe2, 0x24, 0xa4, 0xc2, 0x98,
-	0x69, 0xf9, 0x5d, 0xd7, 0xc7, 0x66, 0xb3, 0xc1, 0x5d, 0x18, 0xb4, 0x69, 0x8a, 0xb3, 0x8d, 0x6d,
-	0xb3, 0xd5, 0xa1, 0x49, 0xd9, 0x36, 0xcf, 0x7b, 0xca, 0x6f, 0xf6, 0xaf, 0x87, 0x60, 0x21, 0x03,
-	0x4e, 0xac, 0xaf, 0xdd, 0x28, 0x01, 0xe3, 0xff, 0x8a, 0x57, 0x63, 0x80, 0xb9, 0x62, 0x19, 0x3d,
-	0x01, 0x8c, 0xfa, 0x06, 0x40, 0xd4, 0x1b, 0x5b, 0xf9, 0x4a, 0x7c, 0xe5, 0x53, 0xba, 0xd1, 0x09,
-	0x2b, 0x40, 0xc3, 0xba, 0x68, 0xa9, 0x5f, 0x29, 0x30, 0x9d, 0x02, 0x4f, 0x6d, 0x39, 0xa5, 0xff,
-	0x96, 0xd3, 0xa1, 0x41, 0xa7, 0xa7, 0xcd, 0x71, 0x69, 0xbe, 0x44, 0xad, 0xbb, 0x7b, 0x41, 0xeb,
-	0xf4, 0xfa, 0x71, 0xf8, 0xed, 0x6b, 0x8f, 0xe1, 0x6a, 0xe2, 0x30, 0xce, 0x6e, 0xb3, 0xcb, 0xcf,
-	0xcd, 0x23, 0x58, 0xcc, 0x06, 0x2c, 0x77, 0xc4, 0x7f, 0x0c, 0x57, 0x5b, 0xb6, 0x1d, 0xe5, 0x98,
-	0x03, 0x9f, 0xf7, 0x3f, 0x80, 0xc5, 0x6c, 0xc0, 0x01, 0x0f, 0x5f, 0x1d, 0xb8, 0x26, 0xe1, 0xf2,
-	0xa0, 0x37, 0xa8, 0xba, 0xb9, 0x3f, 0x93, 0x9f, 0x82, 0x56, 0x34, 0xdc, 0x73, 0x48, 0x0b, 0x02,
-	0xe8, 0x81, 0x4d, 0x28, 0x99, 0x16, 0xa4, 0xc6, 0x7f, 0x1e, 0x69, 0x81, 0xfc, 0x4b, 0xba, 0x04,
-	0xd3, 0x0a, 0xd3, 0x82, 0x1c, 0xed, 0x06, 0x34, 0xfc, 0x11, 0x2c, 0xf0, 0xd3, 0xef, 0x2e, 0xf6,
-	0x9e, 0xc3, 0x71, 0xfd, 0x00, 0xd4, 0x2c, 0xb8, 0xe7, 0x7b, 0x62, 0x8f, 0x2f, 0xc0, 0x41, 0xcf,
-	0x86, 0x25, 0x0f, 0xb7, 0xe9, 0xf1, 0x4b, 0x9f, 0x2b, 0xd9, 0x74, 0x0e, 0x6c, 0x46, 0xd1, 0xb9,
-	0x52, 0x1e, 0xa1, 0xf4, 0xb9, 0x32, 0xb1, 0x02, 0x2f, 0xc1, 0xf3, 0x45, 0xe7, 0xca, 0x3c, 0xed,
-	0xca, 0x98, 0xbb, 0xf9, 0x8b, 0x21, 0xa8, 0x8a, 0x77, 0x31, 0xe8, 0x6d, 0xa8, 0x85, 0xef, 0xe4,
-	0xd0, 0xd5, 0x98, 0x54, 0xf2, 0x11, 0x9e, 0xba, 0x98, 0xdd, 0x29, 0x34, 0xd8, 0x86, 0x51, 0xfe,
-	0xaa, 0x66, 0x39, 0xef, 0xf1, 0x8d, 0x80, 0x59, 0xc9, 0xed, 0x17, 0x48, 0x07, 0x30, 0x21, 0x3f,
-	0xf7, 0x41, 0x37, 0x73, 0x44, 0x92, 0x3b, 0x5a, 0x5d, 0xef, 0xcf, 0xc8, 0x07, 0xd9, 0xfc, 0x67,
-	0x05, 0x6a, 0xe1, 0xab, 0x10, 0x64, 0x40, 0x23, 0xfe, 0xc8, 0x46, 0x1a, 0xb0, 0xe8, 0x61, 0x8f,
-	0x34, 0x60, 0xf1, 0x7b, 0x9d, 0x13, 0x58, 0xc8, 0x7d, 0x11, 0x83, 0x5e, 0xc8, 0x82, 0xc9, 0x29,
-	0x4e, 0xa9, 0xb7, 0xcf, 0xc7, 0x1c, 0x16, 0xbd, 0xa7, 0x92, 0x4c, 0x48, 0x2b, 0x40, 0x08, 0x46,
-	0x59, 0x2b, 0xe4, 0x11, 0xe0, 0x1d, 0x98, 0xcb, 0x7e, 0x9d, 0x82, 0xd6, 0x53, 0x37, 0xe7, 0x79,
-	0xe6, 0xdc, 0x3a, 0x07, 0xa7, 0x18, 0x4e, 0x87, 0x71, 0x89, 0x03, 0xad, 0xe4, 0xc9, 0x06, 0xe0,
-	0xab, 0xf9, 0x0c, 0x02, 0xb3, 0x0b, 0xf3, 0x39, 0xef, 0x43, 0xd0, 0xad, 0xf4, 0x8d, 0x7e, 0x9e,
-	0x11, 0xdf, 0x3b, 0x0f, 0xab, 0x18, 0xf1, 0x29, 0x4c, 0xc8, 0x2c, 0x68, 0x35, 0x57, 0x3a, 0xc0,
-	0xbf, 0x56, 0xc0, 0x11, 0xc1, 0xca, 0xcf, 0x35, 0x24, 0xd8, 0xcc, 0x77, 0x24, 0x12, 0x6c, 0xce,
-	0x5b, 0x8f, 0xd7, 0x61, 0x94, 0xf5, 0xa0, 0xf9, 0x24, 0x6f, 0x00, 0xd2, 0x4c, 0x77, 0x88, 0x4d,
-	0xf6, 0xe5, 0x30, 0x8c, 0xd0, 0x38, 0x87, 0xde, 0x82, 0xaa, 0xb8, 0xce, 0x47, 0x0b, 0x31, 0x6e,
-	0xf9, 0x99, 0x80, 0xaa, 0x66, 0x75, 0x09, 0x35, 0x1e, 0x42, 0x3d, 0x76, 0x37, 0x8f, 0x96, 0x62,
-	0xac, 0xe9, 0xbb, 0x7f, 0x75, 0x39, 0xaf, 0x5b, 0xa0, 0xed, 0x00, 0x44, 0xb7, 0xc0, 0x68, 0x31,
-	0xe7, 0x72, 0x98, 0x63, 0x2d, 0x15, 0x5e, 0x1d, 0xa3, 0x8f, 0x61, 0x3a, 0x75, 0x5f, 0x84, 0xd6,
-	0x8a, 0x6f, 0x93, 0x38, 0xf0, 0xf5, 0xf3, 0x5c, 0x39, 0xa1, 0x7b, 0x30, 0x16, 0x5c, 0xe2, 0xa0,
-	0xb8, 0x83, 0x12, 0xd7, 0x43, 0xea, 0xd5, 0xcc, 0x3e, 0x31, 0x11, 0xff, 0xaa, 0xb1, 0x92, 0x80,
-	0xdb, 0x23, 0x3e, 0x9d, 0x8b, 0x60, 0xdd, 0xc5, 0xe7, 0x22, 0xb1, 0xe0, 0xd4, 0xac, 0xae, 0x68,
-	0x1b, 0x4a, 0x95, 0x78, 0x69, 0x1b, 0x66, 0xdd, 0x02, 0x48, 0xdb, 0x30, 0xbb, 0x88, 0xff, 0x0c,
-	0xa6, 0x52, 0xfb, 0x4f, 0x4b, 0x4b, 0xa5, 0x56, 0xf0, 0x5a, 0x21, 0x4f, 0x14, 0xa6, 0xb2, 0xcb,
-	0xce, 0x52, 0x98, 0x2a, 0xac, 0x9b, 0x4b, 0x61, 0xaa, 0x4f, 0x0d, 0xfb, 0x4d, 0xa8, 0xf0, 0x43,
-	0x1e, 0x6a, 0xa6, 0xce, 0x7d, 0x01, 0xdc, 0x42, 0x46, 0x8f, 0x10, 0xff, 0x30, 0x5d, 0xb1, 0xbd,
-	0x56, 0x70, 0x7e, 0x14, 0x80, 0x5a, 0x11, 0x8b, 0x40, 0xf6, 0xa1, 0x99, 0x77, 0x39, 0x86, 0xe2,
-	0x11, 0xac, 0xcf, 0x4d, 0x9e, 0xfa, 0xc2, 0xb9, 0x78, 0x63, 0xe6, 0xc8, 0x3c, 0xb2, 0x39, 0x99,
-	0x57, 0x6b, 0xb2, 0x39, 0x39, 0x37, 0x66, 0x3a, 0x8c, 0x4b, 0x45, 0x63, 0x69, 0x1d, 0x66, 0x15,
-	0xa6, 0xa5, 0x75, 0x98, 0x5d, 0x6f, 0x7e, 0x06, 0x53, 0xc9, 0x12, 0x9e, 0xb4, 0x0e, 0x73, 0xca,
-	0xce, 0xd2, 0x3a, 0xcc, 0x2d, 0x0d, 0xbf, 0x15, 0x15, 0xe6, 0x16, 0x32, 0xce, 0x67, 0x19, 0x5b,
-	0x2f, 0x79, 0xce, 0xd3, 0x61, 0x5c, 0xaa, 0xa3, 0x4a, 0x26, 0x67, 0xd5, 0x70, 0x25, 0x93, 0xb3,
-	0x4b, 0xb0, 0x1d, 0x98, 0xcb, 0xae, 0x5a, 0x4a, 0xbb, 0xa3, 0xb0, 0xec, 0x2a, 0xed, 0x8e, 0x3e,
-	0x25, 0xd0, 0x8f, 0xb3, 0x2a, 0x42, 0x6b, 0xc5, 0x85, 0x9c, 0x74, 0xc0, 0xcc, 0xad, 0xf6, 0x6c,
-	0xfe, 0xbb, 0x06, 0x15, 0xb1, 0xce, 0x8e, 0x60, 0x26, 0xab, 0xde, 0x81, 0x6e, 0xc4, 0x5f, 0x25,
-	0xe4, 0x57, 0x58, 0xd4, 0x9b, 0x7d, 0xf9, 0x84, 0x4d, 0x67, 0xa0, 0xe6, 0x57, 0x24, 0xd0, 0xed,
-	0x3c, 0x98, 0xac, 0x4c, 0x5c, 0xbd, 0x73, 0x4e, 0xee, 0x58, 0xe0, 0x4c, 0x94, 0x0b, 0xe4, 0xc0,
-	0x99, 0x5d, 0xcb, 0x90, 0x03, 0x67, 0x5e, 0xbd, 0x81, 0x06, 0xce, 0xcc, 0xc4, 0x5c, 0x0e, 0x9c,
-	0x45, 0x95, 0x05, 0x39, 0x70, 0x16, 0x67, 0xf9, 0x03, 0x06, 0x4e, 0x03, 0x50, 0x3a, 0x3b, 0x47,
-	0xd7, 0x53, 0x02, 0x19, 0xb5, 0x00, 0xf5, 0xff, 0xfb, 0x70, 0x5d, 0x66, 0x04, 0x3d, 0x82, 0x99,
-	0xac, 0xb2, 0xa2, 0xb4, 0x8c, 0x0b, 0x0a, 0x99, 0xd2, 0x32, 0x2e, 0xac, 0x4f, 0x7e, 0x4b, 0x01,
-	0x35, 0x59, 0x4d, 0xc8, 0x5e, 0x9f, 0x89, 0x28, 0xb8, 0x56, 0xc8, 0xf3, 0x5c, 0x03, 0x6a, 0x3c,
-	0xa3, 0x96, 0x03, 0x6a, 0x46, 0x25, 0x40, 0x0e, 0xa8, 0x99, 0xc9, 0x78, 0x7a, 0xd7, 0x04, 0xe0,
-	0x05, 0xbb, 0x26, 0x31, 0xca, 0xad, 0x73, 0x70, 0xf2, 0xe1, 0xb6, 0xae, 0x7f, 0xa4, 0xd1, 0x08,
-	0xf8, 0xe9, 0x86, 0xe5, 0xde, 0x65, 0x1f, 0x77, 0xbb, 0x9e, 0x75, 0x62, 0x10, 0x7c, 0x37, 0x84,
-	0xe8, 0xee, 0xef, 0x57, 0xd8, 0x2b, 0xb7, 0x97, 0xff, 0x17, 0x00, 0x00, 0xff, 0xff, 0xde, 0x43,
-	0x56, 0xb1, 0xd2, 0x37, 0x00, 0x00,
+	// 2992 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x5a, 0xc9, 0x6f, 0x1c, 0xc7,
+	0xd5, 0xff, 0x9a, 0x43, 0xce, 0x70, 0xde, 0x0c, 0xb7, 0x12, 0x97, 0x61, 0x8b, 0xe2, 0xd2, 0xd4,
+	0x27, 0x91, 0xb1, 0x44, 0xd9, 0xb4, 0xe1, 0xc4, 0x8e, 0x8d, 0x78, 0x28, 0xc9, 0x26, 0x2d, 0xc9,
+	0x62, 0x9a, 0x92, 0x63, 0xd8, 0x81, 0xc7, 0x4d, 0x76, 0x91, 0x6c, 0xbb, 0xa7, 0xbb, 0xdd, 0x5d,
+	0x43, 0x85, 0x40, 0x60, 0xe4, 0x90, 0xe5, 0x14, 0x20, 0xc7, 0xc0, 0x08, 0x72, 0xcd, 0x29, 0x39,
+	0xe4, 0x92, 0x63, 0x6e, 0x81, 0x81, 0xfc, 0x07, 0x39, 0x38, 0x40, 0x0e, 0x01, 0x72, 0x49, 0x0e,
+	0xb9, 0xe5, 0x14, 0xd4, 0xd2, 0xfb, 0x42, 0x4e, 0x0f, 0x0d, 0x1a, 0xb9, 0x75, 0xbd, 0xfa, 0xbd,
+	0x5f, 0xbd, 0xda, 0x5e, 0xbf, 0x7a, 0x55, 0x30, 0xd1, 0xed, 0x99, 0xc4, 0xb0, 0x6c, 0x1d, 0x6f,
+	0x38, 0xae, 0x4d, 0x6c, 0x54, 0x0f, 0x04, 0x32, 0x1c, 0xd9, 0x47, 0x36, 0x17, 0xcb, 0x4b, 0x47,
+	0xb6, 0x7d, 0x64, 0xe2, 0x3b, 0xac, 0xb4, 0xdf, 0x3b, 0xbc, 0x43, 0x8c, 0x2e, 0xf6, 0x88, 0xd6,
+	0x75, 0x38, 0x40, 0x59, 0x83, 0x31, 0x15, 0x7f, 0xda, 0xc3, 0x1e, 0xd9, 0xc6, 0x9a, 0x8e, 0x5d,
+	0x34, 0x07, 0x35, 0xcd, 0x31, 0x3a, 0x9f, 0xe0, 0xd3, 0x96, 0xb4, 0x2c, 0xad, 0x35, 0xd5, 0xaa,
+	0xe6, 0x18, 0x0f, 0xf0, 0xa9, 0x72, 0x0f, 0x26, 0xef, 0x19, 0xde, 0x27, 0x7b, 0x8e, 0x76, 0x80,
+	0x85, 0x0a, 0x7a, 0x1e, 0xaa, 0xc7, 0x4c, 0x8d, 0x61, 0x1b, 0x9b, 0xad, 0x8d, 0xd0, 0xae, 0x18,
+	0xad, 0x2a, 0x70, 0xca, 0x1f, 0x25, 0x98, 0x8a, 0xd0, 0x78, 0x8e, 0x6d, 0x79, 0x18, 0x2d, 0x40,
+	0x5d, 0x33, 0x4d, 0xfb, 0x40, 0x23, 0x58, 0x67, 0x54, 0x15, 0x35, 0x14, 0xa0, 0x25, 0x68, 0xf4,
+	0x3c, 0xac, 0x77, 0x1c, 0x03, 0x1f, 0x60, 0xaf, 0x35, 0xc4, 0xea, 0x81, 0x8a, 0x76, 0x99, 0x04,
+	0x5d, 0x03, 0x56, 0xea, 0x10, 0x57, 0xf3, 0x8e, 0x5b, 0x15, 0xae, 0x4f, 0x25, 0x4f, 0xa8, 0x00,
+	0x21, 0x18, 0x3e, 0x74, 0x31, 0x6e, 0x0d, 0xb3, 0x0a, 0xf6, 0xcd, 0x5a, 0x3c, 0xd1, 0x0c, 0x53,
+	0xdb, 0x37, 0x71, 0x6b, 0x44, 0xb4, 0xe8, 0x0b, 0x90, 0x0c, 0xa3, 0xf6, 0x09, 0x76, 0x29, 0x45,
+	0xab, 0xca, 0x2a, 0x83, 0xb2, 0xf2, 0x3b, 0x09, 0x9a, 0x7b, 0xc4, 0x76, 0xb5, 0x23, 0xfc, 0xd4,
+	0xd3, 0x8e, 0x30, 0x52, 0x60, 0x4c, 0x23, 0x1d, 0x17, 0x7b, 0xa4, 0x43, 0x6c, 0xa2, 0x99, 0xac,
+	0x03, 0x92, 0xda, 0xd0, 0x88, 0x8a, 0x3d, 0xf2, 0x84, 0x8a, 0xd0, 0x03, 0x18, 0x37, 0x2c, 0x82,
+	0xdd, 0x13, 0xcd, 0xec, 0x78, 0x44, 0x73, 0x09, 0xeb, 0x45, 0x63, 0x53, 0xde, 0xe0, 0x13, 0xb4,
+	0xe1, 0x4f, 0xd0, 0xc6, 0x13, 0x7f, 0x82, 0xb6, 0x46, 0xbf, 0xf8, 0x72, 0xe9, 0xff, 0x7e, 0xf1,
+	0xd7, 0x25, 0x49, 0x1d, 0xf3, 0x75, 0xf7, 0xa8, 0x2a, 0xba, 0x0d, 0x57, 0x62, 0x0d, 0x76, 0xf6,
+	0x4f, 0x09, 0xf6, 0x58, 0xbf, 0x25, 0x75, 0x32, 0xd2, 0xec, 0x16, 0x95, 0x2b, 0x7f, 0x90, 0xe0,
+	0x4a, 0xd4, 0xe0, 0xd2, 0x93, 0x87, 0xbe, 0x45, 0x07, 0xd2, 0xee, 0xf6, 0x65, 0x3b, 0xd3, 0x40,
+	0x2f, 0xc1, 0x10, 0xb1, 0x5b, 0x95, 0x3e, 0xf4, 0x86, 0x88, 0xad, 0xfc, 0x5a, 0x82, 0xe9, 0xb8,
+	0xe5, 0x62, 0xbd, 0xbc, 0x06, 0x63, 0x1e, 0x97, 0x77, 0x7a, 0xb4, 0xa2, 0x25, 0x2d, 0x57, 0xd6,
+	0x1a, 0x9b, 0x73, 0x91, 0x1e, 0xc4, 0xf4, 0x9a, 0x5e, 0x74, 0xc2, 0x5a, 0x50, 0xf3, 0x7a, 0xdd,
+	0xae, 0xe6, 0x9e, 0xb2, 0x9e, 0x48, 0xaa, 0x5f, 0x44, 0x1b, 0x70, 0x45, 0x3b, 0xc1, 0x21, 0x6f,
+	0x6c, 0x64, 0xa7, 0x44, 0x15, 0x23, 0xe1, 0x43, 0xfb, 0x6f, 0x09, 0x16, 0xa2, 0x0d, 0xed, 0x69,
+	0x04, 0x9b, 0xa6, 0x41, 0x06, 0x18, 0xe3, 0x17, 0xa0, 0xe9, 0xf9, 0x2c, 0x1d, 0x43, 0x67, 0x16,
+	0x36, 0xb7, 0xc6, 0xe9, 0xb8, 0xfc, 0xe5, 0xcb, 0xa5, 0xea, 0x3b, 0xb6, 0x8e, 0x77, 0xee, 0xa9,
+	0x8d, 0x00, 0xb3, 0xa3, 0x07, 0xd3, 0x52, 0x29, 0x39, 0x2d, 0xc3, 0x7d, 0x4e, 0xcb, 0x6f, 0x24,
+	0xb8, 0x96, 0xd3, 0xeb, 0xaf, 0xd9, 0xfc, 0xec, 0xc2, 0xc2, 0x96, 0x66, 0xe9, 0xcf, 0x0c, 0x9d,
+	0x1c, 0x3f, 0xb2, 0x2d, 0x72, 0xbc, 0xc7, 0x89, 0xca, 0xfb, 0xaf, 0x17, 0xe1, 0x5a, 0x0e, 0xa3,
+	0xe8, 0x3a, 0x82, 0x61, 0xe6, 0x36, 0xb8, 0x17, 0x63, 0xdf, 0xca, 0xcf, 0x24, 0x58, 0x0e, 0xb4,
+	0x84, 0xc2, 0xa5, 0x2c, 0x15, 0xe5, 0x75, 0x58, 0x29, 0x30, 0x44, 0x74, 0x21, 0x32, 0xfe, 0xbc,
+	0x17, 0x7e, 0x51, 0x79, 0x00, 0x73, 0x49, 0xf5, 0xf2, 0x43, 0xf9, 0x12, 0xb4, 0xd2, 0x64, 0x67,
+	0x9a, 0xf0, 0x63, 0x09, 0xae, 0xdd, 0x3f, 0x72, 0xb1, 0xe7, 0x5d, 0xea, 0x40, 0xbe, 0x0a, 0x8b,
+	0x79, 0x56, 0x9c, 0xd9, 0x85, 0x6d, 0x98, 0x8e, 0xe9, 0x96, 0x1f, 0xc2, 0x17, 0x60, 0x26, 0xc1,
+	0x74, 0x66, 0xe3, 0x3f, 0x91, 0x60, 0x71, 0xc7, 0xba, 0xfc, 0x01, 0xfc, 0x36, 0x2c, 0xe5, 0x9a,
+	0x71, 0x66, 0x27, 0x76, 0x60, 0x26, 0xae, 0x5c, 0x7e, 0x08, 0x37, 0x61, 0x36, 0x49, 0x75, 0x66,
+	0xf3, 0x3f, 0x84, 0x99, 0x7b, 0x9a, 0x61, 0x5e, 0xd2, 0xc8, 0xed, 0xc1, 0x6c, 0xb2, 0x75, 0x61,
+	0xf1, 0x2b, 0xd0, 0xe4, 0x6e, 0xd1, 0xb5, 0x4d, 0xb3, 0xe7, 0x08, 0xaf, 0x3b, 0x1b, 0x31, 0x82,
+	0xbb, 0x5b, 0x56, 0xab, 0x36, 0x7a, 0x61, 0x41, 0x79, 0x03, 0x9a, 0x8c, 0xb4, 0xfc, 0x40, 0xbe,
+	0x0d, 0x63, 0x82, 0x61, 0x70, 0x6b, 0xfe, 0x2c, 0x41, 0x23, 0x52, 0x89, 0xd6, 0xa1, 0x8a, 0xd9,
+	0x1c, 0x09, 0x6b, 0xa6, 0x22, 0x24, 0x7c, 0x03, 0xa8, 0x02, 0x80, 0x6e, 0x41, 0xcd, 0xe0, 0xf3,
+	0x29, 0xc2, 0x14, 0x14, 0xc1, 0x8a, 0x99, 0x56, 0x7d, 0x08, 0x9a, 0x85, 0xaa, 0x8e, 0x4d, 0x4c,
+	0xb0, 0x88, 0x1a, 0x45, 0x29, 0x23, 0x5e, 0x1b, 0x2e, 0x1d, 0xaf, 0x29, 0x0f, 0xa1, 0x7a, 0x3f,
+	0x68, 0xce, 0xc5, 0x8e, 0x66, 0xb8, 0x62, 0x45, 0x89, 0x12, 0x9a, 0x86, 0x11, 0xad, 0xa7, 0x1b,
+	0x44, 0xc4, 0xb6, 0xbc, 0x40, 0xa5, 0xfc, 0xef, 0xc9, 0x6d, 0xe3, 0x05, 0xe5, 0x9b, 0x50, 0xdb,
+	0xb1, 0xe2, 0x74, 0x7a, 0x8c, 0x4e, 0x0f, 0x15, 0x87, 0xa2, 0x8a, 0x5b, 0x30, 0xfe, 0x2e, 0x76,
+	0x3d, 0xc3, 0xb6, 0xca, 0x4f, 0xf2, 0x73, 0x30, 0x11, 0x70, 0x84, 0xdb, 0xe4, 0x84, 0x8b, 0x18,
+	0x4b, 0x5d, 0xf5, 0x8b, 0xca, 0x9b, 0x80, 0x1e, 0x6a, 0x1e, 0xb9, 0x6b, 0x5b, 0x44, 0x3b, 0x20,
+	0xe5, 0x1b, 0xfd, 0x10, 0xae, 0xc4, 0x78, 0x44, 0xc3, 0x6f, 0x41, 0xd3, 0xd4, 0x3c, 0xd2, 0x39,
+	0xe0, 0xf2, 0x96, 0xd4, 0xc7, 0x0c, 0x35, 0xcc, 0x90, 0x50, 0xf9, 0x01, 0x4c, 0xa9, 0xd8, 0xe9,
+	0x11, 0x8d, 0x0c, 0x32, 0x36, 0x65, 0xb6, 0xf2, 0xe7, 0x12, 0x34, 0xda, 0x74, 0xae, 0xbf, 0x67,
+	0x58, 0xba, 0xfd, 0x8c, 0x76, 0xe9, 0x19, 0xfb, 0x12, 0x8b, 0xae, 0xaf, 0x2e, 0x71, 0x4d, 0x7e,
+	0x44, 0x58, 0x81, 0xa6, 0x6d, 0x99, 0x86, 0x85, 0x3b, 0x07, 0x76, 0xcf, 0xe2, 0xeb, 0x6a, 0x44,
+	0x6d, 0x70, 0xd9, 0x5d, 0x2a, 0xa2, 0xa7, 0x2a, 0x7e, 0x7a, 0xe0, 0x88, 0x0a, 0x43, 0x00, 0x13,
+	0x31, 0x80, 0xf2, 0x9f, 0x1a, 0xa0, 0xe8, 0xb8, 0x04, 0xb1, 0x5d, 0x95, 0xd3, 0x08, 0xeb, 0xae,
+	0xc7, 0x06, 0x26, 0x09, 0xdf, 0x78, 0xcc, 0xb0, 0xaa, 0xd0, 0x41, 0xaf, 0x44, 0x57, 0x7a, 0x63,
+	0x73, 0xb5, 0x58, 0x99, 0x8d, 0x8d, 0xbf, 0x1d, 0x1e, 0xc1, 0x84, 0x6e, 0x78, 0x9f, 0xf6, 0x34,
+	0xd3, 0x38, 0x34, 0xb0, 0xde, 0xd1, 0xc8, 0x39, 0x23, 0x5e, 0x89, 0x8d, 0xcf, 0x78, 0x54, 0xb9,
+	0x4d, 0xe8, 0x58, 0x7b, 0x3d, 0xcf, 0xc1, 0x96, 0xce, 0xb9, 0x86, 0xfb, 0xe0, 0x6a, 0x04, 0x9a,
+	0x6d, 0x82, 0xde, 0x85, 0x69, 0xfb, 0xf0, 0x90, 0x0d, 0x76, 0x8c, 0x70, 0xa4, 0x0f, 0x42, 0x24,
+	0x18, 0xf6, 0x22, 0xbc, 0x1f, 0xc0, 0x9c, 0xcf, 0xdb, 0xb3, 0x74, 0xec, 0x76, 0x5c, 0x7c, 0x62,
+	0xe0, 0x67, 0x94, 0xba, 0xda, 0x07, 0xb5, 0x6f, 0xdc, 0x53, 0xca, 0xa1, 0x32, 0x8a, 0x36, 0x41,
+	0x6d, 0xa8, 0x9f, 0x60, 0x42, 0xb8, 0xa5, 0xf5, 0x3e, 0xe8, 0x46, 0xb9, 0x5a, 0x9b, 0xa0, 0xbb,
+	0x00, 0x3d, 0x47, 0xd7, 0x04, 0x47, 0xad, 0x8f, 0xa5, 0x5a, 0x17, 0x7a, 0xdc, 0x8e, 0x8f, 0x6d,
+	0xc3, 0xe2, 0x1c, 0xa3, 0x7d, 0x70, 0x8c, 0x72, 0xb5, 0x36, 0x91, 0x17, 0xa1, 0xca, 0x17, 0x19,
+	0xf5, 0x7b, 0xde, 0x81, 0xed, 0x62, 0x71, 0x02, 0xe7, 0x05, 0xf9, 0xf7, 0x43, 0x30, 0xd2, 0xf6,
+	0x1d, 0x6a, 0xba, 0x1e, 0xad, 0xc3, 0x24, 0x9f, 0x37, 0xea, 0xb4, 0x3a, 0x1c, 0xc0, 0xcf, 0x1d,
+	0x13, 0xa1, 0x7c, 0x8f, 0x41, 0x33, 0xf6, 0x4c, 0x25, 0xba, 0x67, 0xd0, 0x2a, 0x8c, 0x79, 0xbd,
+	0x83, 0x03, 0xec, 0x79, 0x02, 0xc2, 0x73, 0x0e, 0x4d, 0x21, 0xe4, 0x20, 0xea, 0xed, 0x4d, 0xe7,
+	0x58, 0x63, 0x2b, 0x44, 0x52, 0x79, 0x81, 0x1e, 0x1c, 0xf6, 0x31, 0xd1, 0xd8, 0xdc, 0x4a, 0x2a,
+	0xfb, 0xa6, 0x74, 0x3d, 0xeb, 0x13, 0xcb, 0x7e, 0x66, 0x75, 0xb8, 0x46, 0x8d, 0x55, 0x36, 0x85,
+	0xb0, 0xcd, 0x14, 0x57, 0xc0, 0x2f, 0x77, 0x18, 0xc1, 0x28, 0xc3, 0x34, 0x84, 0x6c, 0x8b, 0xf2,
+	0x3c, 0x0f, 0xb5, 0x63, 0xc3, 0x23, 0xb6, 0x7b, 0xda, 0xaa, 0xa7, 0xfe, 0xc2, 0x11, 0x07, 0xa4,
+	0xfa, 0x30, 0xe5, 0x5f, 0x12, 0xb4, 0xc2, 0x0d, 0xb9, 0xcd, 0xa5, 0xff, 0xd3, 0xa7, 0xda, 0x5f,
+	0x0e, 0xc3, 0x7c, 0x46, 0x8f, 0x85, 0xd7, 0x7b, 0x00, 0x75, 0xcf, 0xd2, 0x1c, 0xef, 0xd8, 0x26,
+	0x9e, 0x88, 0x64, 0x6e, 0x67, 0xfa, 0xae, 0x84, 0xe2, 0xc6, 0x9e, 0xd0, 0x52, 0x43, 0x7d, 0xf9,
+	0xb7, 0x15, 0x18, 0xf5, 0xe5, 0x68, 0x0b, 0xea, 0x41, 0x52, 0xae, 0x2f, 0x87, 0x1f, 0xaa, 0xd1,
+	0x75, 0xc9, 0x7c, 0x64, 0x6c, 0xf5, 0x02, 0x13, 0xed, 0xe5, 0xae, 0xf1, 0x4a, 0xf6, 0x1a, 0x0f,
+	0x7f, 0x1d, 0x1c, 0x36, 0xcc, 0x97, 0x13, 0x97, 0x71, 0x48, 0x86, 0x27, 0x1e, 0xb9, 0x40, 0x4f,
+	0x5c, 0xbd, 0x68, 0x4f, 0x5c, 0x1b, 0xcc, 0x13, 0x2b, 0x0f, 0xa1, 0xf5, 0xc4, 0xed, 0x79, 0x04,
+	0xeb, 0x41, 0xcc, 0xed, 0x95, 0x0f, 0x67, 0xfe, 0x24, 0xc1, 0x7c, 0x06, 0x9d, 0x58, 0x68, 0x1f,
+	0x00, 0x22, 0xbc, 0xb2, 0x13, 0xec, 0x06, 0x7f, 0xc5, 0xdd, 0x8a, 0x70, 0xe7, 0x32, 0x6c, 0xd0,
+	0xcd, 0xf4, 0x54, 0x7d, 0xa8, 0x4e, 0x91, 0x24, 0x44, 0x7e, 0x08, 0x35, 0x51, 0x8b, 0x6e, 0x42,
+	0x8d, 0xf2, 0x74, 0x44, 0xf0, 0x98, 0xde, 0x8c, 0x55, 0x5a, 0xbd, 0xa3, 0xd3, 0xf8, 0x4e, 0xd3,
+	0xf5, 0x20, 0xa0, 0xae, 0xab, 0x7e, 0x51, 0xb9, 0x0b, 0x13, 0x8f, 0x1d, 0xec, 0x6a, 0xc4, 0x76,
+	0xcb, 0x8f, 0x86, 0x01, 0x93, 0x21, 0x89, 0x18, 0x83, 0x69, 0x18, 0xc1, 0x5d, 0xcd, 0x30, 0x45,
+	0x40, 0xc9, 0x0b, 0x34, 0xda, 0x7d, 0xa6, 0x99, 0x26, 0x26, 0xc2, 0x0e, 0x51, 0x42, 0x37, 0x61,
+	0x82, 0x7f, 0x75, 0x0e, 0xb1, 0x46, 0x7a, 0x2e, 0x4b, 0x08, 0x55, 0xd6, 0xea, 0xea, 0x38, 0x17,
+	0xbf, 0x29, 0xa4, 0xca, 0x4f, 0x25, 0x58, 0xba, 0xef, 0x11, 0xa3, 0xab, 0x11, 0xac, 0xef, 0x6a,
+	0xa7, 0x76, 0x8f, 0x5c, 0xce, 0x09, 0xee, 0xbb, 0xb0, 0x9c, 0x6f, 0x87, 0x18, 0x83, 0xdb, 0x80,
+	0xb0, 0x8f, 0xe9, 0x60, 0xcd, 0xb5, 0x0c, 0xeb, 0xc8, 0x13, 0x71, 0xfe, 0x54, 0x50, 0x73, 0x5f,
+	0x54, 0x28, 0x6f, 0xc3, 0x6c, 0x82, 0xb2, 0xfc, 0x94, 0x6c, 0xc3, 0x5c, 0x8a, 0xab, 0x9c, 0x55,
+	0x5b, 0x30, 0x3e, 0xf0, 0x01, 0x7d, 0x07, 0x26, 0x92, 0x27, 0xf3, 0x97, 0xa1, 0xe1, 0x30, 0xbb,
+	0x3a, 0x86, 0x75, 0x68, 0x0b, 0xa6, 0x99, 0x08, 0x13, 0xb7, 0x7a, 0xc7, 0x3a, 0xb4, 0x55, 0x70,
+	0x82, 0x6f, 0xe5, 0x23, 0x98, 0x16, 0x54, 0xbb, 0xd8, 0x35, 0x6c, 0xbd, 0xfc, 0xa4, 0xcf, 0x42,
+	0xd5, 0x61, 0x14, 0xfe, 0x5a, 0xe4, 0x25, 0xe5, 0x31, 0xcc, 0x24, 0x5a, 0x18, 0xd0, 0xe4, 0xcf,
+	0x60, 0xee, 0x52, 0xd3, 0x34, 0x2a, 0xb4, 0x72, 0xf3, 0x33, 0x65, 0xfb, 0xf4, 0x2b, 0x9a, 0x3f,
+	0x4e, 0x90, 0x0e, 0x3a, 0x21, 0x25, 0x02, 0x8c, 0x70, 0x0e, 0x2b, 0xb1, 0x39, 0x7c, 0x0f, 0x16,
+	0xf3, 0xac, 0x1b, 0xb0, 0xe3, 0x6d, 0x18, 0xa3, 0x5b, 0x03, 0x97, 0xef, 0xa7, 0x72, 0x03, 0xc6,
+	0x7d, 0x8a, 0xd0, 0x59, 0x86, 0xd7, 0x4e, 0x15, 0x95, 0x17, 0x98, 0x3f, 0x60, 0xb8, 0xc1, 0x97,
+	0x8d, 0xf2, 0x11, 0xcc, 0xa5, 0xb8, 0x44, 0xe3, 0xf7, 0x61, 0x12, 0xb3, 0xaa, 0xf0, 0x67, 0x25,
+	0xfe, 0x55, 0x72, 0x34, 0x45, 0x93, 0xd0, 0x9e, 0xc0, 0x71, 0x81, 0xf2, 0x3e, 0x4c, 0x24, 0x30,
+	0xd9, 0xdd, 0x2a, 0xb3, 0x82, 0xb7, 0x61, 0xfa, 0xa9, 0xa5, 0x1b, 0x1e, 0x71, 0x8d, 0xfd, 0x1e,
+	0x19, 0x64, 0xec, 0x6f, 0xc3, 0x4c, 0x82, 0xa9, 0x70, 0x0a, 0x3e, 0x83, 0xb9, 0x5d, 0xed, 0xd4,
+	0x23, 0xbd, 0xfd, 0xcb, 0xd9, 0xba, 0xdb, 0xd0, 0x4a, 0xb7, 0x2f, 0x2c, 0xbe, 0x05, 0x35, 0x87,
+	0xd7, 0xb5, 0xa4, 0x54, 0x96, 0x4c, 0x68, 0xa9, 0x3e, 0x84, 0xba, 0x71, 0x5f, 0x56, 0x7a, 0xf0,
+	0xbe, 0x03, 0x13, 0x01, 0x47, 0x29, 0x23, 0x3e, 0x82, 0x69, 0x21, 0xfb, 0xaa, 0x9c, 0xf7, 0x7d,
+	0x98, 0x49, 0xb4, 0x50, 0xca, 0x50, 0xea, 0xde, 0x92, 0x03, 0xff, 0x35, 0x72, 0x6f, 0xef, 0xc0,
+	0x62, 0x9e, 0x75, 0xa5, 0xba, 0xfb, 0x12, 0x40, 0xe8, 0xee, 0xe8, 0x29, 0xf6, 0x18, 0x9b, 0xc1,
+	0xf5, 0x17, 0xfd, 0xa6, 0x32, 0x47, 0x13, 0x46, 0x57, 0x54, 0xf6, 0xad, 0xfc, 0xbc, 0x02, 0x35,
+	0x41, 0x45, 0x2f, 0xd0, 0x79, 0xa2, 0x58, 0xdc, 0x6a, 0xfb, 0x17, 0xe8, 0x4c, 0xd8, 0x66, 0xd7,
+	0xd9, 0xe8, 0x2a, 0xd4, 0x39, 0xe6, 0x08, 0xfb, 0x59, 0xd2, 0x51, 0x26, 0x78, 0x0b, 0x13, 0xb4,
+	0x06, 0x93, 0x41, 0x65, 0x47, 0x24, 0x58, 0xf9, 0xd9, 0x7c, 0xdc, 0xc7, 0xa8, 0x4c, 0x8a, 0x6e,
+	0xc0, 0x44, 0x88, 0xe4, 0x89, 0x28, 0x7e, 0x42, 0x1f, 0xf3, 0x81, 0x3c, 0x53, 0xb0, 0x0c, 0xcd,
+	0x03, 0xbb, 0xeb, 0x04, 0x16, 0xf1, 0x17, 0x02, 0x40, 0x65, 0xc2, 0xa0, 0x79, 0x18, 0x65, 0x88,
+	0x23, 0xcc, 0x0f, 0x2c, 0x15, 0xb5, 0x46, 0xcb, 0xd4, 0x9c, 0x1b, 0x30, 0xe1, 0x57, 0xf9, 0xd6,
+	0xd4, 0x78, 0x23, 0x02, 0x21, 0x8c, 0xb9, 0x0e, 0xe3, 0x01, 0x8e, 0xdb, 0x32, 0xca, 0xb3, 0x05,
+	0x02, 0xc6, 0x4d, 0xf1, 0x47, 0xb4, 0x9e, 0x31, 0xa2, 0x10, 0x8e, 0x28, 0x5a, 0x86, 0x46, 0xc4,
+	0x37, 0xb5, 0x1a, 0xac, 0x2a, 0x2a, 0xa2, 0xaf, 0x1a, 0x74, 0xc3, 0x73, 0x6c, 0x0f, 0xeb, 0xad,
+	0x26, 0x1f, 0x42, 0xbf, 0x4c, 0x8f, 0x38, 0xdb, 0xd8, 0xd4, 0xdb, 0x5d, 0xbb, 0x67, 0x91, 0x41,
+	0x8f, 0xfb, 0xca, 0x17, 0x43, 0x30, 0x9f, 0x41, 0x27, 0xd6, 0xd7, 0x6e, 0x98, 0x8d, 0xe0, 0xff,
+	0x8a, 0x97, 0x23, 0x84, 0xb9, 0x6a, 0x19, 0x35, 0x3e, 0x8d, 0xfc, 0x1a, 0x40, 0x58, 0x1b, 0x59,
+	0xf9, 0x52, 0x74, 0xe5, 0x53, 0xb9, 0xd6, 0x0d, 0xd2, 0xa1, 0x15, 0x55, 0x94, 0xe4, 0xcf, 0x25,
+	0x98, 0x4a, 0x91, 0xa7, 0xb6, 0x9c, 0x74, 0xf6, 0x96, 0x53, 0xa1, 0x49, 0xa7, 0xa7, 0xc3, 0x79,
+	0xe9, 0x79, 0x89, 0xf6, 0xee, 0x4e, 0x9f, 0xbd, 0x53, 0x1b, 0xc7, 0xc1, 0xb7, 0xa7, 0x3c, 0x86,
+	0xab, 0x89, 0x60, 0x9c, 0x3d, 0xed, 0x28, 0x3f, 0x37, 0x8f, 0x60, 0x21, 0x9b, 0xb0, 0x5c, 0x88,
+	0xff, 0x18, 0xae, 0xb6, 0x4d, 0x33, 0x3c, 0x63, 0x0e, 0x1c, 0xef, 0xbf, 0x0b, 0x0b, 0xd9, 0x84,
+	0x03, 0x06, 0x5f, 0x5d, 0x58, 0x89, 0xf1, 0x72, 0xa7, 0x37, 0xa8, 0xb9, 0xb9, 0x3f, 0x93, 0xef,
+	0x83, 0x52, 0xd4, 0xdc, 0x05, 0x1c, 0x0b, 0x7c, 0xea, 0x81, 0xbb, 0x50, 0xf2, 0x58, 0x90, 0x6a,
+	0xff, 0x22, 0x8e, 0x05, 0xf1, 0x5f, 0xd2, 0x25, 0x74, 0xad, 0xf0, 0x58, 0x90, 0x63, 0xdd, 0x80,
+	0x1d, 0x7f, 0x04, 0xf3, 0x3c, 0xfa, 0xdd, 0xc5, 0xee, 0x05, 0x84, 0xeb, 0x07, 0x20, 0x67, 0xd1,
+	0x5d, 0x6c, 0xc4, 0x1e, 0x5d, 0x80, 0x83, 0xc6, 0x86, 0x25, 0x83, 0xdb, 0x74, 0xfb, 0xa5, 0xe3,
+	0x4a, 0x36, 0x9d, 0x03, 0x77, 0xa3, 0x28, 0xae, 0x8c, 0xb7, 0x50, 0x3a, 0xae, 0x4c, 0xac, 0xc0,
+	0x4b, 0x18, 0xf9, 0xa2, 0xb8, 0x32, 0xcf, 0xba, 0x32, 0xdd, 0xdd, 0xfc, 0xd1, 0x10, 0xd4, 0xc4,
+	0x23, 0x31, 0xf4, 0x26, 0xd4, 0x83, 0x47, 0xa3, 0xe8, 0x6a, 0x44, 0x2b, 0xf9, 0x22, 0x55, 0x5e,
+	0xc8, 0xae, 0x14, 0x16, 0x6c, 0xc3, 0x08, 0x7f, 0x62, 0xb6, 0x98, 0xf7, 0x12, 0x4d, 0xd0, 0x2c,
+	0xe5, 0xd6, 0x0b, 0xa6, 0x03, 0x18, 0x8f, 0xbf, 0x7d, 0x43, 0x37, 0x73, 0x54, 0x92, 0x3b, 0x5a,
+	0x5e, 0x3b, 0x1b, 0xc8, 0x1b, 0xd9, 0xfc, 0x5b, 0x15, 0xea, 0xc1, 0x13, 0x29, 0xa4, 0x41, 0x33,
+	0xfa, 0xe2, 0x2c, 0xd6, 0x60, 0xd1, 0x2b, 0x37, 0x79, 0xed, 0x6c, 0xa0, 0xe8, 0xd5, 0x09, 0xcc,
+	0xe7, 0x3e, 0x0f, 0x43, 0xcf, 0x65, 0xd1, 0xe4, 0x24, 0xa7, 0xe4, 0x5b, 0xe7, 0x03, 0x07, 0x49,
+	0xef, 0xc9, 0x24, 0x08, 0x29, 0x05, 0x0c, 0x7e, 0x2b, 0xab, 0x85, 0x18, 0x41, 0xde, 0x85, 0xd9,
+	0xec, 0xa7, 0x5a, 0x68, 0x2d, 0xf5, 0x8c, 0x24, 0xaf, 0x3b, 0xeb, 0xe7, 0x40, 0x8a, 0xe6, 0x54,
+	0x18, 0x8b, 0x21, 0xd0, 0x52, 0x9e, 0xae, 0x4f, 0xbe, 0x9c, 0x0f, 0x10, 0x9c, 0x0e, 0xcc, 0xe5,
+	0x3c, 0x96, 0x42, 0xeb, 0xe9, 0xe7, 0x2d, 0x79, 0x9d, 0xf8, 0xc6, 0x79, 0xa0, 0xa2, 0xc5, 0xa7,
+	0x30, 0x1e, 0x87, 0xa0, 0xe5, 0x5c, 0x6d, 0x9f, 0x7f, 0xa5, 0x00, 0x11, 0xd2, 0xc6, 0xdf, 0x2e,
+	0xc5, 0x68, 0x33, 0x1f, 0x55, 0xc9, 0x2b, 0x05, 0x08, 0x41, 0xfb, 0x2a, 0x8c, 0xb0, 0x1a, 0x34,
+	0x97, 0xc4, 0xfa, 0x24, 0xad, 0x74, 0x85, 0xd8, 0x64, 0x7f, 0xaf, 0xc0, 0x30, 0xf5, 0x73, 0xe8,
+	0x0d, 0xa8, 0x89, 0xb7, 0x2d, 0x68, 0x3e, 0x82, 0x8e, 0xbf, 0x99, 0x91, 0xe5, 0xac, 0x2a, 0x61,
+	0xc6, 0x43, 0x68, 0x44, 0x1e, 0xaa, 0xa0, 0x6b, 0x11, 0x68, 0xfa, 0x21, 0x8c, 0xbc, 0x98, 0x57,
+	0x2d, 0xd8, 0x76, 0x00, 0xc2, 0x6b, 0x45, 0xb4, 0x90, 0xf3, 0x52, 0x82, 0x73, 0x5d, 0x2b, 0x7c,
+	0x47, 0x81, 0x3e, 0x8c, 0xbe, 0x70, 0xf1, 0x0f, 0x38, 0xab, 0xc5, 0xf7, 0x97, 0x9c, 0xf8, 0xfa,
+	0x79, 0x2e, 0x39, 0x29, 0x7f, 0xea, 0x3e, 0x2a, 0xc6, 0x9f, 0x77, 0x7d, 0x26, 0x5f, 0x2f, 0x06,
+	0x09, 0xfe, 0xbb, 0x30, 0xea, 0x5f, 0x12, 0xa1, 0xe8, 0x04, 0x24, 0xae, 0x9f, 0xe4, 0xab, 0x99,
+	0x75, 0x62, 0xa2, 0xff, 0x51, 0x67, 0x29, 0x07, 0xbb, 0x47, 0x3c, 0x3a, 0xd7, 0xfe, 0xba, 0x8e,
+	0xce, 0x75, 0x62, 0x41, 0xcb, 0x59, 0x55, 0xe1, 0x36, 0x8f, 0x65, 0xfa, 0x63, 0xdb, 0x3c, 0xeb,
+	0x96, 0x41, 0x5e, 0xce, 0x07, 0x84, 0x6e, 0x30, 0xb5, 0xbf, 0x95, 0xb4, 0x56, 0x6a, 0x87, 0xac,
+	0x16, 0x62, 0x42, 0x37, 0x98, 0x9d, 0xd6, 0x8e, 0xb9, 0xc1, 0xc2, 0xbc, 0xbc, 0xbc, 0x7e, 0x0e,
+	0xa4, 0x68, 0xee, 0x75, 0xa8, 0xf2, 0x20, 0x12, 0xb5, 0x52, 0x71, 0xa5, 0x4f, 0x37, 0x9f, 0x51,
+	0x23, 0xd4, 0xdf, 0x4b, 0x67, 0x84, 0x57, 0x0a, 0xe2, 0x53, 0x41, 0xa8, 0x14, 0x41, 0x04, 0xb3,
+	0x07, 0xad, 0xbc, 0xcb, 0x37, 0x14, 0xf5, 0x90, 0x67, 0xdc, 0x14, 0xca, 0xcf, 0x9d, 0x0b, 0x1b,
+	0xe9, 0x4e, 0x1c, 0x13, 0xef, 0x4e, 0xe6, 0xd5, 0x9d, 0xac, 0x14, 0x41, 0xc2, 0x75, 0x18, 0x4b,
+	0x4a, 0xc7, 0xd6, 0x61, 0x56, 0xe2, 0x5b, 0x5e, 0xce, 0x07, 0x84, 0xeb, 0x30, 0x99, 0x22, 0x8c,
+	0xad, 0xc3, 0x9c, 0xb4, 0xb6, 0xbc, 0x5a, 0x88, 0x11, 0xe4, 0x6f, 0x84, 0x89, 0xbf, 0xf9, 0x34,
+	0x3e, 0x6b, 0xeb, 0x25, 0xe3, 0x48, 0x15, 0xc6, 0x62, 0x79, 0xda, 0x58, 0x97, 0xb3, 0x72, 0xc4,
+	0xf2, 0x72, 0x3e, 0x20, 0xdc, 0x1d, 0xd9, 0x59, 0xd1, 0xd8, 0xee, 0x28, 0x4c, 0xeb, 0xca, 0xeb,
+	0xe7, 0x40, 0x86, 0x0e, 0x33, 0x9d, 0x71, 0x5a, 0x2d, 0x4e, 0x14, 0xa5, 0x1d, 0x66, 0x6e, 0x36,
+	0x69, 0xf3, 0x9f, 0x75, 0xa8, 0x8a, 0x75, 0x76, 0x04, 0xd3, 0x59, 0xf9, 0x14, 0x74, 0x23, 0xfa,
+	0x04, 0x28, 0x3f, 0x83, 0x23, 0xdf, 0x3c, 0x13, 0x27, 0xfa, 0x74, 0x0a, 0x72, 0x7e, 0xc6, 0x03,
+	0xdd, 0xca, 0xa3, 0xc9, 0x3a, 0xe9, 0xcb, 0xb7, 0xcf, 0x89, 0x8e, 0x38, 0xce, 0x44, 0x3a, 0x22,
+	0xee, 0x38, 0xb3, 0x73, 0x25, 0xf2, 0x6a, 0x21, 0x26, 0xe2, 0x38, 0x33, 0x0f, 0xfe, 0x71, 0xc7,
+	0x59, 0x94, 0xb9, 0x90, 0xd7, 0xcf, 0x81, 0xbc, 0x18, 0xc7, 0xa9, 0x01, 0x4a, 0x9f, 0xfe, 0xd1,
+	0xf5, 0x94, 0x42, 0x46, 0xae, 0x41, 0xfe, 0xff, 0x33, 0x50, 0x97, 0xe9, 0x41, 0x8f, 0x60, 0x3a,
+	0x2b, 0x6d, 0x19, 0x5b, 0xc6, 0x05, 0x89, 0x52, 0xf9, 0xe6, 0x99, 0xb8, 0xaf, 0xd6, 0xa1, 0x26,
+	0xb3, 0x15, 0xd9, 0xeb, 0x33, 0xe1, 0x05, 0x57, 0x0b, 0x31, 0x17, 0xea, 0x50, 0xa3, 0x27, 0xf6,
+	0xb8, 0x43, 0xcd, 0xc8, 0x34, 0xc8, 0xcb, 0xf9, 0x80, 0xdc, 0x5d, 0xe3, 0x93, 0x17, 0xec, 0x9a,
+	0x44, 0x2b, 0xeb, 0xe7, 0x40, 0xf2, 0xe6, 0xb6, 0xae, 0xbf, 0xaf, 0x50, 0x0f, 0xf8, 0xf1, 0x86,
+	0x61, 0xdf, 0x61, 0x1f, 0x77, 0x1c, 0xd7, 0x38, 0xd1, 0x08, 0xbe, 0x13, 0x50, 0x38, 0xfb, 0xfb,
+	0x55, 0xf6, 0xf4, 0xeb, 0xc5, 0xff, 0x0e, 0x00, 0x89, 0x8d, 0x73, 0x9e, 0x3f, 0x3b, 0x00, 0x00,
 }
@@ -595,6 +595,7 @@ type DRPCNodeClient interface {
 	Version(ctx context.Context, in *VersionRequest) (*VersionResponse, error)
 	LastContact(ctx context.Context, in *LastContactRequest) (*LastContactResponse, error)
 	Reputation(ctx context.Context, in *ReputationRequest) (*ReputationResponse, error)
+	ReputationHistory(ctx context.Context, in *ReputationHistoryRequest) (*ReputationHistoryResponse, error)
 	TrustedSatellites(ctx context.Context, in *TrustedSatellitesRequest) (*TrustedSatellitesResponse, error)
 	Operator(ctx context.Context, in *OperatorRequest) (*OperatorResponse, error)
 }
@@ -636,6 +637,15 @@ func (c *drpcNodeClient) Reputation(ctx context.Context, in *ReputationRequest)
 	return out, nil
 }
 
+func (c *drpcNodeClient) ReputationHistory(ctx context.Context, in *ReputationHistoryRequest) (*ReputationHistoryResponse, error) {
+	out := new(ReputationHistoryResponse)
+	err := c.cc.Invoke(ctx, "/multinode.Node/ReputationHistory", drpcEncoding_File_multinode_proto{}, in, out)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *drpcNodeClient) TrustedSatellites(ctx context.Context, in *TrustedSatellitesRequest) (*TrustedSatellitesResponse, error) {
 	out := new(TrustedSatellitesResponse)
 	err := c.cc.Invoke(ctx, "/multinode.Node/TrustedSatellites", drpcEncoding_File_multinode_proto{}, in, out)
@@ -658,6 +668,7 @@ type DRPCNodeServer interface {
 	Version(context.Context, *VersionRequest) (*VersionResponse, error)
 	LastContact(context.Context, *LastContactRequest) (*LastContactResponse, error)
 	Reputation(context.Context, *ReputationRequest) (*ReputationResponse, error)
+	ReputationHistory(context.Context, *ReputationHistoryRequest) (*ReputationHistoryResponse, error)
 	TrustedSatellites(context.Context, *TrustedSatellitesRequest) (*TrustedSatellitesResponse, error)
 	Operator(context.Context, *OperatorRequest) (*OperatorResponse, error)
 }
@@ -676,6 +687,10 @@ func (s *DRPCNodeUnimplementedServer) Reputation(context.Context, *ReputationReq
 	return nil, drpcerr.WithCode(errors.New("Unimplemented"), drpcerr.Unimplemented)
 }
 
+func (s *DRPCNodeUnimplementedServer) ReputationHistory(context.Context, *ReputationHistoryRequest) (*ReputationHistoryResponse, error) {
+	return nil, drpcerr.WithCode(errors.New("Unimplemented"), drpcerr.Unimplemented)
+}
+
 func (s *DRPCNodeUnimplementedServer) TrustedSatellites(context.Context, *TrustedSatellitesRequest) (*TrustedSatellitesResponse, error) {
 	return nil, drpcerr.WithCode(errors.New("Unimplemented"), drpcerr.Unimplemented)
 }
@@ -686,7 +701,7 @@ func (s *DRPCNodeUnimplementedServer) Operator(context.Context, *OperatorRequest
 
 type DRPCNodeDescription struct{}
 
-func (DRPCNodeDescription) NumMethods() int { return 5 }
+func (DRPCNodeDescription) NumMethods() int { return 6 }
 
 func (DRPCNodeDescription) Method(n int) (string, drpc.Encoding, drpc.Receiver, interface{}, bool) {
 	switch n {
@@ -718,6 +733,15 @@ func (DRPCNodeDescription) Method(n int) (string, drpc.Encoding, drpc.Receiver,
 					)
 			}, DRPCNodeServer.Reputation, true
 	case 3:
+		return "/multinode.Node/ReputationHistory", drpcEncoding_File_multinode_proto{},
+			func(srv interface{}, ctx context.Context, in1, in2 interface{}) (drpc.Message, error) {
+				return srv.(DRPCNodeServer).
+					ReputationHistory(
+						ctx,
+						in1.(*ReputationHistoryRequest),
+					)
+			}, DRPCNodeServer.ReputationHistory, true
+	case 4:
 		return "/multinode.Node/TrustedSatellites", drpcEncoding_File_multinode_proto{},
 			func(srv interface{}, ctx context.Context, in1, in2 interface{}) (drpc.Message, error) {
 				return srv.(DRPCNodeServer).
@@ -726,7 +750,7 @@ func (DRPCNodeDescription) Method(n int) (string, drpc.Encoding, drpc.Receiver,
 						in1.(*TrustedSatellitesRequest),
 					)
 			}, DRPCNodeServer.TrustedSatellites, true
-	case 4:
+	case 5:
 		return "/multinode.Node/Operator", drpcEncoding_File_multinode_proto{},
 			func(srv interface{}, ctx context.Context, in1, in2 interface{}) (drpc.Message, error) {
 				return srv.(DRPCNodeServer).
@@ -792,6 +816,22 @@ func (x *drpcNode_ReputationStream) SendAndClose(m *ReputationResponse) error {
 	return x.CloseSend()
 }
 
+type DRPCNode_ReputationHistoryStream interface {
+	drpc.Stream
+	SendAndClose(*ReputationHistoryResponse) error
+}
+
+type drpcNode_ReputationHistoryStream struct {
+	drpc.Stream
+}
+
+func (x *drpcNode_ReputationHistoryStream) SendAndClose(m *ReputationHistoryResponse) error {
+	if err := x.MsgSend(m, drpcEncoding_File_multinode_proto{}); err != nil {
+		return err
+	}
+	return x.CloseSend()
+}
+
 type DRPCNode_TrustedSatellitesStream interface {
 	drpc.Stream
 	SendAndClose(*TrustedSatellitesResponse) error
@@ -0,0 +1,163 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+// Package nodetag implements the tags which node operators declare for their
+// storage nodes, e.g. "datacenter" or "region=us-east". The tags are signed
+// with an identity of the operator, so the satellite knows who declared them.
+package nodetag
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"time"
+	"unicode/utf8"
+
+	"github.com/spacemonkeygo/monkit/v3"
+	"github.com/zeebo/errs"
+
+	"storj.io/common/identity"
+	"storj.io/common/signing"
+	"storj.io/common/storj"
+)
+
+var (
+	mon = monkit.Package()
+
+	// Error is the error class of the node tags.
+	Error = errs.Class("nodetag")
+)
+
+// Limits of the tags, which keep the check-in requests small.
+const (
+	MaxTags        = 32
+	MaxNameLength  = 64
+	MaxValueLength = 256
+)
+
+// Tag is a tag declared for a node. Tags without a value are flags, e.g.
+// "datacenter".
+type Tag struct {
+	Name  string `json:"name"`
+	Value string `json:"value,omitempty"`
+}
+
+// TagSet contains the tags declared for a node.
+type TagSet struct {
+	NodeID   storj.NodeID `json:"node_id"`
+	SignedAt time.Time    `json:"signed_at"`
+	Tags     []Tag        `json:"tags"`
+}
+
+// SignedTagSet is a tag set signed by an identity of the node operator.
+type SignedTagSet struct {
+	// TagSet is the serialized tag set, which is signed.
+	TagSet []byte `json:"tag_set"`
+	// Signer is the encoded certificate chain of the signing identity.
+	Signer []byte `json:"signer"`
+	// Signature is the signature of TagSet by Signer.
+	Signature []byte `json:"signature"`
+}
+
+// Validate checks the names and values of the tags.
+func (set *TagSet) Validate() error {
+	if set.NodeID.IsZero() {
+		return Error.New("missing node id")
+	}
+	if len(set.Tags) > MaxTags {
+		return Error.New("too many tags, %d > %d", len(set.Tags), MaxTags)
+	}
+
+	names := make(map[string]bool, len(set.Tags))
+	for _, tag := range set.Tags {
+		switch {
+		case tag.Name == "":
+			return Error.New("empty tag name")
+		case len(tag.Name) > MaxNameLength:
+			return Error.New("tag name %q is longer than %d bytes", tag.Name, MaxNameLength)
+		case len(tag.Value) > MaxValueLength:
+			return Error.New("value of tag %q is longer than %d bytes", tag.Name, MaxValueLength)
+		case !utf8.ValidString(tag.Name) || !utf8.ValidString(tag.Value):
+			return Error.New("tag %q is not valid utf-8", tag.Name)
+		case names[tag.Name]:
+			return Error.New("tag %q is declared more than once", tag.Name)
+		}
+		names[tag.Name] = true
+	}
+	return nil
+}
+
+// Sign signs the tag set with the identity of the node operator.
+func Sign(ctx context.Context, set TagSet, signer *identity.FullIdentity) (_ *SignedTagSet, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if err := set.Validate(); err != nil {
+		return nil, err
+	}
+	data, err := json.Marshal(set)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	signature, err := signing.SignerFromFullIdentity(signer).HashAndSign(ctx, data)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	return &SignedTagSet{
+		TagSet:    data,
+		Signer:    identity.EncodePeerIdentity(signer.PeerIdentity()),
+		Signature: signature,
+	}, nil
+}
+
+// Verify checks the signature of the tag set and that it was declared for
+// the node, and returns the tag set with the id of its signer.
+func Verify(ctx context.Context, signed *SignedTagSet, nodeID storj.NodeID) (_ TagSet, signer storj.NodeID, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	peer, err := identity.DecodePeerIdentity(ctx, signed.Signer)
+	if err != nil {
+		return TagSet{}, storj.NodeID{}, Error.New("invalid signer: %w", err)
+	}
+	if err := signing.SigneeFromPeerIdentity(peer).HashAndVerifySignature(ctx, signed.TagSet, signed.Signature); err != nil {
+		return TagSet{}, storj.NodeID{}, Error.New("invalid signature: %w", err)
+	}
+
+	var set TagSet
+	if err := json.Unmarshal(signed.TagSet, &set); err != nil {
+		return TagSet{}, storj.NodeID{}, Error.New("malformed tag set: %w", err)
+	}
+	if set.NodeID != nodeID {
+		return TagSet{}, storj.NodeID{}, Error.New("tag set is declared for node %s, not for %s", set.NodeID, nodeID)
+	}
+	if err := set.Validate(); err != nil {
+		return TagSet{}, storj.NodeID{}, err
+	}
+	return set, peer.ID, nil
+}
+
+// Encode encodes the signed tag sets into a string, which can be used in the
+// storage node configuration.
+func Encode(sets []*SignedTagSet) (string, error) {
+	data, err := json.Marshal(sets)
+	if err != nil {
+		return "", Error.Wrap(err)
+	}
+	return base64.StdEncoding.EncodeToString(data), nil
+}
+
+// Decode decodes signed tag sets encoded by Encode. An empty string contains
+// no tag sets.
+func Decode(encoded string) ([]*SignedTagSet, error) {
+	if encoded == "" {
+		return nil, nil
+	}
+	data, err := base64.StdEncoding.DecodeString(encoded)
+	if err != nil {
+		return nil, Error.New("invalid encoding: %w", err)
+	}
+	var sets []*SignedTagSet
+	if err := json.Unmarshal(data, &sets); err != nil {
+		return nil, Error.New("malformed signed tag sets: %w", err)
+	}
+	return sets, nil
+}
@@ -0,0 +1,121 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package nodetag_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"storj.io/common/identity"
+	"storj.io/common/identity/testidentity"
+	"storj.io/common/pb"
+	"storj.io/common/storj"
+	"storj.io/common/testcontext"
+	"storj.io/common/testrand"
+	"storj.io/storj/private/nodetag"
+	"storj.io/storj/private/nodetagpb"
+)
+
+func TestSignVerify(t *testing.T) {
+	ctx := testcontext.New(t)
+
+	operator := testidentity.MustPregeneratedSignedIdentity(0, storj.LatestIDVersion())
+	nodeID := testrand.NodeID()
+
+	set := nodetag.TagSet{
+		NodeID:   nodeID,
+		SignedAt: time.Now().UTC().Truncate(time.Second),
+		Tags: []nodetag.Tag{
+			{Name: "datacenter"},
+			{Name: "region", Value: "us-east"},
+		},
+	}
+
+	signed, err := nodetag.Sign(ctx, set, operator)
+	require.NoError(t, err)
+
+	verified, signer, err := nodetag.Verify(ctx, signed, nodeID)
+	require.NoError(t, err)
+	require.Equal(t, operator.ID, signer)
+	require.Equal(t, set, verified)
+
+	t.Run("other node", func(t *testing.T) {
+		_, _, err := nodetag.Verify(ctx, signed, testrand.NodeID())
+		require.Error(t, err)
+	})
+
+	t.Run("tampered", func(t *testing.T) {
+		tampered := *signed
+		tampered.TagSet = []byte(strings.Replace(string(signed.TagSet), "us-east", "us-west", 1))
+		_, _, err := nodetag.Verify(ctx, &tampered, nodeID)
+		require.Error(t, err)
+
+		other := testidentity.MustPregeneratedSignedIdentity(1, storj.LatestIDVersion())
+		tampered = *signed
+		tampered.Signer = identity.EncodePeerIdentity(other.PeerIdentity())
+		_, _, err = nodetag.Verify(ctx, &tampered, nodeID)
+		require.Error(t, err)
+	})
+
+	t.Run("invalid tags", func(t *testing.T) {
+		for _, tags := range [][]nodetag.Tag{
+			{{Name: ""}},
+			{{Name: "a"}, {Name: "a", Value: "b"}},
+			{{Name: strings.Repeat("a", nodetag.MaxNameLength+1)}},
+			{{Name: "a", Value: strings.Repeat("b", nodetag.MaxValueLength+1)}},
+			make([]nodetag.Tag, nodetag.MaxTags+1),
+		} {
+			_, err := nodetag.Sign(ctx, nodetag.TagSet{NodeID: nodeID, Tags: tags}, operator)
+			require.Error(t, err)
+		}
+
+		_, err := nodetag.Sign(ctx, nodetag.TagSet{Tags: set.Tags}, operator)
+		require.Error(t, err)
+	})
+}
+
+func TestEncodeDecode(t *testing.T) {
+	ctx := testcontext.New(t)
+
+	operator := testidentity.MustPregeneratedSignedIdentity(0, storj.LatestIDVersion())
+	signed, err := nodetag.Sign(ctx, nodetag.TagSet{
+		NodeID: testrand.NodeID(),
+		Tags:   []nodetag.Tag{{Name: "ssd"}},
+	}, operator)
+	require.NoError(t, err)
+
+	encoded, err := nodetag.Encode([]*nodetag.SignedTagSet{signed})
+	require.NoError(t, err)
+
+	decoded, err := nodetag.Decode(encoded)
+	require.NoError(t, err)
+	require.Equal(t, []*nodetag.SignedTagSet{signed}, decoded)
+
+	decoded, err = nodetag.Decode("")
+	require.NoError(t, err)
+	require.Empty(t, decoded)
+
+	_, err = nodetag.Decode("not base64!")
+	require.Error(t, err)
+}
+
+func TestProto(t *testing.T) {
+	sets := []*nodetag.SignedTagSet{
+		{TagSet: []byte(`{"tags":[]}`), Signer: testrand.BytesInt(64), Signature: testrand.BytesInt(32)},
+		{TagSet: []byte(`{"tags":[{"name":"ssd"}]}`), Signer: testrand.BytesInt(64), Signature: testrand.BytesInt(32)},
+	}
+
+	data, err := pb.Marshal(&nodetagpb.UpdateTagsRequest{TagSets: nodetag.ToProto(sets)})
+	require.NoError(t, err)
+
+	var received nodetagpb.UpdateTagsRequest
+	require.NoError(t, pb.Unmarshal(data, &received))
+	require.Equal(t, sets, nodetag.FromProto(received.TagSets))
+
+	// requests without tags don't contain tag sets
+	require.Empty(t, nodetag.FromProto(nil))
+}
@@ -0,0 +1,32 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package nodetag
+
+import "storj.io/storj/private/nodetagpb"
+
+// ToProto converts the signed tag sets to their protobuf messages.
+func ToProto(sets []*SignedTagSet) []*nodetagpb.SignedTagSet {
+	messages := make([]*nodetagpb.SignedTagSet, 0, len(sets))
+	for _, set := range sets {
+		messages = append(messages, &nodetagpb.SignedTagSet{
+			TagSet:    set.TagSet,
+			Signer:    set.Signer,
+			Signature: set.Signature,
+		})
+	}
+	return messages
+}
+
+// FromProto converts the protobuf messages to signed tag sets.
+func FromProto(messages []*nodetagpb.SignedTagSet) []*SignedTagSet {
+	sets := make([]*SignedTagSet, 0, len(messages))
+	for _, message := range messages {
+		sets = append(sets, &SignedTagSet{
+			TagSet:    message.TagSet,
+			Signer:    message.Signer,
+			Signature: message.Signature,
+		})
+	}
+	return sets
+}
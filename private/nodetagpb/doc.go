@@ -0,0 +1,8 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+// Package nodetagpb contains protobuf definitions for the node tags declared
+// by storage nodes to satellites.
+package nodetagpb
+
+//go:generate go run gen.go
@@ -0,0 +1,150 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+// Package openmetrics writes metrics in the Prometheus text exposition format.
+//
+// Unlike the monkit based exporter of the debug server, the metric names are
+// chosen by the caller, so they stay stable when the instrumentation changes.
+package openmetrics
+
+import (
+	"bufio"
+	"io"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/zeebo/errs"
+)
+
+// Error is the default error class for the package.
+var Error = errs.Class("openmetrics")
+
+// ContentType is the content type of the text exposition format.
+const ContentType = "text/plain; version=0.0.4; charset=utf-8"
+
+// Type is the type of a metric family.
+type Type string
+
+const (
+	// Gauge is a value that can go up and down.
+	Gauge = Type("gauge")
+	// Counter is a value that only increases.
+	Counter = Type("counter")
+)
+
+// Label is a name and value pair attached to a sample.
+type Label struct {
+	Name  string
+	Value string
+}
+
+// Sample is a single value of a metric family.
+type Sample struct {
+	Labels []Label
+	Value  float64
+}
+
+// Family is a group of samples sharing the same metric name.
+type Family struct {
+	Name    string
+	Help    string
+	Type    Type
+	Samples []Sample
+}
+
+// NewGauge creates a gauge family without samples.
+func NewGauge(name, help string) *Family {
+	return &Family{Name: name, Help: help, Type: Gauge}
+}
+
+// NewCounter creates a counter family without samples.
+func NewCounter(name, help string) *Family {
+	return &Family{Name: name, Help: help, Type: Counter}
+}
+
+// Add adds a sample to the family. Labels are given as name, value pairs.
+func (family *Family) Add(value float64, labels ...string) {
+	sample := Sample{Value: value}
+	for i := 0; i+1 < len(labels); i += 2 {
+		sample.Labels = append(sample.Labels, Label{Name: labels[i], Value: labels[i+1]})
+	}
+	family.Samples = append(family.Samples, sample)
+}
+
+// Write writes the families in the text exposition format. Families
+// without samples are skipped.
+func Write(w io.Writer, families []*Family) error {
+	buf := bufio.NewWriter(w)
+	for _, family := range families {
+		if len(family.Samples) == 0 {
+			continue
+		}
+		if !ValidName(family.Name) {
+			return Error.New("invalid metric name %q", family.Name)
+		}
+
+		if family.Help != "" {
+			_, _ = buf.WriteString("# HELP " + family.Name + " " + helpEscaper.Replace(family.Help) + "\n")
+		}
+		if family.Type != "" {
+			_, _ = buf.WriteString("# TYPE " + family.Name + " " + string(family.Type) + "\n")
+		}
+
+		for _, sample := range family.Samples {
+			_, _ = buf.WriteString(family.Name)
+			if len(sample.Labels) > 0 {
+				_ = buf.WriteByte('{')
+				for i, label := range sample.Labels {
+					if !ValidName(label.Name) {
+						return Error.New("invalid label name %q for %s", label.Name, family.Name)
+					}
+					if i > 0 {
+						_ = buf.WriteByte(',')
+					}
+					_, _ = buf.WriteString(label.Name + `="` + labelEscaper.Replace(label.Value) + `"`)
+				}
+				_ = buf.WriteByte('}')
+			}
+			_ = buf.WriteByte(' ')
+			_, _ = buf.WriteString(formatValue(sample.Value))
+			_ = buf.WriteByte('\n')
+		}
+	}
+	return Error.Wrap(buf.Flush())
+}
+
+// ValidName returns whether name can be used as a metric or label name.
+func ValidName(name string) bool {
+	if name == "" {
+		return false
+	}
+	for i, r := range name {
+		switch {
+		case r == '_' || r == ':':
+		case 'a' <= r && r <= 'z', 'A' <= r && r <= 'Z':
+		case '0' <= r && r <= '9' && i > 0:
+		default:
+			return false
+		}
+	}
+	return true
+}
+
+var (
+	helpEscaper  = strings.NewReplacer(`\`, `\\`, "\n", `\n`)
+	labelEscaper = strings.NewReplacer(`\`, `\\`, "\n", `\n`, `"`, `\"`)
+)
+
+func formatValue(value float64) string {
+	switch {
+	case math.IsNaN(value):
+		return "NaN"
+	case math.IsInf(value, 1):
+		return "+Inf"
+	case math.IsInf(value, -1):
+		return "-Inf"
+	default:
+		return strconv.FormatFloat(value, 'g', -1, 64)
+	}
+}
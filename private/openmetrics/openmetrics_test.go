@@ -0,0 +1,53 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package openmetrics_test
+
+import (
+	"bytes"
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"storj.io/storj/private/openmetrics"
+)
+
+func TestWrite(t *testing.T) {
+	used := openmetrics.NewGauge("storj_disk_used_bytes", "Disk space used.\nPer satellite.")
+	used.Add(1024, "satellite", "1abc", "url", `host:7777 "quoted" \`)
+	used.Add(math.Inf(1), "satellite", "2def")
+
+	deleted := openmetrics.NewCounter("storj_deleted_total", "")
+	deleted.Add(3)
+
+	empty := openmetrics.NewGauge("storj_empty", "Has no samples.")
+
+	var buf bytes.Buffer
+	require.NoError(t, openmetrics.Write(&buf, []*openmetrics.Family{used, deleted, empty}))
+	require.Equal(t, ""+
+		"# HELP storj_disk_used_bytes Disk space used.\\nPer satellite.\n"+
+		"# TYPE storj_disk_used_bytes gauge\n"+
+		"storj_disk_used_bytes{satellite=\"1abc\",url=\"host:7777 \\\"quoted\\\" \\\\\"} 1024\n"+
+		"storj_disk_used_bytes{satellite=\"2def\"} +Inf\n"+
+		"# TYPE storj_deleted_total counter\n"+
+		"storj_deleted_total 3\n",
+		buf.String())
+
+	invalid := openmetrics.NewGauge("storj-invalid", "")
+	invalid.Add(1)
+	require.Error(t, openmetrics.Write(&buf, []*openmetrics.Family{invalid}))
+
+	invalidLabel := openmetrics.NewGauge("storj_valid", "")
+	invalidLabel.Add(1, "0label", "value")
+	require.Error(t, openmetrics.Write(&buf, []*openmetrics.Family{invalidLabel}))
+}
+
+func TestValidName(t *testing.T) {
+	for _, name := range []string{"a", "storj_node", "ns:metric_1", "_x"} {
+		require.True(t, openmetrics.ValidName(name), name)
+	}
+	for _, name := range []string{"", "1a", "with space", "dash-ed", "ünicode"} {
+		require.False(t, openmetrics.ValidName(name), name)
+	}
+}
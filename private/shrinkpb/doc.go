@@ -0,0 +1,8 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+// Package shrinkpb contains protobuf definitions for the partial graceful exit
+// of storage nodes, where a node hands back part of its data to the satellite.
+package shrinkpb
+
+//go:generate go run gen.go
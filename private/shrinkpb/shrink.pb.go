@@ -0,0 +1,217 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: shrink.proto
+
+package shrinkpb
+
+import (
+	fmt "fmt"
+	math "math"
+
+	proto "github.com/gogo/protobuf/proto"
+
+	_ "storj.io/common/pb"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+type InitiateShrinkRequest struct {
+	// bytes is the amount of data the node wants to hand back.
+	Bytes                int64    `protobuf:"varint,1,opt,name=bytes,proto3" json:"bytes,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *InitiateShrinkRequest) Reset()         { *m = InitiateShrinkRequest{} }
+func (m *InitiateShrinkRequest) String() string { return proto.CompactTextString(m) }
+func (*InitiateShrinkRequest) ProtoMessage()    {}
+func (*InitiateShrinkRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5600a247ad7bc7ed, []int{0}
+}
+func (m *InitiateShrinkRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_InitiateShrinkRequest.Unmarshal(m, b)
+}
+func (m *InitiateShrinkRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_InitiateShrinkRequest.Marshal(b, m, deterministic)
+}
+func (m *InitiateShrinkRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InitiateShrinkRequest.Merge(m, src)
+}
+func (m *InitiateShrinkRequest) XXX_Size() int {
+	return xxx_messageInfo_InitiateShrinkRequest.Size(m)
+}
+func (m *InitiateShrinkRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_InitiateShrinkRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_InitiateShrinkRequest proto.InternalMessageInfo
+
+func (m *InitiateShrinkRequest) GetBytes() int64 {
+	if m != nil {
+		return m.Bytes
+	}
+	return 0
+}
+
+type GetShrinkStatusRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetShrinkStatusRequest) Reset()         { *m = GetShrinkStatusRequest{} }
+func (m *GetShrinkStatusRequest) String() string { return proto.CompactTextString(m) }
+func (*GetShrinkStatusRequest) ProtoMessage()    {}
+func (*GetShrinkStatusRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5600a247ad7bc7ed, []int{1}
+}
+func (m *GetShrinkStatusRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetShrinkStatusRequest.Unmarshal(m, b)
+}
+func (m *GetShrinkStatusRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetShrinkStatusRequest.Marshal(b, m, deterministic)
+}
+func (m *GetShrinkStatusRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetShrinkStatusRequest.Merge(m, src)
+}
+func (m *GetShrinkStatusRequest) XXX_Size() int {
+	return xxx_messageInfo_GetShrinkStatusRequest.Size(m)
+}
+func (m *GetShrinkStatusRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetShrinkStatusRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetShrinkStatusRequest proto.InternalMessageInfo
+
+// ShrinkStatus describes the progress of a shrink.
+type ShrinkStatus struct {
+	RequestedBytes int64 `protobuf:"varint,1,opt,name=requested_bytes,json=requestedBytes,proto3" json:"requested_bytes,omitempty"`
+	// selected_bytes is the size of the pieces selected for the transfer.
+	SelectedBytes     int64 `protobuf:"varint,2,opt,name=selected_bytes,json=selectedBytes,proto3" json:"selected_bytes,omitempty"`
+	BytesTransferred  int64 `protobuf:"varint,3,opt,name=bytes_transferred,json=bytesTransferred,proto3" json:"bytes_transferred,omitempty"`
+	PiecesTransferred int64 `protobuf:"varint,4,opt,name=pieces_transferred,json=piecesTransferred,proto3" json:"pieces_transferred,omitempty"`
+	PiecesFailed      int64 `protobuf:"varint,5,opt,name=pieces_failed,json=piecesFailed,proto3" json:"pieces_failed,omitempty"`
+	// selected is true once the pieces to transfer have been selected.
+	Selected             bool     `protobuf:"varint,6,opt,name=selected,proto3" json:"selected,omitempty"`
+	Finished             bool     `protobuf:"varint,7,opt,name=finished,proto3" json:"finished,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ShrinkStatus) Reset()         { *m = ShrinkStatus{} }
+func (m *ShrinkStatus) String() string { return proto.CompactTextString(m) }
+func (*ShrinkStatus) ProtoMessage()    {}
+func (*ShrinkStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5600a247ad7bc7ed, []int{2}
+}
+func (m *ShrinkStatus) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ShrinkStatus.Unmarshal(m, b)
+}
+func (m *ShrinkStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ShrinkStatus.Marshal(b, m, deterministic)
+}
+func (m *ShrinkStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ShrinkStatus.Merge(m, src)
+}
+func (m *ShrinkStatus) XXX_Size() int {
+	return xxx_messageInfo_ShrinkStatus.Size(m)
+}
+func (m *ShrinkStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_ShrinkStatus.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ShrinkStatus proto.InternalMessageInfo
+
+func (m *ShrinkStatus) GetRequestedBytes() int64 {
+	if m != nil {
+		return m.RequestedBytes
+	}
+	return 0
+}
+
+func (m *ShrinkStatus) GetSelectedBytes() int64 {
+	if m != nil {
+		return m.SelectedBytes
+	}
+	return 0
+}
+
+func (m *ShrinkStatus) GetBytesTransferred() int64 {
+	if m != nil {
+		return m.BytesTransferred
+	}
+	return 0
+}
+
+func (m *ShrinkStatus) GetPiecesTransferred() int64 {
+	if m != nil {
+		return m.PiecesTransferred
+	}
+	return 0
+}
+
+func (m *ShrinkStatus) GetPiecesFailed() int64 {
+	if m != nil {
+		return m.PiecesFailed
+	}
+	return 0
+}
+
+func (m *ShrinkStatus) GetSelected() bool {
+	if m != nil {
+		return m.Selected
+	}
+	return false
+}
+
+func (m *ShrinkStatus) GetFinished() bool {
+	if m != nil {
+		return m.Finished
+	}
+	return false
+}
+
+func init() {
+	proto.RegisterType((*InitiateShrinkRequest)(nil), "shrink.InitiateShrinkRequest")
+	proto.RegisterType((*GetShrinkStatusRequest)(nil), "shrink.GetShrinkStatusRequest")
+	proto.RegisterType((*ShrinkStatus)(nil), "shrink.ShrinkStatus")
+}
+
+func init() { proto.RegisterFile("shrink.proto", fileDescriptor_5600a247ad7bc7ed) }
+
+var fileDescriptor_5600a247ad7bc7ed = []byte{
+	// 357 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x92, 0xdd, 0x4a, 0xeb, 0x40,
+	0x10, 0xc7, 0x49, 0x7b, 0xda, 0x53, 0x86, 0x7e, 0x9c, 0x2e, 0x3d, 0x12, 0x02, 0xd6, 0x5a, 0x11,
+	0x0b, 0xd2, 0x54, 0xf4, 0x0d, 0x0a, 0x5a, 0xbc, 0x50, 0xa4, 0xd1, 0x1b, 0x6f, 0xca, 0x36, 0x99,
+	0xb4, 0xab, 0x21, 0x89, 0xbb, 0x53, 0xd1, 0x27, 0xf1, 0x55, 0xbd, 0x94, 0xee, 0x26, 0xfd, 0xa2,
+	0xde, 0x65, 0xfe, 0xbf, 0x1f, 0xcb, 0x64, 0x66, 0xa0, 0xaa, 0xe6, 0x52, 0xc4, 0xaf, 0x6e, 0x2a,
+	0x13, 0x4a, 0x58, 0xd9, 0x54, 0x0e, 0x9b, 0x49, 0xee, 0x63, 0xb8, 0x88, 0xf0, 0x43, 0x90, 0x61,
+	0xdd, 0x3e, 0xfc, 0xbf, 0x8d, 0x05, 0x09, 0x4e, 0xe8, 0x69, 0x6b, 0x8c, 0x6f, 0x0b, 0x54, 0xc4,
+	0x5a, 0x50, 0x9a, 0x7e, 0x12, 0x2a, 0xdb, 0xea, 0x58, 0xbd, 0xe2, 0xd8, 0x14, 0x5d, 0x1b, 0x0e,
+	0x46, 0x48, 0xc6, 0xf4, 0x88, 0xd3, 0x42, 0x65, 0x7e, 0xf7, 0xab, 0x00, 0xd5, 0xcd, 0x9c, 0x9d,
+	0x41, 0x43, 0x1a, 0x86, 0xc1, 0x64, 0xf3, 0xa9, 0xfa, 0x2a, 0x1e, 0x2e, 0x53, 0x76, 0x0a, 0x75,
+	0x85, 0x11, 0xfa, 0x6b, 0xaf, 0xa0, 0xbd, 0x5a, 0x9e, 0x1a, 0xed, 0x1c, 0x9a, 0x9a, 0x4e, 0x48,
+	0xf2, 0x58, 0x85, 0x28, 0x25, 0x06, 0x76, 0x51, 0x9b, 0xff, 0x34, 0x78, 0x5c, 0xe7, 0xac, 0x0f,
+	0x2c, 0x15, 0xe8, 0xef, 0xd8, 0x7f, 0xb4, 0xdd, 0x34, 0x64, 0x53, 0x3f, 0x81, 0x5a, 0xa6, 0x87,
+	0x5c, 0x44, 0x18, 0xd8, 0x25, 0x6d, 0x56, 0x4d, 0x78, 0xa3, 0x33, 0xe6, 0x40, 0x25, 0xef, 0xc8,
+	0x2e, 0x77, 0xac, 0x5e, 0x65, 0xbc, 0xaa, 0x97, 0x2c, 0x14, 0xb1, 0x50, 0x73, 0x0c, 0xec, 0xbf,
+	0x86, 0xe5, 0xf5, 0xe5, 0xb7, 0x05, 0x0d, 0x8f, 0x13, 0x46, 0x91, 0xc8, 0x87, 0xcc, 0xae, 0xa1,
+	0xbe, 0x3d, 0x76, 0x76, 0xe8, 0x66, 0x3b, 0xdb, 0xbb, 0x0e, 0xa7, 0x95, 0xe3, 0xad, 0x19, 0x8f,
+	0xa0, 0xb1, 0xb3, 0x0e, 0xd6, 0xce, 0xc5, 0xfd, 0x7b, 0xfa, 0xe5, 0xa1, 0x27, 0xa8, 0x3d, 0xc8,
+	0xc4, 0x47, 0xa5, 0xb2, 0x76, 0x3a, 0xee, 0xd6, 0xb1, 0x78, 0x94, 0x48, 0x3e, 0xc3, 0xfb, 0x24,
+	0xc0, 0x3b, 0x54, 0x8a, 0xcf, 0xd0, 0x69, 0xef, 0x18, 0xf9, 0x1f, 0x66, 0xbc, 0x67, 0x5d, 0x58,
+	0xc3, 0xe3, 0xe7, 0x23, 0x45, 0x89, 0x7c, 0x71, 0x45, 0x32, 0xd0, 0x1f, 0x83, 0x54, 0x8a, 0x77,
+	0x4e, 0x38, 0x30, 0x4d, 0xa4, 0xd3, 0x69, 0x59, 0xdf, 0xe1, 0xd5, 0xcf, 0x00, 0x2f, 0xe4, 0x31,
+	0x1d, 0xb3, 0x02, 0x00, 0x00,
+}
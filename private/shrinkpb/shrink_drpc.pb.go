@@ -0,0 +1,235 @@
+// Code generated by protoc-gen-go-drpc. DO NOT EDIT.
+// protoc-gen-go-drpc version: v0.0.32
+// source: shrink.proto
+
+package shrinkpb
+
+import (
+	bytes "bytes"
+	context "context"
+	errors "errors"
+
+	jsonpb "github.com/gogo/protobuf/jsonpb"
+	proto "github.com/gogo/protobuf/proto"
+
+	pb "storj.io/common/pb"
+	drpc "storj.io/drpc"
+	drpcerr "storj.io/drpc/drpcerr"
+)
+
+type drpcEncoding_File_shrink_proto struct{}
+
+func (drpcEncoding_File_shrink_proto) Marshal(msg drpc.Message) ([]byte, error) {
+	return proto.Marshal(msg.(proto.Message))
+}
+
+func (drpcEncoding_File_shrink_proto) Unmarshal(buf []byte, msg drpc.Message) error {
+	return proto.Unmarshal(buf, msg.(proto.Message))
+}
+
+func (drpcEncoding_File_shrink_proto) JSONMarshal(msg drpc.Message) ([]byte, error) {
+	var buf bytes.Buffer
+	err := new(jsonpb.Marshaler).Marshal(&buf, msg.(proto.Message))
+	if err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func (drpcEncoding_File_shrink_proto) JSONUnmarshal(buf []byte, msg drpc.Message) error {
+	return jsonpb.Unmarshal(bytes.NewReader(buf), msg.(proto.Message))
+}
+
+type DRPCSatelliteShrinkClient interface {
+	DRPCConn() drpc.Conn
+
+	InitiateShrink(ctx context.Context, in *InitiateShrinkRequest) (*ShrinkStatus, error)
+	GetShrinkStatus(ctx context.Context, in *GetShrinkStatusRequest) (*ShrinkStatus, error)
+	ProcessShrink(ctx context.Context) (DRPCSatelliteShrink_ProcessShrinkClient, error)
+}
+
+type drpcSatelliteShrinkClient struct {
+	cc drpc.Conn
+}
+
+func NewDRPCSatelliteShrinkClient(cc drpc.Conn) DRPCSatelliteShrinkClient {
+	return &drpcSatelliteShrinkClient{cc}
+}
+
+func (c *drpcSatelliteShrinkClient) DRPCConn() drpc.Conn { return c.cc }
+
+func (c *drpcSatelliteShrinkClient) InitiateShrink(ctx context.Context, in *InitiateShrinkRequest) (*ShrinkStatus, error) {
+	out := new(ShrinkStatus)
+	err := c.cc.Invoke(ctx, "/shrink.SatelliteShrink/InitiateShrink", drpcEncoding_File_shrink_proto{}, in, out)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *drpcSatelliteShrinkClient) GetShrinkStatus(ctx context.Context, in *GetShrinkStatusRequest) (*ShrinkStatus, error) {
+	out := new(ShrinkStatus)
+	err := c.cc.Invoke(ctx, "/shrink.SatelliteShrink/GetShrinkStatus", drpcEncoding_File_shrink_proto{}, in, out)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *drpcSatelliteShrinkClient) ProcessShrink(ctx context.Context) (DRPCSatelliteShrink_ProcessShrinkClient, error) {
+	stream, err := c.cc.NewStream(ctx, "/shrink.SatelliteShrink/ProcessShrink", drpcEncoding_File_shrink_proto{})
+	if err != nil {
+		return nil, err
+	}
+	x := &drpcSatelliteShrink_ProcessShrinkClient{stream}
+	return x, nil
+}
+
+type DRPCSatelliteShrink_ProcessShrinkClient interface {
+	drpc.Stream
+	Send(*pb.StorageNodeMessage) error
+	Recv() (*pb.SatelliteMessage, error)
+}
+
+type drpcSatelliteShrink_ProcessShrinkClient struct {
+	drpc.Stream
+}
+
+func (x *drpcSatelliteShrink_ProcessShrinkClient) GetStream() drpc.Stream {
+	return x.Stream
+}
+
+func (x *drpcSatelliteShrink_ProcessShrinkClient) Send(m *pb.StorageNodeMessage) error {
+	return x.MsgSend(m, drpcEncoding_File_shrink_proto{})
+}
+
+func (x *drpcSatelliteShrink_ProcessShrinkClient) Recv() (*pb.SatelliteMessage, error) {
+	m := new(pb.SatelliteMessage)
+	if err := x.MsgRecv(m, drpcEncoding_File_shrink_proto{}); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (x *drpcSatelliteShrink_ProcessShrinkClient) RecvMsg(m *pb.SatelliteMessage) error {
+	return x.MsgRecv(m, drpcEncoding_File_shrink_proto{})
+}
+
+type DRPCSatelliteShrinkServer interface {
+	InitiateShrink(context.Context, *InitiateShrinkRequest) (*ShrinkStatus, error)
+	GetShrinkStatus(context.Context, *GetShrinkStatusRequest) (*ShrinkStatus, error)
+	ProcessShrink(DRPCSatelliteShrink_ProcessShrinkStream) error
+}
+
+type DRPCSatelliteShrinkUnimplementedServer struct{}
+
+func (s *DRPCSatelliteShrinkUnimplementedServer) InitiateShrink(context.Context, *InitiateShrinkRequest) (*ShrinkStatus, error) {
+	return nil, drpcerr.WithCode(errors.New("Unimplemented"), drpcerr.Unimplemented)
+}
+
+func (s *DRPCSatelliteShrinkUnimplementedServer) GetShrinkStatus(context.Context, *GetShrinkStatusRequest) (*ShrinkStatus, error) {
+	return nil, drpcerr.WithCode(errors.New("Unimplemented"), drpcerr.Unimplemented)
+}
+
+func (s *DRPCSatelliteShrinkUnimplementedServer) ProcessShrink(DRPCSatelliteShrink_ProcessShrinkStream) error {
+	return drpcerr.WithCode(errors.New("Unimplemented"), drpcerr.Unimplemented)
+}
+
+type DRPCSatelliteShrinkDescription struct{}
+
+func (DRPCSatelliteShrinkDescription) NumMethods() int { return 3 }
+
+func (DRPCSatelliteShrinkDescription) Method(n int) (string, drpc.Encoding, drpc.Receiver, interface{}, bool) {
+	switch n {
+	case 0:
+		return "/shrink.SatelliteShrink/InitiateShrink", drpcEncoding_File_shrink_proto{},
+			func(srv interface{}, ctx context.Context, in1, in2 interface{}) (drpc.Message, error) {
+				return srv.(DRPCSatelliteShrinkServer).
+					InitiateShrink(
+						ctx,
+						in1.(*InitiateShrinkRequest),
+					)
+			}, DRPCSatelliteShrinkServer.InitiateShrink, true
+	case 1:
+		return "/shrink.SatelliteShrink/GetShrinkStatus", drpcEncoding_File_shrink_proto{},
+			func(srv interface{}, ctx context.Context, in1, in2 interface{}) (drpc.Message, error) {
+				return srv.(DRPCSatelliteShrinkServer).
+					GetShrinkStatus(
+						ctx,
+						in1.(*GetShrinkStatusRequest),
+					)
+			}, DRPCSatelliteShrinkServer.GetShrinkStatus, true
+	case 2:
+		return "/shrink.SatelliteShrink/ProcessShrink", drpcEncoding_File_shrink_proto{},
+			func(srv interface{}, ctx context.Context, in1, in2 interface{}) (drpc.Message, error) {
+				return nil, srv.(DRPCSatelliteShrinkServer).
+					ProcessShrink(
+						&drpcSatelliteShrink_ProcessShrinkStream{in1.(drpc.Stream)},
+					)
+			}, DRPCSatelliteShrinkServer.ProcessShrink, true
+	default:
+		return "", nil, nil, nil, false
+	}
+}
+
+func DRPCRegisterSatelliteShrink(mux drpc.Mux, impl DRPCSatelliteShrinkServer) error {
+	return mux.Register(impl, DRPCSatelliteShrinkDescription{})
+}
+
+type DRPCSatelliteShrink_InitiateShrinkStream interface {
+	drpc.Stream
+	SendAndClose(*ShrinkStatus) error
+}
+
+type drpcSatelliteShrink_InitiateShrinkStream struct {
+	drpc.Stream
+}
+
+func (x *drpcSatelliteShrink_InitiateShrinkStream) SendAndClose(m *ShrinkStatus) error {
+	if err := x.MsgSend(m, drpcEncoding_File_shrink_proto{}); err != nil {
+		return err
+	}
+	return x.CloseSend()
+}
+
+type DRPCSatelliteShrink_GetShrinkStatusStream interface {
+	drpc.Stream
+	SendAndClose(*ShrinkStatus) error
+}
+
+type drpcSatelliteShrink_GetShrinkStatusStream struct {
+	drpc.Stream
+}
+
+func (x *drpcSatelliteShrink_GetShrinkStatusStream) SendAndClose(m *ShrinkStatus) error {
+	if err := x.MsgSend(m, drpcEncoding_File_shrink_proto{}); err != nil {
+		return err
+	}
+	return x.CloseSend()
+}
+
+type DRPCSatelliteShrink_ProcessShrinkStream interface {
+	drpc.Stream
+	Send(*pb.SatelliteMessage) error
+	Recv() (*pb.StorageNodeMessage, error)
+}
+
+type drpcSatelliteShrink_ProcessShrinkStream struct {
+	drpc.Stream
+}
+
+func (x *drpcSatelliteShrink_ProcessShrinkStream) Send(m *pb.SatelliteMessage) error {
+	return x.MsgSend(m, drpcEncoding_File_shrink_proto{})
+}
+
+func (x *drpcSatelliteShrink_ProcessShrinkStream) Recv() (*pb.StorageNodeMessage, error) {
+	m := new(pb.StorageNodeMessage)
+	if err := x.MsgRecv(m, drpcEncoding_File_shrink_proto{}); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (x *drpcSatelliteShrink_ProcessShrinkStream) RecvMsg(m *pb.StorageNodeMessage) error {
+	return x.MsgRecv(m, drpcEncoding_File_shrink_proto{})
+}
@@ -0,0 +1,129 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package testblobs
+
+import (
+	"context"
+	"io"
+	"sync/atomic"
+
+	"go.uber.org/zap"
+
+	"storj.io/storj/storage"
+	"storj.io/storj/storagenode"
+	"storj.io/storj/storagenode/pieces"
+)
+
+// CorruptDB implements a storage node DB which returns altered piece data.
+type CorruptDB struct {
+	storagenode.DB
+	blobs *CorruptBlobs
+	log   *zap.Logger
+}
+
+// NewCorruptDB creates a new corrupting storage node DB wrapping the provided
+// db. Use SetCorrupt to dynamically alter the data read from the pieces.
+func NewCorruptDB(log *zap.Logger, db storagenode.DB) *CorruptDB {
+	return &CorruptDB{
+		DB:    db,
+		blobs: newCorruptBlobs(log, db.Pieces()),
+		log:   log,
+	}
+}
+
+// Pieces returns the blob store.
+func (corrupt *CorruptDB) Pieces() storage.Blobs {
+	return corrupt.blobs
+}
+
+// SetCorrupt enables or disables altering the data read from the pieces.
+func (corrupt *CorruptDB) SetCorrupt(enabled bool) {
+	corrupt.blobs.SetCorrupt(enabled)
+}
+
+// CorruptBlobs implements a blob store which flips the bits of the piece
+// data it reads, leaving the piece headers intact, so that the node still
+// serves the pieces but with altered content.
+type CorruptBlobs struct {
+	storage.Blobs
+	enabled int32
+	log     *zap.Logger
+}
+
+// newCorruptBlobs creates a new corrupting blob store wrapping the provided
+// blobs.
+func newCorruptBlobs(log *zap.Logger, blobs storage.Blobs) *CorruptBlobs {
+	return &CorruptBlobs{
+		Blobs: blobs,
+		log:   log,
+	}
+}
+
+// SetCorrupt enables or disables altering the data read from the pieces.
+func (corrupt *CorruptBlobs) SetCorrupt(enabled bool) {
+	value := int32(0)
+	if enabled {
+		value = 1
+	}
+	atomic.StoreInt32(&corrupt.enabled, value)
+}
+
+// Open opens a reader with the specified namespace and key.
+func (corrupt *CorruptBlobs) Open(ctx context.Context, ref storage.BlobRef) (storage.BlobReader, error) {
+	reader, err := corrupt.Blobs.Open(ctx, ref)
+	if err != nil {
+		return nil, err
+	}
+	return corrupt.wrap(reader), nil
+}
+
+// OpenWithStorageFormat opens a reader for the already-located blob, avoiding the potential need
+// to check multiple storage formats to find the blob.
+func (corrupt *CorruptBlobs) OpenWithStorageFormat(ctx context.Context, ref storage.BlobRef, formatVer storage.FormatVersion) (storage.BlobReader, error) {
+	reader, err := corrupt.Blobs.OpenWithStorageFormat(ctx, ref, formatVer)
+	if err != nil {
+		return nil, err
+	}
+	return corrupt.wrap(reader), nil
+}
+
+func (corrupt *CorruptBlobs) wrap(reader storage.BlobReader) storage.BlobReader {
+	if atomic.LoadInt32(&corrupt.enabled) == 0 {
+		return reader
+	}
+	return &corruptReader{BlobReader: reader}
+}
+
+// corruptReader flips the bits of the data following the piece header.
+type corruptReader struct {
+	storage.BlobReader
+}
+
+// Read reads and alters data from the current offset.
+func (reader *corruptReader) Read(data []byte) (int, error) {
+	offset, err := reader.BlobReader.Seek(0, io.SeekCurrent)
+	if err != nil {
+		return 0, err
+	}
+	n, err := reader.BlobReader.Read(data)
+	flipBits(data[:n], offset)
+	return n, err
+}
+
+// ReadAt reads and alters data from the given offset.
+func (reader *corruptReader) ReadAt(data []byte, offset int64) (int, error) {
+	n, err := reader.BlobReader.ReadAt(data, offset)
+	flipBits(data[:n], offset)
+	return n, err
+}
+
+// flipBits flips the bits of the data read at offset which aren't part of the
+// piece header.
+func flipBits(data []byte, offset int64) {
+	for i := range data {
+		if offset+int64(i) >= pieces.V1PieceHeaderReservedArea {
+			data[i] ^= 0xFF
+		}
+	}
+}
@@ -205,7 +205,8 @@ type Satellite struct {
 	}
 
 	Metrics struct {
-		Chore *metrics.Chore
+		Chore  *metrics.Chore
+		Gauges *metrics.Gauges
 	}
 }
 
@@ -668,6 +669,7 @@ func createNewSystem(name string, log *zap.Logger, config satellite.Config, peer
 	system.GracefulExit.Endpoint = api.GracefulExit.Endpoint
 
 	system.Metrics.Chore = peer.Metrics.Chore
+	system.Metrics.Gauges = peer.Metrics.Gauges
 
 	return system
 }
@@ -738,7 +740,7 @@ func (planet *Planet) newAuditor(ctx context.Context, index int, identity *ident
 	rollupsWriteCache := orders.NewRollupsWriteCache(log.Named("orders-write-cache"), db.Orders(), config.Orders.FlushBatchSize)
 	planet.databases = append(planet.databases, rollupsWriteCacheCloser{rollupsWriteCache})
 
-	return satellite.NewAuditor(log, identity, metabaseDB, revocationDB, db.VerifyQueue(), db.ReverifyQueue(), db.OverlayCache(), db.NodeEvents(), db.Reputation(), db.Containment(), rollupsWriteCache, versionInfo, &config, nil)
+	return satellite.NewAuditor(log, identity, metabaseDB, revocationDB, db.VerifyQueue(), db.ReverifyQueue(), db.OverlayCache(), db.NodeEvents(), db.Reputation(), db.Containment(), db.AuditCoverage(), rollupsWriteCache, versionInfo, &config, nil)
 }
 
 type rollupsWriteCacheCloser struct {
@@ -31,10 +31,12 @@ import (
 	"storj.io/storj/storagenode/console/consoleserver"
 	"storj.io/storj/storagenode/contact"
 	"storj.io/storj/storagenode/gracefulexit"
+	"storj.io/storj/storagenode/maintenance"
 	"storj.io/storj/storagenode/monitor"
 	"storj.io/storj/storagenode/nodestats"
 	"storj.io/storj/storagenode/operator"
 	"storj.io/storj/storagenode/orders"
+	"storj.io/storj/storagenode/piecemigration"
 	"storj.io/storj/storagenode/pieces"
 	"storj.io/storj/storagenode/piecestore"
 	"storj.io/storj/storagenode/preflight"
@@ -159,6 +161,8 @@ func (planet *Planet) newStorageNode(ctx context.Context, prefix string, index,
 		Console: consoleserver.Config{
 			Address:   planet.NewListenAddress(),
 			StaticDir: filepath.Join(developmentRoot, "web/storagenode/"),
+
+			UpdaterSettingsPath: filepath.Join(storageDir, "updater-settings.json"),
 		},
 		Storage2: piecestore.Config{
 			CacheSyncInterval:       defaultInterval,
@@ -212,6 +216,13 @@ func (planet *Planet) newStorageNode(ctx context.Context, prefix string, index,
 			MinBytesPerSecond:      128 * memory.B,
 			MinDownloadTimeout:     2 * time.Minute,
 		},
+		Maintenance: maintenance.Config{
+			Path: filepath.Join(storageDir, "maintenance.json"),
+		},
+		StorageMigration: piecemigration.Config{
+			StatePath: filepath.Join(storageDir, "storage-migration.json"),
+			Interval:  defaultInterval,
+		},
 	}
 	if planet.config.Reconfigure.StorageNode != nil {
 		planet.config.Reconfigure.StorageNode(index, &config)
@@ -0,0 +1,163 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package checker
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/zeebo/errs"
+
+	"storj.io/common/fpath"
+	"storj.io/private/version"
+)
+
+// Release channels followed by the storage node updater.
+const (
+	// ChannelStable follows the rollout of the version server.
+	ChannelStable = "stable"
+	// ChannelBeta updates to the suggested version without waiting for the
+	// rollout to reach the node.
+	ChannelBeta = "beta"
+)
+
+// UpdateSettings are the settings of the storage node updater which can be
+// changed from the storage node dashboard.
+type UpdateSettings struct {
+	// Channel is the release channel to follow, the updater configuration
+	// is used when empty.
+	Channel string `json:"channel"`
+	// Window is the daily time window in which updates are applied, the
+	// updater configuration is used when empty.
+	Window string `json:"window"`
+}
+
+// Validate checks that the channel and the window of the settings are valid.
+func (settings UpdateSettings) Validate() error {
+	switch settings.Channel {
+	case "", ChannelStable, ChannelBeta:
+	default:
+		return Error.New("unknown channel %q, expected %s or %s", settings.Channel, ChannelStable, ChannelBeta)
+	}
+	_, err := ParseUpdateWindow(settings.Window)
+	return err
+}
+
+// LoadUpdateSettings reads the update settings from the file at path.
+// Empty settings are returned when the file doesn't exist.
+func LoadUpdateSettings(path string) (settings UpdateSettings, err error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		if errs.IsFunc(err, os.IsNotExist) {
+			return UpdateSettings{}, nil
+		}
+		return UpdateSettings{}, Error.Wrap(err)
+	}
+	if err := json.Unmarshal(data, &settings); err != nil {
+		return UpdateSettings{}, Error.New("malformed update settings file: %w", err)
+	}
+	return settings, settings.Validate()
+}
+
+// SaveUpdateSettings validates and writes the update settings to the file at
+// path.
+func SaveUpdateSettings(path string, settings UpdateSettings) error {
+	if err := settings.Validate(); err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+		return Error.New("unable to make update settings parent directory: %w", err)
+	}
+
+	data, err := json.MarshalIndent(settings, "", "  ")
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	return Error.Wrap(fpath.AtomicWriteFile(path, data, 0644))
+}
+
+// ChannelProcess returns the version information of the process as seen
+// from the release channel.
+func ChannelProcess(process version.Process, channel string) (version.Process, error) {
+	switch channel {
+	case "", ChannelStable:
+		return process, nil
+	case ChannelBeta:
+		process.Rollout.Cursor = version.PercentageToCursor(100)
+		return process, nil
+	default:
+		return version.Process{}, Error.New("unknown channel %q, expected %s or %s", channel, ChannelStable, ChannelBeta)
+	}
+}
+
+// UpdateWindow is a daily time window, e.g. 22:00-02:00, in which updates
+// are applied. The zero window contains all times.
+type UpdateWindow struct {
+	start, end time.Duration
+	set        bool
+}
+
+// ParseUpdateWindow parses a window formatted as hh:mm-hh:mm. The window
+// wraps around midnight when the end is before the start.
+func ParseUpdateWindow(s string) (UpdateWindow, error) {
+	if s == "" {
+		return UpdateWindow{}, nil
+	}
+
+	startValue, endValue, ok := strings.Cut(s, "-")
+	if !ok {
+		return UpdateWindow{}, Error.New("invalid update window %q, expected hh:mm-hh:mm", s)
+	}
+	start, err := parseTimeOfDay(strings.TrimSpace(startValue))
+	if err != nil {
+		return UpdateWindow{}, Error.New("invalid update window %q, expected hh:mm-hh:mm", s)
+	}
+	end, err := parseTimeOfDay(strings.TrimSpace(endValue))
+	if err != nil {
+		return UpdateWindow{}, Error.New("invalid update window %q, expected hh:mm-hh:mm", s)
+	}
+	if start == end {
+		return UpdateWindow{}, Error.New("invalid update window %q, start and end are the same", s)
+	}
+
+	return UpdateWindow{start: start, end: end, set: true}, nil
+}
+
+// Contains returns whether the time of day of t is in the window.
+func (window UpdateWindow) Contains(t time.Time) bool {
+	if !window.set {
+		return true
+	}
+
+	hour, minute, second := t.Clock()
+	timeOfDay := time.Duration(hour)*time.Hour + time.Duration(minute)*time.Minute + time.Duration(second)*time.Second
+
+	if window.start < window.end {
+		return window.start <= timeOfDay && timeOfDay < window.end
+	}
+	return window.start <= timeOfDay || timeOfDay < window.end
+}
+
+// String returns the window formatted as hh:mm-hh:mm.
+func (window UpdateWindow) String() string {
+	if !window.set {
+		return ""
+	}
+	format := func(d time.Duration) string {
+		return time.Time{}.Add(d).Format("15:04")
+	}
+	return format(window.start) + "-" + format(window.end)
+}
+
+// parseTimeOfDay parses hh:mm into the duration since midnight.
+func parseTimeOfDay(s string) (time.Duration, error) {
+	t, err := time.Parse("15:04", s)
+	if err != nil {
+		return 0, err
+	}
+	return time.Duration(t.Hour())*time.Hour + time.Duration(t.Minute())*time.Minute, nil
+}
@@ -0,0 +1,94 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package checker_test
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"storj.io/common/testcontext"
+	"storj.io/common/testrand"
+	"storj.io/private/version"
+	"storj.io/storj/private/version/checker"
+)
+
+func TestUpdateWindow(t *testing.T) {
+	at := func(hour, minute int) time.Time {
+		return time.Date(2023, 3, 1, hour, minute, 0, 0, time.UTC)
+	}
+
+	always, err := checker.ParseUpdateWindow("")
+	require.NoError(t, err)
+	require.True(t, always.Contains(at(12, 0)))
+
+	for _, invalid := range []string{"02:00", "2-4", "02:00-25:00", "02:00-02:00"} {
+		_, err := checker.ParseUpdateWindow(invalid)
+		require.Error(t, err, invalid)
+	}
+
+	window, err := checker.ParseUpdateWindow("02:00-04:30")
+	require.NoError(t, err)
+	require.Equal(t, "02:00-04:30", window.String())
+	require.False(t, window.Contains(at(1, 59)))
+	require.True(t, window.Contains(at(2, 0)))
+	require.True(t, window.Contains(at(4, 29)))
+	require.False(t, window.Contains(at(4, 30)))
+
+	overnight, err := checker.ParseUpdateWindow("22:00 - 01:00")
+	require.NoError(t, err)
+	require.True(t, overnight.Contains(at(23, 0)))
+	require.True(t, overnight.Contains(at(0, 30)))
+	require.False(t, overnight.Contains(at(12, 0)))
+}
+
+func TestChannelProcess(t *testing.T) {
+	process := version.Process{
+		Minimum:   version.Version{Version: "v1.0.0"},
+		Suggested: version.Version{Version: "v1.1.0"},
+		Rollout: version.Rollout{
+			Seed:   version.RolloutBytes{1},
+			Cursor: version.PercentageToCursor(0),
+		},
+	}
+	current, err := version.NewSemVer("v1.0.0")
+	require.NoError(t, err)
+	nodeID := testrand.NodeID()
+
+	stable, err := checker.ChannelProcess(process, checker.ChannelStable)
+	require.NoError(t, err)
+	update, _, err := version.ShouldUpdateVersion(current, nodeID, stable)
+	require.NoError(t, err)
+	require.True(t, update.IsZero())
+
+	beta, err := checker.ChannelProcess(process, checker.ChannelBeta)
+	require.NoError(t, err)
+	update, _, err = version.ShouldUpdateVersion(current, nodeID, beta)
+	require.NoError(t, err)
+	require.Equal(t, "v1.1.0", update.Version)
+
+	_, err = checker.ChannelProcess(process, "nightly")
+	require.Error(t, err)
+}
+
+func TestUpdateSettings(t *testing.T) {
+	ctx := testcontext.New(t)
+	path := filepath.Join(ctx.Dir(), "settings", "updater-settings.json")
+
+	settings, err := checker.LoadUpdateSettings(path)
+	require.NoError(t, err)
+	require.Equal(t, checker.UpdateSettings{}, settings)
+
+	require.Error(t, checker.SaveUpdateSettings(path, checker.UpdateSettings{Channel: "nightly"}))
+	require.Error(t, checker.SaveUpdateSettings(path, checker.UpdateSettings{Window: "soon"}))
+
+	expected := checker.UpdateSettings{Channel: checker.ChannelBeta, Window: "02:00-04:00"}
+	require.NoError(t, checker.SaveUpdateSettings(path, expected))
+
+	settings, err = checker.LoadUpdateSettings(path)
+	require.NoError(t, err)
+	require.Equal(t, expected, settings)
+}
@@ -7,12 +7,14 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"strconv"
 
 	"github.com/gorilla/mux"
 
 	"storj.io/common/storj"
 	"storj.io/common/uuid"
 	"storj.io/storj/satellite/buckets"
+	"storj.io/storj/satellite/nodeselection/uploadselection"
 )
 
 func validateBucketPathParameters(vars map[string]string) (project uuid.NullUUID, bucket []byte, err error) {
@@ -36,7 +38,9 @@ func validateBucketPathParameters(vars map[string]string) (project uuid.NullUUID
 	return
 }
 
-func parsePlacementConstraint(regionCode string) (storj.PlacementConstraint, error) {
+// parsePlacementConstraint parses the region code, which is either the name
+// of a default placement or the number of a placement defined by the rules.
+func parsePlacementConstraint(regionCode string, rules *uploadselection.PlacementRules) (storj.PlacementConstraint, error) {
 	switch regionCode {
 	case "EU":
 		return storj.EU, nil
@@ -48,9 +52,17 @@ func parsePlacementConstraint(regionCode string) (storj.PlacementConstraint, err
 		return storj.DE, nil
 	case "":
 		return storj.EveryCountry, fmt.Errorf("missing region parameter")
-	default:
-		return storj.EveryCountry, fmt.Errorf("unrecognized region parameter: %s", regionCode)
 	}
+
+	if id, err := strconv.ParseUint(regionCode, 10, 16); err == nil {
+		placement := storj.PlacementConstraint(id)
+		if _, ok := rules.Definition(placement); ok {
+			return placement, nil
+		}
+		return storj.EveryCountry, fmt.Errorf("undefined placement: %s", regionCode)
+	}
+
+	return storj.EveryCountry, fmt.Errorf("unrecognized region parameter: %s", regionCode)
 }
 
 func (server *Server) updateBucket(w http.ResponseWriter, r *http.Request, placement storj.PlacementConstraint) {
@@ -91,9 +103,9 @@ func (server *Server) updateBucket(w http.ResponseWriter, r *http.Request, place
 }
 
 func (server *Server) createGeofenceForBucket(w http.ResponseWriter, r *http.Request) {
-	placement, err := parsePlacementConstraint(r.URL.Query().Get("region"))
+	placement, err := parsePlacementConstraint(r.URL.Query().Get("region"), server.placementRules)
 	if err != nil {
-		sendJSONError(w, err.Error(), "available: EU, EEA, US, DE", http.StatusBadRequest)
+		sendJSONError(w, err.Error(), "available: EU, EEA, US, DE or a defined placement number", http.StatusBadRequest)
 		return
 	}
 
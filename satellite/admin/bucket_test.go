@@ -10,6 +10,7 @@ import (
 
 	"storj.io/common/storj"
 	"storj.io/common/uuid"
+	"storj.io/storj/satellite/nodeselection/uploadselection"
 )
 
 func TestValidateRequestParameters(t *testing.T) {
@@ -104,11 +105,16 @@ func TestParsePlacementConstraint(t *testing.T) {
 		{"EU", "EU", storj.EU, ""},
 		{"EEA", "EEA", storj.EEA, ""},
 		{"DE", "DE", storj.DE, ""},
+		{"defined", "10", 10, ""},
+		{"undefined", "11", storj.EveryCountry, "undefined placement: 11"},
 	}
 
+	rules, err := uploadselection.ParsePlacementRules(`10: country("HU")`)
+	require.NoError(t, err)
+
 	for _, testCase := range testCases {
 		t.Run(testCase.name, func(t *testing.T) {
-			placement, err := parsePlacementConstraint(testCase.region)
+			placement, err := parsePlacementConstraint(testCase.region, rules)
 
 			require.Equal(t, testCase.placement, placement)
 
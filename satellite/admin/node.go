@@ -0,0 +1,160 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package admin
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gorilla/mux"
+
+	"storj.io/common/storj"
+	"storj.io/storj/satellite/audit"
+	"storj.io/storj/satellite/overlay"
+)
+
+func (server *Server) getNodeTags(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	nodeID, err := storj.NodeIDFromString(mux.Vars(r)["node-id"])
+	if err != nil {
+		sendJSONError(w, "invalid node-id",
+			err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	tags, err := server.db.OverlayCache().GetNodeTags(ctx, nodeID)
+	if err != nil {
+		sendJSONError(w, "unable to get node tags",
+			err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	type nodeTag struct {
+		Name     string       `json:"name"`
+		Value    string       `json:"value"`
+		Signer   storj.NodeID `json:"signer"`
+		SignedAt time.Time    `json:"signedAt"`
+	}
+	output := make([]nodeTag, 0, len(tags))
+	for _, tag := range tags {
+		output = append(output, nodeTag{
+			Name:     tag.Name,
+			Value:    tag.Value,
+			Signer:   tag.Signer,
+			SignedAt: tag.SignedAt,
+		})
+	}
+
+	data, err := json.Marshal(output)
+	if err != nil {
+		sendJSONError(w, "json encoding failed",
+			err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	sendJSONData(w, http.StatusOK, data)
+}
+
+// nodeCoverage is the JSON representation of the audit coverage of a node.
+type nodeCoverage struct {
+	NodeID          storj.NodeID `json:"nodeId"`
+	CreatedAt       time.Time    `json:"createdAt"`
+	VettedAt        *time.Time   `json:"vettedAt"`
+	TotalAuditCount int64        `json:"totalAuditCount"`
+	LastAuditedAt   *time.Time   `json:"lastAuditedAt"`
+}
+
+func newNodeCoverage(coverage audit.NodeCoverage) nodeCoverage {
+	return nodeCoverage{
+		NodeID:          coverage.NodeID,
+		CreatedAt:       coverage.CreatedAt,
+		VettedAt:        coverage.VettedAt,
+		TotalAuditCount: coverage.TotalAuditCount,
+		LastAuditedAt:   coverage.LastAuditedAt,
+	}
+}
+
+func (server *Server) getAuditCoverage(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	nodeID, err := storj.NodeIDFromString(mux.Vars(r)["node-id"])
+	if err != nil {
+		sendJSONError(w, "invalid node-id",
+			err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	coverage, err := server.db.AuditCoverage().Get(ctx, nodeID)
+	if err != nil {
+		if overlay.ErrNodeNotFound.Has(err) {
+			sendJSONError(w, "node not found",
+				"", http.StatusNotFound)
+			return
+		}
+		sendJSONError(w, "unable to get audit coverage",
+			err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	data, err := json.Marshal(newNodeCoverage(coverage))
+	if err != nil {
+		sendJSONError(w, "json encoding failed",
+			err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	sendJSONData(w, http.StatusOK, data)
+}
+
+func (server *Server) listAuditCoverage(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	query := r.URL.Query()
+
+	limit := 100
+	if value := query.Get("limit"); value != "" {
+		var err error
+		limit, err = strconv.Atoi(value)
+		if err != nil || limit <= 0 {
+			sendJSONError(w, "invalid limit",
+				"limit must be a positive integer", http.StatusBadRequest)
+			return
+		}
+	}
+
+	var auditedBefore time.Time
+	if value := query.Get("max-interval"); value != "" {
+		maxInterval, err := time.ParseDuration(value)
+		if err != nil {
+			sendJSONError(w, "invalid max-interval",
+				err.Error(), http.StatusBadRequest)
+			return
+		}
+		auditedBefore = time.Now().Add(-maxInterval)
+	}
+
+	nodes, err := server.db.AuditCoverage().List(ctx, auditedBefore, limit)
+	if err != nil {
+		sendJSONError(w, "unable to list audit coverage",
+			err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	output := make([]nodeCoverage, 0, len(nodes))
+	for _, node := range nodes {
+		output = append(output, newNodeCoverage(node))
+	}
+
+	data, err := json.Marshal(output)
+	if err != nil {
+		sendJSONError(w, "json encoding failed",
+			err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	sendJSONData(w, http.StatusOK, data)
+}
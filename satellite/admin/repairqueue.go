@@ -0,0 +1,220 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package admin
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gorilla/mux"
+
+	"storj.io/common/storj"
+	"storj.io/common/uuid"
+	"storj.io/storj/satellite/metabase"
+	"storj.io/storj/satellite/repair/queue"
+)
+
+func (server *Server) listRepairQueue(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	query := r.URL.Query()
+	cursor, err := queue.ParseListCursor(query.Get("cursor"))
+	if err != nil {
+		sendJSONError(w, "invalid cursor",
+			err.Error(), http.StatusBadRequest)
+		return
+	}
+	opts := queue.InspectOptions{
+		Cursor: cursor,
+	}
+	if limit := query.Get("limit"); limit != "" {
+		opts.Limit, err = strconv.Atoi(limit)
+		if err != nil {
+			sendJSONError(w, "invalid limit",
+				err.Error(), http.StatusBadRequest)
+			return
+		}
+	}
+	if placement := query.Get("placement"); placement != "" {
+		value, err := strconv.ParseUint(placement, 10, 16)
+		if err != nil {
+			sendJSONError(w, "invalid placement",
+				err.Error(), http.StatusBadRequest)
+			return
+		}
+		constraint := storj.PlacementConstraint(value)
+		opts.Placement = &constraint
+	}
+	if nodeID := query.Get("node-id"); nodeID != "" {
+		opts.NodeID, err = storj.NodeIDFromString(nodeID)
+		if err != nil {
+			sendJSONError(w, "invalid node-id",
+				err.Error(), http.StatusBadRequest)
+			return
+		}
+	}
+
+	result, err := server.repairQueue.List(ctx, opts)
+	if err != nil {
+		sendJSONError(w, "unable to list repair queue",
+			err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	type segment struct {
+		StreamID         uuid.UUID                 `json:"streamId"`
+		Position         uint64                    `json:"position"`
+		SegmentHealth    float64                   `json:"segmentHealth"`
+		NodeRisk         float64                   `json:"nodeRisk"`
+		Tier             float64                   `json:"tier"`
+		Priority         float64                   `json:"priority"`
+		SegmentCreatedAt *time.Time                `json:"segmentCreatedAt"`
+		InsertedAt       time.Time                 `json:"insertedAt"`
+		UpdatedAt        time.Time                 `json:"updatedAt"`
+		AttemptedAt      *time.Time                `json:"attemptedAt"`
+		Missing          bool                      `json:"missing"`
+		Placement        storj.PlacementConstraint `json:"placement"`
+		Nodes            []storj.NodeID            `json:"nodes"`
+	}
+	output := struct {
+		Segments []segment `json:"segments"`
+		Cursor   string    `json:"cursor,omitempty"`
+	}{
+		Segments: make([]segment, 0, len(result.Segments)),
+	}
+	if result.More {
+		output.Cursor = result.Cursor.String()
+	}
+	for _, s := range result.Segments {
+		item := segment{
+			StreamID:         s.StreamID,
+			Position:         s.Position.Encode(),
+			SegmentHealth:    s.SegmentHealth,
+			NodeRisk:         s.NodeRisk,
+			Tier:             s.Tier,
+			Priority:         s.Priority,
+			SegmentCreatedAt: s.SegmentCreatedAt,
+			InsertedAt:       s.InsertedAt,
+			UpdatedAt:        s.UpdatedAt,
+			AttemptedAt:      s.AttemptedAt,
+			Missing:          s.Missing,
+			Placement:        s.Placement,
+			Nodes:            s.Nodes,
+		}
+		output.Segments = append(output.Segments, item)
+	}
+
+	data, err := json.Marshal(output)
+	if err != nil {
+		sendJSONError(w, "json encoding failed",
+			err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	sendJSONData(w, http.StatusOK, data)
+}
+
+func (server *Server) enqueueSegment(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	streamID, position, ok := segmentFromVars(w, r)
+	if !ok {
+		return
+	}
+
+	var priority float64
+	if value := r.URL.Query().Get("priority"); value != "" {
+		var err error
+		priority, err = strconv.ParseFloat(value, 64)
+		if err != nil {
+			sendJSONError(w, "invalid priority",
+				err.Error(), http.StatusBadRequest)
+			return
+		}
+	}
+
+	err := server.repairQueue.Enqueue(ctx, streamID, position, priority)
+	if err != nil {
+		if metabase.ErrSegmentNotFound.Has(err) {
+			sendJSONError(w, "segment not found",
+				err.Error(), http.StatusNotFound)
+			return
+		}
+		sendJSONError(w, "unable to enqueue segment",
+			err.Error(), http.StatusInternalServerError)
+		return
+	}
+}
+
+func (server *Server) setRepairPriority(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	streamID, position, ok := segmentFromVars(w, r)
+	if !ok {
+		return
+	}
+
+	value := r.URL.Query().Get("priority")
+	if value == "" {
+		sendJSONError(w, "priority missing",
+			"", http.StatusBadRequest)
+		return
+	}
+	priority, err := strconv.ParseFloat(value, 64)
+	if err != nil {
+		sendJSONError(w, "invalid priority",
+			err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	err = server.repairQueue.SetPriority(ctx, streamID, position, priority)
+	if err != nil {
+		if queue.ErrNotQueued.Has(err) {
+			sendJSONError(w, "segment is not queued",
+				err.Error(), http.StatusNotFound)
+			return
+		}
+		sendJSONError(w, "unable to set priority",
+			err.Error(), http.StatusInternalServerError)
+		return
+	}
+}
+
+func (server *Server) dequeueSegment(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	streamID, position, ok := segmentFromVars(w, r)
+	if !ok {
+		return
+	}
+
+	err := server.repairQueue.Remove(ctx, streamID, position)
+	if err != nil {
+		sendJSONError(w, "unable to remove segment",
+			err.Error(), http.StatusInternalServerError)
+		return
+	}
+}
+
+// segmentFromVars parses the stream id and the encoded position of the
+// segment in the path. It sends an error response when they are invalid.
+func segmentFromVars(w http.ResponseWriter, r *http.Request) (_ uuid.UUID, _ metabase.SegmentPosition, ok bool) {
+	vars := mux.Vars(r)
+
+	streamID, err := uuid.FromString(vars["stream-id"])
+	if err != nil {
+		sendJSONError(w, "invalid stream-id",
+			err.Error(), http.StatusBadRequest)
+		return uuid.UUID{}, metabase.SegmentPosition{}, false
+	}
+	position, err := strconv.ParseUint(vars["position"], 10, 64)
+	if err != nil {
+		sendJSONError(w, "invalid position",
+			err.Error(), http.StatusBadRequest)
+		return uuid.UUID{}, metabase.SegmentPosition{}, false
+	}
+	return streamID, metabase.SegmentPositionFromEncoded(position), true
+}
@@ -0,0 +1,125 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package admin_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"storj.io/common/storj"
+	"storj.io/common/testcontext"
+	"storj.io/common/testrand"
+	"storj.io/common/uuid"
+	"storj.io/storj/private/testplanet"
+	"storj.io/storj/satellite"
+	"storj.io/storj/satellite/metabase"
+	"storj.io/storj/satellite/metabase/metabasetest"
+)
+
+func TestRepairQueueAPI(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1,
+		Reconfigure: testplanet.Reconfigure{
+			Satellite: func(_ *zap.Logger, _ int, config *satellite.Config) {
+				config.Admin.Address = "127.0.0.1:0"
+			},
+		},
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		sat := planet.Satellites[0]
+		address := sat.Admin.Admin.Listener.Addr()
+		authToken := sat.Config.Console.AuthToken
+
+		obj := metabasetest.RandObjectStream()
+		nodeID := testrand.NodeID()
+		metabasetest.CreateTestObject{
+			CreateSegment: func(object metabase.Object, index int) metabase.Segment {
+				opts := metabase.CommitSegment{
+					ObjectStream: obj,
+					Position:     metabase.SegmentPosition{Index: uint32(index)},
+					RootPieceID:  testrand.PieceID(),
+					Pieces:       metabase.Pieces{{Number: 0, StorageNode: nodeID}},
+					Placement:    storj.EEA,
+
+					EncryptedKey:      []byte{3},
+					EncryptedKeyNonce: []byte{4},
+					EncryptedETag:     []byte{5},
+
+					EncryptedSize: 1024,
+					PlainSize:     512,
+					Redundancy:    metabasetest.DefaultRedundancy,
+				}
+				metabasetest.CommitSegment{Opts: opts}.Check(ctx, t, sat.Metabase.DB)
+				return metabase.Segment{StreamID: obj.StreamID, Position: opts.Position}
+			},
+		}.Run(ctx, t, sat.Metabase.DB, obj, 1)
+
+		queueURL := fmt.Sprintf("http://%s/api/repair-queue", address)
+		segmentURL := fmt.Sprintf("%s/%s/0", queueURL, obj.StreamID)
+
+		type listed struct {
+			Segments []struct {
+				StreamID  uuid.UUID                 `json:"streamId"`
+				Position  uint64                    `json:"position"`
+				Priority  float64                   `json:"priority"`
+				Placement storj.PlacementConstraint `json:"placement"`
+				Nodes     []storj.NodeID            `json:"nodes"`
+				// Object isn't listed by the API, since it requires scanning
+				// the objects table.
+				Object interface{} `json:"object"`
+			} `json:"segments"`
+			Cursor string `json:"cursor"`
+		}
+		list := func(query string) (result listed) {
+			body := assertReq(ctx, t, queueURL+query, http.MethodGet, "", http.StatusOK, "", authToken)
+			require.NoError(t, json.Unmarshal(body, &result))
+			return result
+		}
+
+		t.Run("enqueue", func(t *testing.T) {
+			assertReq(ctx, t, segmentURL+"?priority=2", http.MethodPost, "", http.StatusOK, "", authToken)
+			assertReq(ctx, t, fmt.Sprintf("%s/%s/0", queueURL, testrand.UUID()), http.MethodPost, "", http.StatusNotFound, "", authToken)
+			assertReq(ctx, t, queueURL+"/invalid/0", http.MethodPost, "", http.StatusBadRequest, "", authToken)
+			assertReq(ctx, t, segmentURL+"?priority=invalid", http.MethodPost, "", http.StatusBadRequest, "", authToken)
+		})
+
+		t.Run("list", func(t *testing.T) {
+			result := list("?objects=true&placement=" + fmt.Sprint(int(storj.EEA)) + "&node-id=" + nodeID.String())
+			require.Len(t, result.Segments, 1)
+			require.Empty(t, result.Cursor)
+
+			segment := result.Segments[0]
+			require.Equal(t, obj.StreamID, segment.StreamID)
+			require.Zero(t, segment.Position)
+			require.EqualValues(t, 2, segment.Priority)
+			require.Equal(t, storj.EEA, segment.Placement)
+			require.Equal(t, []storj.NodeID{nodeID}, segment.Nodes)
+			require.Nil(t, segment.Object)
+
+			require.Empty(t, list("?node-id="+testrand.NodeID().String()).Segments)
+			require.Empty(t, list("?placement="+fmt.Sprint(int(storj.US))).Segments)
+
+			assertReq(ctx, t, queueURL+"?cursor=invalid", http.MethodGet, "", http.StatusBadRequest, "", authToken)
+			assertReq(ctx, t, queueURL+"?limit=invalid", http.MethodGet, "", http.StatusBadRequest, "", authToken)
+			assertReq(ctx, t, queueURL+"?node-id=invalid", http.MethodGet, "", http.StatusBadRequest, "", authToken)
+		})
+
+		t.Run("set priority", func(t *testing.T) {
+			assertReq(ctx, t, segmentURL+"/priority?priority=-1", http.MethodPut, "", http.StatusOK, "", authToken)
+			require.EqualValues(t, -1, list("").Segments[0].Priority)
+
+			assertReq(ctx, t, segmentURL+"/priority", http.MethodPut, "", http.StatusBadRequest, "", authToken)
+			assertReq(ctx, t, fmt.Sprintf("%s/%s/0/priority?priority=1", queueURL, testrand.UUID()), http.MethodPut, "", http.StatusNotFound, "", authToken)
+		})
+
+		t.Run("dequeue", func(t *testing.T) {
+			assertReq(ctx, t, segmentURL, http.MethodDelete, "", http.StatusOK, "", authToken)
+			assertReq(ctx, t, queueURL, http.MethodGet, "", http.StatusOK, `{"segments":[]}`, authToken)
+		})
+	})
+}
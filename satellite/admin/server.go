@@ -20,13 +20,17 @@ import (
 	"storj.io/common/errs2"
 	"storj.io/storj/satellite/accounting"
 	adminui "storj.io/storj/satellite/admin/ui"
+	"storj.io/storj/satellite/audit"
 	"storj.io/storj/satellite/buckets"
 	"storj.io/storj/satellite/console"
 	"storj.io/storj/satellite/console/consoleweb"
 	"storj.io/storj/satellite/console/restkeys"
+	"storj.io/storj/satellite/nodeselection/uploadselection"
 	"storj.io/storj/satellite/oidc"
+	"storj.io/storj/satellite/overlay"
 	"storj.io/storj/satellite/payments"
 	"storj.io/storj/satellite/payments/stripecoinpayments"
+	"storj.io/storj/satellite/repair/queue"
 )
 
 // Config defines configuration for debug server.
@@ -48,6 +52,10 @@ type DB interface {
 	OIDC() oidc.DB
 	// StripeCoinPayments returns database for satellite stripe coin payments
 	StripeCoinPayments() stripecoinpayments.DB
+	// OverlayCache returns database for the nodes of the satellite.
+	OverlayCache() overlay.DB
+	// AuditCoverage returns database for tracking when nodes were last audited.
+	AuditCoverage() audit.CoverageDB
 }
 
 // Server provides endpoints for administrative tasks.
@@ -62,6 +70,8 @@ type Server struct {
 	buckets        *buckets.Service
 	restKeys       *restkeys.Service
 	freezeAccounts *console.AccountFreezeService
+	placementRules *uploadselection.PlacementRules
+	repairQueue    *queue.Inspector
 
 	nowFn func() time.Time
 
@@ -70,7 +80,7 @@ type Server struct {
 }
 
 // NewServer returns a new administration Server.
-func NewServer(log *zap.Logger, listener net.Listener, db DB, buckets *buckets.Service, restKeys *restkeys.Service, freezeAccounts *console.AccountFreezeService, accounts payments.Accounts, console consoleweb.Config, config Config) *Server {
+func NewServer(log *zap.Logger, listener net.Listener, db DB, buckets *buckets.Service, restKeys *restkeys.Service, freezeAccounts *console.AccountFreezeService, accounts payments.Accounts, placementRules *uploadselection.PlacementRules, repairQueue *queue.Inspector, console consoleweb.Config, config Config) *Server {
 	server := &Server{
 		log: log,
 
@@ -81,6 +91,8 @@ func NewServer(log *zap.Logger, listener net.Listener, db DB, buckets *buckets.S
 		buckets:        buckets,
 		restKeys:       restKeys,
 		freezeAccounts: freezeAccounts,
+		placementRules: placementRules,
+		repairQueue:    repairQueue,
 
 		nowFn: time.Now,
 
@@ -118,6 +130,13 @@ func NewServer(log *zap.Logger, listener net.Listener, db DB, buckets *buckets.S
 	api.HandleFunc("/projects/{project}/buckets/{bucket}/geofence", server.createGeofenceForBucket).Methods("POST")
 	api.HandleFunc("/projects/{project}/buckets/{bucket}/geofence", server.deleteGeofenceForBucket).Methods("DELETE")
 	api.HandleFunc("/apikeys/{apikey}", server.deleteAPIKey).Methods("DELETE")
+	api.HandleFunc("/nodes/audit-coverage", server.listAuditCoverage).Methods("GET")
+	api.HandleFunc("/nodes/{node-id}/audit-coverage", server.getAuditCoverage).Methods("GET")
+	api.HandleFunc("/nodes/{node-id}/tags", server.getNodeTags).Methods("GET")
+	api.HandleFunc("/repair-queue", server.listRepairQueue).Methods("GET")
+	api.HandleFunc("/repair-queue/{stream-id}/{position}", server.enqueueSegment).Methods("POST")
+	api.HandleFunc("/repair-queue/{stream-id}/{position}/priority", server.setRepairPriority).Methods("PUT")
+	api.HandleFunc("/repair-queue/{stream-id}/{position}", server.dequeueSegment).Methods("DELETE")
 	api.HandleFunc("/restkeys/{useremail}", server.addRESTKey).Methods("POST")
 	api.HandleFunc("/restkeys/{apikey}/revoke", server.revokeRESTKey).Methods("PUT")
 
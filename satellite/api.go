@@ -25,7 +25,9 @@ import (
 	"storj.io/private/debug"
 	"storj.io/private/version"
 	"storj.io/storj/private/lifecycle"
+	"storj.io/storj/private/nodetagpb"
 	"storj.io/storj/private/server"
+	"storj.io/storj/private/shrinkpb"
 	"storj.io/storj/private/version/checker"
 	"storj.io/storj/satellite/abtesting"
 	"storj.io/storj/satellite/accounting"
@@ -338,6 +340,9 @@ func NewAPI(log *zap.Logger, full *identity.FullIdentity, db DB,
 		if err := pb.DRPCRegisterNode(peer.Server.DRPC(), peer.Contact.Endpoint); err != nil {
 			return nil, errs.Combine(err, peer.Close())
 		}
+		if err := nodetagpb.DRPCRegisterNodeTags(peer.Server.DRPC(), peer.Contact.Endpoint); err != nil {
+			return nil, errs.Combine(err, peer.Close())
+		}
 
 		peer.Services.Add(lifecycle.Item{
 			Name:  "contact:service",
@@ -687,6 +692,9 @@ func NewAPI(log *zap.Logger, full *identity.FullIdentity, db DB,
 			if err := pb.DRPCRegisterSatelliteGracefulExit(peer.Server.DRPC(), peer.GracefulExit.Endpoint); err != nil {
 				return nil, errs.Combine(err, peer.Close())
 			}
+			if err := shrinkpb.DRPCRegisterSatelliteShrink(peer.Server.DRPC(), peer.GracefulExit.Endpoint); err != nil {
+				return nil, errs.Combine(err, peer.Close())
+			}
 		} else {
 			peer.Log.Named("gracefulexit").Info("disabled")
 		}
@@ -0,0 +1,544 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package audit
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	mathrand "math/rand"
+	"sync"
+	"time"
+
+	"github.com/vivint/infectious"
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/common/errs2"
+	"storj.io/common/pb"
+	"storj.io/common/pkcrypto"
+	"storj.io/common/rpc"
+	"storj.io/common/rpc/rpcpool"
+	"storj.io/common/rpc/rpcstatus"
+	"storj.io/common/storj"
+	"storj.io/storj/private/auditpb"
+	"storj.io/storj/satellite/metabase"
+	"storj.io/storj/satellite/orders"
+	"storj.io/storj/satellite/overlay"
+)
+
+// nonceSize is the size of the nonce sent with the challenges.
+const nonceSize = 32
+
+// BatchVerifier audits segments in batches.
+//
+// For every segment of a batch it downloads a random stripe from a few more
+// nodes than required, checks the shares and reconstructs the shares of the
+// other nodes. Each of the other nodes is then challenged once for all of its
+// pieces in the batch, and must answer with the hash of the expected shares.
+//
+// Nodes which time out after being dialed, or which fail to answer a
+// challenge for another reason than a missing piece, are contained: each of
+// their challenged pieces gets a reverification job.
+//
+// architecture: Worker
+type BatchVerifier struct {
+	*Verifier
+	extraShares int
+}
+
+// NewBatchVerifier creates a BatchVerifier which downloads extraShares shares
+// more than required to check the stripe of each segment.
+func NewBatchVerifier(verifier *Verifier, extraShares int) *BatchVerifier {
+	return &BatchVerifier{
+		Verifier:    verifier,
+		extraShares: extraShares,
+	}
+}
+
+// batchOutcome is the outcome of auditing a node, ordered by severity.
+type batchOutcome int
+
+const (
+	batchSuccess batchOutcome = iota
+	batchUnknown
+	batchContained
+	batchOffline
+	batchFailure
+)
+
+// challengedPiece is a share which a node must hash.
+type challengedPiece struct {
+	segment   int
+	pieceNum  int
+	challenge *auditpb.PieceChallenge
+	expected  []byte
+}
+
+// challengedNode is a node challenged for its pieces in the batch.
+type challengedNode struct {
+	nodeID     storj.NodeID
+	address    string
+	lastIPPort string
+	pieces     []challengedPiece
+}
+
+// auditBatch collects the state of a batch audit.
+type auditBatch struct {
+	outcomes   map[storj.NodeID]batchOutcome
+	pending    map[storj.NodeID][]*ReverificationJob
+	reputation map[storj.NodeID]overlay.ReputationStatus
+	nodes      map[storj.NodeID]*challengedNode
+	segments   []metabase.Segment
+}
+
+// add records the outcome of an audit of the node. A node audited several
+// times in a batch keeps its most severe outcome.
+func (batch *auditBatch) add(nodeID storj.NodeID, result batchOutcome) {
+	if current, ok := batch.outcomes[nodeID]; !ok || result > current {
+		batch.outcomes[nodeID] = result
+	}
+}
+
+// contain records that the piece of the segment must be reverified before the
+// node is audited again.
+func (batch *auditBatch) contain(nodeID storj.NodeID, segment metabase.Segment, pieceNum int) {
+	batch.add(nodeID, batchContained)
+	batch.pending[nodeID] = append(batch.pending[nodeID], &ReverificationJob{
+		Locator: PieceLocator{
+			NodeID:   nodeID,
+			StreamID: segment.StreamID,
+			Position: segment.Position,
+			PieceNum: pieceNum,
+		},
+	})
+}
+
+// report returns the report of the outcomes of the batch. The pieces of the
+// nodes which failed or were offline aren't reverified.
+func (batch *auditBatch) report() Report {
+	report := Report{
+		NodesReputation: batch.reputation,
+	}
+	for nodeID, result := range batch.outcomes {
+		switch result {
+		case batchSuccess:
+			report.Successes = append(report.Successes, nodeID)
+		case batchUnknown:
+			report.Unknown = append(report.Unknown, nodeID)
+		case batchContained:
+			report.PendingAudits = append(report.PendingAudits, batch.pending[nodeID]...)
+		case batchOffline:
+			report.Offlines = append(report.Offlines, nodeID)
+		case batchFailure:
+			report.Fails = append(report.Fails, nodeID)
+		}
+	}
+	return report
+}
+
+// VerifyBatch audits the segments, challenging every node once for all the
+// pieces it holds which weren't downloaded to check the stripes.
+func (batch *BatchVerifier) VerifyBatch(ctx context.Context, segments []Segment, skip map[storj.NodeID]bool) (report Report, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	state := &auditBatch{
+		outcomes:   make(map[storj.NodeID]batchOutcome),
+		pending:    make(map[storj.NodeID][]*ReverificationJob),
+		reputation: make(map[storj.NodeID]overlay.ReputationStatus),
+		nodes:      make(map[storj.NodeID]*challengedNode),
+	}
+
+	var errlist errs.Group
+	for _, segment := range segments {
+		if err := batch.prepareSegment(ctx, state, segment, skip); err != nil {
+			errlist.Add(err)
+		}
+	}
+
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+	results := make(map[storj.NodeID]batchOutcome, len(state.nodes))
+	for _, node := range state.nodes {
+		wg.Add(1)
+		go func(node *challengedNode) {
+			defer wg.Done()
+			result := batch.challengeNode(ctx, node)
+			mu.Lock()
+			results[node.nodeID] = result
+			mu.Unlock()
+		}(node)
+	}
+	wg.Wait()
+
+	// a piece may be missing or different because its segment was deleted or
+	// modified since the batch started, so failures and containments are only
+	// reported when all segments of the node are unchanged.
+	altered := make(map[int]bool)
+	checked := make(map[int]bool)
+	for nodeID, result := range results {
+		node := state.nodes[nodeID]
+		switch result {
+		case batchFailure:
+			if batch.segmentsAltered(ctx, state, node, checked, altered) {
+				continue
+			}
+			state.add(nodeID, result)
+		case batchContained:
+			if batch.segmentsAltered(ctx, state, node, checked, altered) {
+				continue
+			}
+			for _, piece := range node.pieces {
+				state.contain(nodeID, state.segments[piece.segment], piece.pieceNum)
+			}
+		default:
+			state.add(nodeID, result)
+		}
+	}
+
+	mon.IntVal("batch_audit_segments").Observe(int64(len(state.segments)))
+	mon.IntVal("batch_audit_challenged_nodes").Observe(int64(len(state.nodes)))
+
+	return state.report(), errlist.Err()
+}
+
+// prepareSegment checks a random stripe of the segment and adds the shares of
+// the nodes which weren't downloaded to their challenges.
+func (batch *BatchVerifier) prepareSegment(ctx context.Context, state *auditBatch, segment Segment, skip map[storj.NodeID]bool) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if segment.Expired(batch.nowFn()) {
+		return nil
+	}
+
+	segmentInfo, err := batch.metabase.GetSegmentByPosition(ctx, metabase.GetSegmentByPosition{
+		StreamID: segment.StreamID,
+		Position: segment.Position,
+	})
+	if err != nil {
+		if metabase.ErrSegmentNotFound.Has(err) {
+			return nil
+		}
+		return err
+	}
+	if segmentInfo.Inline() {
+		return nil
+	}
+
+	stripeIndex, err := GetRandomStripe(ctx, segmentInfo)
+	if err != nil {
+		return err
+	}
+
+	limits, privateKey, cachedNodesInfo, err := batch.orders.CreateAuditOrderLimits(ctx, segmentInfo, skip)
+	if err != nil {
+		if orders.ErrDownloadFailedNotEnoughPieces.Has(err) {
+			err = ErrNotEnoughShares.Wrap(err)
+		}
+		return err
+	}
+	for nodeID, info := range cachedNodesInfo {
+		state.reputation[nodeID] = info.Reputation
+	}
+
+	required := int(segmentInfo.Redundancy.RequiredShares)
+	total := int(segmentInfo.Redundancy.TotalShares)
+	shareSize := segmentInfo.Redundancy.ShareSize
+
+	// download the stripe from randomly chosen nodes.
+	var available []int
+	for pieceNum, limit := range limits {
+		if limit != nil {
+			available = append(available, pieceNum)
+		}
+	}
+	mathrand.Shuffle(len(available), func(i, j int) {
+		available[i], available[j] = available[j], available[i]
+	})
+	downloadCount := required + batch.extraShares
+	if downloadCount > len(available) {
+		downloadCount = len(available)
+	}
+	downloadLimits := make([]*pb.AddressedOrderLimit, len(limits))
+	for _, pieceNum := range available[:downloadCount] {
+		downloadLimits[pieceNum] = limits[pieceNum]
+	}
+
+	shares, err := batch.DownloadShares(ctx, downloadLimits, privateKey, cachedNodesInfo, stripeIndex, shareSize)
+	if err != nil {
+		return err
+	}
+
+	err = batch.checkIfSegmentAltered(ctx, segmentInfo)
+	if err != nil {
+		if ErrSegmentDeleted.Has(err) || ErrSegmentModified.Has(err) {
+			return nil
+		}
+		return err
+	}
+
+	for _, nodeID := range getOfflineNodes(segmentInfo, limits, skip) {
+		state.add(nodeID, batchOffline)
+	}
+
+	downloaded := make(map[int]Share, len(shares))
+	for pieceNum, share := range shares {
+		if share.Error != nil {
+			result := shareErrorOutcome(share.Error)
+			if result == batchContained {
+				batch.log.Info("VerifyBatch: download timeout (contained)",
+					zap.Stringer("Node ID", share.NodeID),
+					zap.String("Segment", segmentInfoString(segment)),
+					zap.Error(share.Error))
+				state.contain(share.NodeID, segmentInfo, pieceNum)
+				continue
+			}
+			state.add(share.NodeID, result)
+			continue
+		}
+		downloaded[pieceNum] = share
+	}
+	if len(downloaded) < required {
+		return ErrNotEnoughShares.New("got: %d, required: %d", len(downloaded), required)
+	}
+
+	alteredPieces, corrected, err := auditShares(ctx, int16(required), int16(total), downloaded)
+	if err != nil {
+		batch.log.Error("could not verify shares", zap.String("Segment", segmentInfoString(segment)), zap.Error(err))
+		return err
+	}
+	altered := make(map[int]bool, len(alteredPieces))
+	for _, pieceNum := range alteredPieces {
+		altered[pieceNum] = true
+	}
+	for pieceNum, share := range downloaded {
+		if altered[pieceNum] {
+			batch.log.Info("VerifyBatch: share data altered (audit failed)",
+				zap.Stringer("Node ID", share.NodeID),
+				zap.String("Segment", segmentInfoString(segment)))
+			state.add(share.NodeID, batchFailure)
+			continue
+		}
+		state.add(share.NodeID, batchSuccess)
+	}
+
+	expected, err := reconstructShares(required, total, corrected)
+	if err != nil {
+		return err
+	}
+
+	index := len(state.segments)
+	state.segments = append(state.segments, segmentInfo)
+
+	offset := int64(shareSize) * int64(stripeIndex)
+	for _, pieceNum := range available[downloadCount:] {
+		limit := limits[pieceNum]
+		nodeID := limit.GetLimit().StorageNodeId
+
+		node, ok := state.nodes[nodeID]
+		if !ok {
+			node = &challengedNode{
+				nodeID:     nodeID,
+				address:    limit.GetStorageNodeAddress().GetAddress(),
+				lastIPPort: cachedNodesInfo[nodeID].LastIPPort,
+			}
+			state.nodes[nodeID] = node
+		}
+		node.pieces = append(node.pieces, challengedPiece{
+			segment:  index,
+			pieceNum: pieceNum,
+			challenge: &auditpb.PieceChallenge{
+				PieceId: limit.GetLimit().PieceId,
+				Offset:  offset,
+				Length:  int64(shareSize),
+			},
+			expected: expected[pieceNum],
+		})
+	}
+
+	return nil
+}
+
+// challengeNode challenges the node for all of its pieces and checks the
+// returned hash.
+func (batch *BatchVerifier) challengeNode(ctx context.Context, node *challengedNode) (result batchOutcome) {
+	defer mon.Task()(&ctx)(nil)
+
+	nonce := make([]byte, nonceSize)
+	if _, err := rand.Read(nonce); err != nil {
+		batch.log.Error("VerifyBatch: unable to generate nonce", zap.Error(err))
+		return batchUnknown
+	}
+
+	request := &auditpb.ChallengeRequest{Nonce: nonce}
+	hash := pkcrypto.NewHash()
+	_, _ = hash.Write(nonce)
+	var size int64
+	for _, piece := range node.pieces {
+		request.Challenges = append(request.Challenges, piece.challenge)
+		_, _ = hash.Write(piece.expected)
+		size += piece.challenge.Length
+	}
+	expected := hash.Sum(nil)
+
+	// the node has to read all the challenged shares.
+	timedCtx := ctx
+	if batch.minBytesPerSecond > 0 {
+		maxTransferTime := time.Duration(int64(time.Second) * size / batch.minBytesPerSecond.Int64())
+		if maxTransferTime < batch.minDownloadTimeout {
+			maxTransferTime = batch.minDownloadTimeout
+		}
+		var cancel func()
+		timedCtx, cancel = context.WithTimeout(ctx, maxTransferTime)
+		defer cancel()
+	}
+
+	response, err := batch.challenge(timedCtx, node, request)
+	// the duration of the challenge depends on the number and the size of the
+	// challenged shares, so it isn't comparable with the latency of a single
+	// transfer, and only the outcome is observed.
+	batch.overlay.ObserveTransfers([]overlay.TransferObservation{{
+		NodeID:  node.nodeID,
+		Success: err == nil,
+	}})
+	if err != nil {
+		result = challengeErrorOutcome(ctx, err)
+		switch result {
+		case batchFailure:
+			batch.log.Info("VerifyBatch: piece not found (audit failed)",
+				zap.Stringer("Node ID", node.nodeID),
+				zap.Error(err))
+		case batchContained:
+			batch.log.Info("VerifyBatch: challenge error (contained)",
+				zap.Stringer("Node ID", node.nodeID),
+				zap.Int("Pieces", len(node.pieces)),
+				zap.Error(err))
+		}
+		return result
+	}
+
+	if !bytes.Equal(response.Hash, expected) {
+		batch.log.Info("VerifyBatch: challenge hash mismatch (audit failed)",
+			zap.Stringer("Node ID", node.nodeID),
+			zap.Int("Pieces", len(node.pieces)))
+		return batchFailure
+	}
+	return batchSuccess
+}
+
+// challenge sends the challenges to the node, trying its last IP and port
+// first.
+func (batch *BatchVerifier) challenge(ctx context.Context, node *challengedNode, request *auditpb.ChallengeRequest) (_ *auditpb.ChallengeResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var conn *rpc.Conn
+	if node.lastIPPort != "" && node.lastIPPort != node.address {
+		conn, err = batch.dialer.DialNodeURL(rpcpool.WithForceDial(ctx), storj.NodeURL{
+			ID:      node.nodeID,
+			Address: node.lastIPPort,
+		})
+		if err != nil {
+			batch.log.Debug("failed to connect to audit target node at cached IP",
+				zap.Stringer("Node ID", node.nodeID),
+				zap.String("cached-ip-and-port", node.lastIPPort),
+				zap.Error(err))
+		}
+	}
+	if conn == nil {
+		conn, err = batch.dialer.DialNodeURL(rpcpool.WithForceDial(ctx), storj.NodeURL{
+			ID:      node.nodeID,
+			Address: node.address,
+		})
+		if err != nil {
+			return nil, Error.Wrap(err)
+		}
+	}
+	defer func() { err = errs.Combine(err, conn.Close()) }()
+
+	return auditpb.NewDRPCPieceAuditClient(conn).Challenge(ctx, request)
+}
+
+// segmentsAltered returns whether any of the challenged segments of the node
+// was deleted or modified during the batch.
+func (batch *BatchVerifier) segmentsAltered(ctx context.Context, state *auditBatch, node *challengedNode, checked, altered map[int]bool) bool {
+	for _, piece := range node.pieces {
+		if !checked[piece.segment] {
+			checked[piece.segment] = true
+			err := batch.checkIfSegmentAltered(ctx, state.segments[piece.segment])
+			if err != nil {
+				if !ErrSegmentDeleted.Has(err) && !ErrSegmentModified.Has(err) {
+					batch.log.Error("VerifyBatch: unable to check segment", zap.Error(err))
+				}
+				altered[piece.segment] = true
+			}
+		}
+		if altered[piece.segment] {
+			return true
+		}
+	}
+	return false
+}
+
+// shareErrorOutcome returns the audit outcome of a node which failed to
+// return a share.
+func shareErrorOutcome(err error) batchOutcome {
+	switch {
+	case rpc.Error.Has(err) && (errs.Is(err, context.DeadlineExceeded) || errs2.IsRPC(err, rpcstatus.Unknown)):
+		// dial failed
+		return batchOffline
+	case rpc.Error.Has(err):
+		// unknown transport error
+		return batchUnknown
+	case errs2.IsRPC(err, rpcstatus.NotFound):
+		// missing piece
+		return batchFailure
+	case errs2.IsRPC(err, rpcstatus.DeadlineExceeded):
+		// dial successful, but download timed out
+		return batchContained
+	default:
+		return batchUnknown
+	}
+}
+
+// challengeErrorOutcome returns the audit outcome of a node which failed to
+// answer a challenge. Unlike a download, the challenge covers many pieces, so
+// any error after the node was dialed, besides a missing piece, makes the node
+// reverify them one by one.
+func challengeErrorOutcome(ctx context.Context, err error) batchOutcome {
+	switch {
+	case rpc.Error.Has(err):
+		return shareErrorOutcome(err)
+	case ctx.Err() != nil:
+		// the batch was canceled
+		return batchUnknown
+	case errs2.IsRPC(err, rpcstatus.NotFound):
+		// missing piece
+		return batchFailure
+	default:
+		return batchContained
+	}
+}
+
+// reconstructShares erasure encodes the stripe again from the corrected
+// shares and returns all the shares of the stripe by piece number.
+func reconstructShares(required, total int, corrected []infectious.Share) (map[int][]byte, error) {
+	f, err := infectious.NewFEC(required, total)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	stripe, err := f.Decode(nil, corrected)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	shares := make(map[int][]byte, total)
+	err = f.Encode(stripe, func(share infectious.Share) {
+		shares[share.Number] = append([]byte{}, share.Data...)
+	})
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	return shares, nil
+}
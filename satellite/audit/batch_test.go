@@ -0,0 +1,225 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package audit_test
+
+import (
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/common/memory"
+	"storj.io/common/storj"
+	"storj.io/common/testcontext"
+	"storj.io/common/testrand"
+	"storj.io/storj/private/testblobs"
+	"storj.io/storj/private/testplanet"
+	"storj.io/storj/satellite"
+	"storj.io/storj/satellite/audit"
+	"storj.io/storj/storagenode"
+)
+
+func TestVerifyBatch(t *testing.T) {
+	testWithChoreAndObserver(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 6, UplinkCount: 1,
+		Reconfigure: testplanet.Reconfigure{
+			StorageNodeDB: func(index int, db storagenode.DB, log *zap.Logger) (storagenode.DB, error) {
+				return testblobs.NewCorruptDB(log.Named("corruptdb"), db), nil
+			},
+			Satellite: testplanet.ReconfigureRS(2, 3, 6, 6),
+		},
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet, pauseQueueing pauseQueueingFunc, runQueueingOnce runQueueingOnceFunc) {
+		satellite := planet.Satellites[0]
+		audits := satellite.Audit
+
+		audits.Worker.Loop.Pause()
+		pauseQueueing(satellite)
+
+		for i := 0; i < 3; i++ {
+			err := planet.Uplinks[0].Upload(ctx, satellite, "testbucket", "test/path"+string(rune('a'+i)), testrand.Bytes(8*memory.KiB))
+			require.NoError(t, err)
+		}
+
+		err := runQueueingOnce(ctx, satellite)
+		require.NoError(t, err)
+
+		var segments []audit.Segment
+		for {
+			segment, err := audits.VerifyQueue.Next(ctx)
+			if audit.ErrEmptyQueue.Has(err) {
+				break
+			}
+			require.NoError(t, err)
+			segments = append(segments, segment)
+		}
+		require.Len(t, segments, 3)
+
+		verifier := audit.NewBatchVerifier(audits.Verifier, 2)
+
+		report, err := verifier.VerifyBatch(ctx, segments, nil)
+		require.NoError(t, err)
+		require.Len(t, report.Successes, len(planet.StorageNodes))
+		require.Empty(t, report.Fails)
+		require.Empty(t, report.Offlines)
+		require.Empty(t, report.Unknown)
+
+		corrupted := planet.StorageNodes[0]
+		corrupted.DB.(*testblobs.CorruptDB).SetCorrupt(true)
+
+		report, err = verifier.VerifyBatch(ctx, segments, nil)
+		require.NoError(t, err)
+		require.Equal(t, storj.NodeIDList{corrupted.ID()}, report.Fails)
+		require.Len(t, report.Successes, len(planet.StorageNodes)-1)
+		require.NotContains(t, report.Successes, corrupted.ID())
+		require.Empty(t, report.Offlines)
+		require.Empty(t, report.Unknown)
+	})
+}
+
+// TestVerifyBatchChallengeError checks that a node which returns an error
+// other than a missing piece when challenged is contained for the challenged
+// pieces.
+func TestVerifyBatchChallengeError(t *testing.T) {
+	const segmentCount = 10
+
+	testWithChoreAndObserver(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 8, UplinkCount: 1,
+		Reconfigure: testplanet.Reconfigure{
+			StorageNodeDB: func(index int, db storagenode.DB, log *zap.Logger) (storagenode.DB, error) {
+				return newBadBlobsAllowVerify(log, db), nil
+			},
+			Satellite: testplanet.ReconfigureRS(2, 3, 8, 8),
+		},
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet, pauseQueueing pauseQueueingFunc, runQueueingOnce runQueueingOnceFunc) {
+		satellite := planet.Satellites[0]
+		audits := satellite.Audit
+
+		audits.Worker.Loop.Pause()
+		pauseQueueing(satellite)
+
+		segments := uploadBatchSegments(ctx, t, planet, segmentCount, runQueueingOnce)
+
+		badNode := planet.StorageNodes[0]
+		badNode.DB.(*testblobs.BadDB).SetError(errs.New("unknown error"))
+
+		// the bad node is challenged for some of the segments; a download
+		// error is only an unknown outcome, the same way as for single audits.
+		verifier := audit.NewBatchVerifier(audits.Verifier, 1)
+		report, err := verifier.VerifyBatch(ctx, segments, nil)
+		require.NoError(t, err)
+		require.NotContains(t, report.Successes, badNode.ID())
+		require.Empty(t, report.Fails)
+		require.Empty(t, report.Offlines)
+		require.Empty(t, report.Unknown)
+		require.NotEmpty(t, report.PendingAudits)
+		require.LessOrEqual(t, len(report.PendingAudits), segmentCount)
+		for _, pending := range report.PendingAudits {
+			require.Equal(t, badNode.ID(), pending.Locator.NodeID)
+		}
+
+		requireContained(ctx, t, satellite, report, badNode.ID())
+	})
+}
+
+// TestVerifyBatchSlowNode checks that a node which stalls after being dialed
+// is contained for all of its pieces in the batch.
+func TestVerifyBatchSlowNode(t *testing.T) {
+	const segmentCount = 3
+
+	testWithChoreAndObserver(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 6, UplinkCount: 1,
+		Reconfigure: testplanet.Reconfigure{
+			StorageNodeDB: func(index int, db storagenode.DB, log *zap.Logger) (storagenode.DB, error) {
+				return testblobs.NewSlowDB(log.Named("slowdb"), db), nil
+			},
+			Satellite: testplanet.Combine(
+				func(log *zap.Logger, index int, config *satellite.Config) {
+					// These config values are chosen to force the slow node to time out without timing out on the normal nodes
+					config.Audit.MinBytesPerSecond = 100 * memory.KiB
+					config.Audit.MinDownloadTimeout = 950 * time.Millisecond
+				},
+				testplanet.ReconfigureRS(2, 3, 6, 6),
+			),
+		},
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet, pauseQueueing pauseQueueingFunc, runQueueingOnce runQueueingOnceFunc) {
+		satellite := planet.Satellites[0]
+		audits := satellite.Audit
+
+		audits.Worker.Loop.Pause()
+		pauseQueueing(satellite)
+
+		segments := uploadBatchSegments(ctx, t, planet, segmentCount, runQueueingOnce)
+
+		slowNode := planet.StorageNodes[0]
+		slowNode.DB.(*testblobs.SlowDB).SetLatency(3 * time.Second)
+
+		// the slow node times out whether its share is downloaded or
+		// challenged, so each of its pieces is reverified.
+		verifier := audit.NewBatchVerifier(audits.Verifier, 2)
+		report, err := verifier.VerifyBatch(ctx, segments, nil)
+		require.NoError(t, err)
+		require.Len(t, report.Successes, len(planet.StorageNodes)-1)
+		require.NotContains(t, report.Successes, slowNode.ID())
+		require.Empty(t, report.Fails)
+		require.Empty(t, report.Offlines)
+		require.Empty(t, report.Unknown)
+		require.Len(t, report.PendingAudits, segmentCount)
+		for _, pending := range report.PendingAudits {
+			require.Equal(t, slowNode.ID(), pending.Locator.NodeID)
+		}
+
+		requireContained(ctx, t, satellite, report, slowNode.ID())
+	})
+}
+
+// uploadBatchSegments uploads count objects of one segment and returns their
+// segments from the verify queue.
+func uploadBatchSegments(ctx *testcontext.Context, t *testing.T, planet *testplanet.Planet, count int, runQueueingOnce runQueueingOnceFunc) []audit.Segment {
+	satellite := planet.Satellites[0]
+
+	for i := 0; i < count; i++ {
+		err := planet.Uplinks[0].Upload(ctx, satellite, "testbucket", "test/path"+strconv.Itoa(i), testrand.Bytes(8*memory.KiB))
+		require.NoError(t, err)
+	}
+
+	err := runQueueingOnce(ctx, satellite)
+	require.NoError(t, err)
+
+	var segments []audit.Segment
+	for {
+		segment, err := satellite.Audit.VerifyQueue.Next(ctx)
+		if audit.ErrEmptyQueue.Has(err) {
+			break
+		}
+		require.NoError(t, err)
+		segments = append(segments, segment)
+	}
+	require.Len(t, segments, count)
+	return segments
+}
+
+// requireContained records the report and checks that the node was contained
+// with a reverification job for each of its pending audits.
+func requireContained(ctx *testcontext.Context, t *testing.T, satellite *testplanet.Satellite, report audit.Report, nodeID storj.NodeID) {
+	satellite.Audit.Reporter.RecordAudits(ctx, report)
+
+	node, err := satellite.Overlay.Service.Get(ctx, nodeID)
+	require.NoError(t, err)
+	require.True(t, node.Contained)
+
+	var jobs int
+	for {
+		job, err := satellite.Audit.ReverifyQueue.GetNextJob(ctx, time.Minute)
+		if audit.ErrEmptyQueue.Has(err) {
+			break
+		}
+		require.NoError(t, err)
+		require.Equal(t, nodeID, job.Locator.NodeID)
+		jobs++
+	}
+	require.Equal(t, len(report.PendingAudits), jobs)
+}
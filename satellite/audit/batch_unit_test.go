@@ -0,0 +1,86 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package audit
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/vivint/infectious"
+
+	"storj.io/common/storj"
+	"storj.io/common/testrand"
+	"storj.io/storj/satellite/metabase"
+)
+
+func TestReconstructShares(t *testing.T) {
+	const (
+		required = 4
+		total    = 10
+	)
+
+	f, err := infectious.NewFEC(required, total)
+	require.NoError(t, err)
+
+	shares := make(map[int][]byte, total)
+	err = f.Encode(testrand.Bytes(4*required), func(share infectious.Share) {
+		shares[share.Number] = append([]byte{}, share.Data...)
+	})
+	require.NoError(t, err)
+
+	var corrected []infectious.Share
+	for _, number := range []int{1, 4, 6, 9} {
+		corrected = append(corrected, infectious.Share{Number: number, Data: append([]byte{}, shares[number]...)})
+	}
+
+	reconstructed, err := reconstructShares(required, total, corrected)
+	require.NoError(t, err)
+	require.Equal(t, shares, reconstructed)
+}
+
+func TestAuditBatchOutcomes(t *testing.T) {
+	success := testrand.NodeID()
+	failure := testrand.NodeID()
+	offline := testrand.NodeID()
+	unknown := testrand.NodeID()
+	contained := testrand.NodeID()
+
+	batch := &auditBatch{
+		outcomes: make(map[storj.NodeID]batchOutcome),
+		pending:  make(map[storj.NodeID][]*ReverificationJob),
+	}
+	segment := metabase.Segment{StreamID: testrand.UUID()}
+
+	batch.add(success, batchSuccess)
+	batch.add(success, batchSuccess)
+
+	// the most severe outcome is kept, whatever the order.
+	batch.add(failure, batchSuccess)
+	batch.add(failure, batchFailure)
+	batch.add(failure, batchOffline)
+	batch.contain(failure, segment, 1)
+
+	batch.add(offline, batchUnknown)
+	batch.add(offline, batchOffline)
+	batch.add(offline, batchSuccess)
+
+	batch.add(unknown, batchSuccess)
+	batch.add(unknown, batchUnknown)
+
+	// the pieces of a contained node are reverified.
+	batch.add(contained, batchUnknown)
+	batch.contain(contained, segment, 2)
+	batch.contain(contained, segment, 3)
+	batch.add(contained, batchSuccess)
+
+	report := batch.report()
+	require.Equal(t, storj.NodeIDList{success}, report.Successes)
+	require.Equal(t, storj.NodeIDList{failure}, report.Fails)
+	require.Equal(t, storj.NodeIDList{offline}, report.Offlines)
+	require.Equal(t, storj.NodeIDList{unknown}, report.Unknown)
+	require.Equal(t, []*ReverificationJob{
+		{Locator: PieceLocator{NodeID: contained, StreamID: segment.StreamID, PieceNum: 2}},
+		{Locator: PieceLocator{NodeID: contained, StreamID: segment.StreamID, PieceNum: 3}},
+	}, report.PendingAudits)
+}
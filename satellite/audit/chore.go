@@ -11,7 +11,6 @@ import (
 	"go.uber.org/zap"
 
 	"storj.io/common/sync2"
-	"storj.io/common/uuid"
 	"storj.io/storj/satellite/metabase/segmentloop"
 )
 
@@ -19,25 +18,27 @@ import (
 //
 // architecture: Chore
 type Chore struct {
-	log   *zap.Logger
-	rand  *rand.Rand
-	queue VerifyQueue
-	Loop  *sync2.Cycle
+	log      *zap.Logger
+	rand     *rand.Rand
+	queue    VerifyQueue
+	coverage CoverageDB
+	Loop     *sync2.Cycle
 
 	segmentLoop *segmentloop.Service
 	config      Config
 }
 
 // NewChore instantiates Chore.
-func NewChore(log *zap.Logger, queue VerifyQueue, loop *segmentloop.Service, config Config) *Chore {
+func NewChore(log *zap.Logger, queue VerifyQueue, coverage CoverageDB, loop *segmentloop.Service, config Config) *Chore {
 	if config.VerificationPushBatchSize < 1 {
 		config.VerificationPushBatchSize = 1
 	}
 	return &Chore{
-		log:   log,
-		rand:  rand.New(rand.NewSource(time.Now().Unix())),
-		queue: queue,
-		Loop:  sync2.NewCycle(config.ChoreInterval),
+		log:      log,
+		rand:     rand.New(rand.NewSource(time.Now().Unix())),
+		queue:    queue,
+		coverage: coverage,
+		Loop:     sync2.NewCycle(config.ChoreInterval),
 
 		segmentLoop: loop,
 		config:      config,
@@ -57,36 +58,19 @@ func (chore *Chore) Run(ctx context.Context) (err error) {
 			return nil
 		}
 
-		type SegmentKey struct {
-			StreamID uuid.UUID
-			Position uint64
+		coverage, err := loadCoverage(ctx, chore.coverage, chore.config.MaxAuditInterval)
+		if err != nil {
+			chore.log.Error("error loading audit coverage", zap.Error(err))
+			return nil
 		}
 
-		var newQueue []Segment
-		queueSegments := make(map[SegmentKey]struct{})
-
-		// Add reservoir segments to queue in pseudorandom order.
-		for i := 0; i < chore.config.Slots; i++ {
-			for _, res := range collector.Reservoirs {
-				segments := res.Segments()
-				// Skip reservoir if no segment at this index.
-				if len(segments) <= i {
-					continue
-				}
-				segment := segments[i]
-				segmentKey := SegmentKey{
-					StreamID: segment.StreamID,
-					Position: segment.Position.Encode(),
-				}
-				if segmentKey == (SegmentKey{}) {
-					continue
-				}
+		newQueue, overdue := buildQueue(collector.Reservoirs, chore.config.Slots, coverage, time.Now())
 
-				if _, ok := queueSegments[segmentKey]; !ok {
-					newQueue = append(newQueue, NewSegment(segment))
-					queueSegments[segmentKey] = struct{}{}
-				}
-			}
+		// Push the segments of the nodes whose audit is overdue ahead of the
+		// others. They are sampled again on the next run when this fails, so
+		// the regular queue is pushed anyway.
+		if err := chore.queue.PushOverdue(ctx, overdue, chore.config.VerificationPushBatchSize); err != nil {
+			chore.log.Error("error pushing overdue segments", zap.Error(err))
 		}
 
 		// Push new queue to queues struct so it can be fetched by worker.
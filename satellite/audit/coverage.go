@@ -0,0 +1,162 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package audit
+
+import (
+	"context"
+	"time"
+
+	"storj.io/common/storj"
+	"storj.io/common/uuid"
+)
+
+// CoverageDB tracks when the nodes were last audited.
+//
+// architecture: Database
+type CoverageDB interface {
+	// RecordAudited sets the time when the nodes were last audited.
+	RecordAudited(ctx context.Context, nodeIDs storj.NodeIDList, auditedAt time.Time) error
+	// LastAudited returns when the nodes which weren't disqualified nor
+	// exited were last audited. The nodes audited before their audits were
+	// recorded are considered audited when their reputation was last updated,
+	// and the nodes never audited when they were created.
+	LastAudited(ctx context.Context) (map[storj.NodeID]time.Time, error)
+	// Get returns the audit coverage of the node.
+	Get(ctx context.Context, nodeID storj.NodeID) (NodeCoverage, error)
+	// List returns the audit coverage of the nodes which weren't disqualified
+	// nor exited, least recently audited first. When auditedBefore isn't zero,
+	// only the nodes which weren't audited since then are returned.
+	List(ctx context.Context, auditedBefore time.Time, limit int) ([]NodeCoverage, error)
+}
+
+// NodeCoverage is the audit coverage of a node.
+type NodeCoverage struct {
+	NodeID          storj.NodeID
+	CreatedAt       time.Time
+	VettedAt        *time.Time
+	TotalAuditCount int64
+	// LastAuditedAt is nil when the node was never audited.
+	LastAuditedAt *time.Time
+}
+
+// OverdueSegment is a segment sampled for a node which wasn't audited within
+// the maximum audit interval.
+type OverdueSegment struct {
+	Segment
+	// DueAt is when the audit of the node became due.
+	DueAt time.Time
+}
+
+// coverage decides which nodes weren't audited within the maximum audit
+// interval.
+type coverage struct {
+	lastAudited map[storj.NodeID]time.Time
+	maxInterval time.Duration
+}
+
+// loadCoverage loads when the nodes were last audited. The returned coverage
+// doesn't consider any node overdue when maxInterval is zero or db is nil.
+func loadCoverage(ctx context.Context, db CoverageDB, maxInterval time.Duration) (_ *coverage, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if maxInterval <= 0 || db == nil {
+		return &coverage{}, nil
+	}
+
+	lastAudited, err := db.LastAudited(ctx)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	return &coverage{
+		lastAudited: lastAudited,
+		maxInterval: maxInterval,
+	}, nil
+}
+
+// overdue returns when the audit of the node became due, if it's overdue at
+// now.
+func (coverage *coverage) overdue(nodeID storj.NodeID, now time.Time) (dueAt time.Time, ok bool) {
+	if coverage.maxInterval <= 0 {
+		return time.Time{}, false
+	}
+
+	// nodes which were disqualified or exited aren't audited anymore.
+	lastAudited, ok := coverage.lastAudited[nodeID]
+	if !ok {
+		return time.Time{}, false
+	}
+	dueAt = lastAudited.Add(coverage.maxInterval)
+	return dueAt, dueAt.Before(now)
+}
+
+// buildQueue builds the verification queue from the per-node reservoirs, in
+// pseudorandom order. The segments sampled for nodes whose audit is overdue
+// are returned separately, with the time when the audit of the node which is
+// overdue the longest became due.
+func buildQueue(reservoirs map[storj.NodeID]*Reservoir, slots int, coverage *coverage, now time.Time) (queue []Segment, overdue []OverdueSegment) {
+	type SegmentKey struct {
+		StreamID uuid.UUID
+		Position uint64
+	}
+
+	queueSegments := make(map[SegmentKey]struct{})
+	overdueSegments := make(map[SegmentKey]int)
+
+	for nodeID, res := range reservoirs {
+		dueAt, ok := coverage.overdue(nodeID, now)
+		if !ok {
+			continue
+		}
+		for _, segment := range res.Segments() {
+			segmentKey := SegmentKey{
+				StreamID: segment.StreamID,
+				Position: segment.Position.Encode(),
+			}
+			if segmentKey == (SegmentKey{}) {
+				continue
+			}
+
+			if index, ok := overdueSegments[segmentKey]; ok {
+				if dueAt.Before(overdue[index].DueAt) {
+					overdue[index].DueAt = dueAt
+				}
+				continue
+			}
+			overdueSegments[segmentKey] = len(overdue)
+			overdue = append(overdue, OverdueSegment{
+				Segment: NewSegment(segment),
+				DueAt:   dueAt,
+			})
+		}
+	}
+
+	// Add reservoir segments to queue in pseudorandom order.
+	for i := 0; i < slots; i++ {
+		for _, res := range reservoirs {
+			segments := res.Segments()
+			// Skip reservoir if no segment at this index.
+			if len(segments) <= i {
+				continue
+			}
+			segment := segments[i]
+			segmentKey := SegmentKey{
+				StreamID: segment.StreamID,
+				Position: segment.Position.Encode(),
+			}
+			if segmentKey == (SegmentKey{}) {
+				continue
+			}
+
+			if _, ok := overdueSegments[segmentKey]; ok {
+				continue
+			}
+			if _, ok := queueSegments[segmentKey]; !ok {
+				queue = append(queue, NewSegment(segment))
+				queueSegments[segmentKey] = struct{}{}
+			}
+		}
+	}
+
+	return queue, overdue
+}
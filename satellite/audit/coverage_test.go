@@ -0,0 +1,123 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package audit
+
+import (
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"storj.io/common/storj"
+	"storj.io/common/testrand"
+	"storj.io/common/uuid"
+	"storj.io/storj/satellite/metabase/segmentloop"
+)
+
+func TestCoverageOverdue(t *testing.T) {
+	now := time.Now()
+	audited := testrand.NodeID()
+	stale := testrand.NodeID()
+	neverAudited := testrand.NodeID()
+	exited := testrand.NodeID()
+
+	enabled := &coverage{
+		lastAudited: map[storj.NodeID]time.Time{
+			audited: now.Add(-time.Hour),
+			stale:   now.Add(-96 * time.Hour),
+			// the nodes which were never audited are due since their creation.
+			neverAudited: now.Add(-120 * time.Hour),
+		},
+		maxInterval: 72 * time.Hour,
+	}
+
+	_, ok := enabled.overdue(audited, now)
+	require.False(t, ok)
+
+	dueAt, ok := enabled.overdue(stale, now)
+	require.True(t, ok)
+	require.Equal(t, now.Add(-24*time.Hour), dueAt)
+
+	dueAt, ok = enabled.overdue(neverAudited, now)
+	require.True(t, ok)
+	require.Equal(t, now.Add(-48*time.Hour), dueAt)
+
+	// disqualified and exited nodes are missing and never overdue.
+	_, ok = enabled.overdue(exited, now)
+	require.False(t, ok)
+
+	// a zero interval disables the coverage.
+	_, ok = (&coverage{}).overdue(neverAudited, now)
+	require.False(t, ok)
+}
+
+func TestBuildQueue(t *testing.T) {
+	now := time.Now()
+	audited := testrand.NodeID()
+	stale := testrand.NodeID()
+	neverAudited := testrand.NodeID()
+	exited := testrand.NodeID()
+
+	segments := make([]segmentloop.Segment, 5)
+	for i := range segments {
+		segments[i] = makeSegment(i + 1)
+	}
+
+	newReservoir := func(segments ...segmentloop.Segment) *Reservoir {
+		res := NewReservoir(len(segments))
+		for i := range segments {
+			res.sample(float64(i+1), &segments[i])
+		}
+		return res
+	}
+	reservoirs := map[storj.NodeID]*Reservoir{
+		neverAudited: newReservoir(segments[0], segments[1]),
+		audited:      newReservoir(segments[1], segments[2], segments[3]),
+		stale:        newReservoir(segments[3], segments[0]),
+		exited:       newReservoir(segments[2]),
+	}
+
+	streamIDs := func(segments []Segment) []uuid.UUID {
+		var ids []uuid.UUID
+		for _, segment := range segments {
+			ids = append(ids, segment.StreamID)
+		}
+		sort.Slice(ids, func(i, j int) bool { return ids[i].Less(ids[j]) })
+		return ids
+	}
+
+	t.Run("disabled", func(t *testing.T) {
+		queue, overdue := buildQueue(reservoirs, 3, &coverage{}, now)
+		require.Empty(t, overdue)
+		require.Equal(t, []uuid.UUID{
+			segments[0].StreamID, segments[1].StreamID, segments[2].StreamID, segments[3].StreamID,
+		}, streamIDs(queue))
+	})
+
+	t.Run("overdue", func(t *testing.T) {
+		enabled := &coverage{
+			lastAudited: map[storj.NodeID]time.Time{
+				audited:      now.Add(-time.Hour),
+				stale:        now.Add(-96 * time.Hour),
+				neverAudited: now.Add(-120 * time.Hour),
+			},
+			maxInterval: 72 * time.Hour,
+		}
+
+		queue, overdue := buildQueue(reservoirs, 3, enabled, now)
+		require.Equal(t, []uuid.UUID{segments[2].StreamID}, streamIDs(queue))
+
+		dueAt := make(map[uuid.UUID]time.Time)
+		for _, segment := range overdue {
+			dueAt[segment.StreamID] = segment.DueAt
+		}
+		require.Equal(t, map[uuid.UUID]time.Time{
+			// held by both overdue nodes, due since the never audited node.
+			segments[0].StreamID: now.Add(-48 * time.Hour),
+			segments[1].StreamID: now.Add(-48 * time.Hour),
+			segments[3].StreamID: now.Add(-24 * time.Hour),
+		}, dueAt)
+	})
+}
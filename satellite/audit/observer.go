@@ -12,7 +12,6 @@ import (
 	"go.uber.org/zap"
 
 	"storj.io/common/storj"
-	"storj.io/common/uuid"
 	"storj.io/storj/satellite/metabase/rangedloop"
 )
 
@@ -22,6 +21,7 @@ import (
 type Observer struct {
 	log      *zap.Logger
 	queue    VerifyQueue
+	coverage CoverageDB
 	config   Config
 	seedRand *rand.Rand
 
@@ -30,13 +30,14 @@ type Observer struct {
 }
 
 // NewObserver instantiates Observer.
-func NewObserver(log *zap.Logger, queue VerifyQueue, config Config) *Observer {
+func NewObserver(log *zap.Logger, queue VerifyQueue, coverage CoverageDB, config Config) *Observer {
 	if config.VerificationPushBatchSize < 1 {
 		config.VerificationPushBatchSize = 1
 	}
 	return &Observer{
 		log:      log,
 		queue:    queue,
+		coverage: coverage,
 		config:   config,
 		seedRand: rand.New(rand.NewSource(time.Now().Unix())),
 	}
@@ -80,33 +81,21 @@ func (obs *Observer) Join(ctx context.Context, partial rangedloop.Partial) error
 }
 
 // Finish builds and dedups an audit queue from the merged per-node reservoirs.
-func (obs *Observer) Finish(ctx context.Context) error {
-	type SegmentKey struct {
-		StreamID uuid.UUID
-		Position uint64
+func (obs *Observer) Finish(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	coverage, err := loadCoverage(ctx, obs.coverage, obs.config.MaxAuditInterval)
+	if err != nil {
+		return err
 	}
 
-	var newQueue []Segment
-	queueSegments := make(map[SegmentKey]struct{})
-
-	// Add reservoir segments to queue in pseudorandom order.
-	for i := 0; i < obs.config.Slots; i++ {
-		for _, res := range obs.reservoirs {
-			segments := res.Segments()
-			// Skip reservoir if no segment at this index.
-			if len(segments) <= i {
-				continue
-			}
-			segment := segments[i]
-			segmentKey := SegmentKey{
-				StreamID: segment.StreamID,
-				Position: segment.Position.Encode(),
-			}
-			if _, ok := queueSegments[segmentKey]; !ok {
-				newQueue = append(newQueue, NewSegment(segment))
-				queueSegments[segmentKey] = struct{}{}
-			}
-		}
+	newQueue, overdue := buildQueue(obs.reservoirs, obs.config.Slots, coverage, time.Now())
+
+	// Push the segments of the nodes whose audit is overdue ahead of the
+	// others. They are sampled again on the next run when this fails, so the
+	// regular queue is pushed anyway.
+	if err := obs.queue.PushOverdue(ctx, overdue, obs.config.VerificationPushBatchSize); err != nil {
+		obs.log.Error("error pushing overdue segments", zap.Error(err))
 	}
 
 	// Push new queue to queues struct so it can be fetched by worker.
@@ -22,6 +22,9 @@ var ErrEmptyQueue = errs.Class("empty audit queue")
 // conform to the same polynomial.
 type VerifyQueue interface {
 	Push(ctx context.Context, segments []Segment, maxBatchSize int) (err error)
+	// PushOverdue pushes segments which must be verified ahead of the ones
+	// pushed with Push, the earliest due first.
+	PushOverdue(ctx context.Context, segments []OverdueSegment, maxBatchSize int) (err error)
 	Next(ctx context.Context) (Segment, error)
 }
 
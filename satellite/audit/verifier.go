@@ -308,6 +308,8 @@ func (verifier *Verifier) DownloadShares(ctx context.Context, limits []*pb.Addre
 
 	shares = make(map[int]Share, len(limits))
 	ch := make(chan *Share, len(limits))
+	// each download only writes its own observation.
+	observations := make([]overlay.TransferObservation, len(limits))
 
 	for i, limit := range limits {
 		if limit == nil {
@@ -322,7 +324,13 @@ func (verifier *Verifier) DownloadShares(ctx context.Context, limits []*pb.Addre
 		}
 
 		go func(i int, limit *pb.AddressedOrderLimit) {
+			start := time.Now()
 			share, err := verifier.GetShare(ctx, limit, piecePrivateKey, ipPort, stripeIndex, shareSize, i)
+			observations[i] = overlay.TransferObservation{
+				NodeID:  limit.GetLimit().StorageNodeId,
+				Success: err == nil,
+				Latency: time.Since(start),
+			}
 			if err != nil {
 				share = Share{
 					Error:    err,
@@ -342,6 +350,14 @@ func (verifier *Verifier) DownloadShares(ctx context.Context, limits []*pb.Addre
 		}
 	}
 
+	observed := observations[:0]
+	for _, observation := range observations {
+		if !observation.NodeID.IsZero() {
+			observed = append(observed, observation)
+		}
+	}
+	verifier.overlay.ObserveTransfers(observed)
+
 	return shares, nil
 }
 
@@ -11,6 +11,7 @@ import (
 	"go.uber.org/zap"
 
 	"storj.io/common/memory"
+	"storj.io/common/storj"
 	"storj.io/common/sync2"
 	"storj.io/storj/satellite/metabase"
 )
@@ -31,11 +32,16 @@ type Config struct {
 	VerificationPushBatchSize int           `help:"number of audit jobs to push at once to the verification queue" devDefault:"10" releaseDefault:"4096"`
 	WorkerConcurrency         int           `help:"number of workers to run audits on segments" default:"2"`
 	UseRangedLoop             bool          `help:"whether or not to use the ranged loop observer instead of the chore." default:"false" testDefault:"false"`
+	MaxAuditInterval          time.Duration `help:"maximum time between audits of a node; segments of nodes not audited for longer are queued ahead of the others (0 disables it)" releaseDefault:"72h" devDefault:"1h" testDefault:"0"`
 
 	ReverifyWorkerConcurrency   int           `help:"number of workers to run reverify audits on pieces" default:"2"`
 	ReverificationRetryInterval time.Duration `help:"how long a single reverification job can take before it may be taken over by another worker" releaseDefault:"6h" devDefault:"10m"`
 
 	ContainmentSyncChoreInterval time.Duration `help:"how often to run the containment-sync chore" releaseDefault:"2h" devDefault:"2m" testDefault:"$TESTINTERVAL"`
+
+	UseBatchAudits   bool `help:"whether or not to audit segments in batches, challenging each node once per batch" default:"false"`
+	BatchSize        int  `help:"number of segments audited in one batch" default:"64"`
+	BatchExtraShares int  `help:"number of shares downloaded in addition to the required ones to check the stripe of a batched segment" default:"4"`
 }
 
 // Worker contains information for populating audit queue and processing audits.
@@ -45,12 +51,20 @@ type Worker struct {
 	verifier      *Verifier
 	reverifyQueue ReverifyQueue
 	reporter      Reporter
+	coverage      CoverageDB
+	batch         *BatchVerifier
+	batchSize     int
 	Loop          *sync2.Cycle
 	concurrency   int
 }
 
 // NewWorker instantiates Worker.
-func NewWorker(log *zap.Logger, queue VerifyQueue, verifier *Verifier, reverifyQueue ReverifyQueue, reporter Reporter, config Config) *Worker {
+func NewWorker(log *zap.Logger, queue VerifyQueue, verifier *Verifier, reverifyQueue ReverifyQueue, reporter Reporter, coverage CoverageDB, config Config) *Worker {
+	var batch *BatchVerifier
+	if config.UseBatchAudits {
+		batch = NewBatchVerifier(verifier, config.BatchExtraShares)
+	}
+
 	return &Worker{
 		log: log,
 
@@ -58,6 +72,9 @@ func NewWorker(log *zap.Logger, queue VerifyQueue, verifier *Verifier, reverifyQ
 		verifier:      verifier,
 		reverifyQueue: reverifyQueue,
 		reporter:      reporter,
+		coverage:      coverage,
+		batch:         batch,
+		batchSize:     config.BatchSize,
 		Loop:          sync2.NewCycle(config.QueueInterval),
 		concurrency:   config.WorkerConcurrency,
 	}
@@ -87,6 +104,10 @@ func (worker *Worker) Close() error {
 func (worker *Worker) process(ctx context.Context) (err error) {
 	defer mon.Task()(&ctx)(&err)
 
+	if worker.batch != nil {
+		return worker.processBatches(ctx)
+	}
+
 	limiter := sync2.NewLimiter(worker.concurrency)
 	defer limiter.Wait()
 
@@ -114,6 +135,91 @@ func (worker *Worker) process(ctx context.Context) (err error) {
 	}
 }
 
+// processBatches repeatedly removes batches of items from the queue and
+// audits them together.
+func (worker *Worker) processBatches(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	limiter := sync2.NewLimiter(worker.concurrency)
+	defer limiter.Wait()
+
+	batchSize := worker.batchSize
+	if batchSize <= 0 {
+		batchSize = 1
+	}
+
+	for {
+		var segments []Segment
+		var queueErr error
+		for len(segments) < batchSize {
+			segment, err := worker.queue.Next(ctx)
+			if err != nil {
+				queueErr = err
+				break
+			}
+			segments = append(segments, segment)
+		}
+
+		if len(segments) > 0 {
+			started := limiter.Go(ctx, func() {
+				err := worker.workBatch(ctx, segments)
+				if err != nil {
+					worker.log.Error("error(s) during batch audit",
+						zap.Int("Segments", len(segments)),
+						zap.Error(err))
+				}
+			})
+			if !started {
+				return ctx.Err()
+			}
+		}
+
+		if queueErr != nil {
+			if ErrEmptyQueue.Has(queueErr) {
+				return nil
+			}
+			return queueErr
+		}
+	}
+}
+
+func (worker *Worker) workBatch(ctx context.Context, segments []Segment) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var errlist errs.Group
+
+	// contained nodes are skipped, the same way as for single audits.
+	skip := make(map[storj.NodeID]bool)
+	for _, segment := range segments {
+		contained, err := worker.verifier.IdentifyContainedNodes(ctx, segment)
+		if err != nil {
+			if !metabase.ErrSegmentNotFound.Has(err) {
+				errlist.Add(err)
+			}
+			continue
+		}
+		for nodeID := range contained {
+			skip[nodeID] = true
+		}
+	}
+
+	report, err := worker.batch.VerifyBatch(ctx, segments, skip)
+	if err != nil {
+		errlist.Add(err)
+	}
+
+	worker.reporter.RecordAudits(ctx, report)
+
+	audited := append(append(storj.NodeIDList{}, report.Successes...), report.Fails...)
+	if worker.coverage != nil && len(audited) > 0 {
+		if err := worker.coverage.RecordAudited(ctx, audited, time.Now()); err != nil {
+			errlist.Add(err)
+		}
+	}
+
+	return errlist.Err()
+}
+
 func (worker *Worker) work(ctx context.Context, segment Segment) (err error) {
 	defer mon.Task()(&ctx)(&err)
 
@@ -149,5 +255,14 @@ func (worker *Worker) work(ctx context.Context, segment Segment) (err error) {
 
 	worker.reporter.RecordAudits(ctx, report)
 
+	// Nodes which failed the audit were audited as well, only the nodes which
+	// didn't answer still need to be audited.
+	audited := append(append(storj.NodeIDList{}, report.Successes...), report.Fails...)
+	if worker.coverage != nil && len(audited) > 0 {
+		if err := worker.coverage.RecordAudited(ctx, audited, time.Now()); err != nil {
+			errlist.Add(err)
+		}
+	}
+
 	return errlist.Err()
 }
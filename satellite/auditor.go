@@ -85,6 +85,7 @@ func NewAuditor(log *zap.Logger, full *identity.FullIdentity,
 	nodeEvents nodeevents.DB,
 	reputationdb reputation.DB,
 	containmentDB audit.Containment,
+	coverageDB audit.CoverageDB,
 	rollupsWriteCache *orders.RollupsWriteCache,
 	versionInfo version.Info, config *Config, atomicLogLevel *zap.AtomicLevel,
 ) (*Auditor, error) {
@@ -251,6 +252,7 @@ func NewAuditor(log *zap.Logger, full *identity.FullIdentity,
 			peer.Audit.Verifier,
 			reverifyQueue,
 			peer.Audit.Reporter,
+			coverageDB,
 			config.Audit)
 		peer.Services.Add(lifecycle.Item{
 			Name:  "audit:verify-worker",
@@ -8,14 +8,19 @@ import (
 	"crypto/x509"
 	"net"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
+	"storj.io/common/identity/testidentity"
 	"storj.io/common/pb"
 	"storj.io/common/rpc/rpcpeer"
 	"storj.io/common/rpc/rpcstatus"
 	"storj.io/common/storj"
 	"storj.io/common/testcontext"
+	"storj.io/common/testrand"
+	"storj.io/storj/private/nodetag"
+	"storj.io/storj/private/nodetagpb"
 	"storj.io/storj/private/testplanet"
 	"storj.io/storj/storagenode"
 )
@@ -54,6 +59,65 @@ func TestSatelliteContactEndpoint(t *testing.T) {
 	})
 }
 
+func TestSatelliteContactEndpoint_NodeTags(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 1, UplinkCount: 0,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		satellite := planet.Satellites[0]
+		node := planet.StorageNodes[0]
+		nodeInfo := node.Contact.Service.Local()
+		operator := testidentity.MustPregeneratedSignedIdentity(1, storj.LatestIDVersion())
+
+		signed, err := nodetag.Sign(ctx, nodetag.TagSet{
+			NodeID:   node.ID(),
+			SignedAt: time.Now().UTC().Truncate(time.Second),
+			Tags: []nodetag.Tag{
+				{Name: "datacenter"},
+				{Name: "region", Value: "us-east"},
+			},
+		}, operator)
+		require.NoError(t, err)
+
+		// tags declared for another node are ignored
+		other, err := nodetag.Sign(ctx, nodetag.TagSet{
+			NodeID: testrand.NodeID(),
+			Tags:   []nodetag.Tag{{Name: "ssd"}},
+		}, operator)
+		require.NoError(t, err)
+
+		peer := rpcpeer.Peer{
+			Addr: &net.TCPAddr{
+				IP:   net.ParseIP(nodeInfo.Address),
+				Port: 5,
+			},
+			State: tls.ConnectionState{
+				PeerCertificates: []*x509.Certificate{node.Identity.Leaf, node.Identity.CA},
+			},
+		}
+		peerCtx := rpcpeer.NewContext(ctx, &peer)
+
+		_, err = satellite.Contact.Endpoint.UpdateTags(peerCtx, &nodetagpb.UpdateTagsRequest{
+			TagSets: nodetag.ToProto([]*nodetag.SignedTagSet{signed, other}),
+		})
+		require.NoError(t, err)
+
+		tags, err := satellite.Overlay.Service.GetNodeTags(ctx, node.ID())
+		require.NoError(t, err)
+		require.Len(t, tags, 2)
+		for _, tag := range tags {
+			require.Equal(t, operator.ID, tag.Signer)
+		}
+
+		// nodes without tags remove their previous tags
+		_, err = satellite.Contact.Endpoint.UpdateTags(peerCtx, &nodetagpb.UpdateTagsRequest{})
+		require.NoError(t, err)
+
+		tags, err = satellite.Overlay.Service.GetNodeTags(ctx, node.ID())
+		require.NoError(t, err)
+		require.Empty(t, tags)
+	})
+}
+
 func TestSatelliteContactEndpoint_QUIC_Unreachable(t *testing.T) {
 	testplanet.Run(t, testplanet.Config{
 		SatelliteCount: 1, StorageNodeCount: 1, UplinkCount: 0,
@@ -19,6 +19,9 @@ import (
 	"storj.io/common/storj"
 	"storj.io/drpc/drpcctx"
 	"storj.io/storj/private/nodeoperator"
+	"storj.io/storj/private/nodetag"
+	"storj.io/storj/private/nodetagpb"
+	"storj.io/storj/satellite/nodeselection/uploadselection"
 	"storj.io/storj/satellite/overlay"
 )
 
@@ -145,6 +148,73 @@ func (endpoint *Endpoint) CheckIn(ctx context.Context, req *pb.CheckInRequest) (
 	}, nil
 }
 
+// UpdateTags is called by storage nodes after checking in, to replace their
+// tags with the tags of the request. Tag sets with invalid signatures, or
+// declared for other nodes, are ignored.
+func (endpoint *Endpoint) UpdateTags(ctx context.Context, req *nodetagpb.UpdateTagsRequest) (_ *nodetagpb.UpdateTagsResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	peerID, err := identity.PeerIdentityFromContext(ctx)
+	if err != nil {
+		return nil, rpcstatus.Error(rpcstatus.Unauthenticated, errCheckInIdentity.New("failed to get ID from context: %v", err).Error())
+	}
+	nodeID := peerID.ID
+
+	tags := endpoint.verifyNodeTags(ctx, nodeID, nodetag.FromProto(req.TagSets))
+
+	err = endpoint.service.overlay.UpdateNodeTags(ctx, nodeID, tags)
+	if err != nil {
+		endpoint.log.Info("failed to update node tags", zap.Stringer("Node ID", nodeID), zap.Error(err))
+		return nil, rpcstatus.Error(rpcstatus.Internal, Error.Wrap(err).Error())
+	}
+	return &nodetagpb.UpdateTagsResponse{}, nil
+}
+
+// verifyNodeTags returns the tags of the valid tag sets declared for the node.
+func (endpoint *Endpoint) verifyNodeTags(ctx context.Context, nodeID storj.NodeID, signedSets []*nodetag.SignedTagSet) uploadselection.NodeTags {
+	var tags uploadselection.NodeTags
+	for _, signed := range signedSets {
+		set, signer, err := nodetag.Verify(ctx, signed, nodeID)
+		if err != nil {
+			endpoint.log.Debug("ignoring invalid node tags", zap.Stringer("Node ID", nodeID), zap.Error(err))
+			continue
+		}
+		for _, tag := range set.Tags {
+			tags = append(tags, uploadselection.NodeTag{
+				NodeID:   nodeID,
+				Signer:   signer,
+				SignedAt: set.SignedAt,
+				Name:     tag.Name,
+				Value:    tag.Value,
+			})
+		}
+	}
+	return latestNodeTags(tags)
+}
+
+// latestNodeTags keeps the most recently signed tag, when a signer declared
+// the same tag in multiple tag sets.
+func latestNodeTags(tags uploadselection.NodeTags) uploadselection.NodeTags {
+	type key struct {
+		signer storj.NodeID
+		name   string
+	}
+	latest := make(map[key]int, len(tags))
+	var result uploadselection.NodeTags
+	for _, tag := range tags {
+		k := key{tag.Signer, tag.Name}
+		if i, ok := latest[k]; ok {
+			if tag.SignedAt.After(result[i].SignedAt) {
+				result[i] = tag
+			}
+			continue
+		}
+		latest[k] = len(result)
+		result = append(result, tag)
+	}
+	return result
+}
+
 func (endpoint *Endpoint) emitEvenkitEvent(ctx context.Context, req *pb.CheckInRequest, pingNodeTCPSuccess bool, pingNodeQUICSuccess bool, nodeInfo overlay.NodeCheckInInfo) {
 	var sourceAddr string
 	transport, found := drpcctx.Transport(ctx)
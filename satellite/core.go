@@ -155,7 +155,8 @@ type Core struct {
 	}
 
 	Metrics struct {
-		Chore *metrics.Chore
+		Chore  *metrics.Chore
+		Gauges *metrics.Gauges
 	}
 }
 
@@ -410,6 +411,7 @@ func New(log *zap.Logger, full *identity.FullIdentity, db DB,
 		} else {
 			peer.Audit.Chore = audit.NewChore(peer.Log.Named("audit:chore"),
 				peer.Audit.VerifyQueue,
+				db.AuditCoverage(),
 				peer.Metainfo.SegmentLoop,
 				config,
 			)
@@ -649,6 +651,21 @@ func New(log *zap.Logger, full *identity.FullIdentity, db DB,
 			peer.Debug.Server.Panel.Add(
 				debug.Cycle("Metrics", peer.Metrics.Chore.Loop))
 		}
+
+		peer.Metrics.Gauges = metrics.NewGauges(
+			log.Named("gauges"),
+			config.Metrics.GaugesInterval,
+			peer.DB.RepairQueue(),
+			peer.Overlay.Service,
+			peer.Overlay.DB,
+		)
+		peer.Services.Add(lifecycle.Item{
+			Name:  "metrics:gauges",
+			Run:   peer.Metrics.Gauges.Run,
+			Close: peer.Metrics.Gauges.Close,
+		})
+		peer.Debug.Server.Panel.Add(
+			debug.Cycle("Metrics Gauges", peer.Metrics.Gauges.Loop))
 	}
 
 	return peer, nil
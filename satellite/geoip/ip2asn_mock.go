@@ -0,0 +1,30 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information
+
+package geoip
+
+// MockIPToASN provides a mock solution for looking up autonomous system numbers in testplanet tests. This is done
+// using the last byte of the ip address and mod'ing it into an autonomous system number.
+type MockIPToASN []uint32
+
+// Close does nothing for the MockIPToASN.
+func (m MockIPToASN) Close() error {
+	return nil
+}
+
+// LookupASN accepts an IP address.
+func (m MockIPToASN) LookupASN(address string) (uint32, error) {
+	if len(m) == 0 {
+		return 0, nil
+	}
+
+	ip, err := addressToIP(address)
+	if err != nil || ip == nil {
+		return 0, err
+	}
+
+	lastBlock := int(ip[len(ip)-1])
+	return m[lastBlock%len(m)], nil
+}
+
+var _ IPToASN = MockIPToASN{}
@@ -0,0 +1,31 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information
+
+package geoip_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"storj.io/storj/satellite/geoip"
+)
+
+func TestIP2ASNMock(t *testing.T) {
+	asn, err := geoip.MockIPToASN{}.LookupASN("127.0.0.1:1234")
+	require.NoError(t, err)
+	require.Zero(t, asn)
+
+	ipLookup := geoip.MockIPToASN{64500, 64501}
+
+	asn, err = ipLookup.LookupASN("127.0.0.1:1234")
+	require.NoError(t, err)
+	require.Equal(t, uint32(64501), asn)
+
+	asn, err = ipLookup.LookupASN("127.0.0.2:1234")
+	require.NoError(t, err)
+	require.Equal(t, uint32(64500), asn)
+
+	_, err = ipLookup.LookupASN("not at all")
+	require.Error(t, err)
+}
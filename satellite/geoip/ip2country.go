@@ -17,6 +17,12 @@ type IPToCountry interface {
 	LookupISOCountryCode(address string) (location.CountryCode, error)
 }
 
+// IPToASN defines an abstraction for resolving the autonomous system number given the string representation of an IP address.
+type IPToASN interface {
+	Close() error
+	LookupASN(address string) (uint32, error)
+}
+
 func addressToIP(address string) (net.IP, error) {
 	host, _, err := net.SplitHostPort(address)
 	if err != nil {
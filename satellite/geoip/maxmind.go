@@ -25,6 +25,7 @@ type ipInfo struct {
 	Country struct {
 		IsoCode string `maxminddb:"iso_code"`
 	} `maxminddb:"country"`
+	AutonomousSystemNumber uint32 `maxminddb:"autonomous_system_number"`
 }
 
 // MaxmindDB provides access to GeoIP data via the maxmind geoip databases.
@@ -33,6 +34,7 @@ type MaxmindDB struct {
 }
 
 var _ IPToCountry = &MaxmindDB{}
+var _ IPToASN = &MaxmindDB{}
 
 // Close will disconnect the underlying connection to the database.
 func (m *MaxmindDB) Close() error {
@@ -54,3 +56,20 @@ func (m *MaxmindDB) LookupISOCountryCode(address string) (location.CountryCode,
 
 	return location.ToCountryCode(info.Country.IsoCode), nil
 }
+
+// LookupASN accepts an IP address and returns its autonomous system number,
+// the database must be an ASN database.
+func (m *MaxmindDB) LookupASN(address string) (uint32, error) {
+	ip, err := addressToIP(address)
+	if err != nil || ip == nil {
+		return 0, err
+	}
+
+	info := &ipInfo{}
+	err = m.db.Lookup(ip, info)
+	if err != nil {
+		return 0, err
+	}
+
+	return info.AutonomousSystemNumber, nil
+}
@@ -53,11 +53,17 @@ func (chore *Chore) Run(ctx context.Context) (err error) {
 			return nil
 		}
 
+		shrinks, err := chore.db.GetUnselectedShrinks(ctx)
+		if err != nil {
+			chore.log.Error("error retrieving shrinking nodes", zap.Error(err))
+			return nil
+		}
+
 		nodeCount := len(exitingNodes)
-		if nodeCount == 0 {
+		if nodeCount == 0 && len(shrinks) == 0 {
 			return nil
 		}
-		chore.log.Debug("found exiting nodes", zap.Int("exitingNodes", nodeCount))
+		chore.log.Debug("found exiting nodes", zap.Int("exitingNodes", nodeCount), zap.Int("shrinkingNodes", len(shrinks)))
 
 		exitingNodesLoopIncomplete := make(storj.NodeIDList, 0, nodeCount)
 		for _, node := range exitingNodes {
@@ -100,7 +106,10 @@ func (chore *Chore) Run(ctx context.Context) (err error) {
 		}
 
 		// Populate transfer queue for nodes that have not completed the exit loop yet
+		// and for nodes that are shrinking
+		shrinkSelection := NewShrinkSelection(shrinks)
 		pathCollector := NewPathCollector(chore.log, chore.db, exitingNodesLoopIncomplete, chore.config.ChoreBatchSize)
+		pathCollector.SetShrinks(shrinkSelection)
 		err = chore.segmentLoop.Join(ctx, pathCollector)
 		if err != nil {
 			chore.log.Error("error joining segment loop.", zap.Error(err))
@@ -127,6 +136,8 @@ func (chore *Chore) Run(ctx context.Context) (err error) {
 			bytesToTransfer := pathCollector.nodeIDStorage[nodeID]
 			mon.IntVal("graceful_exit_init_bytes_stored").Observe(bytesToTransfer)
 		}
+
+		markShrinksSelected(ctx, chore.log, chore.db, shrinkSelection)
 		return nil
 	})
 }
@@ -38,6 +38,21 @@ type TransferQueueItem struct {
 	OrderLimitSendCount int
 }
 
+// Shrink represents the persisted request of a node to hand back part of its
+// data without exiting, i.e. a partial graceful exit.
+type Shrink struct {
+	NodeID         storj.NodeID
+	RequestedBytes int64
+	// SelectedBytes is the size of the pieces added to the transfer queue.
+	SelectedBytes     int64
+	BytesTransferred  int64
+	PiecesTransferred int64
+	PiecesFailed      int64
+	CreatedAt         time.Time
+	SelectedAt        *time.Time
+	FinishedAt        *time.Time
+}
+
 // DB implements CRUD operations for graceful exit service.
 //
 // architecture: Database
@@ -80,4 +95,18 @@ type DB interface {
 	// finished the exit before the indicated time but there are at least one item
 	// left in the transfer queue.
 	CountFinishedTransferQueueItemsByNode(ctx context.Context, before time.Time, asOfSystemTimeInterval time.Duration) (map[storj.NodeID]int64, error)
+
+	// CreateShrink creates a shrink for the node, unless the node has an
+	// unfinished shrink already. It returns the current shrink of the node.
+	CreateShrink(ctx context.Context, nodeID storj.NodeID, requestedBytes int64, createdAt time.Time) (*Shrink, error)
+	// GetShrink gets the last shrink of the node.
+	GetShrink(ctx context.Context, nodeID storj.NodeID) (*Shrink, error)
+	// GetUnselectedShrinks gets the unfinished shrinks whose pieces have not been added to the transfer queue yet.
+	GetUnselectedShrinks(ctx context.Context) ([]*Shrink, error)
+	// MarkShrinkSelected records that the pieces of the shrink have been added to the transfer queue.
+	MarkShrinkSelected(ctx context.Context, nodeID storj.NodeID, selectedBytes int64, selectedAt time.Time) error
+	// IncrementShrinkProgress increments transfer stats of the unfinished shrink of a node.
+	IncrementShrinkProgress(ctx context.Context, nodeID storj.NodeID, bytes int64, successfulTransfers int64, failedTransfers int64) error
+	// FinishShrink marks the unfinished shrink of a node as finished.
+	FinishShrink(ctx context.Context, nodeID storj.NodeID, finishedAt time.Time) error
 }
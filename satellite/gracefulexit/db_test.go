@@ -55,6 +55,63 @@ func TestProgress(t *testing.T) {
 	})
 }
 
+func TestShrink(t *testing.T) {
+	// test basic shrink crud
+	satellitedbtest.Run(t, func(ctx *testcontext.Context, t *testing.T, db satellite.DB) {
+		geDB := db.GracefulExit()
+		nodeID := testrand.NodeID()
+		now := time.Now().UTC()
+
+		_, err := geDB.GetShrink(ctx, nodeID)
+		require.True(t, gracefulexit.ErrNodeNotFound.Has(err))
+
+		shrink, err := geDB.CreateShrink(ctx, nodeID, 100, now)
+		require.NoError(t, err)
+		require.Equal(t, nodeID, shrink.NodeID)
+		require.EqualValues(t, 100, shrink.RequestedBytes)
+		require.Nil(t, shrink.SelectedAt)
+		require.Nil(t, shrink.FinishedAt)
+
+		unselected, err := geDB.GetUnselectedShrinks(ctx)
+		require.NoError(t, err)
+		require.Len(t, unselected, 1)
+		require.Equal(t, nodeID, unselected[0].NodeID)
+
+		require.NoError(t, geDB.MarkShrinkSelected(ctx, nodeID, 120, now))
+		unselected, err = geDB.GetUnselectedShrinks(ctx)
+		require.NoError(t, err)
+		require.Len(t, unselected, 0)
+
+		require.NoError(t, geDB.IncrementShrinkProgress(ctx, nodeID, 60, 2, 1))
+		require.NoError(t, geDB.IncrementShrinkProgress(ctx, nodeID, 40, 1, 0))
+
+		shrink, err = geDB.GetShrink(ctx, nodeID)
+		require.NoError(t, err)
+		require.EqualValues(t, 120, shrink.SelectedBytes)
+		require.EqualValues(t, 100, shrink.BytesTransferred)
+		require.EqualValues(t, 3, shrink.PiecesTransferred)
+		require.EqualValues(t, 1, shrink.PiecesFailed)
+		require.NotNil(t, shrink.SelectedAt)
+		require.Nil(t, shrink.FinishedAt)
+
+		// an unfinished shrink is not replaced
+		shrink, err = geDB.CreateShrink(ctx, nodeID, 200, now)
+		require.NoError(t, err)
+		require.EqualValues(t, 100, shrink.RequestedBytes)
+
+		require.NoError(t, geDB.FinishShrink(ctx, nodeID, now))
+
+		// a finished shrink is replaced by a new one
+		shrink, err = geDB.CreateShrink(ctx, nodeID, 200, now)
+		require.NoError(t, err)
+		require.EqualValues(t, 200, shrink.RequestedBytes)
+		require.Zero(t, shrink.SelectedBytes)
+		require.Zero(t, shrink.BytesTransferred)
+		require.Nil(t, shrink.SelectedAt)
+		require.Nil(t, shrink.FinishedAt)
+	})
+}
+
 func TestSegmentTransferQueueItem(t *testing.T) {
 	// test basic graceful exit transfer queue crud
 	satellitedbtest.Run(t, func(ctx *testcontext.Context, t *testing.T, db satellite.DB) {
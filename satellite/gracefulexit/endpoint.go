@@ -159,6 +159,71 @@ func (endpoint *Endpoint) Process(stream pb.DRPCSatelliteGracefulExit_ProcessStr
 		return nil
 	}
 
+	return endpoint.processTransfers(ctx, stream, nodeID, transferProcess{
+		incrementProgress: endpoint.db.IncrementProgress,
+		finish: func(ctx context.Context) error {
+			isDisqualified, err := endpoint.handleDisqualifiedNode(ctx, nodeID)
+			if err != nil {
+				return rpcstatus.Error(rpcstatus.Internal, err.Error())
+			}
+			if isDisqualified {
+				return rpcstatus.Error(rpcstatus.FailedPrecondition, "Disqualified nodes cannot graceful exit")
+			}
+
+			// update exit status
+			exitStatusRequest, exitFailedReason, err := endpoint.generateExitStatusRequest(ctx, nodeID)
+			if err != nil {
+				return rpcstatus.Error(rpcstatus.Internal, err.Error())
+			}
+
+			err = endpoint.handleFinished(ctx, stream, exitStatusRequest, exitFailedReason)
+			if err != nil {
+				return rpcstatus.Error(rpcstatus.Internal, err.Error())
+			}
+			return nil
+		},
+		failValidation: func(ctx context.Context) error {
+			// immediately fail and complete graceful exit for nodes that fail satellite validation
+			err := endpoint.db.IncrementProgress(ctx, nodeID, 0, 0, 1)
+			if err != nil {
+				return rpcstatus.Error(rpcstatus.Internal, err.Error())
+			}
+
+			mon.Meter("graceful_exit_fail_validation").Mark(1) //mon:locked
+
+			exitStatusRequest := &overlay.ExitStatusRequest{
+				NodeID:         nodeID,
+				ExitFinishedAt: time.Now().UTC(),
+				ExitSuccess:    false,
+			}
+
+			err = endpoint.handleFinished(ctx, stream, exitStatusRequest, pb.ExitFailed_VERIFICATION_FAILED)
+			if err != nil {
+				return rpcstatus.Error(rpcstatus.Internal, err.Error())
+			}
+			return nil
+		},
+	})
+}
+
+// transferProcess contains what differs between processing the transfers of
+// a graceful exit and of a shrink.
+type transferProcess struct {
+	// incrementProgress increments the transfer stats of the node.
+	incrementProgress func(ctx context.Context, nodeID storj.NodeID, bytes int64, successfulTransfers int64, failedTransfers int64) error
+	// finish is called once there are no more pieces to transfer.
+	finish func(ctx context.Context) error
+	// failValidation is called when a piece transfer of the node fails
+	// satellite validation.
+	failValidation func(ctx context.Context) error
+}
+
+// processTransfers sends the pieces of the node to transfer to new nodes,
+// and handles the results of the transfers, until there are no more pieces to
+// transfer.
+func (endpoint *Endpoint) processTransfers(ctx context.Context, stream pb.DRPCSatelliteGracefulExit_ProcessStream, nodeID storj.NodeID, process transferProcess) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
 	// maps pieceIDs to pendingTransfers to keep track of ongoing piece transfer requests
 	// and handles concurrency between sending logic and receiving logic
 	pending := NewPendingMap()
@@ -207,7 +272,7 @@ func (endpoint *Endpoint) Process(stream pb.DRPCSatelliteGracefulExit_ProcessStr
 				}
 
 				for _, inc := range incomplete {
-					err = endpoint.processIncomplete(ctx, stream, pending, inc)
+					err = endpoint.processIncomplete(ctx, stream, pending, inc, process)
 					if err != nil {
 						cancel()
 						return pending.DoneSending(err)
@@ -243,23 +308,8 @@ func (endpoint *Endpoint) Process(stream pb.DRPCSatelliteGracefulExit_ProcessStr
 			// ignore cancelled context which was triggered to finish loop but we still need to do some DB operations
 			ctx = context2.WithoutCancellation(ctx)
 
-			isDisqualified, err := endpoint.handleDisqualifiedNode(ctx, nodeID)
-			if err != nil {
-				return rpcstatus.Error(rpcstatus.Internal, err.Error())
-			}
-			if isDisqualified {
-				return rpcstatus.Error(rpcstatus.FailedPrecondition, "Disqualified nodes cannot graceful exit")
-			}
-
-			// update exit status
-			exitStatusRequest, exitFailedReason, err := endpoint.generateExitStatusRequest(ctx, nodeID)
-			if err != nil {
-				return rpcstatus.Error(rpcstatus.Internal, err.Error())
-			}
-
-			err = endpoint.handleFinished(ctx, stream, exitStatusRequest, exitFailedReason)
-			if err != nil {
-				return rpcstatus.Error(rpcstatus.Internal, err.Error())
+			if err := process.finish(ctx); err != nil {
+				return err
 			}
 			break
 		}
@@ -291,7 +341,7 @@ func (endpoint *Endpoint) Process(stream pb.DRPCSatelliteGracefulExit_ProcessStr
 
 		switch m := request.GetMessage().(type) {
 		case *pb.StorageNodeMessage_Succeeded:
-			err = endpoint.handleSucceeded(ctx, stream, pending, nodeID, m)
+			err = endpoint.handleSucceeded(ctx, stream, pending, nodeID, m, process)
 			if err != nil {
 				if metainfo.ErrNodeAlreadyExists.Has(err) {
 					// this will get retried
@@ -306,30 +356,15 @@ func (endpoint *Endpoint) Process(stream pb.DRPCSatelliteGracefulExit_ProcessStr
 					}
 					endpoint.log.Warn("storagenode failed validation for piece transfer", zap.Stringer("node ID", nodeID), zap.Binary("original message from storagenode", messageBytes), zap.Error(err))
 
-					// immediately fail and complete graceful exit for nodes that fail satellite validation
-					err = endpoint.db.IncrementProgress(ctx, nodeID, 0, 0, 1)
-					if err != nil {
-						return rpcstatus.Error(rpcstatus.Internal, err.Error())
-					}
-
-					mon.Meter("graceful_exit_fail_validation").Mark(1) //mon:locked
-
-					exitStatusRequest := &overlay.ExitStatusRequest{
-						NodeID:         nodeID,
-						ExitFinishedAt: time.Now().UTC(),
-						ExitSuccess:    false,
-					}
-
-					err := endpoint.handleFinished(ctx, stream, exitStatusRequest, pb.ExitFailed_VERIFICATION_FAILED)
-					if err != nil {
-						return rpcstatus.Error(rpcstatus.Internal, err.Error())
+					if err := process.failValidation(ctx); err != nil {
+						return err
 					}
 					break
 				}
 				return rpcstatus.Error(rpcstatus.Internal, err.Error())
 			}
 		case *pb.StorageNodeMessage_Failed:
-			err = endpoint.handleFailed(ctx, pending, nodeID, m)
+			err = endpoint.handleFailed(ctx, pending, nodeID, m, process)
 			if err != nil {
 				return rpcstatus.Error(rpcstatus.Internal, Error.Wrap(err).Error())
 			}
@@ -341,11 +376,11 @@ func (endpoint *Endpoint) Process(stream pb.DRPCSatelliteGracefulExit_ProcessStr
 	return nil
 }
 
-func (endpoint *Endpoint) processIncomplete(ctx context.Context, stream pb.DRPCSatelliteGracefulExit_ProcessStream, pending *PendingMap, incomplete *TransferQueueItem) error {
+func (endpoint *Endpoint) processIncomplete(ctx context.Context, stream pb.DRPCSatelliteGracefulExit_ProcessStream, pending *PendingMap, incomplete *TransferQueueItem, process transferProcess) error {
 	nodeID := incomplete.NodeID
 
 	if incomplete.OrderLimitSendCount >= endpoint.config.MaxOrderLimitSendCount {
-		err := endpoint.db.IncrementProgress(ctx, nodeID, 0, 0, 1)
+		err := process.incrementProgress(ctx, nodeID, 0, 0, 1)
 		if err != nil {
 			return Error.Wrap(err)
 		}
@@ -460,7 +495,7 @@ func (endpoint *Endpoint) processIncomplete(ctx context.Context, stream pb.DRPCS
 	return err
 }
 
-func (endpoint *Endpoint) handleSucceeded(ctx context.Context, stream pb.DRPCSatelliteGracefulExit_ProcessStream, pending *PendingMap, exitingNodeID storj.NodeID, message *pb.StorageNodeMessage_Succeeded) (err error) {
+func (endpoint *Endpoint) handleSucceeded(ctx context.Context, stream pb.DRPCSatelliteGracefulExit_ProcessStream, pending *PendingMap, exitingNodeID storj.NodeID, message *pb.StorageNodeMessage_Succeeded, process transferProcess) (err error) {
 	defer mon.Task()(&ctx)(&err)
 
 	originalPieceID := message.Succeeded.OriginalPieceId
@@ -505,7 +540,7 @@ func (endpoint *Endpoint) handleSucceeded(ctx context.Context, stream pb.DRPCSat
 		failed = -1
 	}
 
-	err = endpoint.db.IncrementProgress(ctx, exitingNodeID, transfer.PieceSize, 1, failed)
+	err = process.incrementProgress(ctx, exitingNodeID, transfer.PieceSize, 1, failed)
 	if err != nil {
 		return Error.Wrap(err)
 	}
@@ -537,7 +572,7 @@ func (endpoint *Endpoint) handleSucceeded(ctx context.Context, stream pb.DRPCSat
 	return nil
 }
 
-func (endpoint *Endpoint) handleFailed(ctx context.Context, pending *PendingMap, nodeID storj.NodeID, message *pb.StorageNodeMessage_Failed) (err error) {
+func (endpoint *Endpoint) handleFailed(ctx context.Context, pending *PendingMap, nodeID storj.NodeID, message *pb.StorageNodeMessage_Failed, process transferProcess) (err error) {
 	defer mon.Task()(&ctx)(&err)
 
 	endpoint.log.Warn("transfer failed",
@@ -601,7 +636,7 @@ func (endpoint *Endpoint) handleFailed(ctx context.Context, pending *PendingMap,
 			return Error.Wrap(err)
 		}
 
-		err = endpoint.db.IncrementProgress(ctx, nodeID, 0, 0, 1)
+		err = process.incrementProgress(ctx, nodeID, 0, 0, 1)
 		if err != nil {
 			return Error.Wrap(err)
 		}
@@ -623,7 +658,7 @@ func (endpoint *Endpoint) handleFailed(ctx context.Context, pending *PendingMap,
 
 	// only increment overall failed count if piece failures has reached the threshold
 	if failedCount == endpoint.config.MaxFailuresPerPiece {
-		err = endpoint.db.IncrementProgress(ctx, nodeID, 0, 0, 1)
+		err = process.incrementProgress(ctx, nodeID, 0, 0, 1)
 		if err != nil {
 			return Error.Wrap(err)
 		}
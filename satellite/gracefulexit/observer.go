@@ -16,9 +16,9 @@ import (
 	"storj.io/storj/satellite/overlay"
 )
 
-// Observer populates the transfer queue for exiting nodes. It also updates the
-// timed out status and removes transefer queue items for inactive exiting
-// nodes.
+// Observer populates the transfer queue for exiting and shrinking nodes. It
+// also updates the timed out status and removes transefer queue items for
+// inactive exiting nodes.
 type Observer struct {
 	log     *zap.Logger
 	db      DB
@@ -28,6 +28,7 @@ type Observer struct {
 	// The following variables are reset on each loop cycle
 	exitingNodes    storj.NodeIDList
 	bytesToTransfer map[storj.NodeID]int64
+	shrinks         *ShrinkSelection
 }
 
 var _ rangedloop.Observer = (*Observer)(nil)
@@ -55,17 +56,26 @@ func (obs *Observer) Start(ctx context.Context, startTime time.Time) (err error)
 		return err
 	}
 
+	// Determine which shrinking nodes have yet to have their pieces selected
+	// for transfer.
+	shrinks, err := obs.db.GetUnselectedShrinks(ctx)
+	if err != nil {
+		return err
+	}
+
+	obs.exitingNodes = nil
+	obs.bytesToTransfer = make(map[storj.NodeID]int64)
+	obs.shrinks = NewShrinkSelection(shrinks)
+
 	nodeCount := len(exitingNodes)
-	if nodeCount == 0 {
+	if nodeCount == 0 && len(shrinks) == 0 {
 		return nil
 	}
 
-	obs.log.Debug("found exiting nodes", zap.Int("exitingNodes", nodeCount))
+	obs.log.Debug("found exiting nodes", zap.Int("exitingNodes", nodeCount), zap.Int("shrinkingNodes", len(shrinks)))
 
 	obs.checkForInactiveNodes(ctx, exitingNodes)
 
-	obs.exitingNodes = nil
-	obs.bytesToTransfer = make(map[storj.NodeID]int64)
 	for _, node := range exitingNodes {
 		if node.ExitLoopCompletedAt == nil {
 			obs.exitingNodes = append(obs.exitingNodes, node.NodeID)
@@ -75,13 +85,15 @@ func (obs *Observer) Start(ctx context.Context, startTime time.Time) (err error)
 }
 
 // Fork returns path collector that will populate the transfer queue for
-// segments belonging to newly exiting nodes for its range.
+// segments belonging to newly exiting and shrinking nodes for its range.
 func (obs *Observer) Fork(ctx context.Context) (_ rangedloop.Partial, err error) {
 	defer mon.Task()(&ctx)(&err)
 
 	// TODO: trim out/refactor segmentloop.Observer bits from path collector
 	// once segmentloop.Observer is removed.
-	return NewPathCollector(obs.log, obs.db, obs.exitingNodes, obs.config.ChoreBatchSize), nil
+	pathCollector := NewPathCollector(obs.log, obs.db, obs.exitingNodes, obs.config.ChoreBatchSize)
+	pathCollector.SetShrinks(obs.shrinks)
+	return pathCollector, nil
 }
 
 // Join flushes the forked path collector and aggregates collected metrics.
@@ -120,6 +132,8 @@ func (obs *Observer) Finish(ctx context.Context) (err error) {
 		}
 		mon.IntVal("graceful_exit_init_bytes_stored").Observe(bytesToTransfer)
 	}
+
+	markShrinksSelected(ctx, obs.log, obs.db, obs.shrinks)
 	return nil
 }
 
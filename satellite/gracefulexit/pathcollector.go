@@ -29,6 +29,10 @@ type PathCollector struct {
 	buffer        []TransferQueueItem
 	batchSize     int
 	nodeIDStorage map[storj.NodeID]int64
+
+	// shrinks selects the pieces of shrinking nodes, it may be shared
+	// between collectors.
+	shrinks *ShrinkSelection
 }
 
 // NewPathCollector instantiates a path collector.
@@ -50,6 +54,12 @@ func NewPathCollector(log *zap.Logger, db DB, exitingNodes storj.NodeIDList, bat
 	return collector
 }
 
+// SetShrinks makes the collector also add the pieces of shrinking nodes to
+// the transfer queue, until the requested amount of data is selected.
+func (collector *PathCollector) SetShrinks(shrinks *ShrinkSelection) {
+	collector.shrinks = shrinks
+}
+
 // LoopStarted is called at each start of a loop.
 func (collector *PathCollector) LoopStarted(context.Context, segmentloop.LoopInfo) (err error) {
 	return nil
@@ -64,7 +74,7 @@ func (collector *PathCollector) Flush(ctx context.Context) (err error) {
 // RemoteSegment takes a remote segment found in metainfo and creates a graceful exit transfer queue item if it doesn't exist already.
 func (collector *PathCollector) RemoteSegment(ctx context.Context, segment *segmentloop.Segment) (err error) {
 	defer remoteSegmentFunc(&ctx)(&err)
-	if len(collector.nodeIDStorage) == 0 {
+	if len(collector.nodeIDStorage) == 0 && collector.shrinks.Empty() {
 		return nil
 	}
 	return collector.handleRemoteSegment(ctx, segment)
@@ -75,7 +85,8 @@ func (collector *PathCollector) handleRemoteSegment(ctx context.Context, segment
 
 	numPieces := len(segment.Pieces)
 	for _, piece := range segment.Pieces {
-		if _, ok := collector.nodeIDStorage[piece.StorageNode]; !ok {
+		_, exiting := collector.nodeIDStorage[piece.StorageNode]
+		if !exiting && !collector.shrinks.Contains(piece.StorageNode) {
 			continue
 		}
 
@@ -88,7 +99,11 @@ func (collector *PathCollector) handleRemoteSegment(ctx context.Context, segment
 			pieceSize = eestream.CalcPieceSize(int64(segment.EncryptedSize), redundancy)
 		}
 
-		collector.nodeIDStorage[piece.StorageNode] += pieceSize
+		if exiting {
+			collector.nodeIDStorage[piece.StorageNode] += pieceSize
+		} else if !collector.shrinks.Select(piece.StorageNode, pieceSize) {
+			continue
+		}
 
 		item := TransferQueueItem{
 			NodeID:          piece.StorageNode,
@@ -125,7 +140,7 @@ func (collector *PathCollector) Process(ctx context.Context, segments []segmentl
 	// Intentionally omitting mon.Task here. The duration for all process
 	// calls are aggregated and and emitted by the ranged loop service.
 
-	if len(collector.nodeIDStorage) == 0 {
+	if len(collector.nodeIDStorage) == 0 && collector.shrinks.Empty() {
 		return nil
 	}
 
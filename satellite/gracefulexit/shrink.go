@@ -0,0 +1,95 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package gracefulexit
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	"storj.io/common/storj"
+)
+
+// ShrinkSelection keeps track of the pieces selected for the transfer from
+// shrinking nodes during a single segment loop. It is safe for concurrent use,
+// so that it can be shared between the path collectors of a ranged loop.
+type ShrinkSelection struct {
+	mu        sync.Mutex
+	requested map[storj.NodeID]int64
+	selected  map[storj.NodeID]int64
+}
+
+// NewShrinkSelection returns a selection for the given unselected shrinks.
+func NewShrinkSelection(shrinks []*Shrink) *ShrinkSelection {
+	selection := &ShrinkSelection{
+		requested: make(map[storj.NodeID]int64, len(shrinks)),
+		selected:  make(map[storj.NodeID]int64, len(shrinks)),
+	}
+	for _, shrink := range shrinks {
+		selection.requested[shrink.NodeID] = shrink.RequestedBytes
+		selection.selected[shrink.NodeID] = 0
+	}
+	return selection
+}
+
+// Empty returns true when there are no shrinking nodes.
+func (selection *ShrinkSelection) Empty() bool {
+	return selection == nil || len(selection.requested) == 0
+}
+
+// Contains returns true when the node is shrinking.
+func (selection *ShrinkSelection) Contains(nodeID storj.NodeID) bool {
+	if selection == nil {
+		return false
+	}
+	_, ok := selection.requested[nodeID]
+	return ok
+}
+
+// Select selects a piece of the node for the transfer, unless the requested
+// amount of data has been selected already. The last selected piece may
+// exceed the requested amount, so that at least the requested amount is
+// transferred when all the transfers succeed.
+func (selection *ShrinkSelection) Select(nodeID storj.NodeID, pieceSize int64) bool {
+	if selection == nil {
+		return false
+	}
+
+	selection.mu.Lock()
+	defer selection.mu.Unlock()
+
+	requested, ok := selection.requested[nodeID]
+	if !ok || selection.selected[nodeID] >= requested {
+		return false
+	}
+	selection.selected[nodeID] += pieceSize
+	return true
+}
+
+// Selected returns the amount of data selected per shrinking node.
+func (selection *ShrinkSelection) Selected() map[storj.NodeID]int64 {
+	selection.mu.Lock()
+	defer selection.mu.Unlock()
+
+	selected := make(map[storj.NodeID]int64, len(selection.selected))
+	for nodeID, bytes := range selection.selected {
+		selected[nodeID] = bytes
+	}
+	return selected
+}
+
+// markShrinksSelected records the amount of data selected for each shrinking
+// node, which allows the nodes to start the transfer.
+func markShrinksSelected(ctx context.Context, log *zap.Logger, db DB, selection *ShrinkSelection) {
+	now := time.Now().UTC()
+	for nodeID, selectedBytes := range selection.Selected() {
+		if err := db.MarkShrinkSelected(ctx, nodeID, selectedBytes, now); err != nil {
+			log.Error("error marking shrink selected.", zap.Stringer("Node ID", nodeID), zap.Error(err))
+			continue
+		}
+		mon.IntVal("graceful_exit_shrink_bytes_selected").Observe(selectedBytes)
+	}
+}
@@ -0,0 +1,45 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package gracefulexit_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"storj.io/common/storj"
+	"storj.io/common/testrand"
+	"storj.io/storj/satellite/gracefulexit"
+)
+
+func TestShrinkSelection(t *testing.T) {
+	var empty *gracefulexit.ShrinkSelection
+	require.True(t, empty.Empty())
+	require.False(t, empty.Select(testrand.NodeID(), 10))
+
+	nodeA, nodeB, other := testrand.NodeID(), testrand.NodeID(), testrand.NodeID()
+	selection := gracefulexit.NewShrinkSelection([]*gracefulexit.Shrink{
+		{NodeID: nodeA, RequestedBytes: 25},
+		{NodeID: nodeB, RequestedBytes: 10},
+	})
+	require.False(t, selection.Empty())
+	require.True(t, selection.Contains(nodeA))
+	require.False(t, selection.Contains(other))
+
+	// pieces are selected until the requested amount is reached
+	require.True(t, selection.Select(nodeA, 10))
+	require.True(t, selection.Select(nodeA, 10))
+	require.True(t, selection.Select(nodeA, 10))
+	require.False(t, selection.Select(nodeA, 10))
+
+	require.True(t, selection.Select(nodeB, 10))
+	require.False(t, selection.Select(nodeB, 1))
+
+	require.False(t, selection.Select(other, 1))
+
+	require.Equal(t, map[storj.NodeID]int64{
+		nodeA: 30,
+		nodeB: 10,
+	}, selection.Selected())
+}
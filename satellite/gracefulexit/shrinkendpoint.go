@@ -0,0 +1,190 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package gracefulexit
+
+import (
+	"context"
+	"time"
+
+	"go.uber.org/zap"
+
+	"storj.io/common/identity"
+	"storj.io/common/pb"
+	"storj.io/common/rpc/rpcstatus"
+	"storj.io/common/storj"
+	"storj.io/storj/private/shrinkpb"
+)
+
+// InitiateShrink is called by storage nodes to request the transfer of the
+// given amount of their data to other nodes. A previous shrink of the node
+// has to be finished before a new one can be requested.
+func (endpoint *Endpoint) InitiateShrink(ctx context.Context, req *shrinkpb.InitiateShrinkRequest) (_ *shrinkpb.ShrinkStatus, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	peer, err := identity.PeerIdentityFromContext(ctx)
+	if err != nil {
+		return nil, rpcstatus.Error(rpcstatus.Unauthenticated, Error.Wrap(err).Error())
+	}
+	nodeID := peer.ID
+
+	if req.Bytes <= 0 {
+		return nil, rpcstatus.Error(rpcstatus.InvalidArgument, "the amount of data to transfer must be positive")
+	}
+
+	if err := endpoint.checkShrinkAllowed(ctx, nodeID); err != nil {
+		return nil, err
+	}
+
+	shrink, err := endpoint.db.GetShrink(ctx, nodeID)
+	switch {
+	case err == nil && shrink.FinishedAt == nil:
+		return nil, rpcstatus.Error(rpcstatus.FailedPrecondition, "a shrink is already in progress")
+	case err != nil && !ErrNodeNotFound.Has(err):
+		return nil, rpcstatus.Error(rpcstatus.Internal, err.Error())
+	}
+
+	shrink, err = endpoint.db.CreateShrink(ctx, nodeID, req.Bytes, time.Now().UTC())
+	if err != nil {
+		return nil, rpcstatus.Error(rpcstatus.Internal, err.Error())
+	}
+
+	endpoint.log.Info("shrink initiated", zap.Stringer("Node ID", nodeID), zap.Int64("bytes", req.Bytes))
+	mon.IntVal("graceful_exit_shrink_bytes_requested").Observe(req.Bytes)
+
+	return shrinkStatus(shrink), nil
+}
+
+// GetShrinkStatus returns the status of the latest shrink of the node.
+func (endpoint *Endpoint) GetShrinkStatus(ctx context.Context, req *shrinkpb.GetShrinkStatusRequest) (_ *shrinkpb.ShrinkStatus, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	peer, err := identity.PeerIdentityFromContext(ctx)
+	if err != nil {
+		return nil, rpcstatus.Error(rpcstatus.Unauthenticated, Error.Wrap(err).Error())
+	}
+
+	shrink, err := endpoint.db.GetShrink(ctx, peer.ID)
+	if err != nil {
+		if ErrNodeNotFound.Has(err) {
+			return nil, rpcstatus.Error(rpcstatus.NotFound, "no shrink was requested")
+		}
+		return nil, rpcstatus.Error(rpcstatus.Internal, err.Error())
+	}
+
+	return shrinkStatus(shrink), nil
+}
+
+// ProcessShrink is called by shrinking storage nodes to receive the pieces to
+// transfer to new nodes. The stream is closed once all the selected pieces
+// were processed.
+func (endpoint *Endpoint) ProcessShrink(stream shrinkpb.DRPCSatelliteShrink_ProcessShrinkStream) (err error) {
+	ctx := stream.Context()
+	defer mon.Task()(&ctx)(&err)
+
+	peer, err := identity.PeerIdentityFromContext(ctx)
+	if err != nil {
+		return rpcstatus.Error(rpcstatus.Unauthenticated, Error.Wrap(err).Error())
+	}
+
+	nodeID := peer.ID
+	endpoint.log.Debug("shrink process", zap.Stringer("Node ID", nodeID))
+
+	// shrinks and graceful exits share the transfer queue of the node, so
+	// they share the connection limit too.
+	if !endpoint.connections.tryAdd(nodeID) {
+		return rpcstatus.Error(rpcstatus.Aborted, "Only one concurrent connection allowed for graceful exit")
+	}
+	defer func() {
+		endpoint.connections.delete(nodeID)
+	}()
+
+	if err := endpoint.checkShrinkAllowed(ctx, nodeID); err != nil {
+		return err
+	}
+
+	shrink, err := endpoint.db.GetShrink(ctx, nodeID)
+	if err != nil {
+		if ErrNodeNotFound.Has(err) {
+			return rpcstatus.Error(rpcstatus.NotFound, "no shrink was requested")
+		}
+		return rpcstatus.Error(rpcstatus.Internal, err.Error())
+	}
+	if shrink.FinishedAt != nil {
+		return nil
+	}
+	if shrink.SelectedAt == nil {
+		err = stream.Send(&pb.SatelliteMessage{Message: &pb.SatelliteMessage_NotReady{NotReady: &pb.NotReady{}}})
+		if err != nil {
+			return rpcstatus.Error(rpcstatus.Internal, err.Error())
+		}
+		return nil
+	}
+
+	finishShrink := func(ctx context.Context) error {
+		if err := endpoint.db.FinishShrink(ctx, nodeID, time.Now().UTC()); err != nil {
+			return Error.Wrap(err)
+		}
+		// remove remaining items from the queue
+		return Error.Wrap(endpoint.db.DeleteTransferQueueItems(ctx, nodeID))
+	}
+
+	return endpoint.processTransfers(ctx, stream, nodeID, transferProcess{
+		incrementProgress: endpoint.db.IncrementShrinkProgress,
+		finish: func(ctx context.Context) error {
+			if err := finishShrink(ctx); err != nil {
+				return rpcstatus.Error(rpcstatus.Internal, err.Error())
+			}
+			mon.Meter("graceful_exit_shrink_finished").Mark(1)
+			return nil
+		},
+		failValidation: func(ctx context.Context) error {
+			// stop the shrink of nodes that fail satellite validation
+			err := endpoint.db.IncrementShrinkProgress(ctx, nodeID, 0, 0, 1)
+			if err != nil {
+				return rpcstatus.Error(rpcstatus.Internal, err.Error())
+			}
+			if err := finishShrink(ctx); err != nil {
+				return rpcstatus.Error(rpcstatus.Internal, err.Error())
+			}
+
+			mon.Meter("graceful_exit_shrink_fail_validation").Mark(1)
+
+			return rpcstatus.Error(rpcstatus.FailedPrecondition, "piece transfer failed validation")
+		},
+	})
+}
+
+// checkShrinkAllowed returns an rpc error when the node is not allowed to
+// shrink, i.e. when it is disqualified or gracefully exiting.
+func (endpoint *Endpoint) checkShrinkAllowed(ctx context.Context, nodeID storj.NodeID) error {
+	nodeInfo, err := endpoint.overlay.Get(ctx, nodeID)
+	if err != nil {
+		return rpcstatus.Error(rpcstatus.Internal, err.Error())
+	}
+	if nodeInfo.Disqualified != nil {
+		return rpcstatus.Error(rpcstatus.FailedPrecondition, "Disqualified nodes cannot shrink")
+	}
+
+	exitStatus, err := endpoint.overlaydb.GetExitStatus(ctx, nodeID)
+	if err != nil {
+		return rpcstatus.Error(rpcstatus.Internal, err.Error())
+	}
+	if exitStatus.ExitInitiatedAt != nil {
+		return rpcstatus.Error(rpcstatus.FailedPrecondition, "Exiting nodes cannot shrink")
+	}
+	return nil
+}
+
+// shrinkStatus converts the shrink to its protobuf representation.
+func shrinkStatus(shrink *Shrink) *shrinkpb.ShrinkStatus {
+	return &shrinkpb.ShrinkStatus{
+		RequestedBytes:    shrink.RequestedBytes,
+		SelectedBytes:     shrink.SelectedBytes,
+		BytesTransferred:  shrink.BytesTransferred,
+		PiecesTransferred: shrink.PiecesTransferred,
+		PiecesFailed:      shrink.PiecesFailed,
+		Selected:          shrink.SelectedAt != nil,
+		Finished:          shrink.FinishedAt != nil,
+	}
+}
@@ -0,0 +1,241 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package gracefulexit_test
+
+import (
+	"io"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/common/errs2"
+	"storj.io/common/identity"
+	"storj.io/common/memory"
+	"storj.io/common/pb"
+	"storj.io/common/rpc/rpcstatus"
+	"storj.io/common/signing"
+	"storj.io/common/storj"
+	"storj.io/common/testcontext"
+	"storj.io/common/testrand"
+	"storj.io/storj/private/shrinkpb"
+	"storj.io/storj/private/testplanet"
+	"storj.io/storj/satellite"
+	"storj.io/storj/satellite/metabase"
+	"storj.io/uplink/private/eestream"
+)
+
+func TestShrinkSuccess(t *testing.T) {
+	testShrinkSuccess(t, false)
+}
+
+func TestShrinkSuccessRangedLoop(t *testing.T) {
+	testShrinkSuccess(t, true)
+}
+
+func testShrinkSuccess(t *testing.T, useRangedLoop bool) {
+	const successThreshold = 4
+	const selectedPieces = 3
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount:   1,
+		StorageNodeCount: successThreshold + 1,
+		UplinkCount:      1,
+		Reconfigure: testplanet.Reconfigure{
+			Satellite: testplanet.Combine(
+				func(log *zap.Logger, index int, config *satellite.Config) {
+					config.GracefulExit.UseRangedLoop = useRangedLoop
+				},
+				testplanet.ReconfigureRS(2, 3, successThreshold, successThreshold),
+			),
+		},
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		uplinkPeer := planet.Uplinks[0]
+		satellite := planet.Satellites[0]
+
+		if !useRangedLoop {
+			satellite.GracefulExit.Chore.Loop.Pause()
+		}
+		runLoop := func() {
+			if useRangedLoop {
+				_, err := satellite.RangedLoop.RangedLoop.Service.RunOnce(ctx)
+				require.NoError(t, err)
+				return
+			}
+			satellite.GracefulExit.Chore.Loop.TriggerWait()
+		}
+
+		nodeFullIDs := make(map[storj.NodeID]*identity.FullIdentity)
+		for _, node := range planet.StorageNodes {
+			nodeFullIDs[node.ID()] = node.Identity
+		}
+
+		for i := 0; i < numObjects; i++ {
+			err := uplinkPeer.Upload(ctx, satellite, "testbucket", "test/path"+strconv.Itoa(i), testrand.Bytes(5*memory.KiB))
+			require.NoError(t, err)
+		}
+
+		shrinkingNode, err := findNodeToExit(ctx, planet, numObjects)
+		require.NoError(t, err)
+
+		// all the segments have the same size, so the shrink selects exactly
+		// the requested number of pieces.
+		segments, err := satellite.Metabase.DB.TestingAllSegments(ctx)
+		require.NoError(t, err)
+		redundancy, err := eestream.NewRedundancyStrategyFromStorj(segments[0].Redundancy)
+		require.NoError(t, err)
+		pieceSize := eestream.CalcPieceSize(int64(segments[0].EncryptedSize), redundancy)
+		requestedBytes := selectedPieces * pieceSize
+
+		before := countPieces(segments)
+		require.Greater(t, before[shrinkingNode.ID()], selectedPieces)
+
+		conn, err := shrinkingNode.Dialer.DialNodeURL(ctx, satellite.NodeURL())
+		require.NoError(t, err)
+		defer ctx.Check(conn.Close)
+
+		client := shrinkpb.NewDRPCSatelliteShrinkClient(conn)
+
+		_, err = client.GetShrinkStatus(ctx, &shrinkpb.GetShrinkStatusRequest{})
+		require.True(t, errs2.IsRPC(err, rpcstatus.NotFound))
+
+		_, err = client.InitiateShrink(ctx, &shrinkpb.InitiateShrinkRequest{Bytes: 0})
+		require.True(t, errs2.IsRPC(err, rpcstatus.InvalidArgument))
+
+		status, err := client.InitiateShrink(ctx, &shrinkpb.InitiateShrinkRequest{Bytes: requestedBytes})
+		require.NoError(t, err)
+		require.Equal(t, requestedBytes, status.RequestedBytes)
+		require.False(t, status.Selected)
+		require.False(t, status.Finished)
+
+		_, err = client.InitiateShrink(ctx, &shrinkpb.InitiateShrinkRequest{Bytes: requestedBytes})
+		require.True(t, errs2.IsRPC(err, rpcstatus.FailedPrecondition))
+
+		// the pieces aren't selected until the segment loop runs.
+		c, err := client.ProcessShrink(ctx)
+		require.NoError(t, err)
+		response, err := c.Recv()
+		require.NoError(t, err)
+		require.IsType(t, &pb.SatelliteMessage_NotReady{}, response.GetMessage())
+		require.NoError(t, c.CloseSend())
+
+		runLoop()
+
+		status, err = client.GetShrinkStatus(ctx, &shrinkpb.GetShrinkStatusRequest{})
+		require.NoError(t, err)
+		require.True(t, status.Selected)
+		require.Equal(t, requestedBytes, status.SelectedBytes)
+
+		queued, err := satellite.DB.GracefulExit().GetIncomplete(ctx, shrinkingNode.ID(), 2*selectedPieces, 0)
+		require.NoError(t, err)
+		require.Len(t, queued, selectedPieces)
+
+		// the selected shrink isn't selected again.
+		runLoop()
+		queued, err = satellite.DB.GracefulExit().GetIncomplete(ctx, shrinkingNode.ID(), 2*selectedPieces, 0)
+		require.NoError(t, err)
+		require.Len(t, queued, selectedPieces)
+
+		c, err = client.ProcessShrink(ctx)
+		require.NoError(t, err)
+		defer ctx.Check(c.CloseSend)
+
+		transferred := make(map[storj.NodeID]int)
+		deletedCount := 0
+		for {
+			response, err := c.Recv()
+			if errs.Is(err, io.EOF) {
+				break
+			}
+			require.NoError(t, err)
+
+			switch m := response.GetMessage().(type) {
+			case *pb.SatelliteMessage_TransferPiece:
+				pieceReader, err := shrinkingNode.Storage2.Store.Reader(ctx, satellite.ID(), m.TransferPiece.OriginalPieceId)
+				require.NoError(t, err)
+
+				header, err := pieceReader.GetPieceHeader()
+				require.NoError(t, err)
+				require.NoError(t, pieceReader.Close())
+
+				orderLimit := header.OrderLimit
+				originalPieceHash := &pb.PieceHash{
+					PieceId:   orderLimit.PieceId,
+					Hash:      header.GetHash(),
+					PieceSize: pieceReader.Size(),
+					Timestamp: header.GetCreationTime(),
+					Signature: header.GetSignature(),
+				}
+
+				receivingNodeID := m.TransferPiece.AddressedOrderLimit.Limit.StorageNodeId
+				newPieceHash := &pb.PieceHash{
+					PieceId:   m.TransferPiece.AddressedOrderLimit.Limit.PieceId,
+					Hash:      originalPieceHash.Hash,
+					PieceSize: originalPieceHash.PieceSize,
+					Timestamp: time.Now(),
+				}
+
+				receivingNode := nodeFullIDs[receivingNodeID]
+				require.NotNil(t, receivingNode)
+				signedNewPieceHash, err := signing.SignPieceHash(ctx, signing.SignerFromFullIdentity(receivingNode), newPieceHash)
+				require.NoError(t, err)
+
+				err = c.Send(&pb.StorageNodeMessage{
+					Message: &pb.StorageNodeMessage_Succeeded{
+						Succeeded: &pb.TransferSucceeded{
+							OriginalPieceId:      m.TransferPiece.OriginalPieceId,
+							OriginalPieceHash:    originalPieceHash,
+							OriginalOrderLimit:   &orderLimit,
+							ReplacementPieceHash: signedNewPieceHash,
+						},
+					},
+				})
+				require.NoError(t, err)
+				transferred[receivingNodeID]++
+			case *pb.SatelliteMessage_DeletePiece:
+				deletedCount++
+			default:
+				require.FailNow(t, "should not reach this case: %#v", m)
+			}
+		}
+		require.Equal(t, selectedPieces, deletedCount)
+
+		// the pieces were moved to the receiving nodes.
+		segments, err = satellite.Metabase.DB.TestingAllSegments(ctx)
+		require.NoError(t, err)
+		after := countPieces(segments)
+		require.Equal(t, before[shrinkingNode.ID()]-selectedPieces, after[shrinkingNode.ID()])
+		for nodeID, count := range transferred {
+			require.Equal(t, before[nodeID]+count, after[nodeID])
+		}
+
+		status, err = client.GetShrinkStatus(ctx, &shrinkpb.GetShrinkStatusRequest{})
+		require.NoError(t, err)
+		require.True(t, status.Finished)
+		require.EqualValues(t, selectedPieces, status.PiecesTransferred)
+		require.Equal(t, requestedBytes, status.BytesTransferred)
+		require.Zero(t, status.PiecesFailed)
+
+		queued, err = satellite.DB.GracefulExit().GetIncomplete(ctx, shrinkingNode.ID(), 2*selectedPieces, 0)
+		require.NoError(t, err)
+		require.Empty(t, queued)
+
+		// a finished shrink allows a new one.
+		_, err = client.InitiateShrink(ctx, &shrinkpb.InitiateShrinkRequest{Bytes: requestedBytes})
+		require.NoError(t, err)
+	})
+}
+
+// countPieces returns the number of pieces of the segments per node.
+func countPieces(segments []metabase.Segment) map[storj.NodeID]int {
+	counts := make(map[storj.NodeID]int)
+	for _, segment := range segments {
+		for _, piece := range segment.Pieces {
+			counts[piece.StorageNode]++
+		}
+	}
+	return counts
+}
@@ -14,6 +14,7 @@ import (
 
 	"storj.io/common/storj"
 	"storj.io/common/uuid"
+	"storj.io/private/dbutil/pgutil"
 	"storj.io/private/tagsql"
 )
 
@@ -276,6 +277,100 @@ func (db *DB) GetSegmentByPosition(ctx context.Context, opts GetSegmentByPositio
 	return segment, nil
 }
 
+// GetSegmentsByPosition contains arguments necessary for fetching segments on specific positions.
+type GetSegmentsByPosition struct {
+	Segments []GetSegmentByPosition
+}
+
+// Verify verifies get segments request fields.
+func (opts *GetSegmentsByPosition) Verify() error {
+	for i := range opts.Segments {
+		if err := opts.Segments[i].Verify(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// GetSegmentsByPosition returns information about the segments on the specified
+// positions, ordered by stream id and position. Segments which don't exist are
+// missing from the result.
+func (db *DB) GetSegmentsByPosition(ctx context.Context, opts GetSegmentsByPosition) (segments []Segment, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if err := opts.Verify(); err != nil {
+		return nil, err
+	}
+	if len(opts.Segments) == 0 {
+		return nil, nil
+	}
+
+	streamIDs := make([]uuid.UUID, 0, len(opts.Segments))
+	positions := make([]int64, 0, len(opts.Segments))
+	for _, segment := range opts.Segments {
+		streamIDs = append(streamIDs, segment.StreamID)
+		positions = append(positions, int64(segment.Position.Encode()))
+	}
+
+	err = withRows(db.db.QueryContext(ctx, `
+		SELECT
+			stream_id, position,
+			created_at, expires_at, repaired_at,
+			root_piece_id, encrypted_key_nonce, encrypted_key,
+			encrypted_size, plain_offset, plain_size,
+			encrypted_etag,
+			redundancy,
+			inline_data, remote_alias_pieces,
+			placement
+		FROM segments
+		WHERE
+			(stream_id, position) IN (SELECT unnest($1::BYTEA[]), unnest($2::INT8[]))
+		ORDER BY stream_id ASC, position ASC
+	`, pgutil.UUIDArray(streamIDs), pgutil.Int8Array(positions)))(func(rows tagsql.Rows) error {
+		for rows.Next() {
+			var segment Segment
+			var aliasPieces AliasPieces
+			err := rows.Scan(
+				&segment.StreamID, &segment.Position,
+				&segment.CreatedAt, &segment.ExpiresAt, &segment.RepairedAt,
+				&segment.RootPieceID, &segment.EncryptedKeyNonce, &segment.EncryptedKey,
+				&segment.EncryptedSize, &segment.PlainOffset, &segment.PlainSize,
+				&segment.EncryptedETag,
+				redundancyScheme{&segment.Redundancy},
+				&segment.InlineData, &aliasPieces,
+				&segment.Placement,
+			)
+			if err != nil {
+				return Error.New("failed to scan segments: %w", err)
+			}
+
+			if len(aliasPieces) > 0 {
+				segment.Pieces, err = db.aliasCache.ConvertAliasesToPieces(ctx, aliasPieces)
+				if err != nil {
+					return Error.New("unable to convert aliases to pieces: %w", err)
+				}
+			}
+
+			segments = append(segments, segment)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, Error.New("unable to query segments: %w", err)
+	}
+
+	if db.config.ServerSideCopy {
+		for i := range segments {
+			err = db.updateWithAncestorSegment(ctx, &segments[i])
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	return segments, nil
+}
+
 // GetLatestObjectLastSegment contains arguments necessary for fetching a last segment information.
 type GetLatestObjectLastSegment struct {
 	ObjectLocation
@@ -1007,6 +1007,65 @@ func TestGetSegmentByPosition(t *testing.T) {
 	})
 }
 
+func TestGetSegmentsByPosition(t *testing.T) {
+	metabasetest.Run(t, func(ctx *testcontext.Context, t *testing.T, db *metabase.DB) {
+		t.Run("StreamID missing", func(t *testing.T) {
+			defer metabasetest.DeleteAll{}.Check(ctx, t, db)
+
+			metabasetest.GetSegmentsByPosition{
+				Opts: metabase.GetSegmentsByPosition{
+					Segments: []metabase.GetSegmentByPosition{{}},
+				},
+				ErrClass: &metabase.ErrInvalidRequest,
+				ErrText:  "StreamID missing",
+			}.Check(ctx, t, db)
+
+			metabasetest.Verify{}.Check(ctx, t, db)
+		})
+
+		t.Run("no segments", func(t *testing.T) {
+			defer metabasetest.DeleteAll{}.Check(ctx, t, db)
+
+			metabasetest.GetSegmentsByPosition{}.Check(ctx, t, db)
+
+			metabasetest.Verify{}.Check(ctx, t, db)
+		})
+
+		t.Run("Get segments", func(t *testing.T) {
+			defer metabasetest.DeleteAll{}.Check(ctx, t, db)
+
+			obj1 := metabasetest.CreateObject(ctx, t, db, metabasetest.RandObjectStream(), 2)
+			obj2 := metabasetest.CreateObject(ctx, t, db, metabasetest.RandObjectStream(), 1)
+
+			all, err := db.TestingAllSegments(ctx)
+			require.NoError(t, err)
+			require.Len(t, all, 3)
+
+			var expected []metabase.Segment
+			for _, segment := range all {
+				if segment.StreamID == obj1.StreamID && segment.Position.Index == 0 {
+					continue
+				}
+				expected = append(expected, segment)
+			}
+
+			metabasetest.GetSegmentsByPosition{
+				Opts: metabase.GetSegmentsByPosition{
+					Segments: []metabase.GetSegmentByPosition{
+						{StreamID: obj2.StreamID},
+						{StreamID: obj1.StreamID, Position: metabase.SegmentPosition{Index: 1}},
+						// non existing segment in existing object
+						{StreamID: obj2.StreamID, Position: metabase.SegmentPosition{Index: 1}},
+						// non existing object
+						{StreamID: testrand.UUID()},
+					},
+				},
+				Result: expected,
+			}.Check(ctx, t, db)
+		})
+	})
+}
+
 func TestGetLatestObjectLastSegment(t *testing.T) {
 	metabasetest.Run(t, func(ctx *testcontext.Context, t *testing.T, db *metabase.DB) {
 		obj := metabasetest.RandObjectStream()
@@ -17,6 +17,7 @@ import (
 
 	"storj.io/common/storj"
 	"storj.io/common/testcontext"
+	"storj.io/common/uuid"
 	"storj.io/storj/satellite/metabase"
 )
 
@@ -251,6 +252,23 @@ func (step GetSegmentByPosition) Check(ctx *testcontext.Context, t testing.TB, d
 	require.Zero(t, diff)
 }
 
+// GetSegmentsByPosition is for testing metabase.GetSegmentsByPosition.
+type GetSegmentsByPosition struct {
+	Opts     metabase.GetSegmentsByPosition
+	Result   []metabase.Segment
+	ErrClass *errs.Class
+	ErrText  string
+}
+
+// Check runs the test.
+func (step GetSegmentsByPosition) Check(ctx *testcontext.Context, t testing.TB, db *metabase.DB) {
+	result, err := db.GetSegmentsByPosition(ctx, step.Opts)
+	checkError(t, err, step.ErrClass, step.ErrText)
+
+	diff := cmp.Diff(step.Result, result, DefaultTimeDiff())
+	require.Zero(t, diff)
+}
+
 // GetLatestObjectLastSegment is for testing metabase.GetLatestObjectLastSegment.
 type GetLatestObjectLastSegment struct {
 	Opts     metabase.GetLatestObjectLastSegment
@@ -373,6 +391,23 @@ func (step GetStreamPieceCountByNodeID) Check(ctx *testcontext.Context, t testin
 	require.Zero(t, diff)
 }
 
+// GetObjectLocationsByStreamID is for testing metabase.GetObjectLocationsByStreamID.
+type GetObjectLocationsByStreamID struct {
+	Opts     metabase.GetObjectLocationsByStreamID
+	Result   map[uuid.UUID]metabase.ObjectLocation
+	ErrClass *errs.Class
+	ErrText  string
+}
+
+// Check runs the test.
+func (step GetObjectLocationsByStreamID) Check(ctx *testcontext.Context, t testing.TB, db *metabase.DB) {
+	result, err := db.GetObjectLocationsByStreamID(ctx, step.Opts)
+	checkError(t, err, step.ErrClass, step.ErrText)
+
+	diff := cmp.Diff(step.Result, result)
+	require.Zero(t, diff)
+}
+
 // IterateLoopSegments is for testing metabase.IterateLoopSegments.
 type IterateLoopSegments struct {
 	Opts     metabase.IterateLoopSegments
@@ -0,0 +1,52 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package metabase
+
+import (
+	"context"
+
+	"storj.io/common/uuid"
+	"storj.io/private/dbutil/pgutil"
+	"storj.io/private/tagsql"
+)
+
+// GetObjectLocationsByStreamID contains arguments for GetObjectLocationsByStreamID.
+type GetObjectLocationsByStreamID struct {
+	StreamIDs []uuid.UUID
+}
+
+// GetObjectLocationsByStreamID returns the locations of the committed and
+// pending objects with the stream ids. Stream ids without an object are
+// missing from the result.
+//
+// The objects table isn't indexed by the stream id, so this scans the whole
+// table. It's meant for tools and shouldn't be used by regular requests.
+func (db *DB) GetObjectLocationsByStreamID(ctx context.Context, opts GetObjectLocationsByStreamID) (result map[uuid.UUID]ObjectLocation, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	result = make(map[uuid.UUID]ObjectLocation, len(opts.StreamIDs))
+	if len(opts.StreamIDs) == 0 {
+		return result, nil
+	}
+
+	err = withRows(db.db.QueryContext(ctx, `
+		SELECT stream_id, project_id, bucket_name, object_key
+		FROM objects
+		WHERE stream_id = ANY($1::BYTEA[])
+	`, pgutil.UUIDArray(opts.StreamIDs)))(func(rows tagsql.Rows) error {
+		for rows.Next() {
+			var streamID uuid.UUID
+			var location ObjectLocation
+			if err := rows.Scan(&streamID, &location.ProjectID, &location.BucketName, &location.ObjectKey); err != nil {
+				return Error.New("failed to scan objects: %w", err)
+			}
+			result[streamID] = location
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, Error.New("unable to fetch object locations: %w", err)
+	}
+	return result, nil
+}
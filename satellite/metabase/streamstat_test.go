@@ -9,6 +9,7 @@ import (
 	"storj.io/common/storj"
 	"storj.io/common/testcontext"
 	"storj.io/common/testrand"
+	"storj.io/common/uuid"
 	"storj.io/storj/satellite/metabase"
 	"storj.io/storj/satellite/metabase/metabasetest"
 )
@@ -219,3 +220,36 @@ func TestGetStreamPieceCountByNodeID(t *testing.T) {
 		})
 	})
 }
+
+func TestGetObjectLocationsByStreamID(t *testing.T) {
+	metabasetest.Run(t, func(ctx *testcontext.Context, t *testing.T, db *metabase.DB) {
+		t.Run("no stream ids", func(t *testing.T) {
+			defer metabasetest.DeleteAll{}.Check(ctx, t, db)
+
+			metabasetest.GetObjectLocationsByStreamID{
+				Result: map[uuid.UUID]metabase.ObjectLocation{},
+			}.Check(ctx, t, db)
+
+			metabasetest.Verify{}.Check(ctx, t, db)
+		})
+
+		t.Run("objects", func(t *testing.T) {
+			defer metabasetest.DeleteAll{}.Check(ctx, t, db)
+
+			first := metabasetest.RandObjectStream()
+			second := metabasetest.RandObjectStream()
+			metabasetest.CreateObject(ctx, t, db, first, 1)
+			metabasetest.CreateObject(ctx, t, db, second, 0)
+
+			metabasetest.GetObjectLocationsByStreamID{
+				Opts: metabase.GetObjectLocationsByStreamID{
+					StreamIDs: []uuid.UUID{first.StreamID, second.StreamID, testrand.UUID()},
+				},
+				Result: map[uuid.UUID]metabase.ObjectLocation{
+					first.StreamID:  first.Location(),
+					second.StreamID: second.Location(),
+				},
+			}.Check(ctx, t, db)
+		})
+	})
+}
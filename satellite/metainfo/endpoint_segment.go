@@ -323,6 +323,8 @@ func (endpoint *Endpoint) CommitSegment(ctx context.Context, req *pb.SegmentComm
 		})
 	}
 
+	endpoint.observeUploads(originalLimits, pieces)
+
 	id, err := uuid.FromBytes(streamID.StreamId)
 	if err != nil {
 		endpoint.log.Error("internal", zap.Error(err))
@@ -405,6 +407,30 @@ func (endpoint *Endpoint) CommitSegment(ctx context.Context, req *pb.SegmentComm
 	}, nil
 }
 
+// observeUploads records which of the nodes that got an order limit for the
+// segment stored a valid piece, for the performance weighted node selection.
+// Nodes without a piece were too slow, e.g. cut off by the long tail
+// cancellation, or failed.
+func (endpoint *Endpoint) observeUploads(originalLimits []*pb.OrderLimit, pieces metabase.Pieces) {
+	stored := make(map[storj.NodeID]struct{}, len(pieces))
+	for _, piece := range pieces {
+		stored[piece.StorageNode] = struct{}{}
+	}
+
+	observations := make([]overlay.TransferObservation, 0, len(originalLimits))
+	for _, limit := range originalLimits {
+		if limit == nil {
+			continue
+		}
+		_, ok := stored[limit.StorageNodeId]
+		observations = append(observations, overlay.TransferObservation{
+			NodeID:  limit.StorageNodeId,
+			Success: ok,
+		})
+	}
+	endpoint.overlay.ObserveTransfers(observations)
+}
+
 // MakeInlineSegment makes inline segment on satellite.
 func (endpoint *Endpoint) MakeInlineSegment(ctx context.Context, req *pb.SegmentMakeInlineRequest) (resp *pb.SegmentMakeInlineResponse, err error) {
 	defer mon.Task()(&ctx)(&err)
@@ -24,6 +24,8 @@ var (
 // Config contains configurable values for metrics collection.
 type Config struct {
 	UseRangedLoop bool `help:"whether to use ranged loop instead of segment loop" default:"false"`
+
+	GaugesInterval time.Duration `help:"how often the dashboard gauges exported by the debug server are collected" default:"1m0s"`
 }
 
 // Chore implements the metrics chore.
@@ -0,0 +1,159 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package metrics
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/spacemonkeygo/monkit/v3"
+	"go.uber.org/zap"
+
+	"storj.io/common/sync2"
+	"storj.io/storj/private/openmetrics"
+	"storj.io/storj/satellite/overlay"
+	"storj.io/storj/satellite/repair/queue"
+)
+
+// Gauges periodically collects dashboard level gauges of the satellite.
+//
+// The gauges are chained into the monkit registry with stable names, which
+// don't depend on the instrumentation of the code, so they are exported by
+// the /metrics endpoint of the debug server. The gauges are removed from the
+// registry when closed.
+//
+// architecture: Chore
+type Gauges struct {
+	log         *zap.Logger
+	Loop        *sync2.Cycle
+	repairQueue queue.RepairQueue
+	overlay     *overlay.Service
+	overlayDB   overlay.DB
+
+	mu       sync.Mutex
+	families []*openmetrics.Family
+}
+
+// NewGauges creates a new gauges chore.
+func NewGauges(log *zap.Logger, interval time.Duration, repairQueue queue.RepairQueue, overlay *overlay.Service, overlayDB overlay.DB) *Gauges {
+	gauges := &Gauges{
+		log:         log,
+		Loop:        sync2.NewCycle(interval),
+		repairQueue: repairQueue,
+		overlay:     overlay,
+		overlayDB:   overlayDB,
+	}
+	registerGauges(gauges)
+	return gauges
+}
+
+// registered contains the gauges which are chained into the monkit registry.
+// Chained sources can't be removed from the registry, so a single source
+// reporting the registered gauges is chained instead.
+var registered struct {
+	once   sync.Once
+	mu     sync.Mutex
+	gauges map[*Gauges]struct{}
+}
+
+func registerGauges(gauges *Gauges) {
+	registered.once.Do(func() {
+		registered.gauges = map[*Gauges]struct{}{}
+		mon.Chain(monkit.StatSourceFunc(func(cb func(key monkit.SeriesKey, field string, val float64)) {
+			registered.mu.Lock()
+			all := make([]*Gauges, 0, len(registered.gauges))
+			for gauges := range registered.gauges {
+				all = append(all, gauges)
+			}
+			registered.mu.Unlock()
+
+			for _, gauges := range all {
+				gauges.Stats(cb)
+			}
+		}))
+	})
+
+	registered.mu.Lock()
+	defer registered.mu.Unlock()
+	registered.gauges[gauges] = struct{}{}
+}
+
+func unregisterGauges(gauges *Gauges) {
+	registered.mu.Lock()
+	defer registered.mu.Unlock()
+	delete(registered.gauges, gauges)
+}
+
+// Run starts the gauges chore.
+func (gauges *Gauges) Run(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	return gauges.Loop.Run(ctx, func(ctx context.Context) error {
+		if err := gauges.Collect(ctx); err != nil {
+			gauges.log.Error("failed to collect gauges", zap.Error(err))
+		}
+		return nil
+	})
+}
+
+// Collect updates the gauges.
+func (gauges *Gauges) Collect(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	repairQueueLength, err := gauges.repairQueue.Count(ctx)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	// the download selection cache contains the reliable nodes, including the
+	// suspended ones, and is refreshed anyway.
+	reliable, err := gauges.overlay.DownloadSelectionCache.Size(ctx)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	exiting, err := gauges.overlayDB.GetExitingNodes(ctx)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	repairQueue := openmetrics.NewGauge("storj_satellite_repair_queue_segments", "Segments waiting in the repair queue.")
+	repairQueue.Add(float64(repairQueueLength))
+	reliableNodes := openmetrics.NewGauge("storj_satellite_reliable_nodes", "Nodes which are online and not disqualified or exited.")
+	reliableNodes.Add(float64(reliable))
+	exitingNodes := openmetrics.NewGauge("storj_satellite_exiting_nodes", "Nodes which are gracefully exiting.")
+	exitingNodes.Add(float64(len(exiting)))
+
+	gauges.mu.Lock()
+	gauges.families = []*openmetrics.Family{repairQueue, reliableNodes, exitingNodes}
+	gauges.mu.Unlock()
+	return nil
+}
+
+// Families returns the last collected gauges.
+func (gauges *Gauges) Families() []*openmetrics.Family {
+	gauges.mu.Lock()
+	defer gauges.mu.Unlock()
+	return gauges.families
+}
+
+// Stats implements monkit.StatSource.
+func (gauges *Gauges) Stats(cb func(key monkit.SeriesKey, field string, val float64)) {
+	for _, family := range gauges.Families() {
+		for _, sample := range family.Samples {
+			key := monkit.NewSeriesKey(family.Name)
+			for _, label := range sample.Labels {
+				key = key.WithTag(label.Name, label.Value)
+			}
+			cb(key, "value", sample.Value)
+		}
+	}
+}
+
+// Close stops the gauges chore and removes the gauges from the monkit
+// registry.
+func (gauges *Gauges) Close() error {
+	unregisterGauges(gauges)
+	gauges.Loop.Close()
+	return nil
+}
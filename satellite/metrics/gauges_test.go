@@ -0,0 +1,47 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package metrics_test
+
+import (
+	"testing"
+
+	"github.com/spacemonkeygo/monkit/v3"
+	"github.com/stretchr/testify/require"
+
+	"storj.io/common/testcontext"
+	"storj.io/common/testrand"
+	"storj.io/storj/private/testplanet"
+	"storj.io/storj/satellite/metabase"
+	"storj.io/storj/satellite/repair/queue"
+)
+
+func TestGauges(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 2, UplinkCount: 0,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		satellite := planet.Satellites[0]
+		gauges := satellite.Metrics.Gauges
+		gauges.Loop.Pause()
+
+		_, err := satellite.DB.RepairQueue().Insert(ctx, &queue.InjuredSegment{
+			StreamID: testrand.UUID(),
+			Position: metabase.SegmentPosition{},
+		})
+		require.NoError(t, err)
+
+		require.NoError(t, satellite.Overlay.Service.DownloadSelectionCache.Refresh(ctx))
+		require.NoError(t, gauges.Collect(ctx))
+
+		values := map[string]float64{}
+		gauges.Stats(func(key monkit.SeriesKey, field string, val float64) {
+			require.Equal(t, "value", field)
+			values[key.Measurement] = val
+		})
+		require.Equal(t, map[string]float64{
+			"storj_satellite_repair_queue_segments": 1,
+			"storj_satellite_reliable_nodes":        2,
+			"storj_satellite_exiting_nodes":         0,
+		}, values)
+	})
+}
@@ -10,10 +10,21 @@ import (
 
 // Criteria to filter nodes.
 type Criteria struct {
-	ExcludeNodeIDs       []storj.NodeID
-	AutoExcludeSubnets   map[string]struct{} // initialize it with empty map to keep only one node per subnet.
-	Placement            storj.PlacementConstraint
+	ExcludeNodeIDs     []storj.NodeID
+	AutoExcludeSubnets map[string]struct{} // initialize it with empty map to keep only one node per subnet.
+	Placement          storj.PlacementConstraint
+	// PlacementRules define which nodes match the placement, nil means the
+	// default placement rules.
+	PlacementRules       *PlacementRules
 	ExcludedCountryCodes []location.CountryCode
+
+	// MaxPerFailureDomain limits the number of included nodes sharing a
+	// failure domain, zero means no limit.
+	MaxPerFailureDomain int
+	// FailureDomains counts the nodes per failure domain, initialize it with
+	// an empty map (or with the domains of already used nodes) when
+	// MaxPerFailureDomain is set.
+	FailureDomains map[string]int
 }
 
 // MatchInclude returns with true if node is selected.
@@ -22,10 +33,20 @@ func (c *Criteria) MatchInclude(node *Node) bool {
 		return false
 	}
 
-	if !c.Placement.AllowedCountry(node.CountryCode) {
+	if !c.PlacementRules.Match(c.Placement, node) {
 		return false
 	}
 
+	var domains []string
+	if c.MaxPerFailureDomain > 0 && c.FailureDomains != nil {
+		domains = node.FailureDomains()
+		for _, domain := range domains {
+			if c.FailureDomains[domain] >= c.MaxPerFailureDomain {
+				return false
+			}
+		}
+	}
+
 	if c.AutoExcludeSubnets != nil {
 		if _, excluded := c.AutoExcludeSubnets[node.LastNet]; excluded {
 			return false
@@ -42,6 +63,10 @@ func (c *Criteria) MatchInclude(node *Node) bool {
 		}
 	}
 
+	for _, domain := range domains {
+		c.FailureDomains[domain]++
+	}
+
 	return true
 }
 
@@ -138,3 +138,28 @@ func TestCriteria_Geofencing(t *testing.T) {
 		})
 	}
 }
+
+func TestCriteria_FailureDomains(t *testing.T) {
+	criteria := Criteria{
+		AutoExcludeSubnets:  map[string]struct{}{},
+		MaxPerFailureDomain: 2,
+		FailureDomains:      map[string]int{},
+	}
+
+	operator := func(lastNet string) *Node {
+		return &Node{LastNet: lastNet, Email: "Operator@mail.test", Wallet: "0xabc"}
+	}
+
+	assert.True(t, criteria.MatchInclude(operator("192.168.0.1")))
+	assert.True(t, criteria.MatchInclude(operator("192.168.1.1")))
+	assert.False(t, criteria.MatchInclude(operator("192.168.2.1")))
+	assert.False(t, criteria.MatchInclude(&Node{LastNet: "192.168.3.1", Wallet: "0xABC"}))
+
+	// the subnet of a node excluded by its failure domain isn't used up
+	assert.True(t, criteria.MatchInclude(&Node{LastNet: "192.168.2.1", ASN: 64500}))
+	assert.True(t, criteria.MatchInclude(&Node{LastNet: "192.168.4.1", ASN: 64500}))
+	assert.False(t, criteria.MatchInclude(&Node{LastNet: "192.168.5.1", ASN: 64500}))
+
+	// nodes without known failure domains are only limited by their subnet
+	assert.True(t, criteria.MatchInclude(&Node{LastNet: "192.168.6.1"}))
+}
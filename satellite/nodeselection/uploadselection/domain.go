@@ -0,0 +1,75 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package uploadselection
+
+import (
+	"math"
+	"strconv"
+	"strings"
+)
+
+// FailureDomains returns the failure domains of a node run by the operator
+// with the given email and wallet, in the autonomous system asn. Nodes sharing
+// a failure domain are likely to fail together, e.g. when their operator stops
+// running them or when their network provider has an outage.
+//
+// Unknown attributes, i.e. empty strings and the zero asn, are not a domain.
+func FailureDomains(email, wallet string, asn uint32) []string {
+	var domains []string
+	if email = strings.ToLower(strings.TrimSpace(email)); email != "" {
+		domains = append(domains, "email:"+email)
+	}
+	if wallet = strings.ToLower(strings.TrimSpace(wallet)); wallet != "" {
+		domains = append(domains, "wallet:"+wallet)
+	}
+	if asn != 0 {
+		domains = append(domains, "asn:"+strconv.FormatUint(uint64(asn), 10))
+	}
+	return domains
+}
+
+// FailureDomainLimit returns the maximum number of pieces of a segment with
+// the given number of pieces that a single failure domain may hold. The limit
+// is at least one piece, and zero means that there is no limit.
+func FailureDomainLimit(share float64, pieces int) int {
+	if share <= 0 || share >= 1 || pieces <= 0 {
+		return 0
+	}
+	limit := int(math.Floor(share * float64(pieces)))
+	if limit < 1 {
+		return 1
+	}
+	return limit
+}
+
+// ExcessFailureDomainPieces returns the indexes of the pieces which exceed the
+// limit of pieces per failure domain, given the failure domains of the nodes
+// holding the pieces. The pieces are considered in order, the first pieces of
+// a domain are kept and the excess pieces don't count towards their other
+// domains. Pieces without domains never exceed the limit.
+func ExcessFailureDomainPieces(domains [][]string, limit int) []int {
+	if limit <= 0 {
+		return nil
+	}
+
+	var excess []int
+	counts := make(map[string]int)
+	for i, pieceDomains := range domains {
+		exceeds := false
+		for _, domain := range pieceDomains {
+			if counts[domain] >= limit {
+				exceeds = true
+				break
+			}
+		}
+		if exceeds {
+			excess = append(excess, i)
+			continue
+		}
+		for _, domain := range pieceDomains {
+			counts[domain]++
+		}
+	}
+	return excess
+}
@@ -0,0 +1,458 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package uploadselection
+
+import (
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/zeebo/errs"
+
+	"storj.io/common/storj"
+	"storj.io/common/storj/location"
+	"storj.io/private/version"
+)
+
+// NodeFilter decides whether a node is included.
+type NodeFilter interface {
+	MatchInclude(node *Node) bool
+}
+
+// ParseNodeFilter parses a node filter expression. Expressions combine the
+// following functions with && (and), || (or), ! (not) and parentheses:
+//
+//	all()                      every node
+//	country("DE", "EU", ...)   nodes in the countries, EU and EEA are the member countries
+//	continent("EU", "NA", ...) nodes on the continents (AF, AN, AS, EU, NA, OC or SA)
+//	asn(15169, ...)            nodes in the autonomous systems
+//	tag("key")                 nodes with the tag, signed by anyone
+//	tag("key", "value", ...)   nodes with the tag set to one of the values
+//	signed_tag("signer", "key", "value", ...)
+//	                           nodes with the tag signed by the node id of the signer
+//	operator("email", ...)     nodes of the operators, identified by email or wallet
+//	min_version("v1.70.0")     nodes running at least the version
+//
+// Arguments are quoted with double or single quotes, which may be omitted
+// for arguments consisting of letters, digits and ._-@+ only.
+func ParseNodeFilter(expression string) (NodeFilter, error) {
+	filter, err := parseNodeFilter(expression)
+	return filter, Error.Wrap(err)
+}
+
+// parseNodeFilter parses a node filter expression, see ParseNodeFilter.
+func parseNodeFilter(expression string) (NodeFilter, error) {
+	tokens, err := tokenize(expression)
+	if err != nil {
+		return nil, err
+	}
+	if len(tokens) == 0 {
+		return nil, errs.New("empty node filter")
+	}
+
+	parser := &filterParser{tokens: tokens}
+	filter, err := parser.parseOr()
+	if err != nil {
+		return nil, err
+	}
+	if !parser.done() {
+		return nil, errs.New("unexpected %q at position %d", parser.peek().value, parser.peek().pos)
+	}
+	return filter, nil
+}
+
+type tokenKind int
+
+const (
+	tokenWord tokenKind = iota
+	tokenString
+	tokenPunct
+)
+
+type token struct {
+	kind  tokenKind
+	value string
+	pos   int
+}
+
+// tokenize splits the expression into words, quoted strings and punctuation.
+func tokenize(expression string) ([]token, error) {
+	var tokens []token
+	for pos := 0; pos < len(expression); {
+		c := expression[pos]
+		switch {
+		case c == ' ' || c == '\t' || c == '\r' || c == '\n':
+			pos++
+		case strings.HasPrefix(expression[pos:], "&&"), strings.HasPrefix(expression[pos:], "||"):
+			tokens = append(tokens, token{kind: tokenPunct, value: expression[pos : pos+2], pos: pos})
+			pos += 2
+		case c == '(' || c == ')' || c == ',' || c == '!':
+			tokens = append(tokens, token{kind: tokenPunct, value: string(c), pos: pos})
+			pos++
+		case c == '"' || c == '\'':
+			end := strings.IndexByte(expression[pos+1:], c)
+			if end < 0 {
+				return nil, errs.New("unterminated string at position %d", pos)
+			}
+			tokens = append(tokens, token{kind: tokenString, value: expression[pos+1 : pos+1+end], pos: pos})
+			pos += end + 2
+		case isWordChar(rune(c)):
+			end := pos
+			for end < len(expression) && isWordChar(rune(expression[end])) {
+				end++
+			}
+			tokens = append(tokens, token{kind: tokenWord, value: expression[pos:end], pos: pos})
+			pos = end
+		default:
+			return nil, errs.New("unexpected %q at position %d", c, pos)
+		}
+	}
+	return tokens, nil
+}
+
+func isWordChar(c rune) bool {
+	return c < unicode.MaxASCII && (unicode.IsLetter(c) || unicode.IsDigit(c) || strings.ContainsRune("._-@+", c))
+}
+
+// filterParser is a recursive descent parser of node filter expressions.
+type filterParser struct {
+	tokens []token
+	next   int
+}
+
+func (parser *filterParser) done() bool { return parser.next >= len(parser.tokens) }
+
+func (parser *filterParser) peek() token { return parser.tokens[parser.next] }
+
+// accept consumes the next token when it's the punctuation.
+func (parser *filterParser) accept(punct string) bool {
+	if parser.done() {
+		return false
+	}
+	next := parser.peek()
+	if next.kind != tokenPunct || next.value != punct {
+		return false
+	}
+	parser.next++
+	return true
+}
+
+func (parser *filterParser) expect(punct string) error {
+	if parser.accept(punct) {
+		return nil
+	}
+	if parser.done() {
+		return errs.New("expected %q at the end of the expression", punct)
+	}
+	return errs.New("expected %q at position %d, found %q", punct, parser.peek().pos, parser.peek().value)
+}
+
+func (parser *filterParser) parseOr() (NodeFilter, error) {
+	filter, err := parser.parseAnd()
+	if err != nil {
+		return nil, err
+	}
+	filters := orFilter{filter}
+	for parser.accept("||") {
+		filter, err := parser.parseAnd()
+		if err != nil {
+			return nil, err
+		}
+		filters = append(filters, filter)
+	}
+	if len(filters) == 1 {
+		return filters[0], nil
+	}
+	return filters, nil
+}
+
+func (parser *filterParser) parseAnd() (NodeFilter, error) {
+	filter, err := parser.parseUnary()
+	if err != nil {
+		return nil, err
+	}
+	filters := andFilter{filter}
+	for parser.accept("&&") {
+		filter, err := parser.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		filters = append(filters, filter)
+	}
+	if len(filters) == 1 {
+		return filters[0], nil
+	}
+	return filters, nil
+}
+
+func (parser *filterParser) parseUnary() (NodeFilter, error) {
+	if parser.accept("!") {
+		filter, err := parser.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		return notFilter{filter}, nil
+	}
+	if parser.accept("(") {
+		filter, err := parser.parseOr()
+		if err != nil {
+			return nil, err
+		}
+		return filter, parser.expect(")")
+	}
+	return parser.parseCall()
+}
+
+func (parser *filterParser) parseCall() (NodeFilter, error) {
+	if parser.done() {
+		return nil, errs.New("unexpected end of the expression")
+	}
+	name := parser.peek()
+	if name.kind != tokenWord {
+		return nil, errs.New("expected a function at position %d, found %q", name.pos, name.value)
+	}
+	parser.next++
+
+	if err := parser.expect("("); err != nil {
+		return nil, err
+	}
+	var args []string
+	if !parser.accept(")") {
+		for {
+			if parser.done() {
+				return nil, errs.New("unexpected end of the expression")
+			}
+			arg := parser.peek()
+			if arg.kind == tokenPunct {
+				return nil, errs.New("expected an argument at position %d, found %q", arg.pos, arg.value)
+			}
+			parser.next++
+			args = append(args, arg.value)
+
+			if parser.accept(")") {
+				break
+			}
+			if err := parser.expect(","); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	filter, err := newFunctionFilter(name.value, args)
+	if err != nil {
+		return nil, errs.New("%s at position %d: %w", name.value, name.pos, err)
+	}
+	return filter, nil
+}
+
+// newFunctionFilter returns the filter of the function called with args.
+func newFunctionFilter(name string, args []string) (NodeFilter, error) {
+	switch name {
+	case "all":
+		if len(args) != 0 {
+			return nil, errs.New("expected no arguments")
+		}
+		return allFilter{}, nil
+
+	case "country":
+		if len(args) == 0 {
+			return nil, errs.New("expected at least one country")
+		}
+		countries := make(countryFilter)
+		for _, arg := range args {
+			switch strings.ToUpper(arg) {
+			case "EU":
+				for _, country := range location.EuCountries {
+					countries[country] = struct{}{}
+				}
+			case "EEA":
+				for _, country := range location.EuCountries {
+					countries[country] = struct{}{}
+				}
+				for _, country := range location.EeaNonEuCountries {
+					countries[country] = struct{}{}
+				}
+			default:
+				country := location.ToCountryCode(arg)
+				if Continent(country) == "" {
+					return nil, errs.New("unknown country %q", arg)
+				}
+				countries[country] = struct{}{}
+			}
+		}
+		return countries, nil
+
+	case "continent":
+		if len(args) == 0 {
+			return nil, errs.New("expected at least one continent")
+		}
+		continents := make(continentFilter)
+		for _, arg := range args {
+			continent := strings.ToUpper(arg)
+			if _, ok := continentCountries[continent]; !ok {
+				return nil, errs.New("unknown continent %q", arg)
+			}
+			continents[continent] = struct{}{}
+		}
+		return continents, nil
+
+	case "asn":
+		if len(args) == 0 {
+			return nil, errs.New("expected at least one autonomous system number")
+		}
+		asns := make(asnFilter)
+		for _, arg := range args {
+			asn, err := strconv.ParseUint(strings.TrimPrefix(strings.ToUpper(arg), "AS"), 10, 32)
+			if err != nil || asn == 0 {
+				return nil, errs.New("invalid autonomous system number %q", arg)
+			}
+			asns[uint32(asn)] = struct{}{}
+		}
+		return asns, nil
+
+	case "tag":
+		if len(args) == 0 || args[0] == "" {
+			return nil, errs.New("expected a tag key")
+		}
+		return tagFilter{key: args[0], values: args[1:]}, nil
+
+	case "signed_tag":
+		if len(args) < 2 || args[1] == "" {
+			return nil, errs.New("expected a signer and a tag key")
+		}
+		signer, err := storj.NodeIDFromString(args[0])
+		if err != nil {
+			return nil, errs.New("invalid signer %q", args[0])
+		}
+		return tagFilter{signer: signer, key: args[1], values: args[2:]}, nil
+
+	case "operator":
+		if len(args) == 0 {
+			return nil, errs.New("expected at least one email or wallet")
+		}
+		operators := make(operatorFilter)
+		for _, arg := range args {
+			operator := strings.ToLower(strings.TrimSpace(arg))
+			if operator == "" {
+				return nil, errs.New("empty operator")
+			}
+			operators[operator] = struct{}{}
+		}
+		return operators, nil
+
+	case "min_version":
+		if len(args) != 1 {
+			return nil, errs.New("expected a single version")
+		}
+		minimum, err := version.NewSemVer(args[0])
+		if err != nil {
+			return nil, errs.New("invalid version %q", args[0])
+		}
+		return minVersionFilter{minimum: minimum}, nil
+
+	default:
+		return nil, errs.New("unknown function")
+	}
+}
+
+type allFilter struct{}
+
+func (allFilter) MatchInclude(node *Node) bool { return true }
+
+type notFilter struct{ filter NodeFilter }
+
+func (filter notFilter) MatchInclude(node *Node) bool { return !filter.filter.MatchInclude(node) }
+
+type andFilter []NodeFilter
+
+func (filters andFilter) MatchInclude(node *Node) bool {
+	for _, filter := range filters {
+		if !filter.MatchInclude(node) {
+			return false
+		}
+	}
+	return true
+}
+
+type orFilter []NodeFilter
+
+func (filters orFilter) MatchInclude(node *Node) bool {
+	for _, filter := range filters {
+		if filter.MatchInclude(node) {
+			return true
+		}
+	}
+	return false
+}
+
+type countryFilter map[location.CountryCode]struct{}
+
+func (countries countryFilter) MatchInclude(node *Node) bool {
+	_, ok := countries[node.CountryCode]
+	return ok
+}
+
+type continentFilter map[string]struct{}
+
+func (continents continentFilter) MatchInclude(node *Node) bool {
+	_, ok := continents[Continent(node.CountryCode)]
+	return ok
+}
+
+type asnFilter map[uint32]struct{}
+
+func (asns asnFilter) MatchInclude(node *Node) bool {
+	_, ok := asns[node.ASN]
+	return ok
+}
+
+// tagFilter matches the tags of the signer, or the tags of any signer when
+// the signer is zero.
+type tagFilter struct {
+	signer storj.NodeID
+	key    string
+	values []string
+}
+
+func (filter tagFilter) MatchInclude(node *Node) bool {
+	for _, tag := range node.Tags {
+		if tag.Name != filter.key || (!filter.signer.IsZero() && tag.Signer != filter.signer) {
+			continue
+		}
+		if len(filter.values) == 0 {
+			return true
+		}
+		for _, expected := range filter.values {
+			if tag.Value == expected {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+type operatorFilter map[string]struct{}
+
+func (operators operatorFilter) MatchInclude(node *Node) bool {
+	if email := strings.ToLower(strings.TrimSpace(node.Email)); email != "" {
+		if _, ok := operators[email]; ok {
+			return true
+		}
+	}
+	if wallet := strings.ToLower(strings.TrimSpace(node.Wallet)); wallet != "" {
+		if _, ok := operators[wallet]; ok {
+			return true
+		}
+	}
+	return false
+}
+
+type minVersionFilter struct{ minimum version.SemVer }
+
+func (filter minVersionFilter) MatchInclude(node *Node) bool {
+	if node.Version.IsZero() {
+		return false
+	}
+	return node.Version.Compare(filter.minimum) >= 0
+}
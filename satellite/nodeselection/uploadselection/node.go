@@ -6,6 +6,7 @@ package uploadselection
 import (
 	"storj.io/common/storj"
 	"storj.io/common/storj/location"
+	"storj.io/private/version"
 )
 
 // Node defines necessary information for node-selection.
@@ -14,6 +15,14 @@ type Node struct {
 	LastNet     string
 	LastIPPort  string
 	CountryCode location.CountryCode
+	Email       string
+	Wallet      string
+	ASN         uint32
+	Version     version.SemVer
+	Tags        NodeTags
+	// Weight is the relative probability of the node to be selected, nodes
+	// without a weight are selected as the nodes with the highest weight.
+	Weight float64
 }
 
 // Clone returns a deep clone of the selected node.
@@ -23,5 +32,16 @@ func (node *Node) Clone() *Node {
 		LastNet:     node.LastNet,
 		LastIPPort:  node.LastIPPort,
 		CountryCode: node.CountryCode,
+		Email:       node.Email,
+		Wallet:      node.Wallet,
+		ASN:         node.ASN,
+		Version:     node.Version,
+		Tags:        node.Tags.Clone(),
+		Weight:      node.Weight,
 	}
 }
+
+// FailureDomains returns the failure domains of the node.
+func (node *Node) FailureDomains() []string {
+	return FailureDomains(node.Email, node.Wallet, node.ASN)
+}
@@ -0,0 +1,182 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package uploadselection
+
+import (
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+
+	"storj.io/common/storj"
+)
+
+// defaultPlacementDefinitions mirror the countries of the placements defined
+// by storj.PlacementConstraint.
+var defaultPlacementDefinitions = map[storj.PlacementConstraint]string{
+	storj.EveryCountry: `all()`,
+	storj.EU:           `country("EU")`,
+	storj.EEA:          `country("EEA")`,
+	storj.US:           `country("US")`,
+	storj.DE:           `country("DE")`,
+}
+
+// defaultPlacementRules are used when no placement rules are configured.
+var defaultPlacementRules = NewPlacementRules()
+
+// PlacementRules contains the node filters of the placements. The filters
+// are expressions over the attributes of the nodes, see ParseNodeFilter.
+type PlacementRules struct {
+	placements map[storj.PlacementConstraint]placementRule
+}
+
+// placementRule is the parsed definition of a placement.
+type placementRule struct {
+	definition string
+	filter     NodeFilter
+}
+
+// NewPlacementRules returns the default placement rules, which match the
+// countries of the placements defined by storj.PlacementConstraint.
+func NewPlacementRules() *PlacementRules {
+	rules := &PlacementRules{
+		placements: make(map[storj.PlacementConstraint]placementRule),
+	}
+	for placement, definition := range defaultPlacementDefinitions {
+		if err := rules.AddPlacementRule(placement, definition); err != nil {
+			panic(err)
+		}
+	}
+	return rules
+}
+
+// AddPlacementRule defines the placement with the node filter expression,
+// replacing the previous definition of the placement.
+func (rules *PlacementRules) AddPlacementRule(placement storj.PlacementConstraint, expression string) error {
+	if placement == storj.InvalidPlacement {
+		return Error.New("placement %d is reserved for invalid placements", placement)
+	}
+	filter, err := parseNodeFilter(expression)
+	if err != nil {
+		return Error.New("invalid definition of placement %d: %w", placement, err)
+	}
+	rules.placements[placement] = placementRule{
+		definition: strings.TrimSpace(expression),
+		filter:     filter,
+	}
+	return nil
+}
+
+// ParsePlacementRules returns the default placement rules extended with the
+// definitions. The definitions are placement:expression pairs separated by
+// semicolons or new lines, e.g.
+//
+//	10: country("DE") && tag("datacenter", "true")
+//	11: continent("EU") && !asn(16509, 14618)
+//
+// Everything after a # on a line is a comment.
+func ParsePlacementRules(definitions string) (*PlacementRules, error) {
+	rules := NewPlacementRules()
+
+	seen := make(map[storj.PlacementConstraint]bool)
+	for _, line := range strings.Split(definitions, "\n") {
+		if comment := strings.IndexByte(line, '#'); comment >= 0 {
+			line = line[:comment]
+		}
+		for _, definition := range strings.Split(line, ";") {
+			definition = strings.TrimSpace(definition)
+			if definition == "" {
+				continue
+			}
+
+			idValue, expression, ok := strings.Cut(definition, ":")
+			if !ok {
+				return nil, Error.New("invalid placement definition %q, expected placement:expression", definition)
+			}
+			id, err := strconv.ParseUint(strings.TrimSpace(idValue), 10, 16)
+			if err != nil {
+				return nil, Error.New("invalid placement %q: %w", idValue, err)
+			}
+
+			placement := storj.PlacementConstraint(id)
+			if seen[placement] {
+				return nil, Error.New("placement %d is defined more than once", placement)
+			}
+			seen[placement] = true
+
+			if err := rules.AddPlacementRule(placement, expression); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	return rules, nil
+}
+
+// LoadPlacementRules parses the placement rules from config, which is either
+// the path to a file containing the definitions or the definitions themselves.
+// The default placement rules are returned when config is empty.
+func LoadPlacementRules(config string) (*PlacementRules, error) {
+	config = strings.TrimSpace(config)
+	if config == "" {
+		return NewPlacementRules(), nil
+	}
+
+	if info, err := os.Stat(config); err == nil && !info.IsDir() {
+		data, err := os.ReadFile(config)
+		if err != nil {
+			return nil, Error.New("unable to read placement rules: %w", err)
+		}
+		return ParsePlacementRules(string(data))
+	}
+
+	return ParsePlacementRules(config)
+}
+
+// Match returns whether the node may store pieces of segments with the
+// placement. Nodes don't match undefined placements. Nil rules are the
+// default placement rules.
+func (rules *PlacementRules) Match(placement storj.PlacementConstraint, node *Node) bool {
+	if rules == nil {
+		rules = defaultPlacementRules
+	}
+	rule, ok := rules.placements[placement]
+	if !ok {
+		return false
+	}
+	return rule.filter.MatchInclude(node)
+}
+
+// Filter returns the node filter of the placement.
+func (rules *PlacementRules) Filter(placement storj.PlacementConstraint) (NodeFilter, bool) {
+	if rules == nil {
+		rules = defaultPlacementRules
+	}
+	rule, ok := rules.placements[placement]
+	return rule.filter, ok
+}
+
+// Placements returns the defined placements in increasing order.
+func (rules *PlacementRules) Placements() []storj.PlacementConstraint {
+	if rules == nil {
+		rules = defaultPlacementRules
+	}
+	placements := make([]storj.PlacementConstraint, 0, len(rules.placements))
+	for placement := range rules.placements {
+		placements = append(placements, placement)
+	}
+	sort.Slice(placements, func(i, k int) bool {
+		return placements[i] < placements[k]
+	})
+	return placements
+}
+
+// Definition returns the node filter expression of the placement.
+func (rules *PlacementRules) Definition(placement storj.PlacementConstraint) (string, bool) {
+	if rules == nil {
+		rules = defaultPlacementRules
+	}
+	rule, ok := rules.placements[placement]
+	return rule.definition, ok
+}
@@ -0,0 +1,188 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package uploadselection
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"storj.io/common/storj"
+	"storj.io/common/storj/location"
+	"storj.io/common/testcontext"
+	"storj.io/common/testrand"
+	"storj.io/private/version"
+)
+
+func TestParseNodeFilter(t *testing.T) {
+	v170, err := version.NewSemVer("v1.70.0")
+	require.NoError(t, err)
+
+	signer, other := testrand.NodeID(), testrand.NodeID()
+
+	node := &Node{
+		CountryCode: location.Germany,
+		Email:       "Operator@example.com",
+		Wallet:      "0xABC",
+		ASN:         15169,
+		Version:     v170,
+		Tags: NodeTags{
+			{Signer: signer, Name: "datacenter", Value: "true"},
+			{Signer: other, Name: "region", Value: "us-east"},
+		},
+	}
+
+	for expression, expected := range map[string]bool{
+		`all()`:                               true,
+		`country("DE")`:                       true,
+		`country(de)`:                         true,
+		`country("EU")`:                       true,
+		`country("EEA")`:                      true,
+		`country("US", "HU")`:                 false,
+		`continent("EU")`:                     true,
+		`continent("NA")`:                     false,
+		`asn(15169)`:                          true,
+		`asn(AS15169)`:                        true,
+		`asn(16509, 14618)`:                   false,
+		`tag("datacenter")`:                   true,
+		`tag("datacenter", "true")`:           true,
+		`tag('datacenter', 'false')`:          false,
+		`tag("owner")`:                        false,
+		`tag("region", "us-east", "us-west")`: true,
+		`signed_tag("` + signer.String() + `", "datacenter")`:       true,
+		`signed_tag("` + other.String() + `", "datacenter")`:        false,
+		`signed_tag("` + other.String() + `", "region", "us-east")`: true,
+		`operator("operator@example.com")`:                          true,
+		`operator("0xabc")`:                                         true,
+		`operator("other@example.com")`:                             false,
+		`min_version("v1.70.0")`:                                    true,
+		`min_version("v1.71.0")`:                                    false,
+		`!country("DE")`:                                            false,
+		`country("DE") && !asn(15169)`:                              false,
+		`country("US") || asn(15169)`:                               true,
+		`country("US") || asn(1) && all()`:                          false,
+		`(country("US") || asn(1)) || !!all()`:                      true,
+		`!(country("US") || continent("AS"))`:                       true,
+	} {
+		filter, err := ParseNodeFilter(expression)
+		require.NoError(t, err, expression)
+		assert.Equal(t, expected, filter.MatchInclude(node), expression)
+	}
+
+	// unknown attributes don't match
+	filter, err := ParseNodeFilter(`min_version("v1.0.0") || continent("EU") || tag("datacenter")`)
+	require.NoError(t, err)
+	assert.False(t, filter.MatchInclude(&Node{}))
+
+	for _, invalid := range []string{
+		``,
+		`all`,
+		`all(1)`,
+		`country()`,
+		`country("XX")`,
+		`continent("Europe")`,
+		`asn(0)`,
+		`asn(large)`,
+		`tag()`,
+		`signed_tag("datacenter")`,
+		`signed_tag("not a node id", "datacenter")`,
+		`min_version("latest")`,
+		`unknown()`,
+		`country("DE") &&`,
+		`country("DE") & asn(1)`,
+		`(country("DE")`,
+		`country("DE"))`,
+		`country("DE)`,
+		`country("DE",)`,
+	} {
+		_, err := ParseNodeFilter(invalid)
+		assert.Error(t, err, invalid)
+	}
+}
+
+func TestPlacementRules(t *testing.T) {
+	german := &Node{CountryCode: location.Germany}
+	hungarian := &Node{CountryCode: location.Hungary}
+	norwegian := &Node{CountryCode: location.Norway}
+	american := &Node{CountryCode: location.UnitedStates}
+	unknown := &Node{}
+
+	t.Run("defaults", func(t *testing.T) {
+		for _, rules := range []*PlacementRules{nil, NewPlacementRules()} {
+			for _, node := range []*Node{german, hungarian, norwegian, american, unknown} {
+				for _, placement := range []storj.PlacementConstraint{storj.EveryCountry, storj.EU, storj.EEA, storj.US, storj.DE, storj.InvalidPlacement, 10} {
+					assert.Equal(t, placement.AllowedCountry(node.CountryCode), rules.Match(placement, node),
+						"placement %d, country %q", placement, node.CountryCode)
+				}
+			}
+			assert.Equal(t, []storj.PlacementConstraint{0, 1, 2, 3, 4}, rules.Placements())
+		}
+	})
+
+	t.Run("parse", func(t *testing.T) {
+		rules, err := ParsePlacementRules(`
+			# datacenters in europe
+			10: continent("EU") && tag("datacenter", "true")
+			11: country("US") || country("DE"); 3: country("US", "CA")
+		`)
+		require.NoError(t, err)
+		require.Equal(t, []storj.PlacementConstraint{0, 1, 2, 3, 4, 10, 11}, rules.Placements())
+
+		definition, ok := rules.Definition(10)
+		require.True(t, ok)
+		require.Equal(t, `continent("EU") && tag("datacenter", "true")`, definition)
+
+		assert.False(t, rules.Match(10, german))
+		assert.True(t, rules.Match(10, &Node{CountryCode: location.Germany, Tags: NodeTags{{Name: "datacenter", Value: "true"}}}))
+		assert.True(t, rules.Match(11, american))
+		assert.True(t, rules.Match(11, german))
+		assert.False(t, rules.Match(11, hungarian))
+		assert.True(t, rules.Match(3, &Node{CountryCode: location.Canada}))
+		assert.False(t, rules.Match(12, german))
+
+		for _, invalid := range []string{
+			`10 country("DE")`,
+			`x: country("DE")`,
+			`70000: country("DE")`,
+			`5: country("DE")`,
+			`10: country("XX")`,
+			`10: country("DE"); 10: country("US")`,
+		} {
+			_, err := ParsePlacementRules(invalid)
+			assert.Error(t, err, invalid)
+		}
+	})
+
+	t.Run("load", func(t *testing.T) {
+		ctx := testcontext.New(t)
+
+		rules, err := LoadPlacementRules("")
+		require.NoError(t, err)
+		require.Equal(t, []storj.PlacementConstraint{0, 1, 2, 3, 4}, rules.Placements())
+
+		rules, err = LoadPlacementRules(`10: country("HU")`)
+		require.NoError(t, err)
+		assert.True(t, rules.Match(10, hungarian))
+
+		path := filepath.Join(ctx.Dir(), "placement.txt")
+		require.NoError(t, os.WriteFile(path, []byte("10: country(\"NO\")\n11: all()\n"), 0644))
+		rules, err = LoadPlacementRules(path)
+		require.NoError(t, err)
+		assert.True(t, rules.Match(10, norwegian))
+		assert.True(t, rules.Match(11, unknown))
+	})
+}
+
+func TestContinent(t *testing.T) {
+	assert.Equal(t, "EU", Continent(location.Germany))
+	assert.Equal(t, "NA", Continent(location.UnitedStates))
+	assert.Equal(t, "SA", Continent(location.Brazil))
+	assert.Equal(t, "AS", Continent(location.Japan))
+	assert.Equal(t, "OC", Continent(location.Australia))
+	assert.Equal(t, "AF", Continent(location.Kenya))
+	assert.Equal(t, "", Continent(location.None))
+}
@@ -21,10 +21,30 @@ func (nodes SelectByID) Select(n int, criteria Criteria) []*Node {
 		return nil
 	}
 
+	maxWeight := maxWeight(nodes)
+
 	selected := []*Node{}
+	var passed []*Node
 	for _, idx := range mathrand.Perm(len(nodes)) {
 		node := nodes[idx]
 
+		if !acceptWeight(node.Weight, maxWeight) {
+			passed = append(passed, node)
+			continue
+		}
+
+		if !criteria.MatchInclude(node) {
+			continue
+		}
+
+		selected = append(selected, node.Clone())
+		if len(selected) >= n {
+			return selected
+		}
+	}
+
+	// fall back to the nodes passed over due to their weight
+	for _, node := range passed {
 		if !criteria.MatchInclude(node) {
 			continue
 		}
@@ -76,11 +96,36 @@ func (subnets SelectBySubnet) Select(n int, criteria Criteria) []*Node {
 		return nil
 	}
 
+	var maxWeight float64
+	for _, subnet := range subnets {
+		if weight := subnet.maxWeight(); weight > maxWeight {
+			maxWeight = weight
+		}
+	}
+
 	selected := []*Node{}
+	var passed []*Node
 	for _, idx := range mathrand.Perm(len(subnets)) {
 		subnet := subnets[idx]
 		node := subnet.Nodes[mathrand.Intn(len(subnet.Nodes))]
 
+		if !acceptWeight(node.Weight, maxWeight) {
+			passed = append(passed, node)
+			continue
+		}
+
+		if !criteria.MatchInclude(node) {
+			continue
+		}
+
+		selected = append(selected, node.Clone())
+		if len(selected) >= n {
+			return selected
+		}
+	}
+
+	// fall back to the nodes passed over due to their weight
+	for _, node := range passed {
 		if !criteria.MatchInclude(node) {
 			continue
 		}
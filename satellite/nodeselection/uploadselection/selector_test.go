@@ -293,3 +293,41 @@ func TestSelectFiltered(t *testing.T) {
 		AutoExcludeSubnets: map[string]struct{}{},
 	}), 1)
 }
+
+func TestSelectByID_Weighted(t *testing.T) {
+	fast := &uploadselection.Node{
+		NodeURL: storj.NodeURL{ID: testrand.NodeID(), Address: "1.0.1.1:8080"},
+		LastNet: "1.0.1",
+		Weight:  2,
+	}
+	slow := &uploadselection.Node{
+		NodeURL: storj.NodeURL{ID: testrand.NodeID(), Address: "1.0.2.1:8080"},
+		LastNet: "1.0.2",
+		Weight:  1,
+	}
+
+	const executionCount = 10000
+
+	for _, selector := range []uploadselection.Selector{
+		uploadselection.SelectByID{fast, slow},
+		uploadselection.SelectBySubnetFromNodes([]*uploadselection.Node{fast, slow}),
+	} {
+		selectedCount := map[storj.NodeID]int{}
+		for i := 0; i < executionCount; i++ {
+			selected := selector.Select(1, uploadselection.Criteria{})
+			require.Len(t, selected, 1)
+			selectedCount[selected[0].ID]++
+		}
+
+		// the fast node is selected when it comes first, or when the slow node
+		// comes first and is passed over with the probability of 1/2.
+		const selectionEpsilon = 0.05
+		assert.InDelta(t, 0.75, float64(selectedCount[fast.ID])/executionCount, selectionEpsilon)
+		assert.InDelta(t, 0.25, float64(selectedCount[slow.ID])/executionCount, selectionEpsilon)
+
+		// passed over nodes are still selected when there aren't enough nodes
+		for i := 0; i < 100; i++ {
+			require.Len(t, selector.Select(2, uploadselection.Criteria{}), 2)
+		}
+	}
+}
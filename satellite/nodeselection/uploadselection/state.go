@@ -23,6 +23,8 @@ type State struct {
 	stats Stats
 	// netByID returns subnet based on storj.NodeID
 	netByID map[storj.NodeID]string
+	// domainsByID returns failure domains based on storj.NodeID
+	domainsByID map[storj.NodeID][]string
 	// nonDistinct contains selectors for non-distinct selection.
 	nonDistinct struct {
 		Reputable SelectByID
@@ -58,11 +60,14 @@ func NewState(reputableNodes, newNodes []*Node) *State {
 	state := &State{}
 
 	state.netByID = map[storj.NodeID]string{}
+	state.domainsByID = map[storj.NodeID][]string{}
 	for _, node := range reputableNodes {
 		state.netByID[node.ID] = node.LastNet
+		state.domainsByID[node.ID] = node.FailureDomains()
 	}
 	for _, node := range newNodes {
 		state.netByID[node.ID] = node.LastNet
+		state.domainsByID[node.ID] = node.FailureDomains()
 	}
 
 	state.nonDistinct.Reputable = SelectByID(reputableNodes)
@@ -89,7 +94,11 @@ type Request struct {
 	Distinct             bool
 	ExcludedIDs          []storj.NodeID
 	Placement            storj.PlacementConstraint
+	PlacementRules       *PlacementRules
 	ExcludedCountryCodes []string
+	// MaxPerFailureDomain limits the number of nodes per failure domain,
+	// including the excluded nodes, zero means no limit.
+	MaxPerFailureDomain int
 }
 
 // Select selects requestedCount nodes where there will be newFraction nodes.
@@ -118,6 +127,17 @@ func (state *State) Select(ctx context.Context, request Request) (_ []*Node, err
 	}
 
 	criteria.Placement = request.Placement
+	criteria.PlacementRules = request.PlacementRules
+
+	if request.MaxPerFailureDomain > 0 {
+		criteria.MaxPerFailureDomain = request.MaxPerFailureDomain
+		criteria.FailureDomains = make(map[string]int)
+		for _, id := range request.ExcludedIDs {
+			for _, domain := range state.domainsByID[id] {
+				criteria.FailureDomains[domain]++
+			}
+		}
+	}
 
 	if request.Distinct {
 		criteria.AutoExcludeSubnets = make(map[string]struct{})
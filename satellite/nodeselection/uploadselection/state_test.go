@@ -203,3 +203,61 @@ next:
 
 	return xs
 }
+
+func TestState_Select_FailureDomains(t *testing.T) {
+	ctx := testcontext.New(t)
+	defer ctx.Cleanup()
+
+	operatorNodes := createRandomNodes(5, "1.0.1")
+	for _, node := range operatorNodes {
+		node.Wallet = "0xabc"
+	}
+	otherNodes := createRandomNodes(5, "1.0.2")
+	for i, node := range otherNodes {
+		node.Wallet = "0x" + strconv.Itoa(i)
+	}
+
+	state := uploadselection.NewState(joinNodes(operatorNodes, otherNodes), nil)
+
+	{ // at most two nodes of the operator are selected
+		selected, err := state.Select(ctx, uploadselection.Request{
+			Count:               7,
+			MaxPerFailureDomain: 2,
+		})
+		require.NoError(t, err)
+		require.Len(t, selected, 7)
+		require.Len(t, intersectLists(selected, operatorNodes), 2)
+	}
+
+	{ // the excluded nodes count towards the limit
+		selected, err := state.Select(ctx, uploadselection.Request{
+			Count:               5,
+			ExcludedIDs:         []storj.NodeID{operatorNodes[0].ID, operatorNodes[1].ID},
+			MaxPerFailureDomain: 2,
+		})
+		require.NoError(t, err)
+		require.Len(t, selected, 5)
+		require.Empty(t, intersectLists(selected, operatorNodes))
+	}
+
+	{ // not enough nodes when the limit is too strict
+		selected, err := state.Select(ctx, uploadselection.Request{
+			Count:               8,
+			MaxPerFailureDomain: 1,
+		})
+		require.Error(t, err)
+		require.Len(t, selected, 6)
+	}
+}
+
+func TestFailureDomainLimit(t *testing.T) {
+	require.Equal(t, 0, uploadselection.FailureDomainLimit(0, 110))
+	require.Equal(t, 0, uploadselection.FailureDomainLimit(1, 110))
+	require.Equal(t, 0, uploadselection.FailureDomainLimit(0.2, 0))
+	require.Equal(t, 22, uploadselection.FailureDomainLimit(0.2, 110))
+	require.Equal(t, 1, uploadselection.FailureDomainLimit(0.2, 4))
+
+	require.Equal(t, []string{"email:operator@mail.test", "wallet:0xabc", "asn:64500"},
+		uploadselection.FailureDomains(" Operator@Mail.test", "0xABC", 64500))
+	require.Empty(t, uploadselection.FailureDomains("", "", 0))
+}
@@ -0,0 +1,31 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package uploadselection
+
+import (
+	"time"
+
+	"storj.io/common/storj"
+)
+
+// NodeTag is a tag declared for a node, signed by an identity of the node
+// operator.
+type NodeTag struct {
+	NodeID   storj.NodeID
+	Signer   storj.NodeID
+	SignedAt time.Time
+	Name     string
+	Value    string
+}
+
+// NodeTags are the tags of a node.
+type NodeTags []NodeTag
+
+// Clone returns a copy of the tags.
+func (tags NodeTags) Clone() NodeTags {
+	if tags == nil {
+		return nil
+	}
+	return append(NodeTags(nil), tags...)
+}
@@ -0,0 +1,42 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package uploadselection
+
+import (
+	mathrand "math/rand" // Using mathrand here because crypto-graphic randomness is not required and simplifies code.
+)
+
+// Weighted selection
+//
+// The selectors go through the nodes in a random order and pass over a node
+// with the probability of its weight relative to the highest weight. The
+// passed over nodes are only selected when there aren't enough other nodes.
+// This keeps the selection cheap, and the bias is bounded: a node is accepted
+// in the first pass with at least the ratio of the lowest and the highest
+// weight, so that nodes with a low weight still get selected.
+
+// maxWeight returns the highest weight of the nodes.
+func maxWeight(nodes []*Node) float64 {
+	var max float64
+	for _, node := range nodes {
+		if node.Weight > max {
+			max = node.Weight
+		}
+	}
+	return max
+}
+
+// maxWeight returns the highest weight of the nodes in the subnet.
+func (subnet *Subnet) maxWeight() float64 {
+	return maxWeight(subnet.Nodes)
+}
+
+// acceptWeight returns whether a node with the weight is accepted in the
+// first selection pass.
+func acceptWeight(weight, maxWeight float64) bool {
+	if weight <= 0 || weight >= maxWeight {
+		return true
+	}
+	return mathrand.Float64()*maxWeight < weight
+}
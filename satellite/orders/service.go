@@ -510,6 +510,81 @@ func (service *Service) CreatePutRepairOrderLimits(ctx context.Context, bucket m
 	return limits, signer.PrivateKey, nil
 }
 
+// CreateCopyRepairOrderLimits creates the order limits for copying pieces of
+// segment to newNodes, keeping their piece numbers. The piece pieces[i] is
+// downloaded with the GET_REPAIR order limit and uploaded to newNodes[i] with
+// the PUT_REPAIR order limit of the same piece number.
+//
+// The length of the returned orders slices is the total number of pieces of
+// the segment, setting to null the ones which don't correspond to a piece to
+// copy. The pieces held by nodes which aren't online are skipped.
+func (service *Service) CreateCopyRepairOrderLimits(ctx context.Context, bucket metabase.BucketLocation, segment metabase.Segment, pieces metabase.Pieces, newNodes []*overlay.SelectedNode) (getLimits []*pb.AddressedOrderLimit, getPrivateKey storj.PiecePrivateKey, putLimits []*pb.AddressedOrderLimit, putPrivateKey storj.PiecePrivateKey, cachedNodesInfo map[storj.NodeID]overlay.NodeReputation, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if len(newNodes) < len(pieces) {
+		return nil, storj.PiecePrivateKey{}, nil, storj.PiecePrivateKey{}, nil, Error.New("not enough new nodes: got %d, required %d", len(newNodes), len(pieces))
+	}
+
+	redundancy, err := eestream.NewRedundancyStrategyFromStorj(segment.Redundancy)
+	if err != nil {
+		return nil, storj.PiecePrivateKey{}, nil, storj.PiecePrivateKey{}, nil, Error.Wrap(err)
+	}
+
+	pieceSize := eestream.CalcPieceSize(int64(segment.EncryptedSize), redundancy)
+	totalPieces := redundancy.TotalCount()
+
+	nodeIDs := make([]storj.NodeID, len(pieces))
+	for i, piece := range pieces {
+		nodeIDs[i] = piece.StorageNode
+	}
+
+	nodes, err := service.overlay.GetOnlineNodesForAuditRepair(ctx, nodeIDs)
+	if err != nil {
+		service.log.Debug("error getting nodes from overlay", zap.Error(err))
+		return nil, storj.PiecePrivateKey{}, nil, storj.PiecePrivateKey{}, nil, Error.Wrap(err)
+	}
+
+	expirationDate := time.Time{}
+	if segment.ExpiresAt != nil {
+		expirationDate = *segment.ExpiresAt
+	}
+
+	getSigner, err := NewSignerRepairGet(service, segment.RootPieceID, time.Now(), pieceSize, bucket)
+	if err != nil {
+		return nil, storj.PiecePrivateKey{}, nil, storj.PiecePrivateKey{}, nil, Error.Wrap(err)
+	}
+	putSigner, err := NewSignerRepairPut(service, segment.RootPieceID, expirationDate, time.Now(), pieceSize, bucket)
+	if err != nil {
+		return nil, storj.PiecePrivateKey{}, nil, storj.PiecePrivateKey{}, nil, Error.Wrap(err)
+	}
+
+	cachedNodesInfo = make(map[storj.NodeID]overlay.NodeReputation, len(pieces))
+	getLimits = make([]*pb.AddressedOrderLimit, totalPieces)
+	putLimits = make([]*pb.AddressedOrderLimit, totalPieces)
+	for i, piece := range pieces {
+		if int(piece.Number) >= totalPieces {
+			return nil, storj.PiecePrivateKey{}, nil, storj.PiecePrivateKey{}, nil, Error.New("piece num greater than total pieces: %d >= %d", piece.Number, totalPieces)
+		}
+
+		node, ok := nodes[piece.StorageNode]
+		if !ok {
+			continue
+		}
+		cachedNodesInfo[piece.StorageNode] = *node
+
+		getLimits[piece.Number], err = getSigner.Sign(ctx, resolveStorageNode_Reputation(node), int32(piece.Number))
+		if err != nil {
+			return nil, storj.PiecePrivateKey{}, nil, storj.PiecePrivateKey{}, nil, Error.Wrap(err)
+		}
+		putLimits[piece.Number], err = putSigner.Sign(ctx, resolveStorageNode_Selected(newNodes[i], false), int32(piece.Number))
+		if err != nil {
+			return nil, storj.PiecePrivateKey{}, nil, storj.PiecePrivateKey{}, nil, Error.Wrap(err)
+		}
+	}
+
+	return getLimits, getSigner.PrivateKey, putLimits, putSigner.PrivateKey, cachedNodesInfo, nil
+}
+
 // CreateGracefulExitPutOrderLimit creates an order limit for graceful exit put transfers.
 func (service *Service) CreateGracefulExitPutOrderLimit(ctx context.Context, bucket metabase.BucketLocation, nodeID storj.NodeID, pieceNum int32, rootPieceID storj.PieceID, shareSize int32) (limit *pb.AddressedOrderLimit, _ storj.PiecePrivateKey, err error) {
 	defer mon.Task()(&ctx)(&err)
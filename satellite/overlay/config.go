@@ -27,6 +27,7 @@ type Config struct {
 	NodeCheckInWaitPeriod           time.Duration `help:"the amount of time to wait before accepting a redundant check-in from a node (unmodified info since last check-in)" default:"2h" testDefault:"30s"`
 	NodeSoftwareUpdateEmailCooldown time.Duration `help:"the amount of time to wait between sending Node Software Update emails" default:"168h"`
 	RepairExcludedCountryCodes      []string      `help:"list of country codes to exclude nodes from target repair selection" default:"" testDefault:"FR,BE"`
+	Placement                       string        `help:"placement rules as placement:expression definitions separated by semicolons, or the path to a file containing them" default:""`
 	SendNodeEmails                  bool          `help:"whether to send emails to nodes" default:"false"`
 }
 
@@ -48,11 +49,16 @@ type NodeSelectionConfig struct {
 	AsOfSystemTime AsOfSystemTimeConfig
 
 	UploadExcludedCountryCodes []string `help:"list of country codes to exclude from node selection for uploads" default:"" testDefault:"FR,BE"`
+
+	MaxFailureDomainShare float64 `help:"the maximum share of the pieces of a segment that nodes of a single operator wallet, operator email or ASN may hold, 0 disables the limit" default:"0"`
+
+	Performance NodePerformanceConfig
 }
 
 // GeoIPConfig is a configuration struct that helps configure the GeoIP lookup features on the satellite.
 type GeoIPConfig struct {
 	DB            string   `help:"the location of the maxmind database containing geoip country information"`
+	ASNDB         string   `help:"the location of the maxmind database containing geoip autonomous system information"`
 	MockCountries []string `help:"a mock list of countries the satellite will attribute to nodes (useful for testing)"`
 }
 
@@ -0,0 +1,251 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package overlay
+
+import (
+	"context"
+	"math"
+	"sync"
+	"time"
+
+	"github.com/zeebo/errs"
+
+	"storj.io/common/storj"
+)
+
+// NodePerformanceConfig is a configuration struct for the performance weighted
+// node selection.
+type NodePerformanceConfig struct {
+	Enabled       bool          `help:"prefer nodes with a higher transfer success rate and a lower latency in upload selection" default:"false"`
+	HalfLife      time.Duration `help:"how long it takes for a transfer observation to lose half of its weight in the node performance score" default:"24h0m0s"`
+	MaxBias       float64       `help:"how much more likely the best performing nodes are selected than the worst performing ones, 1 means twice as likely" default:"1"`
+	TargetLatency time.Duration `help:"the transfer latency up to which nodes are not penalized in the node performance score" default:"250ms"`
+	FlushInterval time.Duration `help:"how often the transfer observations are added to the node performance statistics in the database" default:"1m0s"`
+}
+
+func (config *NodePerformanceConfig) isValid() error {
+	if config.HalfLife <= 0 {
+		return errs.New("node performance half life must be positive")
+	}
+	if config.MaxBias < 0 || math.IsInf(config.MaxBias, 0) || math.IsNaN(config.MaxBias) {
+		return errs.New("node performance max bias must be a non-negative number")
+	}
+	if config.TargetLatency <= 0 {
+		return errs.New("node performance target latency must be positive")
+	}
+	if config.FlushInterval <= 0 {
+		return errs.New("node performance flush interval must be positive")
+	}
+	return nil
+}
+
+// NodePerformanceDB stores the transfer statistics of the nodes, aggregated
+// over all satellite processes.
+//
+// architecture: Database
+type NodePerformanceDB interface {
+	// UpdateNodePerformance decays the stored statistics of the nodes to the
+	// update time of the given statistics, and adds the given statistics.
+	UpdateNodePerformance(ctx context.Context, stats []NodeTransferStats, halfLife time.Duration) error
+	// GetNodePerformance returns the stored statistics of the nodes.
+	GetNodePerformance(ctx context.Context) ([]NodeTransferStats, error)
+}
+
+// TransferObservation is the outcome of a transfer with a node. A zero
+// latency means that the latency of the transfer is unknown.
+type TransferObservation struct {
+	NodeID  storj.NodeID
+	Success bool
+	Latency time.Duration
+}
+
+// NodeTransferStats contains the transfer statistics of a node, decayed
+// exponentially to UpdatedAt.
+type NodeTransferStats struct {
+	NodeID    storj.NodeID
+	UpdatedAt time.Time
+	Attempts  float64
+	Successes float64
+	// Latencies is the number of successful transfers with a known latency.
+	Latencies float64
+	// Latency is the sum of the known latencies in seconds.
+	Latency float64
+}
+
+// minObservations is the decayed number of observations below which a node
+// isn't tracked anymore.
+const minObservations = 0.01
+
+// NodePerformance keeps a score of the nodes based on the success and the
+// latency of the recent transfers with them. Older observations are decayed
+// exponentially.
+//
+// The observations are collected in memory, and periodically added to the
+// statistics in the database, so that the observations of all satellite
+// processes are taken into account.
+//
+// The score of a node is between 0 and 1. Nodes without observations, e.g.
+// new nodes, get the neutral score of 0.5.
+type NodePerformance struct {
+	db     NodePerformanceDB
+	config NodePerformanceConfig
+
+	mu      sync.Mutex
+	pending map[storj.NodeID]*NodeTransferStats
+}
+
+// NewNodePerformance creates a new node performance tracker.
+func NewNodePerformance(db NodePerformanceDB, config NodePerformanceConfig) *NodePerformance {
+	return &NodePerformance{
+		db:      db,
+		config:  config,
+		pending: make(map[storj.NodeID]*NodeTransferStats),
+	}
+}
+
+// Enabled returns whether the node performance is tracked and used for node
+// selection.
+func (performance *NodePerformance) Enabled() bool {
+	return performance != nil && performance.config.Enabled
+}
+
+// Observe records the outcome of the transfers with the nodes, until they
+// are flushed to the database.
+func (performance *NodePerformance) Observe(observations []TransferObservation, now time.Time) {
+	if !performance.Enabled() || len(observations) == 0 {
+		return
+	}
+
+	performance.mu.Lock()
+	defer performance.mu.Unlock()
+
+	for _, observation := range observations {
+		stats, ok := performance.pending[observation.NodeID]
+		if !ok {
+			stats = &NodeTransferStats{NodeID: observation.NodeID, UpdatedAt: now}
+			performance.pending[observation.NodeID] = stats
+		}
+		stats.add(observation)
+	}
+}
+
+// Flush adds the collected observations to the statistics in the database.
+// When that fails, the observations are kept for the next flush.
+func (performance *NodePerformance) Flush(ctx context.Context, now time.Time) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if !performance.Enabled() {
+		return nil
+	}
+
+	performance.mu.Lock()
+	pending := performance.pending
+	performance.pending = make(map[storj.NodeID]*NodeTransferStats, len(pending))
+	performance.mu.Unlock()
+
+	if len(pending) == 0 {
+		return nil
+	}
+
+	stats := make([]NodeTransferStats, 0, len(pending))
+	for _, nodeStats := range pending {
+		nodeStats.UpdatedAt = now
+		stats = append(stats, *nodeStats)
+	}
+
+	err = performance.db.UpdateNodePerformance(ctx, stats, performance.config.HalfLife)
+	if err != nil {
+		performance.mu.Lock()
+		for id, nodeStats := range pending {
+			if current, ok := performance.pending[id]; ok {
+				nodeStats.merge(current)
+			}
+			performance.pending[id] = nodeStats
+		}
+		performance.mu.Unlock()
+		return Error.Wrap(err)
+	}
+	return nil
+}
+
+// Weights returns the selection weights of the nodes with recent statistics in
+// the database, and the weight of the other nodes. The weights are between 1
+// and 1+MaxBias.
+func (performance *NodePerformance) Weights(ctx context.Context, now time.Time) (weights map[storj.NodeID]float64, defaultWeight float64, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if !performance.Enabled() {
+		return nil, 1, nil
+	}
+
+	stats, err := performance.db.GetNodePerformance(ctx)
+	if err != nil {
+		return nil, 1, Error.Wrap(err)
+	}
+
+	weights = make(map[storj.NodeID]float64, len(stats))
+	for i := range stats {
+		nodeStats := &stats[i]
+		nodeStats.Decay(now, performance.config.HalfLife)
+		if nodeStats.Attempts < minObservations {
+			continue
+		}
+		weights[nodeStats.NodeID] = performance.weight(nodeStats.Score(performance.config.TargetLatency))
+	}
+
+	return weights, performance.weight(0.5), nil
+}
+
+// weight converts a score to a selection weight.
+func (performance *NodePerformance) weight(score float64) float64 {
+	return 1 + performance.config.MaxBias*score
+}
+
+// add adds the observation to the statistics.
+func (stats *NodeTransferStats) add(observation TransferObservation) {
+	stats.Attempts++
+	if observation.Success {
+		stats.Successes++
+		if observation.Latency > 0 {
+			stats.Latencies++
+			stats.Latency += observation.Latency.Seconds()
+		}
+	}
+}
+
+// merge adds the other statistics, decayed to the same time, to the
+// statistics.
+func (stats *NodeTransferStats) merge(other *NodeTransferStats) {
+	stats.Attempts += other.Attempts
+	stats.Successes += other.Successes
+	stats.Latencies += other.Latencies
+	stats.Latency += other.Latency
+}
+
+// Decay decays the statistics to the time now.
+func (stats *NodeTransferStats) Decay(now time.Time, halfLife time.Duration) {
+	elapsed := now.Sub(stats.UpdatedAt)
+	if elapsed <= 0 {
+		return
+	}
+	factor := math.Exp2(-float64(elapsed) / float64(halfLife))
+	stats.Attempts *= factor
+	stats.Successes *= factor
+	stats.Latencies *= factor
+	stats.Latency *= factor
+	stats.UpdatedAt = now
+}
+
+// Score returns the success rate, smoothed towards 0.5 for nodes with few
+// observations, penalized by how much the average latency exceeds the target.
+func (stats *NodeTransferStats) Score(targetLatency time.Duration) float64 {
+	score := (stats.Successes + 1) / (stats.Attempts + 2)
+	if stats.Latencies >= minObservations && stats.Latency > 0 {
+		average := stats.Latency / stats.Latencies
+		if target := targetLatency.Seconds(); average > target {
+			score *= target / average
+		}
+	}
+	return score
+}
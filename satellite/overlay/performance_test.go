@@ -0,0 +1,162 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package overlay_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"storj.io/common/storj"
+	"storj.io/common/testcontext"
+	"storj.io/common/testrand"
+	"storj.io/storj/satellite/overlay"
+)
+
+func TestNodePerformance(t *testing.T) {
+	config := overlay.NodePerformanceConfig{
+		Enabled:       true,
+		HalfLife:      time.Hour,
+		MaxBias:       1,
+		TargetLatency: 100 * time.Millisecond,
+		FlushInterval: time.Minute,
+	}
+	now := time.Date(2023, 3, 1, 0, 0, 0, 0, time.UTC)
+
+	t.Run("disabled", func(t *testing.T) {
+		ctx := testcontext.New(t)
+		db := newPerformanceDB()
+		performance := overlay.NewNodePerformance(db, overlay.NodePerformanceConfig{})
+		require.False(t, performance.Enabled())
+
+		performance.Observe([]overlay.TransferObservation{{NodeID: testrand.NodeID()}}, now)
+		require.NoError(t, performance.Flush(ctx, now))
+		require.Empty(t, db.stats)
+
+		weights, defaultWeight, err := performance.Weights(ctx, now)
+		require.NoError(t, err)
+		require.Empty(t, weights)
+		require.Equal(t, 1.0, defaultWeight)
+	})
+
+	t.Run("score", func(t *testing.T) {
+		fast := overlay.NodeTransferStats{UpdatedAt: now, Attempts: 8, Successes: 8, Latencies: 8, Latency: 8 * 0.05}
+		slow := overlay.NodeTransferStats{UpdatedAt: now, Attempts: 8, Successes: 8, Latencies: 8, Latency: 8 * 0.2}
+		flaky := overlay.NodeTransferStats{UpdatedAt: now, Attempts: 8, Successes: 4}
+
+		require.InDelta(t, 0.9, fast.Score(config.TargetLatency), 1e-9)
+		require.InDelta(t, 0.45, slow.Score(config.TargetLatency), 1e-9)
+		require.InDelta(t, 0.5, flaky.Score(config.TargetLatency), 1e-9)
+		require.Equal(t, 0.5, (&overlay.NodeTransferStats{}).Score(config.TargetLatency))
+
+		// old observations lose their weight
+		flaky.Decay(now.Add(10*time.Hour), config.HalfLife)
+		require.InDelta(t, 8.0/1024, flaky.Attempts, 1e-9)
+		require.InDelta(t, 4.0/1024, flaky.Successes, 1e-9)
+		require.Equal(t, now.Add(10*time.Hour), flaky.UpdatedAt)
+	})
+
+	t.Run("weights", func(t *testing.T) {
+		ctx := testcontext.New(t)
+		db := newPerformanceDB()
+		good, bad := testrand.NodeID(), testrand.NodeID()
+
+		// the observations of all satellite processes are aggregated.
+		for _, performance := range []*overlay.NodePerformance{
+			overlay.NewNodePerformance(db, config),
+			overlay.NewNodePerformance(db, config),
+		} {
+			var observations []overlay.TransferObservation
+			for i := 0; i < 50; i++ {
+				observations = append(observations,
+					overlay.TransferObservation{NodeID: good, Success: true},
+					overlay.TransferObservation{NodeID: bad, Success: false},
+				)
+			}
+			performance.Observe(observations, now)
+			require.NoError(t, performance.Flush(ctx, now))
+		}
+		require.InDelta(t, 100, db.stats[good].Attempts, 1e-9)
+
+		weights, defaultWeight, err := overlay.NewNodePerformance(db, config).Weights(ctx, now)
+		require.NoError(t, err)
+		require.Equal(t, 1.5, defaultWeight)
+		require.Len(t, weights, 2)
+		require.InDelta(t, 2, weights[good], 0.01)
+		require.InDelta(t, 1, weights[bad], 0.01)
+
+		// nodes without recent observations are ignored
+		weights, _, err = overlay.NewNodePerformance(db, config).Weights(ctx, now.Add(100*time.Hour))
+		require.NoError(t, err)
+		require.Empty(t, weights)
+	})
+
+	t.Run("flush failure", func(t *testing.T) {
+		ctx := testcontext.New(t)
+		db := newPerformanceDB()
+		performance := overlay.NewNodePerformance(db, config)
+		id := testrand.NodeID()
+
+		performance.Observe([]overlay.TransferObservation{{NodeID: id, Success: true}}, now)
+
+		db.err = errors.New("db failure")
+		require.Error(t, performance.Flush(ctx, now))
+		require.Empty(t, db.stats)
+
+		// the observations are kept for the next flush
+		performance.Observe([]overlay.TransferObservation{{NodeID: id, Success: false}}, now)
+		db.err = nil
+		require.NoError(t, performance.Flush(ctx, now))
+		require.InDelta(t, 2, db.stats[id].Attempts, 1e-9)
+		require.InDelta(t, 1, db.stats[id].Successes, 1e-9)
+
+		// flushed observations are not added again
+		require.NoError(t, performance.Flush(ctx, now))
+		require.InDelta(t, 2, db.stats[id].Attempts, 1e-9)
+	})
+}
+
+// performanceDB is an in-memory overlay.NodePerformanceDB.
+type performanceDB struct {
+	stats map[storj.NodeID]overlay.NodeTransferStats
+	err   error
+}
+
+func newPerformanceDB() *performanceDB {
+	return &performanceDB{stats: map[storj.NodeID]overlay.NodeTransferStats{}}
+}
+
+func (db *performanceDB) UpdateNodePerformance(ctx context.Context, stats []overlay.NodeTransferStats, halfLife time.Duration) error {
+	if db.err != nil {
+		return db.err
+	}
+	for _, added := range stats {
+		current, ok := db.stats[added.NodeID]
+		if !ok {
+			db.stats[added.NodeID] = added
+			continue
+		}
+		current.Decay(added.UpdatedAt, halfLife)
+		current.Attempts += added.Attempts
+		current.Successes += added.Successes
+		current.Latencies += added.Latencies
+		current.Latency += added.Latency
+		db.stats[added.NodeID] = current
+	}
+	return nil
+}
+
+func (db *performanceDB) GetNodePerformance(ctx context.Context) ([]overlay.NodeTransferStats, error) {
+	if db.err != nil {
+		return nil, db.err
+	}
+	stats := make([]overlay.NodeTransferStats, 0, len(db.stats))
+	for _, nodeStats := range db.stats {
+		stats = append(stats, nodeStats)
+	}
+	return stats, nil
+}
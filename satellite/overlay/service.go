@@ -12,6 +12,7 @@ import (
 	"github.com/zeebo/errs"
 	"go.uber.org/zap"
 
+	"storj.io/common/context2"
 	"storj.io/common/pb"
 	"storj.io/common/storj"
 	"storj.io/common/storj/location"
@@ -21,6 +22,7 @@ import (
 	"storj.io/storj/satellite/mailservice"
 	"storj.io/storj/satellite/metabase"
 	"storj.io/storj/satellite/nodeevents"
+	"storj.io/storj/satellite/nodeselection/uploadselection"
 )
 
 // ErrEmptyNode is returned when the nodeID is empty.
@@ -70,12 +72,23 @@ type DB interface {
 	KnownReliable(ctx context.Context, onlineWindow time.Duration, nodeIDs storj.NodeIDList) ([]*pb.Node, error)
 	// Reliable returns all nodes that are reliable
 	Reliable(context.Context, *NodeCriteria) (storj.NodeIDList, error)
+	// ReliableNodes returns all nodes that are reliable, with their failure domain attributes.
+	ReliableNodes(context.Context, *NodeCriteria) ([]*SelectedNode, error)
+	// KnownReliableNodes filters a set of nodes to reliable (online and qualified) nodes, with their placement attributes.
+	KnownReliableNodes(ctx context.Context, onlineWindow time.Duration, nodeIDs storj.NodeIDList) ([]*SelectedNode, error)
 	// UpdateReputation updates the DB columns for all reputation fields in ReputationStatus.
 	UpdateReputation(ctx context.Context, id storj.NodeID, request ReputationUpdate) error
 	// UpdateNodeInfo updates node dossier with info requested from the node itself like node type, email, wallet, capacity, and version.
 	UpdateNodeInfo(ctx context.Context, node storj.NodeID, nodeInfo *InfoResponse) (stats *NodeDossier, err error)
 	// UpdateCheckIn updates a single storagenode's check-in stats.
 	UpdateCheckIn(ctx context.Context, node NodeCheckInInfo, timestamp time.Time, config NodeSelectionConfig) (err error)
+	// UpdateNodeTags replaces the tags of the node.
+	UpdateNodeTags(ctx context.Context, nodeID storj.NodeID, tags uploadselection.NodeTags) error
+	// GetNodeTags returns the tags of the node.
+	GetNodeTags(ctx context.Context, nodeID storj.NodeID) (uploadselection.NodeTags, error)
+
+	NodePerformanceDB
+
 	// SetNodeContained updates the contained field for the node record.
 	SetNodeContained(ctx context.Context, node storj.NodeID, contained bool) (err error)
 	// SetAllContainedNodes updates the contained field for all nodes, as necessary.
@@ -161,6 +174,7 @@ type NodeCheckInInfo struct {
 	Capacity                *pb.NodeCapacity
 	Version                 *pb.NodeVersion
 	CountryCode             location.CountryCode
+	ASN                     uint32
 	SoftwareUpdateEmailSent bool
 	VersionBelowMin         bool
 }
@@ -253,6 +267,7 @@ type NodeDossier struct {
 	LastOfflineEmail        *time.Time
 	LastSoftwareUpdateEmail *time.Time
 	CountryCode             location.CountryCode
+	ASN                     uint32
 }
 
 // NodeStats contains statistics about a node.
@@ -279,6 +294,14 @@ type SelectedNode struct {
 	LastNet     string
 	LastIPPort  string
 	CountryCode location.CountryCode
+	Email       string
+	Wallet      string
+	ASN         uint32
+	Version     version.SemVer
+	Tags        uploadselection.NodeTags
+	// Reliability is the product of the online and the audit score of the
+	// node. It's only loaded for the reliable nodes.
+	Reliability float64
 }
 
 // NodeReputation is used as a result for creating orders limits for audits.
@@ -294,13 +317,29 @@ type NodeReputation struct {
 func (node *SelectedNode) Clone() *SelectedNode {
 	copy := pb.CopyNode(&pb.Node{Id: node.ID, Address: node.Address})
 	return &SelectedNode{
-		ID:         copy.Id,
-		Address:    copy.Address,
-		LastNet:    node.LastNet,
-		LastIPPort: node.LastIPPort,
+		ID:          copy.Id,
+		Address:     copy.Address,
+		LastNet:     node.LastNet,
+		LastIPPort:  node.LastIPPort,
+		Email:       node.Email,
+		Wallet:      node.Wallet,
+		ASN:         node.ASN,
+		Version:     node.Version,
+		Tags:        node.Tags.Clone(),
+		Reliability: node.Reliability,
 	}
 }
 
+// MatchFilter returns whether the filter includes the selected node.
+func (node *SelectedNode) MatchFilter(filter uploadselection.NodeFilter) bool {
+	return filter.MatchInclude(convSelectedNodeToNode(node))
+}
+
+// FailureDomains returns the failure domains of the selected node.
+func (node *SelectedNode) FailureDomains() []string {
+	return uploadselection.FailureDomains(node.Email, node.Wallet, node.ASN)
+}
+
 // Service is used to store and handle node information.
 //
 // architecture: Service
@@ -314,6 +353,8 @@ type Service struct {
 	config           Config
 
 	GeoIP                  geoip.IPToCountry
+	ASN                    geoip.IPToASN
+	PlacementRules         *uploadselection.PlacementRules
 	UploadSelectionCache   *UploadSelectionCache
 	DownloadSelectionCache *DownloadSelectionCache
 }
@@ -324,6 +365,16 @@ func NewService(log *zap.Logger, db DB, nodeEvents nodeevents.DB, mailService *m
 	if err != nil {
 		return nil, errs.Wrap(err)
 	}
+	if config.Node.Performance.Enabled {
+		if err := config.Node.Performance.isValid(); err != nil {
+			return nil, errs.Wrap(err)
+		}
+	}
+
+	placementRules, err := uploadselection.LoadPlacementRules(config.Placement)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
 
 	var geoIP geoip.IPToCountry = geoip.NewMockIPToCountry(config.GeoIP.MockCountries)
 	if config.GeoIP.DB != "" {
@@ -333,12 +384,21 @@ func NewService(log *zap.Logger, db DB, nodeEvents nodeevents.DB, mailService *m
 		}
 	}
 
+	var asn geoip.IPToASN = geoip.MockIPToASN{}
+	if config.GeoIP.ASNDB != "" {
+		asn, err = geoip.OpenMaxmindDB(config.GeoIP.ASNDB)
+		if err != nil {
+			return nil, errs.Combine(Error.Wrap(err), geoIP.Close())
+		}
+	}
+
 	uploadSelectionCache, err := NewUploadSelectionCache(log, db,
 		config.NodeSelectionCache.Staleness, config.Node,
 	)
 	if err != nil {
 		return nil, errs.Wrap(err)
 	}
+	uploadSelectionCache.PlacementRules = placementRules
 	downloadSelectionCache, err := NewDownloadSelectionCache(log, db, DownloadSelectionCacheConfig{
 		Staleness:      config.NodeSelectionCache.Staleness,
 		OnlineWindow:   config.Node.OnlineWindow,
@@ -358,6 +418,9 @@ func NewService(log *zap.Logger, db DB, nodeEvents nodeevents.DB, mailService *m
 		config:           config,
 
 		GeoIP: geoIP,
+		ASN:   asn,
+
+		PlacementRules: placementRules,
 
 		UploadSelectionCache:   uploadSelectionCache,
 		DownloadSelectionCache: downloadSelectionCache,
@@ -369,12 +432,36 @@ func (service *Service) Run(ctx context.Context) error {
 	return errs.Combine(sync2.Concurrently(
 		func() error { return service.UploadSelectionCache.Run(ctx) },
 		func() error { return service.DownloadSelectionCache.Run(ctx) },
+		func() error { return service.runPerformanceFlush(ctx) },
 	)...)
 }
 
+// runPerformanceFlush periodically adds the collected transfer observations to
+// the node performance statistics in the database.
+func (service *Service) runPerformanceFlush(ctx context.Context) error {
+	performance := service.UploadSelectionCache.Performance
+	if !performance.Enabled() {
+		return nil
+	}
+
+	flush := func(ctx context.Context) {
+		if err := performance.Flush(ctx, time.Now()); err != nil {
+			service.log.Warn("failed to flush node performance", zap.Error(err))
+		}
+	}
+
+	err := sync2.NewCycle(service.config.Node.Performance.FlushInterval).Run(ctx, func(ctx context.Context) error {
+		flush(ctx)
+		return nil
+	})
+	// keep the observations collected since the last flush.
+	flush(context2.WithoutCancellation(ctx))
+	return err
+}
+
 // Close closes resources.
 func (service *Service) Close() error {
-	return service.GeoIP.Close()
+	return errs.Combine(service.GeoIP.Close(), service.ASN.Close())
 }
 
 // Get looks up the provided nodeID from the overlay.
@@ -585,6 +672,56 @@ func (service *Service) Reliable(ctx context.Context) (nodes storj.NodeIDList, e
 	return service.db.Reliable(ctx, criteria)
 }
 
+// ReliableNodes returns the reliable nodes with their failure domain
+// attributes, using the same criteria as Reliable.
+func (service *Service) ReliableNodes(ctx context.Context) (nodes []*SelectedNode, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	criteria := &NodeCriteria{
+		OnlineWindow: service.config.Node.OnlineWindow,
+	}
+	criteria.ExcludedCountries = service.config.RepairExcludedCountryCodes
+	return service.db.ReliableNodes(ctx, criteria)
+}
+
+// ObserveTransfers records the outcome of transfers with the nodes for the
+// performance weighted node selection.
+func (service *Service) ObserveTransfers(observations []TransferObservation) {
+	service.UploadSelectionCache.Performance.Observe(observations, time.Now())
+}
+
+// MatchPlacement returns whether the node may store pieces of segments with
+// the placement.
+func (service *Service) MatchPlacement(placement storj.PlacementConstraint, node *SelectedNode) bool {
+	return service.PlacementRules.Match(placement, convSelectedNodeToNode(node))
+}
+
+// NodePlacements returns the defined placements which the node matches.
+func (service *Service) NodePlacements(node *SelectedNode) (placements []storj.PlacementConstraint) {
+	converted := convSelectedNodeToNode(node)
+	for _, placement := range service.PlacementRules.Placements() {
+		if service.PlacementRules.Match(placement, converted) {
+			placements = append(placements, placement)
+		}
+	}
+	return placements
+}
+
+// IsPlacementDefined returns whether the placement is defined by the
+// placement rules. Segments with an undefined placement are not moved to
+// other nodes, since no node matches their placement.
+func (service *Service) IsPlacementDefined(placement storj.PlacementConstraint) bool {
+	_, ok := service.PlacementRules.Definition(placement)
+	return ok
+}
+
+// FailureDomainLimit returns the maximum number of pieces that a single
+// failure domain may hold of a segment with the given number of pieces, zero
+// means that there is no limit.
+func (service *Service) FailureDomainLimit(pieces int) int {
+	return uploadselection.FailureDomainLimit(service.config.Node.MaxFailureDomainShare, pieces)
+}
+
 // UpdateReputation updates the DB columns for any of the reputation fields.
 func (service *Service) UpdateReputation(ctx context.Context, id storj.NodeID, email string, request ReputationUpdate, reputationChanges []nodeevents.Type) (err error) {
 	defer mon.Task()(&ctx)(&err)
@@ -653,6 +790,7 @@ func (service *Service) UpdateCheckIn(ctx context.Context, node NodeCheckInInfo,
 				zap.Stringer("Node ID", node.NodeID),
 				zap.Error(err))
 		}
+		node.ASN = service.lookupASN(node)
 
 		return service.db.UpdateCheckIn(ctx, node, timestamp, service.config.Node)
 	}
@@ -690,6 +828,12 @@ func (service *Service) UpdateCheckIn(ctx context.Context, node NodeCheckInInfo,
 		node.CountryCode = oldInfo.CountryCode
 	}
 
+	if oldInfo.ASN == 0 || oldInfo.LastIPPort != node.LastIPPort {
+		node.ASN = service.lookupASN(node)
+	} else {
+		node.ASN = oldInfo.ASN
+	}
+
 	if service.config.SendNodeEmails && service.config.Node.MinimumVersion != "" {
 		min, err := version.NewSemVer(service.config.Node.MinimumVersion)
 		if err != nil {
@@ -716,7 +860,7 @@ func (service *Service) UpdateCheckIn(ctx context.Context, node NodeCheckInInfo,
 
 	if dbStale || addrChanged || walletChanged || verChanged || spaceChanged ||
 		oldInfo.LastNet != node.LastNet || oldInfo.LastIPPort != node.LastIPPort ||
-		oldInfo.CountryCode != node.CountryCode || node.SoftwareUpdateEmailSent {
+		oldInfo.CountryCode != node.CountryCode || oldInfo.ASN != node.ASN || node.SoftwareUpdateEmailSent {
 		err = service.db.UpdateCheckIn(ctx, node, timestamp, service.config.Node)
 		if err != nil {
 			return Error.Wrap(err)
@@ -737,6 +881,36 @@ func (service *Service) UpdateCheckIn(ctx context.Context, node NodeCheckInInfo,
 	return nil
 }
 
+// UpdateNodeTags replaces the tags of the node.
+func (service *Service) UpdateNodeTags(ctx context.Context, nodeID storj.NodeID, tags uploadselection.NodeTags) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	return Error.Wrap(service.db.UpdateNodeTags(ctx, nodeID, tags))
+}
+
+// GetNodeTags returns the tags of the node.
+func (service *Service) GetNodeTags(ctx context.Context, nodeID storj.NodeID) (_ uploadselection.NodeTags, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	tags, err := service.db.GetNodeTags(ctx, nodeID)
+	return tags, Error.Wrap(err)
+}
+
+// lookupASN returns the autonomous system number of the node, or zero when
+// it can't be resolved.
+func (service *Service) lookupASN(node NodeCheckInInfo) uint32 {
+	asn, err := service.ASN.LookupASN(node.LastIPPort)
+	if err != nil {
+		mon.Meter("asn_lookup_failed").Mark(1)
+		service.log.Debug("failed to resolve autonomous system number for node",
+			zap.String("node address", node.Address.Address),
+			zap.Stringer("Node ID", node.NodeID),
+			zap.Error(err))
+		return 0
+	}
+	return asn
+}
+
 // GetMissingPieces returns the list of offline nodes and the corresponding pieces.
 func (service *Service) GetMissingPieces(ctx context.Context, pieces metabase.Pieces) (missingPieces []uint16, err error) {
 	defer mon.Task()(&ctx)(&err)
@@ -781,6 +955,81 @@ func (service *Service) GetReliablePiecesInExcludedCountries(ctx context.Context
 	return piecesInExcluded, nil
 }
 
+// GetReliablePiecesOutOfPlacement returns the list of pieces held by reliable
+// nodes which don't match the placement.
+func (service *Service) GetReliablePiecesOutOfPlacement(ctx context.Context, pieces metabase.Pieces, placement storj.PlacementConstraint) (piecesOutOfPlacement []uint16, err error) {
+	defer mon.Task()(&ctx)(&err)
+	if !service.IsPlacementDefined(placement) {
+		mon.Meter("placement_undefined").Mark(1)
+		return nil, nil
+	}
+
+	var nodeIDs storj.NodeIDList
+	for _, p := range pieces {
+		nodeIDs = append(nodeIDs, p.StorageNode)
+	}
+	if len(nodeIDs) == 0 {
+		return nil, nil
+	}
+	nodes, err := service.db.KnownReliableNodes(ctx, service.config.Node.OnlineWindow, nodeIDs)
+	if err != nil {
+		return nil, Error.New("error getting nodes %s", err)
+	}
+
+	outOfPlacement := make(map[storj.NodeID]bool, len(nodes))
+	for _, node := range nodes {
+		if !service.MatchPlacement(placement, node) {
+			outOfPlacement[node.ID] = true
+		}
+	}
+
+	for _, p := range pieces {
+		if outOfPlacement[p.StorageNode] {
+			piecesOutOfPlacement = append(piecesOutOfPlacement, p.Number)
+		}
+	}
+	return piecesOutOfPlacement, nil
+}
+
+// GetReliablePiecesInExcessDomains returns the list of pieces held by reliable
+// nodes which exceed the number of pieces a single failure domain may hold of
+// a segment with totalPieces pieces, see checker.ReliabilityCache.ExcessPieces.
+func (service *Service) GetReliablePiecesInExcessDomains(ctx context.Context, pieces metabase.Pieces, totalPieces int) (piecesInExcess []uint16, err error) {
+	defer mon.Task()(&ctx)(&err)
+	limit := service.FailureDomainLimit(totalPieces)
+	if limit == 0 || len(pieces) == 0 {
+		return nil, nil
+	}
+
+	var nodeIDs storj.NodeIDList
+	for _, p := range pieces {
+		nodeIDs = append(nodeIDs, p.StorageNode)
+	}
+	nodes, err := service.db.KnownReliableNodes(ctx, service.config.Node.OnlineWindow, nodeIDs)
+	if err != nil {
+		return nil, Error.New("error getting nodes %s", err)
+	}
+
+	reliable := make(map[storj.NodeID]*SelectedNode, len(nodes))
+	for _, node := range nodes {
+		reliable[node.ID] = node
+	}
+
+	var reliablePieces metabase.Pieces
+	var domains [][]string
+	for _, p := range pieces {
+		if node, ok := reliable[p.StorageNode]; ok {
+			reliablePieces = append(reliablePieces, p)
+			domains = append(domains, node.FailureDomains())
+		}
+	}
+
+	for _, i := range uploadselection.ExcessFailureDomainPieces(domains, limit) {
+		piecesInExcess = append(piecesInExcess, reliablePieces[i].Number)
+	}
+	return piecesInExcess, nil
+}
+
 // DQNodesLastSeenBefore disqualifies nodes who have not been contacted since the cutoff time.
 func (service *Service) DQNodesLastSeenBefore(ctx context.Context, cutoff time.Time, limit int) (count int, err error) {
 	defer mon.Task()(&ctx)(&err)
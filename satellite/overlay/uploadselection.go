@@ -10,6 +10,7 @@ import (
 	"go.uber.org/zap"
 
 	"storj.io/common/pb"
+	"storj.io/common/storj"
 	"storj.io/common/sync2"
 	"storj.io/storj/satellite/nodeselection/uploadselection"
 )
@@ -20,6 +21,8 @@ import (
 type UploadSelectionDB interface {
 	// SelectAllStorageNodesUpload returns all nodes that qualify to store data, organized as reputable nodes and new nodes
 	SelectAllStorageNodesUpload(ctx context.Context, selectionCfg NodeSelectionConfig) (reputable, new []*SelectedNode, err error)
+
+	NodePerformanceDB
 }
 
 // UploadSelectionCacheConfig is a configuration for upload selection cache.
@@ -36,6 +39,13 @@ type UploadSelectionCache struct {
 	db              UploadSelectionDB
 	selectionConfig NodeSelectionConfig
 
+	// Performance tracks the performance of the nodes, which is used to
+	// weight the node selection when enabled.
+	Performance *NodePerformance
+	// PlacementRules define which nodes match the placements, nil means the
+	// default placement rules.
+	PlacementRules *uploadselection.PlacementRules
+
 	cache sync2.ReadCache
 }
 
@@ -45,6 +55,7 @@ func NewUploadSelectionCache(log *zap.Logger, db UploadSelectionDB, staleness ti
 		log:             log,
 		db:              db,
 		selectionConfig: config,
+		Performance:     NewNodePerformance(db, config.Performance),
 	}
 	return cache, cache.cache.Init(staleness/2, staleness, cache.read)
 }
@@ -73,7 +84,24 @@ func (cache *UploadSelectionCache) read(ctx context.Context) (_ interface{}, err
 		return nil, Error.Wrap(err)
 	}
 
-	state := uploadselection.NewState(convSelectedNodesToNodes(reputableNodes), convSelectedNodesToNodes(newNodes))
+	reputable, new := convSelectedNodesToNodes(reputableNodes), convSelectedNodesToNodes(newNodes)
+	if cache.Performance.Enabled() {
+		weights, defaultWeight, err := cache.Performance.Weights(ctx, time.Now())
+		if err != nil {
+			// nodes are selected without weights until the next refresh.
+			cache.log.Warn("failed to get node performance", zap.Error(err))
+		}
+		for _, nodes := range [][]*uploadselection.Node{reputable, new} {
+			for _, node := range nodes {
+				node.Weight = defaultWeight
+				if weight, ok := weights[node.ID]; ok {
+					node.Weight = weight
+				}
+			}
+		}
+	}
+
+	state := uploadselection.NewState(reputable, new)
 
 	mon.IntVal("refresh_cache_size_reputable").Observe(int64(len(reputableNodes)))
 	mon.IntVal("refresh_cache_size_new").Observe(int64(len(newNodes)))
@@ -99,7 +127,9 @@ func (cache *UploadSelectionCache) GetNodes(ctx context.Context, req FindStorage
 		Distinct:             cache.selectionConfig.DistinctIP,
 		ExcludedIDs:          req.ExcludedIDs,
 		Placement:            req.Placement,
+		PlacementRules:       cache.PlacementRules,
 		ExcludedCountryCodes: cache.selectionConfig.UploadExcludedCountryCodes,
+		MaxPerFailureDomain:  uploadselection.FailureDomainLimit(cache.selectionConfig.MaxFailureDomainShare, req.RequestedCount+len(req.ExcludedIDs)),
 	})
 	if uploadselection.ErrNotEnoughNodes.Has(err) {
 		err = ErrNotEnoughNodes.Wrap(err)
@@ -127,6 +157,11 @@ func convNodesToSelectedNodes(nodes []*uploadselection.Node) (xs []*SelectedNode
 			LastNet:     n.LastNet,
 			LastIPPort:  n.LastIPPort,
 			CountryCode: n.CountryCode,
+			Email:       n.Email,
+			Wallet:      n.Wallet,
+			ASN:         n.ASN,
+			Version:     n.Version,
+			Tags:        n.Tags,
 		})
 	}
 	return xs
@@ -134,15 +169,29 @@ func convNodesToSelectedNodes(nodes []*uploadselection.Node) (xs []*SelectedNode
 
 func convSelectedNodesToNodes(nodes []*SelectedNode) (xs []*uploadselection.Node) {
 	for _, n := range nodes {
-		xs = append(xs, &uploadselection.Node{
-			NodeURL: (&pb.Node{
-				Id:      n.ID,
-				Address: n.Address,
-			}).NodeURL(),
-			LastNet:     n.LastNet,
-			LastIPPort:  n.LastIPPort,
-			CountryCode: n.CountryCode,
-		})
+		xs = append(xs, convSelectedNodeToNode(n))
 	}
 	return xs
 }
+
+func convSelectedNodeToNode(n *SelectedNode) *uploadselection.Node {
+	// reliable nodes are loaded without their address
+	nodeURL := storj.NodeURL{ID: n.ID}
+	if n.Address != nil {
+		nodeURL = (&pb.Node{
+			Id:      n.ID,
+			Address: n.Address,
+		}).NodeURL()
+	}
+	return &uploadselection.Node{
+		NodeURL:     nodeURL,
+		LastNet:     n.LastNet,
+		LastIPPort:  n.LastIPPort,
+		CountryCode: n.CountryCode,
+		Email:       n.Email,
+		Wallet:      n.Wallet,
+		ASN:         n.ASN,
+		Version:     n.Version,
+		Tags:        n.Tags,
+	}
+}
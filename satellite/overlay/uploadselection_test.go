@@ -128,6 +128,8 @@ type mockdb struct {
 	callCount int
 	reputable []*overlay.SelectedNode
 	new       []*overlay.SelectedNode
+
+	overlay.NodePerformanceDB
 }
 
 func (m *mockdb) SelectAllStorageNodesUpload(ctx context.Context, selectionCfg overlay.NodeSelectionConfig) (reputable, new []*overlay.SelectedNode, err error) {
@@ -106,6 +106,8 @@ type DB interface {
 	VerifyQueue() audit.VerifyQueue
 	// ReverifyQueue returns queue for pieces that need audit reverification
 	ReverifyQueue() audit.ReverifyQueue
+	// AuditCoverage returns database for tracking when nodes were last audited
+	AuditCoverage() audit.CoverageDB
 	// Console returns database for satellite console
 	Console() console.DB
 	// OIDC returns the database for OIDC resources.
@@ -110,7 +110,7 @@ func NewRangedLoop(log *zap.Logger, db DB, metabaseDB *metabase.DB, config *Conf
 	}
 
 	{ // setup audit observer
-		peer.Audit.Observer = audit.NewObserver(log.Named("audit"), db.VerifyQueue(), config.Audit)
+		peer.Audit.Observer = audit.NewObserver(log.Named("audit"), db.VerifyQueue(), db.AuditCoverage(), config.Audit)
 	}
 
 	{ // setup metrics observer
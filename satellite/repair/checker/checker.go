@@ -42,22 +42,25 @@ type Checker struct {
 	statsCollector       *statsCollector
 	repairOverrides      RepairOverridesMap
 	nodeFailureRate      float64
+	prioritizer          prioritizer
 	repairQueueBatchSize int
 	Loop                 *sync2.Cycle
 }
 
 // NewChecker creates a new instance of checker.
 func NewChecker(logger *zap.Logger, repairQueue queue.RepairQueue, metabase *metabase.DB, segmentLoop *segmentloop.Service, overlay *overlay.Service, config Config) *Checker {
+	nodestate := NewReliabilityCache(overlay, config.ReliabilityCacheStaleness)
 	return &Checker{
 		logger: logger,
 
 		repairQueue:          repairQueue,
 		metabase:             metabase,
 		segmentLoop:          segmentLoop,
-		nodestate:            NewReliabilityCache(overlay, config.ReliabilityCacheStaleness),
+		nodestate:            nodestate,
 		statsCollector:       newStatsCollector(),
 		repairOverrides:      config.RepairOverrides.GetMap(),
 		nodeFailureRate:      config.NodeFailureRate,
+		prioritizer:          newPrioritizer(logger, nodestate, config),
 		repairQueueBatchSize: config.RepairQueueInsertBatchSize,
 
 		Loop: sync2.NewCycle(config.Interval),
@@ -121,6 +124,7 @@ func (checker *Checker) IdentifyInjuredSegments(ctx context.Context) (err error)
 		monStats:         aggregateStats{},
 		repairOverrides:  checker.repairOverrides,
 		nodeFailureRate:  checker.nodeFailureRate,
+		prioritizer:      checker.prioritizer,
 		getNodesEstimate: checker.getNodesEstimate,
 		log:              checker.logger,
 	}
@@ -179,6 +183,7 @@ type checkerObserver struct {
 	monStats         aggregateStats // TODO(cam): once we verify statsCollector reports data correctly, remove this
 	repairOverrides  RepairOverridesMap
 	nodeFailureRate  float64
+	prioritizer      prioritizer
 	getNodesEstimate func(ctx context.Context) (int, error)
 	log              *zap.Logger
 
@@ -194,6 +199,7 @@ func NewCheckerObserver(checker *Checker) segmentloop.Observer {
 		monStats:         aggregateStats{},
 		repairOverrides:  checker.repairOverrides,
 		nodeFailureRate:  checker.nodeFailureRate,
+		prioritizer:      checker.prioritizer,
 		getNodesEstimate: checker.getNodesEstimate,
 		log:              checker.logger,
 	}
@@ -270,7 +276,26 @@ func (obs *checkerObserver) RemoteSegment(ctx context.Context, segment *segmentl
 		return errs.Combine(Error.New("error getting missing pieces"), err)
 	}
 
-	numHealthy := len(pieces) - len(missingPieces)
+	// pieces on nodes which don't match the placement are counted as unhealthy
+	outOfPlacementPieces, err := obs.nodestate.OutOfPlacementPieces(ctx, segment.CreatedAt, segment.Pieces, segment.Placement)
+	if err != nil {
+		obs.monStats.remoteSegmentsFailedToCheck++
+		stats.iterationAggregates.remoteSegmentsFailedToCheck++
+		return errs.Combine(Error.New("error getting out of placement pieces"), err)
+	}
+
+	// pieces exceeding the limit of their failure domain are counted as unhealthy
+	excessPieces, err := obs.nodestate.ExcessPieces(ctx, segment.CreatedAt, withoutPieces(segment.Pieces, outOfPlacementPieces), int(segment.Redundancy.TotalShares))
+	if err != nil {
+		obs.monStats.remoteSegmentsFailedToCheck++
+		stats.iterationAggregates.remoteSegmentsFailedToCheck++
+		return errs.Combine(Error.New("error getting excess pieces"), err)
+	}
+
+	// misplaced pieces can still be downloaded, so they only count against
+	// the health of the segment, not against its availability.
+	numAvailable := len(pieces) - len(missingPieces)
+	numHealthy := numAvailable - len(outOfPlacementPieces) - len(excessPieces)
 	mon.IntVal("checker_segment_total_count").Observe(int64(len(pieces))) //mon:locked
 	stats.segmentTotalCount.Observe(int64(len(pieces)))
 	mon.IntVal("checker_segment_healthy_count").Observe(int64(numHealthy)) //mon:locked
@@ -294,12 +319,9 @@ func (obs *checkerObserver) RemoteSegment(ctx context.Context, segment *segmentl
 		stats.injuredSegmentHealth.Observe(segmentHealth)
 		obs.monStats.remoteSegmentsNeedingRepair++
 		stats.iterationAggregates.remoteSegmentsNeedingRepair++
-		err := obs.repairQueue.Insert(ctx, &queue.InjuredSegment{
-			StreamID:      segment.StreamID,
-			Position:      segment.Position,
-			UpdatedAt:     time.Now().UTC(),
-			SegmentHealth: segmentHealth,
-		}, func() {
+		injured := obs.prioritizer.injuredSegment(ctx, segment,
+			healthyPieces(pieces, missingPieces, outOfPlacementPieces, excessPieces), segmentHealth)
+		err = obs.repairQueue.Insert(ctx, injured, func() {
 			// Counters are increased after the queue has determined
 			// that the segment wasn't already queued for repair.
 			obs.monStats.newRemoteSegmentsNeedingRepair++
@@ -311,7 +333,7 @@ func (obs *checkerObserver) RemoteSegment(ctx context.Context, segment *segmentl
 		}
 
 		// monitor irreperable segments
-		if numHealthy < required {
+		if numAvailable < required {
 			if !containsStreamID(obs.monStats.objectsLost, segment.StreamID) {
 				obs.monStats.objectsLost = append(obs.monStats.objectsLost, segment.StreamID)
 			}
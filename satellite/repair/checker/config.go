@@ -5,6 +5,7 @@ package checker
 
 import (
 	"fmt"
+	"sort"
 	"strconv"
 	"strings"
 	"time"
@@ -23,6 +24,80 @@ type Config struct {
 	// This results in `2/9200/4 = 0.00005435` being the probability of any single node going down in the interval of one checker iteration.
 	NodeFailureRate            float64 `help:"the probability of a single node going down within the next checker iteration" default:"0.00005435" `
 	RepairQueueInsertBatchSize int     `help:"Number of damaged segments to buffer in-memory before flushing to the repair queue" default:"100" `
+
+	NodeReliabilityWeight float64        `help:"how many times more likely than an average node a node with zero reliability is to go down, used to prioritize segments stored on unreliable nodes" default:"50"`
+	SegmentAgeWeight      float64        `help:"how much segments older than a year are prioritized over new segments, 0.5 means their priority is 1.5 times higher" default:"0.5"`
+	PlacementTiers        PlacementTiers `help:"comma-separated repair priority tiers of placements in the format placement:tier, segments of higher tiers are repaired earlier" default:""`
+}
+
+// PlacementTiers is a configuration struct that contains the repair priority
+// tiers of placements. Placements without a tier have tier 1.
+//
+// Can be used as a flag.
+type PlacementTiers struct {
+	Tiers map[storj.PlacementConstraint]float64
+}
+
+// Type implements pflag.Value.
+func (PlacementTiers) Type() string { return "checker.PlacementTiers" }
+
+// String is required for pflag.Value. It is a comma separated list of placement:tier.
+func (pt *PlacementTiers) String() string {
+	placements := make([]storj.PlacementConstraint, 0, len(pt.Tiers))
+	for placement := range pt.Tiers {
+		placements = append(placements, placement)
+	}
+	sort.Slice(placements, func(i, j int) bool { return placements[i] < placements[j] })
+
+	var s strings.Builder
+	for i, placement := range placements {
+		if i > 0 {
+			s.WriteString(",")
+		}
+		fmt.Fprintf(&s, "%d:%s", placement, strconv.FormatFloat(pt.Tiers[placement], 'g', -1, 64))
+	}
+	return s.String()
+}
+
+// Set sets the value from a string in the format "placement:tier,placement:tier,...".
+func (pt *PlacementTiers) Set(s string) error {
+	pt.Tiers = nil
+	for _, tierString := range strings.Split(s, ",") {
+		tierString = strings.TrimSpace(tierString)
+		if tierString == "" {
+			continue
+		}
+
+		info := strings.Split(tierString, ":")
+		if len(info) != 2 {
+			return Error.New("Invalid placement tier (expect format placement:tier, got %s)", tierString)
+		}
+		placement, err := strconv.ParseUint(info[0], 10, 16)
+		if err != nil {
+			return Error.New("Invalid placement (should be valid integer): %s, %w", info[0], err)
+		}
+		tier, err := strconv.ParseFloat(info[1], 64)
+		if err != nil {
+			return Error.New("Invalid tier (should be valid number): %s, %w", info[1], err)
+		}
+		if tier <= 0 {
+			return Error.New("Invalid tier (should be positive): %s", info[1])
+		}
+
+		if pt.Tiers == nil {
+			pt.Tiers = make(map[storj.PlacementConstraint]float64)
+		}
+		pt.Tiers[storj.PlacementConstraint(placement)] = tier
+	}
+	return nil
+}
+
+// Tier returns the repair priority tier of the placement.
+func (pt *PlacementTiers) Tier(placement storj.PlacementConstraint) float64 {
+	if tier, ok := pt.Tiers[placement]; ok {
+		return tier
+	}
+	return 1
 }
 
 // RepairOverride is a configuration struct that contains an override repair
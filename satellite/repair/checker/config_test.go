@@ -0,0 +1,30 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package checker_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"storj.io/common/storj"
+	"storj.io/storj/satellite/repair/checker"
+)
+
+func TestPlacementTiers(t *testing.T) {
+	var tiers checker.PlacementTiers
+	require.NoError(t, tiers.Set(""))
+	require.Equal(t, float64(1), tiers.Tier(storj.EU))
+	require.Equal(t, "", tiers.String())
+
+	require.NoError(t, tiers.Set("2:1.5, 1:3"))
+	require.Equal(t, float64(3), tiers.Tier(storj.EU))
+	require.Equal(t, 1.5, tiers.Tier(storj.EEA))
+	require.Equal(t, float64(1), tiers.Tier(storj.EveryCountry))
+	require.Equal(t, "1:3,2:1.5", tiers.String())
+
+	for _, invalid := range []string{"1", "1:", "a:2", "1:0", "1:-2", "70000:2"} {
+		require.Error(t, tiers.Set(invalid), invalid)
+	}
+}
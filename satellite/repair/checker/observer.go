@@ -34,6 +34,7 @@ type RangedLoopObserver struct {
 	nodestate            *ReliabilityCache
 	repairOverrides      RepairOverridesMap
 	nodeFailureRate      float64
+	prioritizer          prioritizer
 	repairQueueBatchSize int
 
 	// the following are reset on each iteration
@@ -46,13 +47,15 @@ type RangedLoopObserver struct {
 
 // NewRangedLoopObserver creates new checker observer instance.
 func NewRangedLoopObserver(logger *zap.Logger, repairQueue queue.RepairQueue, overlay *overlay.Service, config Config) rangedloop.Observer {
+	nodestate := NewReliabilityCache(overlay, config.ReliabilityCacheStaleness)
 	return &RangedLoopObserver{
 		logger: logger,
 
 		repairQueue:          repairQueue,
-		nodestate:            NewReliabilityCache(overlay, config.ReliabilityCacheStaleness),
+		nodestate:            nodestate,
 		repairOverrides:      config.RepairOverrides.GetMap(),
 		nodeFailureRate:      config.NodeFailureRate,
+		prioritizer:          newPrioritizer(logger, nodestate, config),
 		repairQueueBatchSize: config.RepairQueueInsertBatchSize,
 		statsCollector:       make(map[string]*observerRSStats),
 	}
@@ -212,6 +215,7 @@ type repairPartial struct {
 	rsStats          map[string]*partialRSStats
 	repairOverrides  RepairOverridesMap
 	nodeFailureRate  float64
+	prioritizer      prioritizer
 	getNodesEstimate func(ctx context.Context) (int, error)
 	log              *zap.Logger
 	lastStreamID     uuid.UUID
@@ -229,6 +233,7 @@ func newRangedLoopCheckerPartial(observer *RangedLoopObserver) rangedloop.Partia
 		rsStats:          make(map[string]*partialRSStats),
 		repairOverrides:  observer.repairOverrides,
 		nodeFailureRate:  observer.nodeFailureRate,
+		prioritizer:      observer.prioritizer,
 		getNodesEstimate: observer.getNodesEstimate,
 		log:              observer.logger,
 		getObserverStats: observer.getObserverStats,
@@ -319,7 +324,26 @@ func (rp *repairPartial) process(ctx context.Context, segment *segmentloop.Segme
 		return Error.New("error getting missing pieces: %w", err)
 	}
 
-	numHealthy := len(pieces) - len(missingPieces)
+	// pieces on nodes which don't match the placement are counted as unhealthy
+	outOfPlacementPieces, err := rp.nodestate.OutOfPlacementPieces(ctx, segment.CreatedAt, segment.Pieces, segment.Placement)
+	if err != nil {
+		rp.totalStats.remoteSegmentsFailedToCheck++
+		stats.iterationAggregates.remoteSegmentsFailedToCheck++
+		return Error.New("error getting out of placement pieces: %w", err)
+	}
+
+	// pieces exceeding the limit of their failure domain are counted as unhealthy
+	excessPieces, err := rp.nodestate.ExcessPieces(ctx, segment.CreatedAt, withoutPieces(segment.Pieces, outOfPlacementPieces), int(segment.Redundancy.TotalShares))
+	if err != nil {
+		rp.totalStats.remoteSegmentsFailedToCheck++
+		stats.iterationAggregates.remoteSegmentsFailedToCheck++
+		return Error.New("error getting excess pieces: %w", err)
+	}
+
+	// misplaced pieces can still be downloaded, so they only count against
+	// the health of the segment, not against its availability.
+	numAvailable := len(pieces) - len(missingPieces)
+	numHealthy := numAvailable - len(outOfPlacementPieces) - len(excessPieces)
 	mon.IntVal("checker_segment_total_count").Observe(int64(len(pieces))) //mon:locked
 	stats.segmentStats.segmentTotalCount.Observe(int64(len(pieces)))
 
@@ -343,12 +367,9 @@ func (rp *repairPartial) process(ctx context.Context, segment *segmentloop.Segme
 		stats.segmentStats.injuredSegmentHealth.Observe(segmentHealth)
 		rp.totalStats.remoteSegmentsNeedingRepair++
 		stats.iterationAggregates.remoteSegmentsNeedingRepair++
-		err := rp.repairQueue.Insert(ctx, &queue.InjuredSegment{
-			StreamID:      segment.StreamID,
-			Position:      segment.Position,
-			UpdatedAt:     time.Now().UTC(),
-			SegmentHealth: segmentHealth,
-		}, func() {
+		injured := rp.prioritizer.injuredSegment(ctx, segment,
+			healthyPieces(pieces, missingPieces, outOfPlacementPieces, excessPieces), segmentHealth)
+		err = rp.repairQueue.Insert(ctx, injured, func() {
 			// Counters are increased after the queue has determined
 			// that the segment wasn't already queued for repair.
 			rp.totalStats.newRemoteSegmentsNeedingRepair++
@@ -360,7 +381,7 @@ func (rp *repairPartial) process(ctx context.Context, segment *segmentloop.Segme
 		}
 
 		// monitor irreparable segments
-		if numHealthy < required {
+		if numAvailable < required {
 			if !containsStreamID(rp.totalStats.objectsLost, segment.StreamID) {
 				rp.totalStats.objectsLost = append(rp.totalStats.objectsLost, segment.StreamID)
 			}
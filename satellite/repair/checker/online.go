@@ -11,7 +11,9 @@ import (
 
 	"storj.io/common/storj"
 	"storj.io/storj/satellite/metabase"
+	"storj.io/storj/satellite/nodeselection/uploadselection"
 	"storj.io/storj/satellite/overlay"
+	"storj.io/storj/satellite/repair"
 )
 
 // ReliabilityCache caches the reliable nodes for the specified staleness duration
@@ -27,10 +29,30 @@ type ReliabilityCache struct {
 
 // reliabilityState.
 type reliabilityState struct {
-	reliable map[storj.NodeID]struct{}
+	reliable map[storj.NodeID]*reliableNode
 	created  time.Time
 }
 
+// reliableNode contains the attributes of a reliable node.
+type reliableNode struct {
+	// domains are the failure domains of the node.
+	domains []string
+	// placements are the placements matched by the node.
+	placements []storj.PlacementConstraint
+	// reliability is the reliability of the node from its reputation.
+	reliability float64
+}
+
+// matches returns whether the node matches the placement.
+func (node *reliableNode) matches(placement storj.PlacementConstraint) bool {
+	for _, p := range node.placements {
+		if p == placement {
+			return true
+		}
+	}
+	return false
+}
+
 // NewReliabilityCache creates a new reliability checking cache.
 func NewReliabilityCache(overlay *overlay.Service, staleness time.Duration) *ReliabilityCache {
 	return &ReliabilityCache{
@@ -75,6 +97,101 @@ func (cache *ReliabilityCache) MissingPieces(ctx context.Context, created time.T
 	return unreliable, nil
 }
 
+// ExcessPieces returns the reliable pieces which exceed the number of pieces a
+// single failure domain may hold of a segment with totalPieces pieces. The
+// pieces are considered in order, the first pieces of a domain are kept and
+// the excess pieces don't count towards their other domains.
+func (cache *ReliabilityCache) ExcessPieces(ctx context.Context, created time.Time, pieces metabase.Pieces, totalPieces int) (_ []metabase.Piece, err error) {
+	limit := cache.overlay.FailureDomainLimit(totalPieces)
+	if limit == 0 {
+		return nil, nil
+	}
+
+	state, err := cache.loadFast(ctx, created)
+	if err != nil {
+		return nil, err
+	}
+
+	var reliable metabase.Pieces
+	var domains [][]string
+	for _, p := range pieces {
+		if node, ok := state.reliable[p.StorageNode]; ok {
+			reliable = append(reliable, p)
+			domains = append(domains, node.domains)
+		}
+	}
+
+	var excess []metabase.Piece
+	for _, i := range uploadselection.ExcessFailureDomainPieces(domains, limit) {
+		excess = append(excess, reliable[i])
+	}
+	return excess, nil
+}
+
+// OutOfPlacementPieces returns the reliable pieces which are held by nodes
+// not matching the placement. Pieces of undefined placements are not out of
+// placement, since no node would match them.
+func (cache *ReliabilityCache) OutOfPlacementPieces(ctx context.Context, created time.Time, pieces metabase.Pieces, placement storj.PlacementConstraint) (_ []metabase.Piece, err error) {
+	if !cache.overlay.IsPlacementDefined(placement) {
+		return nil, nil
+	}
+
+	state, err := cache.loadFast(ctx, created)
+	if err != nil {
+		return nil, err
+	}
+
+	var outOfPlacement []metabase.Piece
+	for _, p := range pieces {
+		node, ok := state.reliable[p.StorageNode]
+		if ok && !node.matches(placement) {
+			outOfPlacement = append(outOfPlacement, p)
+		}
+	}
+	return outOfPlacement, nil
+}
+
+// FailureRates returns the probabilities of the nodes holding the reliable
+// pieces to go down within the next checker iteration, based on their
+// reliability, see repair.NodeFailureRate.
+func (cache *ReliabilityCache) FailureRates(ctx context.Context, created time.Time, pieces metabase.Pieces, failureRate, reliabilityWeight float64) (_ []float64, err error) {
+	state, err := cache.loadFast(ctx, created)
+	if err != nil {
+		return nil, err
+	}
+
+	rates := make([]float64, 0, len(pieces))
+	for _, p := range pieces {
+		if node, ok := state.reliable[p.StorageNode]; ok {
+			rates = append(rates, repair.NodeFailureRate(failureRate, node.reliability, reliabilityWeight))
+		}
+	}
+	return rates, nil
+}
+
+// withoutPieces returns the pieces which are not in excluded, keeping their order.
+func withoutPieces(pieces metabase.Pieces, excluded []metabase.Piece) metabase.Pieces {
+	if len(excluded) == 0 {
+		return pieces
+	}
+	remaining := make(metabase.Pieces, 0, len(pieces))
+	for _, p := range pieces {
+		if !containsPiece(excluded, p) {
+			remaining = append(remaining, p)
+		}
+	}
+	return remaining
+}
+
+func containsPiece(pieces []metabase.Piece, piece metabase.Piece) bool {
+	for _, p := range pieces {
+		if p == piece {
+			return true
+		}
+	}
+	return false
+}
+
 func (cache *ReliabilityCache) loadFast(ctx context.Context, validUpTo time.Time) (_ *reliabilityState, err error) {
 	// This code is designed to be very fast in the case where a refresh is not needed: just an
 	// atomic load from rarely written to bit of shared memory. The general strategy is to first
@@ -114,17 +231,21 @@ func (cache *ReliabilityCache) Refresh(ctx context.Context) (err error) {
 func (cache *ReliabilityCache) refreshLocked(ctx context.Context) (_ *reliabilityState, err error) {
 	defer mon.Task()(&ctx)(&err)
 
-	nodes, err := cache.overlay.Reliable(ctx)
+	nodes, err := cache.overlay.ReliableNodes(ctx)
 	if err != nil {
 		return nil, Error.Wrap(err)
 	}
 
 	state := &reliabilityState{
 		created:  time.Now(),
-		reliable: make(map[storj.NodeID]struct{}, len(nodes)),
+		reliable: make(map[storj.NodeID]*reliableNode, len(nodes)),
 	}
-	for _, id := range nodes {
-		state.reliable[id] = struct{}{}
+	for _, node := range nodes {
+		state.reliable[node.ID] = &reliableNode{
+			domains:     node.FailureDomains(),
+			placements:  cache.overlay.NodePlacements(node),
+			reliability: node.Reliability,
+		}
 	}
 
 	cache.state.Store(state)
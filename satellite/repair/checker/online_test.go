@@ -13,6 +13,7 @@ import (
 	"golang.org/x/sync/errgroup"
 
 	"storj.io/common/storj"
+	"storj.io/common/storj/location"
 	"storj.io/common/testcontext"
 	"storj.io/common/testrand"
 	"storj.io/storj/satellite/metabase"
@@ -52,9 +53,130 @@ func TestReliabilityCache_Concurrent(t *testing.T) {
 	require.NoError(t, group.Wait())
 }
 
+func TestReliabilityCache_ExcessPieces(t *testing.T) {
+	ctx := testcontext.New(t)
+	defer ctx.Cleanup()
+
+	operator := []storj.NodeID{testrand.NodeID(), testrand.NodeID(), testrand.NodeID()}
+	sameASN := testrand.NodeID()
+	other := testrand.NodeID()
+
+	db := domainsOverlayDB{nodes: []*overlay.SelectedNode{
+		{ID: operator[0], Wallet: "0xabc", ASN: 64500},
+		{ID: operator[1], Wallet: "0xabc", ASN: 64501},
+		{ID: operator[2], Wallet: "0xABC"},
+		{ID: sameASN, Wallet: "0xdef", ASN: 64500},
+		{ID: other, Wallet: "0x123"},
+	}}
+
+	newCache := func(share float64) *ReliabilityCache {
+		config := overlay.Config{
+			Node: overlay.NodeSelectionConfig{MaxFailureDomainShare: share},
+			NodeSelectionCache: overlay.UploadSelectionCacheConfig{
+				Staleness: time.Hour,
+			},
+		}
+		overlayService, err := overlay.NewService(zap.NewNop(), db, fakeNodeEvents{}, nil, "", "", config)
+		require.NoError(t, err)
+		t.Cleanup(func() { require.NoError(t, overlayService.Close()) })
+		return NewReliabilityCache(overlayService, time.Hour)
+	}
+
+	pieces := metabase.Pieces{
+		{Number: 0, StorageNode: operator[0]},
+		{Number: 1, StorageNode: operator[1]},
+		{Number: 2, StorageNode: operator[2]},
+		{Number: 3, StorageNode: sameASN},
+		{Number: 4, StorageNode: other},
+		{Number: 5, StorageNode: testrand.NodeID()},
+	}
+
+	// no limit
+	excess, err := newCache(0).ExcessPieces(ctx, time.Time{}, pieces, 10)
+	require.NoError(t, err)
+	require.Empty(t, excess)
+
+	// at most 2 pieces per domain, only the third piece of the operator is in excess
+	excess, err = newCache(0.2).ExcessPieces(ctx, time.Time{}, pieces, 10)
+	require.NoError(t, err)
+	require.Equal(t, []metabase.Piece{pieces[2]}, excess)
+
+	// at most 1 piece per domain
+	excess, err = newCache(0.1).ExcessPieces(ctx, time.Time{}, pieces, 10)
+	require.NoError(t, err)
+	require.Equal(t, []metabase.Piece{pieces[1], pieces[2], pieces[3]}, excess)
+}
+
+func TestReliabilityCache_OutOfPlacementPieces(t *testing.T) {
+	ctx := testcontext.New(t)
+	defer ctx.Cleanup()
+
+	german, hungarian, american := testrand.NodeID(), testrand.NodeID(), testrand.NodeID()
+	datacenter := testrand.NodeID()
+
+	db := domainsOverlayDB{nodes: []*overlay.SelectedNode{
+		{ID: german, CountryCode: location.Germany},
+		{ID: hungarian, CountryCode: location.Hungary},
+		{ID: american, CountryCode: location.UnitedStates},
+		{ID: datacenter, CountryCode: location.Germany, ASN: 16509},
+	}}
+
+	config := overlay.Config{
+		NodeSelectionCache: overlay.UploadSelectionCacheConfig{
+			Staleness: time.Hour,
+		},
+		Placement: `10: country("DE") && !asn(16509)`,
+	}
+	overlayService, err := overlay.NewService(zap.NewNop(), db, fakeNodeEvents{}, nil, "", "", config)
+	require.NoError(t, err)
+	defer ctx.Check(overlayService.Close)
+	cache := NewReliabilityCache(overlayService, time.Hour)
+
+	pieces := metabase.Pieces{
+		{Number: 0, StorageNode: german},
+		{Number: 1, StorageNode: hungarian},
+		{Number: 2, StorageNode: american},
+		{Number: 3, StorageNode: datacenter},
+		{Number: 4, StorageNode: testrand.NodeID()},
+	}
+
+	for _, tc := range []struct {
+		placement storj.PlacementConstraint
+		expected  []metabase.Piece
+	}{
+		{storj.EveryCountry, nil},
+		{storj.EU, []metabase.Piece{pieces[2]}},
+		{storj.US, []metabase.Piece{pieces[0], pieces[1], pieces[3]}},
+		{10, []metabase.Piece{pieces[1], pieces[2], pieces[3]}},
+		// no node matches undefined placements, so their pieces are kept
+		{11, nil},
+	} {
+		outOfPlacement, err := cache.OutOfPlacementPieces(ctx, time.Time{}, pieces, tc.placement)
+		require.NoError(t, err)
+		require.Equal(t, tc.expected, outOfPlacement, "placement %d", tc.placement)
+	}
+}
+
 type fakeOverlayDB struct{ overlay.DB }
 type fakeNodeEvents struct{ nodeevents.DB }
 
+func (db fakeOverlayDB) ReliableNodes(ctx context.Context, criteria *overlay.NodeCriteria) (nodes []*overlay.SelectedNode, err error) {
+	ids, err := db.Reliable(ctx, criteria)
+	for _, id := range ids {
+		nodes = append(nodes, &overlay.SelectedNode{ID: id})
+	}
+	return nodes, err
+}
+
+type domainsOverlayDB struct {
+	overlay.DB
+	nodes []*overlay.SelectedNode
+}
+
+func (db domainsOverlayDB) ReliableNodes(context.Context, *overlay.NodeCriteria) ([]*overlay.SelectedNode, error) {
+	return db.nodes, nil
+}
+
 func (fakeOverlayDB) Reliable(context.Context, *overlay.NodeCriteria) (storj.NodeIDList, error) {
 	return storj.NodeIDList{
 		testrand.NodeID(),
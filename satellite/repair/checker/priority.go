@@ -0,0 +1,82 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package checker
+
+import (
+	"context"
+	"time"
+
+	"go.uber.org/zap"
+
+	"storj.io/storj/satellite/metabase"
+	"storj.io/storj/satellite/metabase/segmentloop"
+	"storj.io/storj/satellite/repair"
+	"storj.io/storj/satellite/repair/queue"
+)
+
+// prioritizer computes the repair priority of injured segments.
+type prioritizer struct {
+	log               *zap.Logger
+	nodestate         *ReliabilityCache
+	nodeFailureRate   float64
+	reliabilityWeight float64
+	ageWeight         float64
+	tiers             PlacementTiers
+}
+
+func newPrioritizer(log *zap.Logger, nodestate *ReliabilityCache, config Config) prioritizer {
+	return prioritizer{
+		log:               log,
+		nodestate:         nodestate,
+		nodeFailureRate:   config.NodeFailureRate,
+		reliabilityWeight: config.NodeReliabilityWeight,
+		ageWeight:         config.SegmentAgeWeight,
+		tiers:             config.PlacementTiers,
+	}
+}
+
+// injuredSegment returns the injured segment to insert into the repair queue,
+// with its priority computed from the health of the segment, the reliability
+// of the nodes holding the healthy pieces, the age of the segment and the
+// tier of its placement. When the reliability of the nodes can't be
+// determined, the nodes are considered as reliable as the average node, so
+// that the segment is still queued.
+func (p prioritizer) injuredSegment(ctx context.Context, segment *segmentloop.Segment, healthy metabase.Pieces, health float64) *queue.InjuredSegment {
+	defer mon.Task()(&ctx)(nil)
+
+	nodeRisk := 1.0
+	failureRates, err := p.nodestate.FailureRates(ctx, segment.CreatedAt, healthy, p.nodeFailureRate, p.reliabilityWeight)
+	if err != nil {
+		p.log.Warn("error computing node risk of injured segment, prioritizing without it", zap.Error(err))
+	} else {
+		nodeRisk = repair.NodeRisk(failureRates, int(segment.Redundancy.RequiredShares), p.nodeFailureRate)
+	}
+
+	createdAt := segment.CreatedAt
+	factors := repair.PriorityFactors{
+		Health:   health,
+		NodeRisk: nodeRisk,
+		Age:      time.Since(createdAt),
+		Tier:     p.tiers.Tier(segment.Placement),
+	}
+
+	return &queue.InjuredSegment{
+		StreamID:         segment.StreamID,
+		Position:         segment.Position,
+		UpdatedAt:        time.Now().UTC(),
+		SegmentHealth:    health,
+		NodeRisk:         factors.NodeRisk,
+		Tier:             factors.Tier,
+		SegmentCreatedAt: &createdAt,
+		Priority:         repair.Priority(factors, p.ageWeight),
+	}
+}
+
+// healthyPieces returns the pieces which are in none of the unhealthy lists.
+func healthyPieces(pieces metabase.Pieces, unhealthy ...[]metabase.Piece) metabase.Pieces {
+	for _, excluded := range unhealthy {
+		pieces = withoutPieces(pieces, excluded)
+	}
+	return pieces
+}
@@ -3,7 +3,11 @@
 
 package repair
 
-import "math"
+import (
+	"math"
+	"sort"
+	"time"
+)
 
 // SegmentHealth returns a value corresponding to the health of a segment in the
 // repair queue. Lower health segments should be repaired first.
@@ -62,3 +66,108 @@ const (
 	minChurnPerRound = 1e-10
 	minTotalNodes    = 100
 )
+
+// NodeFailureRate returns the probability of a node going down within the
+// next checker iteration, given the failure rate of an average node and the
+// reliability of the node. The reliability is between 0 and 1, where 1 is a
+// perfectly reliable node, e.g. the product of the online and audit scores
+// of the node. The failure rate grows linearly with the unreliability of the
+// node, weight is how much.
+func NodeFailureRate(failureRate, reliability, weight float64) float64 {
+	reliability = math.Max(0, math.Min(1, reliability))
+	return failureRate * (1 + weight*(1-reliability))
+}
+
+// ProjectedHealth returns the expected number of iterations until the
+// segment stored on nodes with the failure rates has less than minPieces
+// pieces.
+//
+// The lifetimes of the nodes are modeled as exponentially distributed, so the
+// expected time until the next node fails is the inverse of the sum of the
+// failure rates of the remaining nodes. The least reliable nodes are assumed
+// to fail first, which makes the projection pessimistic when the failure
+// rates differ and exact when they are equal.
+func ProjectedHealth(failureRates []float64, minPieces int) float64 {
+	if len(failureRates) < minPieces {
+		return 0
+	}
+
+	rates := append([]float64(nil), failureRates...)
+	sort.Sort(sort.Reverse(sort.Float64Slice(rates)))
+
+	var remaining float64
+	for _, rate := range rates {
+		remaining += rate
+	}
+
+	var health float64
+	for _, rate := range rates[:len(rates)-minPieces+1] {
+		if remaining <= 0 {
+			return math.Inf(1)
+		}
+		health += 1 / remaining
+		remaining -= rate
+	}
+	return health
+}
+
+// NodeRisk returns how much more likely the segment stored on nodes with the
+// failure rates is to be lost than if it were stored on average nodes with
+// the failure rate. Segments on less reliable nodes have a risk above 1.
+func NodeRisk(failureRates []float64, minPieces int, failureRate float64) float64 {
+	actual := ProjectedHealth(failureRates, minPieces)
+	if actual == 0 || math.IsInf(actual, 1) {
+		return 1
+	}
+
+	average := make([]float64, len(failureRates))
+	for i := range average {
+		average[i] = failureRate
+	}
+	expected := ProjectedHealth(average, minPieces)
+	if math.IsInf(expected, 1) {
+		return 1
+	}
+	return expected / actual
+}
+
+// PriorityFactors contains the factors of the repair priority of a segment.
+type PriorityFactors struct {
+	// Health is the health of the segment, see SegmentHealth.
+	Health float64
+	// NodeRisk is the risk of the nodes holding the pieces of the segment,
+	// see NodeRisk.
+	NodeRisk float64
+	// Age is the age of the segment.
+	Age time.Duration
+	// Tier is the priority tier of the segment, e.g. of its placement.
+	// Segments of higher tiers are repaired earlier.
+	Tier float64
+}
+
+// maxPriorityAge is the age from which older segments don't get a higher
+// priority anymore.
+const maxPriorityAge = 365 * 24 * time.Hour
+
+// Priority returns the repair priority of the segment. Like with the health,
+// lower priority segments should be repaired first.
+//
+// The health is divided by the node risk, since the segment is expected to
+// lose its pieces faster when they are stored on unreliable nodes, and by
+// the tier. Older segments are considered more valuable, since most deleted
+// data is deleted soon after the upload. Their priority is divided by up to
+// 1+ageWeight for segments older than a year.
+func Priority(factors PriorityFactors, ageWeight float64) float64 {
+	priority := factors.Health
+	if factors.NodeRisk > 0 {
+		priority /= factors.NodeRisk
+	}
+	if factors.Tier > 0 {
+		priority /= factors.Tier
+	}
+	if factors.Age > 0 && ageWeight > 0 {
+		age := math.Min(float64(factors.Age)/float64(maxPriorityAge), 1)
+		priority /= 1 + ageWeight*age
+	}
+	return priority
+}
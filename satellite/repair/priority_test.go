@@ -5,9 +5,13 @@ package repair_test
 
 import (
 	"math"
+	"math/rand"
+	"sort"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	"storj.io/storj/satellite/repair"
 )
@@ -54,3 +58,208 @@ func TestHighHealthAndLowFailureRate(t *testing.T) {
 		repair.SegmentHealth(11, 10, 10000, failureRate),
 		repair.SegmentHealth(39, 34, 10000, failureRate))
 }
+
+func TestProjectedHealth(t *testing.T) {
+	const failureRate = 0.01
+
+	uniform := []float64{failureRate, failureRate, failureRate, failureRate}
+	// with equal rates the health is the expected time until
+	// len-minPieces+1 nodes failed
+	assert.InDelta(t, 1/(4*failureRate)+1/(3*failureRate), repair.ProjectedHealth(uniform, 3), 1e-9)
+	assert.Equal(t, float64(0), repair.ProjectedHealth(uniform, 5))
+
+	unreliable := []float64{failureRate, failureRate, failureRate, 10 * failureRate}
+	assert.Less(t, repair.ProjectedHealth(unreliable, 3), repair.ProjectedHealth(uniform, 3))
+	assert.Greater(t, repair.ProjectedHealth(append(uniform, failureRate), 3), repair.ProjectedHealth(uniform, 3))
+
+	assert.InDelta(t, 1, repair.NodeRisk(uniform, 3, failureRate), 1e-9)
+	assert.Greater(t, repair.NodeRisk(unreliable, 3, failureRate), 1.0)
+	assert.Equal(t, float64(1), repair.NodeRisk(uniform, 5, failureRate))
+	assert.Equal(t, float64(1), repair.NodeRisk([]float64{0, 0, 0}, 2, failureRate))
+}
+
+func TestNodeFailureRate(t *testing.T) {
+	const failureRate = 0.01
+	assert.Equal(t, failureRate, repair.NodeFailureRate(failureRate, 1, 50))
+	assert.Equal(t, failureRate, repair.NodeFailureRate(failureRate, 0.5, 0))
+	assert.InDelta(t, 51*failureRate, repair.NodeFailureRate(failureRate, 0, 50), 1e-9)
+	assert.InDelta(t, 51*failureRate, repair.NodeFailureRate(failureRate, -1, 50), 1e-9)
+	assert.Less(t, repair.NodeFailureRate(failureRate, 0.9, 50), repair.NodeFailureRate(failureRate, 0.8, 50))
+}
+
+func TestPriority(t *testing.T) {
+	base := repair.PriorityFactors{Health: 100, NodeRisk: 1, Tier: 1}
+	assert.Equal(t, float64(100), repair.Priority(base, 0.5))
+	// zero factors don't change the priority
+	assert.Equal(t, float64(100), repair.Priority(repair.PriorityFactors{Health: 100}, 0.5))
+
+	risky := base
+	risky.NodeRisk = 2
+	assert.Equal(t, float64(50), repair.Priority(risky, 0.5))
+
+	tiered := base
+	tiered.Tier = 4
+	assert.Equal(t, float64(25), repair.Priority(tiered, 0.5))
+
+	old := base
+	old.Age = 10 * 365 * 24 * time.Hour
+	assert.InDelta(t, 100/1.5, repair.Priority(old, 0.5), 1e-9)
+	assert.Equal(t, float64(100), repair.Priority(old, 0))
+
+	young := base
+	young.Age = 24 * time.Hour
+	assert.Less(t, repair.Priority(old, 0.5), repair.Priority(young, 0.5))
+	assert.Less(t, repair.Priority(young, 0.5), repair.Priority(base, 0.5))
+}
+
+// TestPriorityDurabilitySimulation simulates node churn on a network of nodes
+// with different reliabilities and compares how many segments are lost when
+// the repair queue is ordered by the segment health and when it's ordered by
+// the priority, which also considers the reliability of the nodes.
+//
+// The simulation starts with a backlog of injured segments, e.g. after many
+// nodes went offline, which the repairer works through with a limited
+// capacity. The order matters for how many segments are lost meanwhile.
+func TestPriorityDurabilitySimulation(t *testing.T) {
+	const (
+		numNodes  = 1000
+		numRounds = 150
+
+		failureRate       = 0.002
+		reliabilityWeight = 50
+	)
+
+	healthOrder := func(segment *simulatedSegment, nodes []simulatedNode) float64 {
+		return repair.SegmentHealth(len(segment.pieces), simulatedMinPieces, numNodes, failureRate)
+	}
+	priorityOrder := func(segment *simulatedSegment, nodes []simulatedNode) float64 {
+		rates := make([]float64, 0, len(segment.pieces))
+		for _, node := range segment.pieces {
+			rates = append(rates, repair.NodeFailureRate(failureRate, nodes[node].reliability, reliabilityWeight))
+		}
+		return repair.Priority(repair.PriorityFactors{
+			Health:   repair.SegmentHealth(len(segment.pieces), simulatedMinPieces, numNodes, failureRate),
+			NodeRisk: repair.NodeRisk(rates, simulatedMinPieces, failureRate),
+		}, 0)
+	}
+
+	var lostByHealth, lostByPriority int
+	for seed := int64(0); seed < 5; seed++ {
+		lostByHealth += simulateChurn(seed, numNodes, numRounds, failureRate, reliabilityWeight, healthOrder)
+		lostByPriority += simulateChurn(seed, numNodes, numRounds, failureRate, reliabilityWeight, priorityOrder)
+	}
+	t.Logf("segments lost: %d when ordered by health, %d when ordered by priority", lostByHealth, lostByPriority)
+
+	require.Greater(t, lostByHealth, 0, "the simulation should lose segments")
+	require.Less(t, lostByPriority, lostByHealth)
+}
+
+// Parameters of the simulated segments.
+const (
+	simulatedSegments        = 2000
+	simulatedMinPieces       = 4
+	simulatedRepairThreshold = 7
+	simulatedTotalPieces     = 10
+	simulatedRepairsPerRound = 40
+)
+
+type simulatedNode struct {
+	reliability float64
+}
+
+type simulatedSegment struct {
+	pieces []int
+	lost   bool
+}
+
+// simulateChurn simulates the churn of nodes for a number of rounds, where a
+// limited number of injured segments are repaired each round in the order
+// given by priority, lowest first. It returns the number of lost segments.
+func simulateChurn(seed int64, numNodes, numRounds int, failureRate, reliabilityWeight float64,
+	priority func(*simulatedSegment, []simulatedNode) float64) (lost int) {
+	// the churn and the placement use separate sources, so the nodes fail
+	// the same way regardless of the repair order
+	churn := rand.New(rand.NewSource(seed))
+	placement := rand.New(rand.NewSource(seed + 1))
+
+	newNode := func() simulatedNode {
+		// most nodes are reliable, but some of them are much less reliable
+		if churn.Float64() < 0.8 {
+			return simulatedNode{reliability: 0.99}
+		}
+		return simulatedNode{reliability: 0.6}
+	}
+	nodes := make([]simulatedNode, numNodes)
+	for i := range nodes {
+		nodes[i] = newNode()
+	}
+
+	place := func(segment *simulatedSegment) {
+		used := make(map[int]bool, simulatedTotalPieces)
+		for _, node := range segment.pieces {
+			used[node] = true
+		}
+		for len(segment.pieces) < simulatedTotalPieces {
+			node := placement.Intn(numNodes)
+			if !used[node] {
+				used[node] = true
+				segment.pieces = append(segment.pieces, node)
+			}
+		}
+	}
+
+	segments := make([]*simulatedSegment, simulatedSegments)
+	for i := range segments {
+		segments[i] = &simulatedSegment{}
+		place(segments[i])
+		segments[i].pieces = segments[i].pieces[:simulatedMinPieces+placement.Intn(simulatedRepairThreshold-simulatedMinPieces+1)]
+	}
+
+	for round := 0; round < numRounds; round++ {
+		// nodes fail and are replaced by new nodes, which hold no pieces
+		failed := make([]bool, numNodes)
+		for i := range nodes {
+			if churn.Float64() < repair.NodeFailureRate(failureRate, nodes[i].reliability, reliabilityWeight) {
+				failed[i] = true
+				nodes[i] = newNode()
+			}
+		}
+
+		var injured []*simulatedSegment
+		for _, segment := range segments {
+			if segment.lost {
+				continue
+			}
+			remaining := segment.pieces[:0]
+			for _, node := range segment.pieces {
+				if !failed[node] {
+					remaining = append(remaining, node)
+				}
+			}
+			segment.pieces = remaining
+
+			switch {
+			case len(segment.pieces) < simulatedMinPieces:
+				segment.lost = true
+				lost++
+			case len(segment.pieces) <= simulatedRepairThreshold:
+				injured = append(injured, segment)
+			}
+		}
+
+		priorities := make(map[*simulatedSegment]float64, len(injured))
+		for _, segment := range injured {
+			priorities[segment] = priority(segment, nodes)
+		}
+		sort.SliceStable(injured, func(i, j int) bool {
+			return priorities[injured[i]] < priorities[injured[j]]
+		})
+		if len(injured) > simulatedRepairsPerRound {
+			injured = injured[:simulatedRepairsPerRound]
+		}
+		for _, segment := range injured {
+			place(segment)
+		}
+	}
+	return lost
+}
@@ -0,0 +1,283 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package queue
+
+import (
+	"context"
+	"time"
+
+	"github.com/zeebo/errs"
+
+	"storj.io/common/storj"
+	"storj.io/common/uuid"
+	"storj.io/storj/satellite/metabase"
+)
+
+// ErrNotQueued is returned when the segment is not in the repair queue.
+var ErrNotQueued = errs.Class("segment not queued")
+
+// maxInspectScanned is the maximum number of queued segments the inspector
+// looks at when listing with filters, before returning the cursor to
+// continue from.
+const maxInspectScanned = 10000
+
+// InspectOptions contains the options of listing the repair queue.
+type InspectOptions struct {
+	Cursor ListCursor
+	Limit  int
+
+	// Placement lists only the segments with the placement, when set.
+	Placement *storj.PlacementConstraint
+	// NodeID lists only the segments with a piece on the node, when set.
+	NodeID storj.NodeID
+	// IncludeObjects includes the locations of the objects of the segments,
+	// which requires scanning the whole objects table. It's meant for offline
+	// tools only.
+	IncludeObjects bool
+}
+
+// InspectedSegment is an injured segment with its metadata.
+type InspectedSegment struct {
+	InjuredSegment
+
+	// Missing is set when the segment doesn't exist anymore.
+	Missing   bool
+	Placement storj.PlacementConstraint
+	Nodes     []storj.NodeID
+	// Object is the location of the object of the segment, when requested
+	// and found.
+	Object *metabase.ObjectLocation
+}
+
+// InspectResult is the result of listing the repair queue.
+type InspectResult struct {
+	Segments []InspectedSegment
+	// Cursor is the cursor to continue listing from, when More is set.
+	Cursor ListCursor
+	More   bool
+}
+
+// Inspector lists and manipulates the repair queue on behalf of operators.
+type Inspector struct {
+	queue    RepairQueue
+	metabase *metabase.DB
+}
+
+// NewInspector creates a new repair queue inspector.
+func NewInspector(queue RepairQueue, metabase *metabase.DB) *Inspector {
+	return &Inspector{
+		queue:    queue,
+		metabase: metabase,
+	}
+}
+
+// List lists the queued segments matching the options, ordered by stream id
+// and position.
+func (inspector *Inspector) List(ctx context.Context, opts InspectOptions) (result InspectResult, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	limit := opts.Limit
+	if limit <= 0 {
+		limit = 100
+	}
+
+	cursor, scanned := opts.Cursor, 0
+listing:
+	for {
+		queued, more, err := inspector.queue.List(ctx, cursor, limit)
+		if err != nil {
+			return InspectResult{}, Error.Wrap(err)
+		}
+
+		inspected, err := inspector.inspect(ctx, queued)
+		if err != nil {
+			return InspectResult{}, err
+		}
+
+		for _, segment := range inspected {
+			if len(result.Segments) >= limit {
+				result.Cursor, result.More = cursor, true
+				break listing
+			}
+			cursor = ListCursor{StreamID: segment.StreamID, Position: segment.Position}
+
+			if segment.matches(opts) {
+				result.Segments = append(result.Segments, segment)
+			}
+		}
+
+		scanned += len(queued)
+		if !more {
+			break
+		}
+		if len(result.Segments) >= limit || scanned >= maxInspectScanned {
+			result.Cursor, result.More = cursor, true
+			break
+		}
+	}
+
+	if opts.IncludeObjects {
+		if err := inspector.attachObjects(ctx, result.Segments); err != nil {
+			return InspectResult{}, err
+		}
+	}
+	return result, nil
+}
+
+// inspect loads the metadata of the queued segments with a single metabase
+// query.
+func (inspector *Inspector) inspect(ctx context.Context, queued []InjuredSegment) (_ []InspectedSegment, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	positions := make([]metabase.GetSegmentByPosition, 0, len(queued))
+	for _, injured := range queued {
+		positions = append(positions, metabase.GetSegmentByPosition{
+			StreamID: injured.StreamID,
+			Position: injured.Position,
+		})
+	}
+	segments, err := inspector.metabase.GetSegmentsByPosition(ctx, metabase.GetSegmentsByPosition{
+		Segments: positions,
+	})
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	found := make(map[ListCursor]metabase.Segment, len(segments))
+	for _, segment := range segments {
+		found[ListCursor{StreamID: segment.StreamID, Position: segment.Position}] = segment
+	}
+
+	inspected := make([]InspectedSegment, 0, len(queued))
+	for _, injured := range queued {
+		segment, ok := found[ListCursor{StreamID: injured.StreamID, Position: injured.Position}]
+		if !ok {
+			inspected = append(inspected, InspectedSegment{InjuredSegment: injured, Missing: true})
+			continue
+		}
+
+		segmentInfo := InspectedSegment{
+			InjuredSegment: injured,
+			Placement:      segment.Placement,
+		}
+		for _, piece := range segment.Pieces {
+			segmentInfo.Nodes = append(segmentInfo.Nodes, piece.StorageNode)
+		}
+		inspected = append(inspected, segmentInfo)
+	}
+	return inspected, nil
+}
+
+// matches returns whether the segment matches the filters of the options.
+func (segment *InspectedSegment) matches(opts InspectOptions) bool {
+	if opts.Placement == nil && opts.NodeID.IsZero() {
+		return true
+	}
+	if segment.Missing {
+		return false
+	}
+	if opts.Placement != nil && segment.Placement != *opts.Placement {
+		return false
+	}
+	if !opts.NodeID.IsZero() {
+		for _, nodeID := range segment.Nodes {
+			if nodeID == opts.NodeID {
+				return true
+			}
+		}
+		return false
+	}
+	return true
+}
+
+// attachObjects loads the object locations of the segments.
+func (inspector *Inspector) attachObjects(ctx context.Context, segments []InspectedSegment) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if len(segments) == 0 {
+		return nil
+	}
+
+	streamIDs := make([]uuid.UUID, 0, len(segments))
+	for _, segment := range segments {
+		streamIDs = append(streamIDs, segment.StreamID)
+	}
+	locations, err := inspector.metabase.GetObjectLocationsByStreamID(ctx, metabase.GetObjectLocationsByStreamID{
+		StreamIDs: streamIDs,
+	})
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	for i := range segments {
+		if location, ok := locations[segments[i].StreamID]; ok {
+			location := location
+			segments[i].Object = &location
+		}
+	}
+	return nil
+}
+
+// Enqueue adds the segment to the repair queue with the priority, which
+// makes it selectable for repair. Lower priority segments are repaired first.
+// When the segment is queued already, only its priority is changed, so that
+// the health, risk and tier computed by the checker are kept.
+func (inspector *Inspector) Enqueue(ctx context.Context, streamID uuid.UUID, position metabase.SegmentPosition, priority float64) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	segment, err := inspector.metabase.GetSegmentByPosition(ctx, metabase.GetSegmentByPosition{
+		StreamID: streamID,
+		Position: position,
+	})
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	if segment.Inline() {
+		return Error.New("inline segments can't be repaired")
+	}
+
+	updated, err := inspector.queue.UpdatePriority(ctx, streamID, position, priority)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	if updated {
+		return nil
+	}
+
+	_, err = inspector.queue.Insert(ctx, &InjuredSegment{
+		StreamID:         streamID,
+		Position:         position,
+		SegmentCreatedAt: &segment.CreatedAt,
+		Priority:         priority,
+		UpdatedAt:        time.Now().UTC(),
+	})
+	return Error.Wrap(err)
+}
+
+// SetPriority sets the priority of the queued segment. Lower priority segments
+// are repaired first. A segment which is being repaired isn't selected again
+// until its repair attempt expires. The checker replaces the priority, when
+// it finds the segment again.
+func (inspector *Inspector) SetPriority(ctx context.Context, streamID uuid.UUID, position metabase.SegmentPosition, priority float64) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	updated, err := inspector.queue.UpdatePriority(ctx, streamID, position, priority)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	if !updated {
+		return ErrNotQueued.New("%s/%d", streamID, position.Encode())
+	}
+	return nil
+}
+
+// Remove removes the segment from the repair queue.
+func (inspector *Inspector) Remove(ctx context.Context, streamID uuid.UUID, position metabase.SegmentPosition) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	return Error.Wrap(inspector.queue.Delete(ctx, &InjuredSegment{
+		StreamID: streamID,
+		Position: position,
+	}))
+}
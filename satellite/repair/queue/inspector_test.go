@@ -0,0 +1,165 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package queue_test
+
+import (
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"storj.io/common/storj"
+	"storj.io/common/testcontext"
+	"storj.io/common/testrand"
+	"storj.io/storj/private/testplanet"
+	"storj.io/storj/satellite/metabase"
+	"storj.io/storj/satellite/metabase/metabasetest"
+	"storj.io/storj/satellite/repair/queue"
+)
+
+func TestInspector(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		satellite := planet.Satellites[0]
+		metabaseDB := satellite.Metabase.DB
+		repairQueue := satellite.DB.RepairQueue()
+		inspector := queue.NewInspector(repairQueue, metabaseDB)
+
+		nodeA, nodeB := testrand.NodeID(), testrand.NodeID()
+
+		// segments 1, 3 and 5 have the EEA placement, segments 0, 1 and 2 have
+		// a piece on node A.
+		var segments []metabase.Segment
+		for i := 0; i < 6; i++ {
+			placement := storj.EveryCountry
+			if i%2 == 1 {
+				placement = storj.EEA
+			}
+			pieces := metabase.Pieces{{Number: 0, StorageNode: nodeB}}
+			if i < 3 {
+				pieces = append(pieces, metabase.Piece{Number: 1, StorageNode: nodeA})
+			}
+			segment := createSegment(ctx, t, metabaseDB, placement, pieces)
+			segments = append(segments, segment)
+
+			_, err := repairQueue.Insert(ctx, &queue.InjuredSegment{
+				StreamID:      segment.StreamID,
+				Position:      segment.Position,
+				SegmentHealth: float64(i),
+				Tier:          1,
+			})
+			require.NoError(t, err)
+		}
+
+		// the segment was deleted after it was queued.
+		missing := queue.InjuredSegment{StreamID: testrand.UUID(), SegmentHealth: 10}
+		_, err := repairQueue.Insert(ctx, &missing)
+		require.NoError(t, err)
+
+		list := func(opts queue.InspectOptions) (listed []queue.InspectedSegment) {
+			for {
+				result, err := inspector.List(ctx, opts)
+				require.NoError(t, err)
+				require.LessOrEqual(t, len(result.Segments), opts.Limit)
+				listed = append(listed, result.Segments...)
+				if !result.More {
+					return listed
+				}
+				opts.Cursor = result.Cursor
+			}
+		}
+		healths := func(listed []queue.InspectedSegment) (healths []float64) {
+			for _, segment := range listed {
+				healths = append(healths, segment.SegmentHealth)
+			}
+			sort.Float64s(healths)
+			return healths
+		}
+
+		listed := list(queue.InspectOptions{Limit: 2})
+		require.Len(t, listed, 7)
+		require.True(t, sort.SliceIsSorted(listed, func(i, j int) bool {
+			return listed[i].StreamID.Less(listed[j].StreamID)
+		}))
+		require.Equal(t, []float64{0, 1, 2, 3, 4, 5, 10}, healths(listed))
+		for _, segment := range listed {
+			require.Equal(t, segment.StreamID == missing.StreamID, segment.Missing)
+			require.Nil(t, segment.Object)
+		}
+
+		eea := storj.EEA
+		require.Equal(t, []float64{1, 3, 5}, healths(list(queue.InspectOptions{Limit: 1, Placement: &eea})))
+		require.Equal(t, []float64{0, 1, 2}, healths(list(queue.InspectOptions{Limit: 2, NodeID: nodeA})))
+		require.Equal(t, []float64{1}, healths(list(queue.InspectOptions{Limit: 2, Placement: &eea, NodeID: nodeA})))
+		require.Empty(t, list(queue.InspectOptions{Limit: 2, NodeID: testrand.NodeID()}))
+
+		listed = list(queue.InspectOptions{Limit: 10, NodeID: nodeA, IncludeObjects: true})
+		require.Len(t, listed, 3)
+		for _, segment := range listed {
+			require.NotNil(t, segment.Object)
+			require.ElementsMatch(t, []storj.NodeID{nodeA, nodeB}, segment.Nodes)
+		}
+
+		// enqueuing a queued segment keeps what the checker computed.
+		queued := segments[3]
+		require.NoError(t, inspector.Enqueue(ctx, queued.StreamID, queued.Position, -1))
+		listed = list(queue.InspectOptions{Limit: 10, Placement: &eea})
+		require.Len(t, listed, 3)
+		for _, segment := range listed {
+			if segment.StreamID != queued.StreamID {
+				continue
+			}
+			require.EqualValues(t, 3, segment.SegmentHealth)
+			require.EqualValues(t, 1, segment.Tier)
+			require.EqualValues(t, -1, segment.Priority)
+		}
+
+		selected, err := repairQueue.Select(ctx)
+		require.NoError(t, err)
+		require.Equal(t, queued.StreamID, selected.StreamID)
+
+		// a segment which isn't queued is added.
+		segment := createSegment(ctx, t, metabaseDB, storj.EveryCountry, metabase.Pieces{{Number: 0, StorageNode: nodeA}})
+		err = inspector.SetPriority(ctx, segment.StreamID, segment.Position, 1)
+		require.True(t, queue.ErrNotQueued.Has(err))
+
+		require.NoError(t, inspector.Enqueue(ctx, segment.StreamID, segment.Position, 1))
+		require.NoError(t, inspector.SetPriority(ctx, segment.StreamID, segment.Position, 2))
+		require.Len(t, list(queue.InspectOptions{Limit: 10}), 8)
+
+		require.NoError(t, inspector.Remove(ctx, segment.StreamID, segment.Position))
+		require.Len(t, list(queue.InspectOptions{Limit: 10}), 7)
+
+		err = inspector.Enqueue(ctx, testrand.UUID(), metabase.SegmentPosition{}, 1)
+		require.True(t, metabase.ErrSegmentNotFound.Has(err))
+	})
+}
+
+// createSegment creates an object with a single remote segment.
+func createSegment(ctx *testcontext.Context, t *testing.T, db *metabase.DB, placement storj.PlacementConstraint, pieces metabase.Pieces) metabase.Segment {
+	obj := metabasetest.RandObjectStream()
+	_, segments := metabasetest.CreateTestObject{
+		CreateSegment: func(object metabase.Object, index int) metabase.Segment {
+			opts := metabase.CommitSegment{
+				ObjectStream: obj,
+				Position:     metabase.SegmentPosition{Index: uint32(index)},
+				RootPieceID:  testrand.PieceID(),
+				Pieces:       pieces,
+				Placement:    placement,
+
+				EncryptedKey:      []byte{3},
+				EncryptedKeyNonce: []byte{4},
+				EncryptedETag:     []byte{5},
+
+				EncryptedSize: 1024,
+				PlainSize:     512,
+				Redundancy:    metabasetest.DefaultRedundancy,
+			}
+			metabasetest.CommitSegment{Opts: opts}.Check(ctx, t, db)
+			return metabase.Segment{StreamID: obj.StreamID, Position: opts.Position}
+		},
+	}.Run(ctx, t, db, obj, 1)
+	return segments[0]
+}
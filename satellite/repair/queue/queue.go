@@ -5,6 +5,9 @@ package queue
 
 import (
 	"context"
+	"fmt"
+	"strconv"
+	"strings"
 	"time"
 
 	"storj.io/common/uuid"
@@ -18,9 +21,54 @@ type InjuredSegment struct {
 	Position metabase.SegmentPosition
 
 	SegmentHealth float64
-	AttemptedAt   *time.Time
-	UpdatedAt     time.Time
-	InsertedAt    time.Time
+	// NodeRisk, Tier and SegmentCreatedAt are the factors of the priority
+	// besides the health, see repair.Priority.
+	NodeRisk         float64
+	Tier             float64
+	SegmentCreatedAt *time.Time
+	// Priority is the repair priority of the segment, lower priority segments
+	// are repaired first. When zero, the health is used instead.
+	Priority float64
+
+	AttemptedAt *time.Time
+	UpdatedAt   time.Time
+	InsertedAt  time.Time
+}
+
+// ListCursor is the position after which the repair queue is listed.
+type ListCursor struct {
+	StreamID uuid.UUID
+	Position metabase.SegmentPosition
+}
+
+// String returns the cursor in the format stream-id/position, where the
+// position is encoded.
+func (cursor ListCursor) String() string {
+	return fmt.Sprintf("%s/%d", cursor.StreamID, cursor.Position.Encode())
+}
+
+// ParseListCursor parses a cursor in the format stream-id/position. An empty
+// string is the cursor of the start of the queue.
+func ParseListCursor(s string) (ListCursor, error) {
+	if s == "" {
+		return ListCursor{}, nil
+	}
+	streamIDString, positionString, ok := strings.Cut(s, "/")
+	if !ok {
+		return ListCursor{}, Error.New("invalid cursor %q (expect format stream-id/position)", s)
+	}
+	streamID, err := uuid.FromString(streamIDString)
+	if err != nil {
+		return ListCursor{}, Error.New("invalid stream id of cursor %q: %w", s, err)
+	}
+	position, err := strconv.ParseUint(positionString, 10, 64)
+	if err != nil {
+		return ListCursor{}, Error.New("invalid position of cursor %q: %w", s, err)
+	}
+	return ListCursor{
+		StreamID: streamID,
+		Position: metabase.SegmentPositionFromEncoded(position),
+	}, nil
 }
 
 // RepairQueue implements queueing for segments that need repairing.
@@ -42,6 +90,12 @@ type RepairQueue interface {
 	SelectN(ctx context.Context, limit int) ([]InjuredSegment, error)
 	// Count counts the number of segments in the repair queue.
 	Count(ctx context.Context) (count int, err error)
+	// List lists limit amount of injured segments after the cursor, ordered
+	// by stream id and position.
+	List(ctx context.Context, cursor ListCursor, limit int) (segments []InjuredSegment, more bool, err error)
+	// UpdatePriority sets the priority of an injured segment. A segment which
+	// is being repaired stays unselectable until its repair attempt expires.
+	UpdatePriority(ctx context.Context, streamID uuid.UUID, position metabase.SegmentPosition, priority float64) (updated bool, err error)
 
 	// TestingSetAttemptedTime sets attempted time for a segment.
 	TestingSetAttemptedTime(ctx context.Context, streamID uuid.UUID, position metabase.SegmentPosition, t time.Time) (rowsAffected int64, err error)
@@ -5,6 +5,7 @@ package queue_test
 
 import (
 	"math/rand"
+	"sort"
 	"testing"
 	"time"
 
@@ -261,6 +262,61 @@ func TestOrderOverwrite(t *testing.T) {
 	})
 }
 
+// TestOrderPriority ensures that segments are selected by their priority,
+// which falls back to the segment health when not set.
+func TestOrderPriority(t *testing.T) {
+	satellitedbtest.Run(t, func(ctx *testcontext.Context, t *testing.T, db satellite.DB) {
+		repairQueue := db.RepairQueue()
+
+		createdAt := time.Now().Add(-24 * time.Hour).UTC().Truncate(time.Microsecond)
+		healthy := &queue.InjuredSegment{
+			StreamID:         uuid.UUID{1},
+			SegmentHealth:    10,
+			NodeRisk:         10,
+			Tier:             2,
+			SegmentCreatedAt: &createdAt,
+			Priority:         0.5,
+		}
+		unhealthy := &queue.InjuredSegment{
+			StreamID:      uuid.UUID{2},
+			SegmentHealth: 5,
+			Priority:      5,
+		}
+		unprioritized := &queue.InjuredSegment{
+			StreamID:      uuid.UUID{3},
+			SegmentHealth: 1,
+		}
+		_, err := repairQueue.InsertBatch(ctx, []*queue.InjuredSegment{unhealthy, healthy})
+		require.NoError(t, err)
+		_, err = repairQueue.Insert(ctx, unprioritized)
+		require.NoError(t, err)
+
+		segments, err := repairQueue.SelectN(ctx, 10)
+		require.NoError(t, err)
+		require.Len(t, segments, 3)
+		for _, segment := range segments {
+			if segment.StreamID == healthy.StreamID {
+				require.Equal(t, healthy.NodeRisk, segment.NodeRisk)
+				require.Equal(t, healthy.Tier, segment.Tier)
+				require.NotNil(t, segment.SegmentCreatedAt)
+				require.WithinDuration(t, createdAt, *segment.SegmentCreatedAt, time.Second)
+				require.Equal(t, healthy.Priority, segment.Priority)
+			}
+		}
+
+		for _, next := range []*queue.InjuredSegment{healthy, unprioritized, unhealthy} {
+			injuredSeg, err := repairQueue.Select(ctx)
+			require.NoError(t, err)
+			assert.Equal(t, next.StreamID, injuredSeg.StreamID)
+		}
+
+		// queue should be considered "empty" now
+		injuredSeg, err := repairQueue.Select(ctx)
+		assert.True(t, storage.ErrEmptyQueue.Has(err))
+		assert.Nil(t, injuredSeg)
+	})
+}
+
 func TestCount(t *testing.T) {
 	satellitedbtest.Run(t, func(ctx *testcontext.Context, t *testing.T, db satellite.DB) {
 		repairQueue := db.RepairQueue()
@@ -282,3 +338,85 @@ func TestCount(t *testing.T) {
 	})
 
 }
+
+func TestListUpdatePriority(t *testing.T) {
+	satellitedbtest.Run(t, func(ctx *testcontext.Context, t *testing.T, db satellite.DB) {
+		repairQueue := db.RepairQueue()
+
+		var expected []queue.ListCursor
+		for _, streamID := range []uuid.UUID{{3}, {1}, {2}} {
+			for _, index := range []uint32{1, 0} {
+				position := metabase.SegmentPosition{Index: index}
+				_, err := repairQueue.Insert(ctx, &queue.InjuredSegment{
+					StreamID:      streamID,
+					Position:      position,
+					SegmentHealth: 10,
+				})
+				require.NoError(t, err)
+				expected = append(expected, queue.ListCursor{StreamID: streamID, Position: position})
+			}
+		}
+		sort.Slice(expected, func(i, j int) bool {
+			if expected[i].StreamID != expected[j].StreamID {
+				return expected[i].StreamID.Less(expected[j].StreamID)
+			}
+			return expected[i].Position.Less(expected[j].Position)
+		})
+
+		var listed []queue.ListCursor
+		cursor := queue.ListCursor{}
+		for {
+			segments, more, err := repairQueue.List(ctx, cursor, 4)
+			require.NoError(t, err)
+			require.LessOrEqual(t, len(segments), 4)
+			for _, segment := range segments {
+				cursor = queue.ListCursor{StreamID: segment.StreamID, Position: segment.Position}
+				listed = append(listed, cursor)
+			}
+			if !more {
+				break
+			}
+		}
+		require.Equal(t, expected, listed)
+
+		parsed, err := queue.ParseListCursor(expected[3].String())
+		require.NoError(t, err)
+		require.Equal(t, expected[3], parsed)
+
+		// updating the priority of a segment which is being repaired doesn't
+		// make it selectable again
+		first, err := repairQueue.Select(ctx)
+		require.NoError(t, err)
+
+		updated, err := repairQueue.UpdatePriority(ctx, first.StreamID, first.Position, -1)
+		require.NoError(t, err)
+		require.True(t, updated)
+
+		selected, err := repairQueue.Select(ctx)
+		require.NoError(t, err)
+		require.False(t, selected.StreamID == first.StreamID && selected.Position == first.Position)
+
+		segments, _, err := repairQueue.List(ctx, queue.ListCursor{}, len(expected))
+		require.NoError(t, err)
+		for _, segment := range segments {
+			if segment.StreamID == first.StreamID && segment.Position == first.Position {
+				require.EqualValues(t, -1, segment.Priority)
+				require.NotNil(t, segment.AttemptedAt)
+			}
+		}
+
+		// the segment is selected first again once its repair attempt expired
+		_, err = repairQueue.TestingSetAttemptedTime(ctx, first.StreamID, first.Position, time.Now().Add(-7*time.Hour))
+		require.NoError(t, err)
+
+		selected, err = repairQueue.Select(ctx)
+		require.NoError(t, err)
+		require.Equal(t, first.StreamID, selected.StreamID)
+		require.Equal(t, first.Position, selected.Position)
+		require.EqualValues(t, -1, selected.Priority)
+
+		updated, err = repairQueue.UpdatePriority(ctx, testrand.UUID(), metabase.SegmentPosition{}, 1)
+		require.NoError(t, err)
+		require.False(t, updated)
+	})
+}
@@ -10,6 +10,8 @@ import (
 	"io"
 	"math"
 	"net"
+	"strings"
+	"sync"
 	"testing"
 	"time"
 
@@ -50,14 +52,18 @@ import (
 //     threshold
 //   - Downloads the data from those left nodes and check that it's the same than the uploaded one.
 func TestDataRepairInMemoryBlake(t *testing.T) {
-	testDataRepair(t, true, pb.PieceHashAlgorithm_BLAKE3)
+	testDataRepair(t, true, false, pb.PieceHashAlgorithm_BLAKE3)
 }
 
 func TestDataRepairToDiskSHA256(t *testing.T) {
-	testDataRepair(t, false, pb.PieceHashAlgorithm_SHA256)
+	testDataRepair(t, false, false, pb.PieceHashAlgorithm_SHA256)
 }
 
-func testDataRepair(t *testing.T, inMemoryRepair bool, hashAlgo pb.PieceHashAlgorithm) {
+func TestDataRepairStreamingBlake(t *testing.T) {
+	testDataRepair(t, false, true, pb.PieceHashAlgorithm_BLAKE3)
+}
+
+func testDataRepair(t *testing.T, inMemoryRepair, streamingRepair bool, hashAlgo pb.PieceHashAlgorithm) {
 	const (
 		RepairMaxExcessRateOptimalThreshold = 0.05
 		minThreshold                        = 3
@@ -73,6 +79,11 @@ func testDataRepair(t *testing.T, inMemoryRepair bool, hashAlgo pb.PieceHashAlgo
 				func(log *zap.Logger, index int, config *satellite.Config) {
 					config.Repairer.MaxExcessRateOptimalThreshold = RepairMaxExcessRateOptimalThreshold
 					config.Repairer.InMemoryRepair = inMemoryRepair
+					config.Repairer.StreamingRepair = streamingRepair
+					if streamingRepair {
+						// repair a few stripes at a time
+						config.Repairer.MaxBufferMem = 16 * memory.KiB
+					}
 				},
 				testplanet.ReconfigureRS(minThreshold, 5, successThreshold, 9),
 			),
@@ -1294,6 +1305,191 @@ func TestCorruptDataRepair_Failed(t *testing.T) {
 	})
 }
 
+// TestCorruptDataRepair_Streaming does the following:
+//   - Uploads test data
+//   - Kills some nodes carrying pieces, so that only the minimum required are left
+//   - Corrupts the piece of one of the remaining nodes
+//   - Triggers a streaming repair
+//   - Verifies that the repaired pieces aren't committed, that the corrupted
+//     piece is removed from the segment and that its node failed the audit.
+func TestCorruptDataRepair_Streaming(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount:   1,
+		StorageNodeCount: 15,
+		UplinkCount:      1,
+		Reconfigure: testplanet.Reconfigure{
+			Satellite: testplanet.Combine(
+				func(log *zap.Logger, index int, config *satellite.Config) {
+					config.Repairer.MaxExcessRateOptimalThreshold = 0.05
+					config.Repairer.StreamingRepair = true
+					config.Repairer.MaxBufferMem = 16 * memory.KiB
+					config.Repairer.ReputationUpdateEnabled = true
+					config.Reputation.InitialAlpha = 1
+					config.Reputation.AuditLambda = 0.95
+				},
+				testplanet.ReconfigureRS(4, 4, 9, 9),
+			),
+		},
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		uplinkPeer := planet.Uplinks[0]
+		satellite := planet.Satellites[0]
+		// stop audit to prevent possible interactions i.e. repair timeout problems
+		satellite.Audit.Worker.Loop.Pause()
+
+		satellite.Repair.Checker.Loop.Pause()
+		satellite.Repair.Repairer.Loop.Pause()
+
+		var testData = testrand.Bytes(64 * memory.KiB)
+		// first, upload some remote data
+		err := uplinkPeer.Upload(ctx, satellite, "testbucket", "test/path", testData)
+		require.NoError(t, err)
+
+		segment, _ := getRemoteSegment(ctx, t, satellite, planet.Uplinks[0].Projects[0].ID, "testbucket")
+		require.Equal(t, 9, len(segment.Pieces))
+		require.Equal(t, 4, int(segment.Redundancy.RequiredShares))
+		toKill := 5
+
+		// kill nodes and track lost pieces
+		originalNodes := make(map[storj.NodeID]bool)
+		var availablePieces metabase.Pieces
+
+		for i, piece := range segment.Pieces {
+			originalNodes[piece.StorageNode] = true
+			if i >= toKill {
+				availablePieces = append(availablePieces, piece)
+				continue
+			}
+
+			err := planet.StopNodeAndUpdate(ctx, planet.FindNode(piece.StorageNode))
+			require.NoError(t, err)
+		}
+		require.Equal(t, 4, len(availablePieces))
+
+		corruptedPiece := availablePieces[0]
+
+		// corrupt piece data
+		corruptedNode := planet.FindNode(corruptedPiece.StorageNode)
+		require.NotNil(t, corruptedNode)
+		corruptedPieceID := segment.RootPieceID.Derive(corruptedPiece.StorageNode, int32(corruptedPiece.Number))
+		corruptPieceData(ctx, t, planet, corruptedNode, corruptedPieceID)
+
+		reputationService := satellite.Repairer.Reputation
+
+		corruptedNodeReputation, err := reputationService.Get(ctx, corruptedPiece.StorageNode)
+		require.NoError(t, err)
+
+		satellite.Repair.Checker.Loop.Restart()
+		satellite.Repair.Checker.Loop.TriggerWait()
+		satellite.Repair.Checker.Loop.Pause()
+		satellite.Repair.Repairer.Loop.Restart()
+		satellite.Repair.Repairer.Loop.TriggerWait()
+		satellite.Repair.Repairer.Loop.Pause()
+		satellite.Repair.Repairer.WaitForPendingRepairs()
+
+		// the corrupted piece is detected at the end of the stream, after the
+		// repaired pieces were streamed, so they must not be in the segment
+		segmentAfter, _ := getRemoteSegment(ctx, t, satellite, planet.Uplinks[0].Projects[0].ID, "testbucket")
+		for _, piece := range segmentAfter.Pieces {
+			require.Contains(t, originalNodes, piece.StorageNode, "there should be no new nodes in pointer")
+			require.NotEqual(t, corruptedPiece.StorageNode, piece.StorageNode, "corrupted piece should be removed")
+		}
+
+		corruptedNodeReputationAfter, err := reputationService.Get(ctx, corruptedPiece.StorageNode)
+		require.NoError(t, err)
+		require.Equal(t, corruptedNodeReputation.TotalAuditCount+1, corruptedNodeReputationAfter.TotalAuditCount)
+		require.Equal(t, corruptedNodeReputation.AuditSuccessCount, corruptedNodeReputationAfter.AuditSuccessCount)
+	})
+}
+
+// TestStreamingRepairSourceLost does the following:
+//   - Uploads test data
+//   - Kills some nodes carrying pieces, so that the segment is injured
+//   - Triggers a streaming repair, which stops the node of a downloaded piece
+//     after the first window
+//   - Verifies that the segment is repaired without streaming from the
+//     remaining pieces.
+func TestStreamingRepairSourceLost(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount:   1,
+		StorageNodeCount: 12,
+		UplinkCount:      1,
+		Reconfigure: testplanet.Reconfigure{
+			Satellite: testplanet.Combine(
+				func(log *zap.Logger, index int, config *satellite.Config) {
+					config.Repairer.StreamingRepair = true
+					config.Repairer.MaxBufferMem = 16 * memory.KiB
+				},
+				testplanet.ReconfigureRS(3, 5, 8, 8),
+			),
+		},
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		uplinkPeer := planet.Uplinks[0]
+		satellite := planet.Satellites[0]
+		// stop audit to prevent possible interactions i.e. repair timeout problems
+		satellite.Audit.Worker.Loop.Pause()
+
+		satellite.Repair.Checker.Loop.Pause()
+		satellite.Repair.Repairer.Loop.Pause()
+
+		// the pieces are larger than what a download requests at once, so that
+		// the stopped node can't have sent its whole piece already.
+		testData := testrand.Bytes(2 * memory.MiB)
+		err := uplinkPeer.Upload(ctx, satellite, "testbucket", "test/path", testData)
+		require.NoError(t, err)
+
+		segment, _ := getRemoteSegment(ctx, t, satellite, planet.Uplinks[0].Projects[0].ID, "testbucket")
+		require.Equal(t, 8, len(segment.Pieces))
+
+		originalNodes := make(map[storj.NodeID]bool)
+		for i, piece := range segment.Pieces {
+			originalNodes[piece.StorageNode] = true
+			if i < 3 {
+				err := planet.StopNodeAndUpdate(ctx, planet.FindNode(piece.StorageNode))
+				require.NoError(t, err)
+			}
+		}
+
+		var lostNode storj.NodeID
+		var stopOnce sync.Once
+		satellite.Repairer.EcRepairer.OnTestingStreamWindowHook = func(offset int64, sources []storj.NodeID) {
+			stopOnce.Do(func() {
+				lostNode = sources[0]
+				require.NoError(t, planet.StopNodeAndUpdate(ctx, planet.FindNode(lostNode)))
+			})
+		}
+
+		var reports int
+		satellite.Repairer.SegmentRepairer.OnTestingPiecesReportHook = func(pieces repairer.FetchResultReport) {
+			reports++
+		}
+
+		satellite.Repair.Checker.Loop.Restart()
+		satellite.Repair.Checker.Loop.TriggerWait()
+		satellite.Repair.Checker.Loop.Pause()
+		satellite.Repair.Repairer.Loop.Restart()
+		satellite.Repair.Repairer.Loop.TriggerWait()
+		satellite.Repair.Repairer.Loop.Pause()
+		satellite.Repair.Repairer.WaitForPendingRepairs()
+
+		require.False(t, lostNode.IsZero(), "the streaming repair didn't start")
+		// the streaming repair and the repair without streaming
+		require.Equal(t, 2, reports)
+
+		segmentAfter, _ := getRemoteSegment(ctx, t, satellite, planet.Uplinks[0].Projects[0].ID, "testbucket")
+		var repaired int
+		for _, piece := range segmentAfter.Pieces {
+			if !originalNodes[piece.StorageNode] {
+				repaired++
+			}
+		}
+		require.NotZero(t, repaired, "there should be new nodes in the segment")
+
+		data, err := uplinkPeer.Download(ctx, satellite, "testbucket", "test/path")
+		require.NoError(t, err)
+		require.Equal(t, testData, data)
+	})
+}
+
 // TestRepairExpiredSegment
 // - Upload tests data to 7 nodes
 // - Kill nodes so that repair threshold > online nodes > minimum threshold
@@ -3191,6 +3387,412 @@ func TestSegmentInExcludedCountriesRepairIrreparable(t *testing.T) {
 	})
 }
 
+//   - 8 storage nodes in an EU country
+//   - upload an object to a bucket with the EU placement on 4 nodes
+//   - move one node holding a piece out of the EU
+//   - run the checker and check the segment is in the repair queue
+//   - run the repairer
+//   - check that only the piece out of placement was copied to another EU node
+//     with the same piece number and that the bucket is reported compliant.
+func TestOutOfPlacementPiecesMigration(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount:   1,
+		StorageNodeCount: 8,
+		UplinkCount:      1,
+		Reconfigure: testplanet.Reconfigure{
+			Satellite: testplanet.Combine(
+				func(log *zap.Logger, index int, config *satellite.Config) {
+					config.Repairer.InMemoryRepair = true
+					config.Repairer.MigrateOutOfPlacement = true
+				},
+				testplanet.ReconfigureRS(2, 3, 4, 4),
+			),
+		},
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		uplinkPeer := planet.Uplinks[0]
+		satellite := planet.Satellites[0]
+		projectID := uplinkPeer.Projects[0].ID
+		// stop audit to prevent possible interactions i.e. repair timeout problems
+		satellite.Audit.Worker.Loop.Pause()
+
+		satellite.Repair.Checker.Loop.Pause()
+		satellite.Repair.Repairer.Loop.Pause()
+
+		for _, node := range planet.StorageNodes {
+			require.NoError(t, satellite.Overlay.Service.TestNodeCountryCode(ctx, node.ID(), "DE"))
+		}
+		require.NoError(t, satellite.Overlay.Service.UploadSelectionCache.Refresh(ctx))
+
+		_, err := satellite.API.Buckets.Service.CreateBucket(ctx, storj.Bucket{
+			Name:      "testbucket",
+			ProjectID: projectID,
+			Placement: storj.EU,
+		})
+		require.NoError(t, err)
+
+		testData := testrand.Bytes(8 * memory.KiB)
+		err = uplinkPeer.Upload(ctx, satellite, "testbucket", "test/path", testData)
+		require.NoError(t, err)
+
+		segment, _ := getRemoteSegment(ctx, t, satellite, projectID, "testbucket")
+		require.Equal(t, storj.EU, segment.Placement)
+		require.Len(t, segment.Pieces, 4)
+
+		movedPiece := segment.Pieces[0]
+		require.NoError(t, satellite.Overlay.Service.TestNodeCountryCode(ctx, movedPiece.StorageNode, "US"))
+
+		reporter := repairer.NewPlacementReporter(satellite.Metabase.DB, satellite.Overlay.Service)
+		bucket := metabase.BucketLocation{ProjectID: projectID, BucketName: "testbucket"}
+
+		compliance, err := reporter.BucketCompliance(ctx, bucket)
+		require.NoError(t, err)
+		require.EqualValues(t, 1, compliance.Segments)
+		require.EqualValues(t, 0, compliance.CompliantSegments)
+		require.EqualValues(t, 4, compliance.Pieces)
+		require.EqualValues(t, 1, compliance.PiecesOutOfPlacement)
+
+		// trigger checker to add segment to repair queue
+		satellite.Repair.Checker.Loop.Restart()
+		satellite.Repair.Checker.Loop.TriggerWait()
+		satellite.Repair.Checker.Loop.Pause()
+
+		count, err := satellite.DB.RepairQueue().Count(ctx)
+		require.NoError(t, err)
+		require.Equal(t, 1, count)
+
+		satellite.Repair.Repairer.Loop.Restart()
+		satellite.Repair.Repairer.Loop.TriggerWait()
+		satellite.Repair.Repairer.Loop.Pause()
+		satellite.Repair.Repairer.WaitForPendingRepairs()
+
+		count, err = satellite.DB.RepairQueue().Count(ctx)
+		require.NoError(t, err)
+		require.Zero(t, count)
+
+		segmentAfterRepair, _ := getRemoteSegment(ctx, t, satellite, projectID, "testbucket")
+		require.Len(t, segmentAfterRepair.Pieces, len(segment.Pieces))
+		for i, piece := range segmentAfterRepair.Pieces {
+			require.Equal(t, segment.Pieces[i].Number, piece.Number)
+			if piece.Number == movedPiece.Number {
+				require.NotEqual(t, movedPiece.StorageNode, piece.StorageNode)
+				for _, original := range segment.Pieces {
+					require.NotEqual(t, original.StorageNode, piece.StorageNode)
+				}
+			} else {
+				require.Equal(t, segment.Pieces[i].StorageNode, piece.StorageNode)
+			}
+		}
+
+		compliance, err = reporter.BucketCompliance(ctx, bucket)
+		require.NoError(t, err)
+		require.EqualValues(t, 1, compliance.CompliantSegments)
+		require.EqualValues(t, 0, compliance.PiecesOutOfPlacement)
+		require.Equal(t, float64(1), compliance.Progress())
+
+		// the copied piece must be usable for downloading the object, so only
+		// the copy and one other piece are left online
+		stopped := 0
+		for _, piece := range segmentAfterRepair.Pieces {
+			if piece.Number != movedPiece.Number && stopped < 2 {
+				require.NoError(t, planet.StopNodeAndUpdate(ctx, planet.FindNode(piece.StorageNode)))
+				stopped++
+			}
+		}
+		require.NoError(t, planet.StopNodeAndUpdate(ctx, planet.FindNode(movedPiece.StorageNode)))
+
+		data, err := uplinkPeer.Download(ctx, satellite, "testbucket", "test/path")
+		require.NoError(t, err)
+		require.Equal(t, testData, data)
+	})
+}
+
+//   - 8 storage nodes in an EU country
+//   - upload an object to a bucket with the EU placement on 4 nodes
+//   - move three nodes holding a piece out of the EU, more than the number of
+//     pieces the segment can lose
+//   - run the checker and the repairer
+//   - check that the pieces out of placement were copied to other EU nodes
+//     with the same piece numbers.
+func TestOutOfPlacementPiecesMigrationBelowMinimum(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount:   1,
+		StorageNodeCount: 8,
+		UplinkCount:      1,
+		Reconfigure: testplanet.Reconfigure{
+			Satellite: testplanet.Combine(
+				func(log *zap.Logger, index int, config *satellite.Config) {
+					config.Repairer.InMemoryRepair = true
+					config.Repairer.MigrateOutOfPlacement = true
+				},
+				testplanet.ReconfigureRS(2, 3, 4, 4),
+			),
+		},
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		uplinkPeer := planet.Uplinks[0]
+		satellite := planet.Satellites[0]
+		projectID := uplinkPeer.Projects[0].ID
+		// stop audit to prevent possible interactions i.e. repair timeout problems
+		satellite.Audit.Worker.Loop.Pause()
+
+		satellite.Repair.Checker.Loop.Pause()
+		satellite.Repair.Repairer.Loop.Pause()
+
+		for _, node := range planet.StorageNodes {
+			require.NoError(t, satellite.Overlay.Service.TestNodeCountryCode(ctx, node.ID(), "DE"))
+		}
+		require.NoError(t, satellite.Overlay.Service.UploadSelectionCache.Refresh(ctx))
+
+		_, err := satellite.API.Buckets.Service.CreateBucket(ctx, storj.Bucket{
+			Name:      "testbucket",
+			ProjectID: projectID,
+			Placement: storj.EU,
+		})
+		require.NoError(t, err)
+
+		testData := testrand.Bytes(8 * memory.KiB)
+		err = uplinkPeer.Upload(ctx, satellite, "testbucket", "test/path", testData)
+		require.NoError(t, err)
+
+		segment, _ := getRemoteSegment(ctx, t, satellite, projectID, "testbucket")
+		require.Len(t, segment.Pieces, 4)
+
+		movedNodes := make(map[storj.NodeID]bool)
+		for _, piece := range segment.Pieces[:3] {
+			require.NoError(t, satellite.Overlay.Service.TestNodeCountryCode(ctx, piece.StorageNode, "US"))
+			movedNodes[piece.StorageNode] = true
+		}
+
+		satellite.Repair.Checker.Loop.Restart()
+		satellite.Repair.Checker.Loop.TriggerWait()
+		satellite.Repair.Checker.Loop.Pause()
+
+		count, err := satellite.DB.RepairQueue().Count(ctx)
+		require.NoError(t, err)
+		require.Equal(t, 1, count)
+
+		satellite.Repair.Repairer.Loop.Restart()
+		satellite.Repair.Repairer.Loop.TriggerWait()
+		satellite.Repair.Repairer.Loop.Pause()
+		satellite.Repair.Repairer.WaitForPendingRepairs()
+
+		count, err = satellite.DB.RepairQueue().Count(ctx)
+		require.NoError(t, err)
+		require.Zero(t, count)
+
+		segmentAfterRepair, _ := getRemoteSegment(ctx, t, satellite, projectID, "testbucket")
+		require.Len(t, segmentAfterRepair.Pieces, len(segment.Pieces))
+		for i, piece := range segmentAfterRepair.Pieces {
+			require.Equal(t, segment.Pieces[i].Number, piece.Number)
+			if movedNodes[segment.Pieces[i].StorageNode] {
+				require.False(t, movedNodes[piece.StorageNode])
+				for _, original := range segment.Pieces {
+					require.NotEqual(t, original.StorageNode, piece.StorageNode)
+				}
+			} else {
+				require.Equal(t, segment.Pieces[i].StorageNode, piece.StorageNode)
+			}
+		}
+
+		for nodeID := range movedNodes {
+			require.NoError(t, planet.StopNodeAndUpdate(ctx, planet.FindNode(nodeID)))
+		}
+
+		data, err := uplinkPeer.Download(ctx, satellite, "testbucket", "test/path")
+		require.NoError(t, err)
+		require.Equal(t, testData, data)
+	})
+}
+
+//   - 8 storage nodes in an EU country
+//   - upload an object to a bucket with the EU placement on 4 nodes
+//   - move three nodes holding a piece out of the EU, leaving fewer pieces
+//     in placement than required
+//   - run the checker and the repairer
+//   - check that the pieces out of placement were used to repair the segment
+//     and were replaced by pieces on EU nodes.
+func TestOutOfPlacementPiecesRepair(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount:   1,
+		StorageNodeCount: 8,
+		UplinkCount:      1,
+		Reconfigure: testplanet.Reconfigure{
+			Satellite: testplanet.Combine(
+				func(log *zap.Logger, index int, config *satellite.Config) {
+					config.Repairer.InMemoryRepair = true
+				},
+				testplanet.ReconfigureRS(2, 3, 4, 4),
+			),
+		},
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		uplinkPeer := planet.Uplinks[0]
+		satellite := planet.Satellites[0]
+		projectID := uplinkPeer.Projects[0].ID
+		// stop audit to prevent possible interactions i.e. repair timeout problems
+		satellite.Audit.Worker.Loop.Pause()
+
+		satellite.Repair.Checker.Loop.Pause()
+		satellite.Repair.Repairer.Loop.Pause()
+
+		for _, node := range planet.StorageNodes {
+			require.NoError(t, satellite.Overlay.Service.TestNodeCountryCode(ctx, node.ID(), "DE"))
+		}
+		require.NoError(t, satellite.Overlay.Service.UploadSelectionCache.Refresh(ctx))
+
+		_, err := satellite.API.Buckets.Service.CreateBucket(ctx, storj.Bucket{
+			Name:      "testbucket",
+			ProjectID: projectID,
+			Placement: storj.EU,
+		})
+		require.NoError(t, err)
+
+		testData := testrand.Bytes(8 * memory.KiB)
+		err = uplinkPeer.Upload(ctx, satellite, "testbucket", "test/path", testData)
+		require.NoError(t, err)
+
+		segment, _ := getRemoteSegment(ctx, t, satellite, projectID, "testbucket")
+		require.Len(t, segment.Pieces, 4)
+
+		movedNodes := make(map[storj.NodeID]bool)
+		for _, piece := range segment.Pieces[:3] {
+			require.NoError(t, satellite.Overlay.Service.TestNodeCountryCode(ctx, piece.StorageNode, "US"))
+			movedNodes[piece.StorageNode] = true
+		}
+
+		satellite.Repair.Checker.Loop.Restart()
+		satellite.Repair.Checker.Loop.TriggerWait()
+		satellite.Repair.Checker.Loop.Pause()
+
+		count, err := satellite.DB.RepairQueue().Count(ctx)
+		require.NoError(t, err)
+		require.Equal(t, 1, count)
+
+		satellite.Repair.Repairer.Loop.Restart()
+		satellite.Repair.Repairer.Loop.TriggerWait()
+		satellite.Repair.Repairer.Loop.Pause()
+		satellite.Repair.Repairer.WaitForPendingRepairs()
+
+		count, err = satellite.DB.RepairQueue().Count(ctx)
+		require.NoError(t, err)
+		require.Zero(t, count)
+
+		segmentAfterRepair, _ := getRemoteSegment(ctx, t, satellite, projectID, "testbucket")
+		require.GreaterOrEqual(t, len(segmentAfterRepair.Pieces), int(segment.Redundancy.OptimalShares))
+		for _, piece := range segmentAfterRepair.Pieces {
+			require.False(t, movedNodes[piece.StorageNode], "piece %d is still out of placement", piece.Number)
+		}
+
+		// the repaired pieces must be usable without the pieces out of placement
+		for nodeID := range movedNodes {
+			require.NoError(t, planet.StopNodeAndUpdate(ctx, planet.FindNode(nodeID)))
+		}
+
+		data, err := uplinkPeer.Download(ctx, satellite, "testbucket", "test/path")
+		require.NoError(t, err)
+		require.Equal(t, testData, data)
+	})
+}
+
+//   - 8 storage nodes run by different operators
+//   - upload an object on 4 nodes, at most 2 of them may share an operator
+//   - make three nodes holding a piece share the same operator wallet
+//   - run the checker and the repairer
+//   - check that the piece in excess was replaced by a piece on a node of
+//     another operator and that the checker doesn't queue the segment again.
+func TestFailureDomainExcessPiecesRepair(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount:   1,
+		StorageNodeCount: 8,
+		UplinkCount:      1,
+		Reconfigure: testplanet.Reconfigure{
+			Satellite: testplanet.Combine(
+				func(log *zap.Logger, index int, config *satellite.Config) {
+					config.Repairer.InMemoryRepair = true
+					config.Overlay.Node.MaxFailureDomainShare = 0.5
+				},
+				testplanet.ReconfigureRS(2, 3, 4, 4),
+			),
+			StorageNode: func(index int, config *storagenode.Config) {
+				config.Operator.Wallet = fmt.Sprintf("0x%040x", index+1)
+			},
+		},
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		uplinkPeer := planet.Uplinks[0]
+		satellite := planet.Satellites[0]
+		// stop audit to prevent possible interactions i.e. repair timeout problems
+		satellite.Audit.Worker.Loop.Pause()
+
+		satellite.Repair.Checker.Loop.Pause()
+		satellite.Repair.Repairer.Loop.Pause()
+
+		// the check-ins would reset the operator of the nodes
+		for _, node := range planet.StorageNodes {
+			node.Contact.Chore.Pause(ctx)
+		}
+
+		testData := testrand.Bytes(8 * memory.KiB)
+		err := uplinkPeer.Upload(ctx, satellite, "testbucket", "test/path", testData)
+		require.NoError(t, err)
+
+		segment, _ := getRemoteSegment(ctx, t, satellite, uplinkPeer.Projects[0].ID, "testbucket")
+		require.Len(t, segment.Pieces, 4)
+
+		sharedWallet := "0x" + strings.Repeat("ab", 20)
+		sharedNodes := make(map[storj.NodeID]bool)
+		for _, piece := range segment.Pieces[:3] {
+			_, err := satellite.DB.OverlayCache().UpdateNodeInfo(ctx, piece.StorageNode, &overlay.InfoResponse{
+				Operator: &pb.NodeOperator{
+					Email:  planet.FindNode(piece.StorageNode).Config.Operator.Email,
+					Wallet: sharedWallet,
+				},
+			})
+			require.NoError(t, err)
+			sharedNodes[piece.StorageNode] = true
+		}
+		excessPiece := segment.Pieces[2]
+
+		require.NoError(t, satellite.Overlay.Service.UploadSelectionCache.Refresh(ctx))
+		require.NoError(t, satellite.Repair.Checker.RefreshReliabilityCache(ctx))
+
+		satellite.Repair.Checker.Loop.Restart()
+		satellite.Repair.Checker.Loop.TriggerWait()
+		satellite.Repair.Checker.Loop.Pause()
+
+		count, err := satellite.DB.RepairQueue().Count(ctx)
+		require.NoError(t, err)
+		require.Equal(t, 1, count)
+
+		satellite.Repair.Repairer.Loop.Restart()
+		satellite.Repair.Repairer.Loop.TriggerWait()
+		satellite.Repair.Repairer.Loop.Pause()
+		satellite.Repair.Repairer.WaitForPendingRepairs()
+
+		count, err = satellite.DB.RepairQueue().Count(ctx)
+		require.NoError(t, err)
+		require.Zero(t, count)
+
+		segmentAfterRepair, _ := getRemoteSegment(ctx, t, satellite, uplinkPeer.Projects[0].ID, "testbucket")
+		require.GreaterOrEqual(t, len(segmentAfterRepair.Pieces), int(segment.Redundancy.OptimalShares))
+
+		shared := 0
+		for _, piece := range segmentAfterRepair.Pieces {
+			require.NotEqual(t, excessPiece, piece)
+			if sharedNodes[piece.StorageNode] {
+				shared++
+			}
+		}
+		require.Equal(t, 2, shared)
+
+		// the repaired segment is healthy for the checker as well
+		satellite.Repair.Checker.Loop.Restart()
+		satellite.Repair.Checker.Loop.TriggerWait()
+		satellite.Repair.Checker.Loop.Pause()
+
+		count, err = satellite.DB.RepairQueue().Count(ctx)
+		require.NoError(t, err)
+		require.Zero(t, count)
+	})
+}
+
 func reputationRatio(info reputation.Info) float64 {
 	return info.AuditReputationAlpha / (info.AuditReputationAlpha + info.AuditReputationBeta)
 }
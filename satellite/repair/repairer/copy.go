@@ -0,0 +1,122 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package repairer
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	"storj.io/common/errs2"
+	"storj.io/common/pb"
+	"storj.io/common/storj"
+	"storj.io/storj/satellite/metabase"
+	"storj.io/storj/satellite/overlay"
+)
+
+// CopyPieces downloads the pieces of the GET_REPAIR order limits and uploads
+// each of them, unchanged, to the node of the PUT_REPAIR order limit with the
+// same piece number. The pieces are copied concurrently and each copy must
+// finish within timeout.
+//
+// successfulNodes contains, indexed by piece number, the nodes which stored a
+// copy of the piece, pieces reports the results of downloading the pieces.
+func (ec *ECRepairer) CopyPieces(ctx context.Context, getLimits []*pb.AddressedOrderLimit, cachedNodesInfo map[storj.NodeID]overlay.NodeReputation, getPrivateKey storj.PiecePrivateKey, putLimits []*pb.AddressedOrderLimit, putPrivateKey storj.PiecePrivateKey, pieceSize int64, timeout time.Duration) (successfulNodes []*pb.Node, pieces FetchResultReport, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if len(getLimits) != len(putLimits) {
+		return nil, FetchResultReport{}, Error.New("number of get limits (%d) does not match number of put limits (%d)", len(getLimits), len(putLimits))
+	}
+
+	successfulNodes = make([]*pb.Node, len(putLimits))
+
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+	var successfulCount int
+	for i := range getLimits {
+		if getLimits[i] == nil || putLimits[i] == nil {
+			continue
+		}
+
+		wg.Add(1)
+		go func(pieceNum int, getLimit, putLimit *pb.AddressedOrderLimit) {
+			defer wg.Done()
+
+			piece := metabase.Piece{
+				Number:      uint16(pieceNum),
+				StorageNode: getLimit.GetLimit().StorageNodeId,
+			}
+
+			info := cachedNodesInfo[getLimit.GetLimit().StorageNodeId]
+			address := getLimit.GetStorageNodeAddress().GetAddress()
+			var triedLastIPPort bool
+			if info.LastIPPort != "" && info.LastIPPort != address {
+				address = info.LastIPPort
+				triedLastIPPort = true
+			}
+
+			pieceReadCloser, _, _, err := ec.downloadAndVerifyPiece(ctx, getLimit, address, getPrivateKey, "", pieceSize)
+			// if piecestore dial with last ip:port failed try again with node address
+			if triedLastIPPort && ErrDialFailed.Has(err) {
+				if pieceReadCloser != nil {
+					_ = pieceReadCloser.Close()
+				}
+				pieceReadCloser, _, _, err = ec.downloadAndVerifyPiece(ctx, getLimit, getLimit.GetStorageNodeAddress().GetAddress(), getPrivateKey, "", pieceSize)
+			}
+			if err != nil {
+				if pieceReadCloser != nil {
+					_ = pieceReadCloser.Close()
+				}
+
+				mu.Lock()
+				ec.recordFetchFailure(&pieces, getLimit, piece, err)
+				mu.Unlock()
+				return
+			}
+
+			mu.Lock()
+			pieces.Successful = append(pieces.Successful, PieceFetchResult{Piece: piece})
+			mu.Unlock()
+
+			putCtx, cancel := context.WithTimeout(ctx, timeout)
+			defer cancel()
+
+			// putPiece closes the reader.
+			_, err = ec.putPiece(putCtx, ctx, putLimit, putPrivateKey, pieceReadCloser)
+			if err != nil {
+				if !errs2.IsCanceled(err) {
+					ec.log.Warn("Copying piece to a storage node failed",
+						zap.Stringer("Node ID", putLimit.GetLimit().StorageNodeId),
+						zap.Uint16("Piece Num", piece.Number),
+						zap.Error(err),
+					)
+				}
+				return
+			}
+
+			mu.Lock()
+			successfulNodes[piece.Number] = &pb.Node{
+				Id:      putLimit.GetLimit().StorageNodeId,
+				Address: putLimit.GetStorageNodeAddress(),
+			}
+			successfulCount++
+			mu.Unlock()
+		}(i, getLimits[i], putLimits[i])
+	}
+	wg.Wait()
+
+	if ctxErr := ctx.Err(); ctxErr != nil {
+		return nil, pieces, ctxErr
+	}
+	if successfulCount == 0 {
+		return nil, pieces, Error.New("copying pieces to all nodes failed")
+	}
+
+	mon.IntVal("repair_copy_pieces_total").Observe(int64(nonNilCount(putLimits)))
+	mon.IntVal("repair_copy_pieces_successful").Observe(int64(successfulCount))
+
+	return successfulNodes, pieces, nil
+}
@@ -48,6 +48,11 @@ type ECRepairer struct {
 	satelliteSignee signing.Signee
 	downloadTimeout time.Duration
 	inmemory        bool
+
+	// OnTestingStreamWindowHook is called by the streaming repair after each
+	// window was passed to the uploads, with the nodes of the downloaded
+	// pieces.
+	OnTestingStreamWindowHook func(offset int64, sources []storj.NodeID)
 }
 
 // NewECRepairer creates a new repairer for interfacing with storagenodes.
@@ -155,47 +160,7 @@ func (ec *ECRepairer) Get(ctx context.Context, limits []*pb.AddressedOrderLimit,
 						_ = pieceReadCloser.Close()
 					}
 
-					// gather nodes where the calculated piece hash doesn't match the uplink signed piece hash
-					if ErrPieceHashVerifyFailed.Has(err) {
-						ec.log.Info("audit failed",
-							zap.Stringer("node ID", limit.GetLimit().StorageNodeId),
-							zap.Stringer("Piece ID", limit.Limit.PieceId),
-							zap.String("reason", err.Error()))
-						pieces.Failed = append(pieces.Failed, PieceFetchResult{Piece: piece, Err: err})
-						return
-					}
-
-					pieceAudit := audit.PieceAuditFromErr(err)
-					switch pieceAudit {
-					case audit.PieceAuditFailure:
-						ec.log.Debug("Failed to download piece for repair: piece not found (audit failed)",
-							zap.Stringer("Node ID", limit.GetLimit().StorageNodeId),
-							zap.Stringer("Piece ID", limit.Limit.PieceId),
-							zap.Error(err))
-						pieces.Failed = append(pieces.Failed, PieceFetchResult{Piece: piece, Err: err})
-
-					case audit.PieceAuditOffline:
-						ec.log.Debug("Failed to download piece for repair: dial timeout (offline)",
-							zap.Stringer("Node ID", limit.GetLimit().StorageNodeId),
-							zap.Stringer("Piece ID", limit.Limit.PieceId),
-							zap.Error(err))
-						pieces.Offline = append(pieces.Offline, PieceFetchResult{Piece: piece, Err: err})
-
-					case audit.PieceAuditContained:
-						ec.log.Info("Failed to download piece for repair: download timeout (contained)",
-							zap.Stringer("Node ID", limit.GetLimit().StorageNodeId),
-							zap.Stringer("Piece ID", limit.Limit.PieceId),
-							zap.Error(err))
-						pieces.Contained = append(pieces.Contained, PieceFetchResult{Piece: piece, Err: err})
-
-					case audit.PieceAuditUnknown:
-						ec.log.Info("Failed to download piece for repair: unknown transport error (skipped)",
-							zap.Stringer("Node ID", limit.GetLimit().StorageNodeId),
-							zap.Stringer("Piece ID", limit.Limit.PieceId),
-							zap.Error(err))
-						pieces.Unknown = append(pieces.Unknown, PieceFetchResult{Piece: piece, Err: err})
-					}
-
+					ec.recordFetchFailure(&pieces, limit, piece, err)
 					return
 				}
 
@@ -231,6 +196,51 @@ func (ec *ECRepairer) Get(ctx context.Context, limits []*pb.AddressedOrderLimit,
 	return decodeReader, pieces, nil
 }
 
+// recordFetchFailure logs the failure to download the piece and adds it to the
+// pieces report according to the audit outcome of the error.
+func (ec *ECRepairer) recordFetchFailure(pieces *FetchResultReport, limit *pb.AddressedOrderLimit, piece metabase.Piece, err error) {
+	// gather nodes where the calculated piece hash doesn't match the uplink signed piece hash
+	if ErrPieceHashVerifyFailed.Has(err) {
+		ec.log.Info("audit failed",
+			zap.Stringer("node ID", limit.GetLimit().StorageNodeId),
+			zap.Stringer("Piece ID", limit.Limit.PieceId),
+			zap.String("reason", err.Error()))
+		pieces.Failed = append(pieces.Failed, PieceFetchResult{Piece: piece, Err: err})
+		return
+	}
+
+	pieceAudit := audit.PieceAuditFromErr(err)
+	switch pieceAudit {
+	case audit.PieceAuditFailure:
+		ec.log.Debug("Failed to download piece for repair: piece not found (audit failed)",
+			zap.Stringer("Node ID", limit.GetLimit().StorageNodeId),
+			zap.Stringer("Piece ID", limit.Limit.PieceId),
+			zap.Error(err))
+		pieces.Failed = append(pieces.Failed, PieceFetchResult{Piece: piece, Err: err})
+
+	case audit.PieceAuditOffline:
+		ec.log.Debug("Failed to download piece for repair: dial timeout (offline)",
+			zap.Stringer("Node ID", limit.GetLimit().StorageNodeId),
+			zap.Stringer("Piece ID", limit.Limit.PieceId),
+			zap.Error(err))
+		pieces.Offline = append(pieces.Offline, PieceFetchResult{Piece: piece, Err: err})
+
+	case audit.PieceAuditContained:
+		ec.log.Info("Failed to download piece for repair: download timeout (contained)",
+			zap.Stringer("Node ID", limit.GetLimit().StorageNodeId),
+			zap.Stringer("Piece ID", limit.Limit.PieceId),
+			zap.Error(err))
+		pieces.Contained = append(pieces.Contained, PieceFetchResult{Piece: piece, Err: err})
+
+	case audit.PieceAuditUnknown:
+		ec.log.Info("Failed to download piece for repair: unknown transport error (skipped)",
+			zap.Stringer("Node ID", limit.GetLimit().StorageNodeId),
+			zap.Stringer("Piece ID", limit.Limit.PieceId),
+			zap.Error(err))
+		pieces.Unknown = append(pieces.Unknown, PieceFetchResult{Piece: piece, Err: err})
+	}
+}
+
 // lazyHashWriter is a writer which can get the hash algorithm just before the first write.
 type lazyHashWriter struct {
 	hasher     hash.Hash
@@ -322,28 +332,34 @@ func (ec *ECRepairer) downloadAndVerifyPiece(ctx context.Context, limit *pb.Addr
 		return pieceReadCloser, nil, nil, Error.New("didn't download the correct amount of data, want %d, got %d", pieceSize, downloadedPieceSize)
 	}
 
+	hash, originalLimit, err = ec.verifyDownload(ctx, downloader, hashWriter.Sum(nil))
+	return pieceReadCloser, hash, originalLimit, err
+}
+
+// verifyDownload verifies the signed piece hash and the original order limit
+// sent by the storagenode at the end of the download against the hash
+// calculated from the downloaded data.
+func (ec *ECRepairer) verifyDownload(ctx context.Context, downloader *piecestore.Download, calculatedHash []byte) (hash *pb.PieceHash, originalLimit *pb.OrderLimit, err error) {
 	// get signed piece hash and original order limit
 	hash, originalLimit = downloader.GetHashAndLimit()
 	if hash == nil {
-		return pieceReadCloser, hash, originalLimit, Error.New("hash was not sent from storagenode")
+		return hash, originalLimit, Error.New("hash was not sent from storagenode")
 	}
 	if originalLimit == nil {
-		return pieceReadCloser, hash, originalLimit, Error.New("original order limit was not sent from storagenode")
+		return hash, originalLimit, Error.New("original order limit was not sent from storagenode")
 	}
 
 	// verify order limit from storage node is signed by the satellite
 	if err := verifyOrderLimitSignature(ctx, ec.satelliteSignee, originalLimit); err != nil {
-		return pieceReadCloser, hash, originalLimit, err
+		return hash, originalLimit, err
 	}
 
 	// verify the hashes from storage node
-	calculatedHash := hashWriter.Sum(nil)
 	if err := verifyPieceHash(ctx, originalLimit, hash, calculatedHash); err != nil {
-
-		return pieceReadCloser, hash, originalLimit, ErrPieceHashVerifyFailed.Wrap(err)
+		return hash, originalLimit, ErrPieceHashVerifyFailed.Wrap(err)
 	}
 
-	return pieceReadCloser, hash, originalLimit, nil
+	return hash, originalLimit, nil
 }
 
 func verifyPieceHash(ctx context.Context, limit *pb.OrderLimit, hash *pb.PieceHash, expectedHash []byte) (err error) {
@@ -393,6 +409,17 @@ func (ec *ECRepairer) Repair(ctx context.Context, limits []*pb.AddressedOrderLim
 		return nil, nil, err
 	}
 
+	return ec.putPieces(ctx, limits, privateKey, rs, readers, timeout, successfulNeeded)
+}
+
+// putPieces uploads the pieces read from readers to the nodes of the order
+// limits. Once successfulNeeded uploads succeeded, or the timeout expired, the
+// remaining uploads are canceled.
+func (ec *ECRepairer) putPieces(ctx context.Context, limits []*pb.AddressedOrderLimit, privateKey storj.PiecePrivateKey, rs eestream.RedundancyStrategy, readers []io.ReadCloser, timeout time.Duration, successfulNeeded int) (successfulNodes []*pb.Node, successfulHashes []*pb.PieceHash, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	pieceCount := len(limits)
+
 	// info contains data about a single piece transfer
 	type info struct {
 		i    int
@@ -0,0 +1,145 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package repairer
+
+import (
+	"context"
+
+	"storj.io/common/storj"
+	"storj.io/common/uuid"
+	"storj.io/storj/satellite/metabase"
+	"storj.io/storj/satellite/overlay"
+)
+
+// PlacementCompliance reports how many segments of a bucket and their pieces
+// are stored on nodes matching the placement of the segments.
+type PlacementCompliance struct {
+	ProjectID  uuid.UUID
+	BucketName string
+
+	// Segments is the number of remote segments of the bucket, and
+	// CompliantSegments the number of them without pieces out of placement.
+	Segments          int64
+	CompliantSegments int64
+
+	// Pieces is the number of pieces held by reliable nodes, and
+	// PiecesOutOfPlacement the number of them held by nodes which don't
+	// match the placement of their segment.
+	Pieces               int64
+	PiecesOutOfPlacement int64
+}
+
+// Progress returns the ratio of segments without pieces out of placement.
+func (compliance PlacementCompliance) Progress() float64 {
+	if compliance.Segments == 0 {
+		return 1
+	}
+	return float64(compliance.CompliantSegments) / float64(compliance.Segments)
+}
+
+// PlacementReporter computes the placement compliance of buckets.
+type PlacementReporter struct {
+	metabase *metabase.DB
+	overlay  *overlay.Service
+
+	batchSize int
+}
+
+// NewPlacementReporter creates a new placement compliance reporter.
+func NewPlacementReporter(metabase *metabase.DB, overlay *overlay.Service) *PlacementReporter {
+	return &PlacementReporter{
+		metabase:  metabase,
+		overlay:   overlay,
+		batchSize: 1000,
+	}
+}
+
+// BucketCompliance iterates the segments of all the objects of the bucket and
+// returns how many of them are stored on nodes matching their placement.
+func (reporter *PlacementReporter) BucketCompliance(ctx context.Context, bucket metabase.BucketLocation) (compliance PlacementCompliance, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	compliance.ProjectID = bucket.ProjectID
+	compliance.BucketName = bucket.BucketName
+
+	nodes, err := reporter.overlay.ReliableNodes(ctx)
+	if err != nil {
+		return compliance, Error.Wrap(err)
+	}
+	reliable := make(map[storj.NodeID]*overlay.SelectedNode, len(nodes))
+	for _, node := range nodes {
+		reliable[node.ID] = node
+	}
+
+	for _, status := range []metabase.ObjectStatus{metabase.Committed, metabase.Pending} {
+		err = reporter.metabase.IterateObjectsAllVersionsWithStatus(ctx, metabase.IterateObjectsWithStatus{
+			ProjectID:  bucket.ProjectID,
+			BucketName: bucket.BucketName,
+			Recursive:  true,
+			BatchSize:  reporter.batchSize,
+			Status:     status,
+		}, func(ctx context.Context, it metabase.ObjectsIterator) error {
+			var entry metabase.ObjectEntry
+			for it.Next(ctx, &entry) {
+				if err := reporter.streamCompliance(ctx, entry.StreamID, reliable, &compliance); err != nil {
+					return err
+				}
+			}
+			return nil
+		})
+		if err != nil {
+			return compliance, Error.Wrap(err)
+		}
+	}
+
+	return compliance, nil
+}
+
+// streamCompliance adds the placement compliance of the segments of the stream.
+func (reporter *PlacementReporter) streamCompliance(ctx context.Context, streamID uuid.UUID, reliable map[storj.NodeID]*overlay.SelectedNode, compliance *PlacementCompliance) error {
+	var cursor metabase.SegmentPosition
+	for {
+		result, err := reporter.metabase.ListSegments(ctx, metabase.ListSegments{
+			StreamID: streamID,
+			Cursor:   cursor,
+			Limit:    reporter.batchSize,
+		})
+		if err != nil {
+			return err
+		}
+
+		for _, segment := range result.Segments {
+			cursor = segment.Position
+			if segment.Inline() {
+				continue
+			}
+
+			// segments with an undefined placement are never repaired
+			// because of it, so they are counted as compliant.
+			defined := reporter.overlay.IsPlacementDefined(segment.Placement)
+
+			compliant := true
+			for _, piece := range segment.Pieces {
+				node, ok := reliable[piece.StorageNode]
+				if !ok {
+					continue
+				}
+				compliance.Pieces++
+				if defined && !reporter.overlay.MatchPlacement(segment.Placement, node) {
+					compliance.PiecesOutOfPlacement++
+					compliant = false
+				}
+			}
+
+			compliance.Segments++
+			if compliant {
+				compliance.CompliantSegments++
+			}
+		}
+
+		if !result.More {
+			return nil
+		}
+	}
+}
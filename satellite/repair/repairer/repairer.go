@@ -34,6 +34,8 @@ type Config struct {
 	MaxBufferMem                  memory.Size   `help:"maximum buffer memory (in bytes) to be allocated for read buffers" default:"4.0 MiB"`
 	MaxExcessRateOptimalThreshold float64       `help:"ratio applied to the optimal threshold to calculate the excess of the maximum number of repaired pieces to upload" default:"0.05"`
 	InMemoryRepair                bool          `help:"whether to download pieces for repair in memory (true) or download to disk (false)" default:"false"`
+	StreamingRepair               bool          `help:"whether to repair segments stripe by stripe, buffering at most max-buffer-mem or a single stripe when it does not fit, instead of downloading the segment before uploading the repaired pieces" default:"false"`
+	MigrateOutOfPlacement         bool          `help:"whether to copy only the pieces out of placement to nodes matching the placement, instead of repairing the segment, when that brings the segment above the repair threshold" default:"true"`
 	ReputationUpdateEnabled       bool          `help:"whether the audit score of nodes should be updated as a part of repair" default:"false"`
 	UseRangedLoop                 bool          `help:"whether to use ranged loop instead of segment loop" default:"false"`
 }
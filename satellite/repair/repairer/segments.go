@@ -84,6 +84,15 @@ type SegmentRepairer struct {
 	timeout        time.Duration
 	reporter       audit.Reporter
 
+	// streaming repairs segments stripe by stripe, using at most
+	// maxBufferMem for the buffers.
+	streaming    bool
+	maxBufferMem int
+
+	// migrateOutOfPlacement copies the pieces out of placement to nodes
+	// matching the placement, instead of repairing the segment, when possible.
+	migrateOutOfPlacement bool
+
 	reputationUpdateEnabled bool
 
 	// multiplierOptimalThreshold is the value that multiplied by the optimal
@@ -132,6 +141,9 @@ func NewSegmentRepairer(
 		repairOverrides:            repairOverrides.GetMap(),
 		reporter:                   reporter,
 		reputationUpdateEnabled:    config.ReputationUpdateEnabled,
+		streaming:                  config.StreamingRepair,
+		maxBufferMem:               config.MaxBufferMem.Int(),
+		migrateOutOfPlacement:      config.MigrateOutOfPlacement,
 
 		nowFn: time.Now,
 	}
@@ -143,6 +155,23 @@ func NewSegmentRepairer(
 func (repairer *SegmentRepairer) Repair(ctx context.Context, queueSegment *queue.InjuredSegment) (shouldDelete bool, err error) {
 	defer mon.Task()(&ctx, queueSegment.StreamID.String(), queueSegment.Position.Encode())(&err)
 
+	shouldDelete, err = repairer.repair(ctx, queueSegment, repairer.streaming)
+	if errStreamSourceLost.Has(err) {
+		// The uploads of the streaming repair were aborted, the segment is
+		// repaired again with new order limits, downloading it first.
+		mon.Meter("repair_stream_fallback").Mark(1)
+		repairer.log.Info("streaming repair lost a piece, repairing without streaming",
+			zap.String("StreamID", queueSegment.StreamID.String()),
+			zap.Uint64("Position", queueSegment.Position.Encode()),
+			zap.Error(err))
+		return repairer.repair(ctx, queueSegment, false)
+	}
+	return shouldDelete, err
+}
+
+// repair repairs the segment, downloading and uploading the pieces stripe by
+// stripe when streaming is true.
+func (repairer *SegmentRepairer) repair(ctx context.Context, queueSegment *queue.InjuredSegment, streaming bool) (shouldDelete bool, err error) {
 	segment, err := repairer.metabase.GetSegmentByPosition(ctx, metabase.GetSegmentByPosition{
 		StreamID: queueSegment.StreamID,
 		Position: queueSegment.Position,
@@ -195,33 +224,45 @@ func (repairer *SegmentRepairer) Repair(ctx context.Context, queueSegment *queue
 		return false, overlayQueryError.New("error identifying missing pieces: %w", err)
 	}
 
-	numHealthy := len(pieces) - len(missingPieces)
-	// irreparable segment
-	if numHealthy < int(segment.Redundancy.RequiredShares) {
-		mon.Counter("repairer_segments_below_min_req").Inc(1) //mon:locked
-		stats.repairerSegmentsBelowMinReq.Inc(1)
-		mon.Meter("repair_nodes_unavailable").Mark(1) //mon:locked
-		stats.repairerNodesUnavailable.Mark(1)
+	piecesOutOfPlacement, err := repairer.overlay.GetReliablePiecesOutOfPlacement(ctx, pieces, segment.Placement)
+	if err != nil {
+		return false, overlayQueryError.New("error identifying pieces out of placement: %w", err)
+	}
 
-		repairer.log.Warn("irreparable segment",
-			zap.String("StreamID", queueSegment.StreamID.String()),
-			zap.Uint64("Position", queueSegment.Position.Encode()),
-			zap.Int("piecesAvailable", numHealthy),
-			zap.Int16("piecesRequired", segment.Redundancy.RequiredShares),
-		)
-		return false, nil
+	// pieces exceeding the limit of their failure domain are replaced as well,
+	// the same way as the checker counts them.
+	outOfPlacementSet := sliceToSet(piecesOutOfPlacement)
+	var inPlacementPieces metabase.Pieces
+	for _, piece := range pieces {
+		if !outOfPlacementSet[piece.Number] {
+			inPlacementPieces = append(inPlacementPieces, piece)
+		}
+	}
+	piecesInExcessDomains, err := repairer.overlay.GetReliablePiecesInExcessDomains(ctx, inPlacementPieces, int(segment.Redundancy.TotalShares))
+	if err != nil {
+		return false, overlayQueryError.New("error identifying pieces in excess failure domains: %w", err)
 	}
 
+	// pieces out of placement or in excess failure domains are misplaced:
+	// they can still be downloaded, but they don't count towards the health of
+	// the segment and are replaced by pieces on well placed nodes.
+	lostPiecesSet := sliceToSet(missingPieces)
+	misplacedSet := sliceToSet(append(append([]uint16{}, piecesOutOfPlacement...), piecesInExcessDomains...))
+
+	numAvailable := len(pieces) - len(lostPiecesSet)
+	numHealthy := numAvailable - len(misplacedSet)
+
 	piecesInExcludedCountries, err := repairer.overlay.GetReliablePiecesInExcludedCountries(ctx, pieces)
 	if err != nil {
 		return false, overlayQueryError.New("error identifying pieces in excluded countries: %w", err)
 	}
 
-	numHealthyInExcludedCountries := len(piecesInExcludedCountries)
-
-	// ensure we get values, even if only zero values, so that redash can have an alert based on this
-	mon.Counter("repairer_segments_below_min_req").Inc(0) //mon:locked
-	stats.repairerSegmentsBelowMinReq.Inc(0)
+	numHealthyInExcludedCountries := 0
+	for _, number := range piecesInExcludedCountries {
+		if !lostPiecesSet[number] && !misplacedSet[number] {
+			numHealthyInExcludedCountries++
+		}
+	}
 
 	repairThreshold := int32(segment.Redundancy.RepairShares)
 
@@ -236,6 +277,36 @@ func (repairer *SegmentRepairer) Repair(ctx context.Context, queueSegment *queue
 		repairThreshold = overrideValue
 	}
 
+	// the misplaced pieces are copied to well placed nodes when the copies are
+	// enough to bring the segment above the repair threshold, which avoids
+	// reconstructing the segment. This is checked first since all the pieces
+	// of a segment may become misplaced at once, e.g. when its placement
+	// changes.
+	if repairer.migrateOutOfPlacement && len(misplacedSet) > 0 &&
+		numAvailable-numHealthyInExcludedCountries > int(repairThreshold) {
+		return repairer.migrate(ctx, segment, misplacedSet)
+	}
+
+	// irreparable segment
+	if numAvailable < int(segment.Redundancy.RequiredShares) {
+		mon.Counter("repairer_segments_below_min_req").Inc(1) //mon:locked
+		stats.repairerSegmentsBelowMinReq.Inc(1)
+		mon.Meter("repair_nodes_unavailable").Mark(1) //mon:locked
+		stats.repairerNodesUnavailable.Mark(1)
+
+		repairer.log.Warn("irreparable segment",
+			zap.String("StreamID", queueSegment.StreamID.String()),
+			zap.Uint64("Position", queueSegment.Position.Encode()),
+			zap.Int("piecesAvailable", numAvailable),
+			zap.Int16("piecesRequired", segment.Redundancy.RequiredShares),
+		)
+		return false, nil
+	}
+
+	// ensure we get values, even if only zero values, so that redash can have an alert based on this
+	mon.Counter("repairer_segments_below_min_req").Inc(0) //mon:locked
+	stats.repairerSegmentsBelowMinReq.Inc(0)
+
 	// repair not needed
 	if numHealthy-numHealthyInExcludedCountries > int(repairThreshold) {
 		mon.Meter("repair_unnecessary").Mark(1) //mon:locked
@@ -251,10 +322,9 @@ func (repairer *SegmentRepairer) Repair(ctx context.Context, queueSegment *queue
 	mon.FloatVal("healthy_ratio_before_repair").Observe(healthyRatioBeforeRepair) //mon:locked
 	stats.healthyRatioBeforeRepair.Observe(healthyRatioBeforeRepair)
 
-	lostPiecesSet := sliceToSet(missingPieces)
-
 	var healthyPieces, unhealthyPieces metabase.Pieces
-	// Populate healthyPieces with all pieces from the segment except those correlating to indices in lostPieces
+	// Populate healthyPieces with all pieces from the segment except those correlating to indices in lostPieces,
+	// the misplaced pieces are kept as download sources.
 	for _, piece := range pieces {
 		excludeNodeIDs = append(excludeNodeIDs, piece.StorageNode)
 		if !lostPiecesSet[piece.Number] {
@@ -286,16 +356,26 @@ func (repairer *SegmentRepairer) Repair(ctx context.Context, queueSegment *queue
 
 	// Double check for healthy pieces which became unhealthy inside CreateGetRepairOrderLimits
 	// Remove them from healthyPieces and add them to unhealthyPieces
-	var newHealthyPieces metabase.Pieces
+	var newHealthyPieces, misplacedPieces metabase.Pieces
 	for _, piece := range healthyPieces {
-		if getOrderLimits[piece.Number] == nil {
+		switch {
+		case getOrderLimits[piece.Number] == nil:
 			unhealthyPieces = append(unhealthyPieces, piece)
-		} else {
+		case misplacedSet[piece.Number]:
+			misplacedPieces = append(misplacedPieces, piece)
+		default:
 			newHealthyPieces = append(newHealthyPieces, piece)
 		}
 	}
 	healthyPieces = newHealthyPieces
 
+	// the piece numbers of misplaced pieces may be reused by the repaired
+	// pieces, since the misplaced pieces are replaced.
+	reservedLimits := make([]*pb.AddressedOrderLimit, len(getOrderLimits))
+	for _, piece := range healthyPieces {
+		reservedLimits[piece.Number] = getOrderLimits[piece.Number]
+	}
+
 	var requestCount int
 	var minSuccessfulNeeded int
 	{
@@ -308,6 +388,7 @@ func (repairer *SegmentRepairer) Repair(ctx context.Context, queueSegment *queue
 	request := overlay.FindStorageNodesRequest{
 		RequestedCount: requestCount,
 		ExcludedIDs:    excludeNodeIDs,
+		Placement:      segment.Placement,
 	}
 	newNodes, err := repairer.overlay.FindStorageNodesForUpload(ctx, request)
 	if err != nil {
@@ -315,13 +396,21 @@ func (repairer *SegmentRepairer) Repair(ctx context.Context, queueSegment *queue
 	}
 
 	// Create the order limits for the PUT_REPAIR action
-	putLimits, putPrivateKey, err := repairer.orders.CreatePutRepairOrderLimits(ctx, metabase.BucketLocation{}, segment, getOrderLimits, newNodes, repairer.multiplierOptimalThreshold, numHealthyInExcludedCountries)
+	putLimits, putPrivateKey, err := repairer.orders.CreatePutRepairOrderLimits(ctx, metabase.BucketLocation{}, segment, reservedLimits, newNodes, repairer.multiplierOptimalThreshold, numHealthyInExcludedCountries)
 	if err != nil {
 		return false, orderLimitFailureError.New("could not create PUT_REPAIR order limits: %w", err)
 	}
 
-	// Download the segment using just the healthy pieces
-	segmentReader, piecesReport, err := repairer.ec.Get(ctx, getOrderLimits, cachedNodesInfo, getPrivateKey, redundancy, int64(segment.EncryptedSize))
+	var segmentReader io.ReadCloser
+	var successfulNodes []*pb.Node
+	var piecesReport FetchResultReport
+	if streaming {
+		// Download the healthy pieces and upload the repaired pieces stripe by stripe
+		successfulNodes, _, piecesReport, err = repairer.ec.StreamRepair(ctx, getOrderLimits, cachedNodesInfo, getPrivateKey, putLimits, putPrivateKey, redundancy, int64(segment.EncryptedSize), repairer.maxBufferMem, repairer.timeout, minSuccessfulNeeded)
+	} else {
+		// Download the segment using just the healthy pieces
+		segmentReader, piecesReport, err = repairer.ec.Get(ctx, getOrderLimits, cachedNodesInfo, getPrivateKey, redundancy, int64(segment.EncryptedSize))
+	}
 
 	// ensure we get values, even if only zero values, so that redash can have an alert based on this
 	mon.Meter("repair_too_many_nodes_failed").Mark(0)     //mon:locked
@@ -425,41 +514,39 @@ func (repairer *SegmentRepairer) Repair(ctx context.Context, queueSegment *queue
 			// repair will be attempted again if the segment remains unhealthy.
 			return false, nil
 		}
+		if errStreamSourceLost.Has(err) {
+			return false, err
+		}
+		if errStreamAborted.Has(err) {
+			// The stream failed after the download started, the pieces which
+			// failed verification are removed so that the next attempt doesn't
+			// use them again.
+			repairer.recordAudits(ctx, cachedNodesInfo, piecesReport)
+			if removeErr := repairer.removeFailedPieces(ctx, segment, piecesReport); removeErr != nil {
+				return false, errs.Combine(repairReconstructError.Wrap(err), removeErr)
+			}
+			return false, repairReconstructError.Wrap(err)
+		}
+		if streaming {
+			// The segment was downloaded, but the repaired pieces couldn't be uploaded.
+			repairer.recordAudits(ctx, cachedNodesInfo, piecesReport)
+			return false, repairPutError.Wrap(err)
+		}
 		// The segment's redundancy strategy is invalid, or else there was an internal error.
 		return true, repairReconstructError.New("segment could not be reconstructed: %w", err)
 	}
-	defer func() { err = errs.Combine(err, segmentReader.Close()) }()
 
 	// only report audit result when segment can be successfully downloaded
-	cachedNodesReputation := make(map[storj.NodeID]overlay.ReputationStatus, len(cachedNodesInfo))
-	for id, info := range cachedNodesInfo {
-		cachedNodesReputation[id] = info.Reputation
-	}
-
-	report := audit.Report{
-		NodesReputation: cachedNodesReputation,
-	}
+	repairer.recordAudits(ctx, cachedNodesInfo, piecesReport)
 
-	for _, outcome := range piecesReport.Successful {
-		report.Successes = append(report.Successes, outcome.Piece.StorageNode)
-	}
-	for _, outcome := range piecesReport.Failed {
-		report.Fails = append(report.Fails, outcome.Piece.StorageNode)
-	}
-	for _, outcome := range piecesReport.Offline {
-		report.Offlines = append(report.Offlines, outcome.Piece.StorageNode)
-	}
-	for _, outcome := range piecesReport.Unknown {
-		report.Unknown = append(report.Unknown, outcome.Piece.StorageNode)
-	}
-	if repairer.reputationUpdateEnabled {
-		repairer.reporter.RecordAudits(ctx, report)
-	}
+	if !streaming {
+		defer func() { err = errs.Combine(err, segmentReader.Close()) }()
 
-	// Upload the repaired pieces
-	successfulNodes, _, err := repairer.ec.Repair(ctx, putLimits, putPrivateKey, redundancy, segmentReader, repairer.timeout, minSuccessfulNeeded)
-	if err != nil {
-		return false, repairPutError.Wrap(err)
+		// Upload the repaired pieces
+		successfulNodes, _, err = repairer.ec.Repair(ctx, putLimits, putPrivateKey, redundancy, segmentReader, repairer.timeout, minSuccessfulNeeded)
+		if err != nil {
+			return false, repairPutError.Wrap(err)
+		}
 	}
 
 	pieceSize := eestream.CalcPieceSize(int64(segment.EncryptedSize), redundancy)
@@ -509,6 +596,9 @@ func (repairer *SegmentRepairer) Repair(ctx context.Context, queueSegment *queue
 	mon.FloatVal("healthy_ratio_after_repair").Observe(healthyRatioAfterRepair) //mon:locked
 	stats.healthyRatioAfterRepair.Observe(healthyRatioAfterRepair)
 
+	// misplaced pieces are replaced like unhealthy pieces
+	unhealthyPieces = append(unhealthyPieces, misplacedPieces...)
+
 	var toRemove metabase.Pieces
 	if healthyAfterRepair >= int(segment.Redundancy.OptimalShares) {
 		// if full repair, remove all unhealthy pieces
@@ -572,6 +662,122 @@ func (repairer *SegmentRepairer) Repair(ctx context.Context, queueSegment *queue
 	return true, nil
 }
 
+// migrate copies the misplaced pieces of the segment, held by nodes which
+// don't match its placement or which exceed the limit of their failure domain,
+// to new well placed nodes, and replaces the copied pieces in the segment.
+// Contrary to Repair, the segment isn't reconstructed, each copied piece is
+// downloaded and uploaded unchanged with the same piece number.
+func (repairer *SegmentRepairer) migrate(ctx context.Context, segment metabase.Segment, misplacedSet map[uint16]bool) (shouldDelete bool, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var excludeNodeIDs storj.NodeIDList
+	var misplacedPieces metabase.Pieces
+	for _, piece := range segment.Pieces {
+		excludeNodeIDs = append(excludeNodeIDs, piece.StorageNode)
+		if misplacedSet[piece.Number] {
+			misplacedPieces = append(misplacedPieces, piece)
+		}
+	}
+
+	newNodes, err := repairer.overlay.FindStorageNodesForUpload(ctx, overlay.FindStorageNodesRequest{
+		RequestedCount: len(misplacedPieces),
+		ExcludedIDs:    excludeNodeIDs,
+		Placement:      segment.Placement,
+	})
+	if err != nil {
+		return false, overlayQueryError.Wrap(err)
+	}
+
+	getLimits, getPrivateKey, putLimits, putPrivateKey, cachedNodesInfo, err := repairer.orders.CreateCopyRepairOrderLimits(ctx, metabase.BucketLocation{}, segment, misplacedPieces, newNodes)
+	if err != nil {
+		return false, orderLimitFailureError.New("could not create order limits for copying pieces: %w", err)
+	}
+
+	redundancy, err := eestream.NewRedundancyStrategyFromStorj(segment.Redundancy)
+	if err != nil {
+		return true, invalidRepairError.New("invalid redundancy strategy: %w", err)
+	}
+	pieceSize := eestream.CalcPieceSize(int64(segment.EncryptedSize), redundancy)
+
+	successfulNodes, piecesReport, err := repairer.ec.CopyPieces(ctx, getLimits, cachedNodesInfo, getPrivateKey, putLimits, putPrivateKey, pieceSize, repairer.timeout)
+
+	if repairer.OnTestingPiecesReportHook != nil {
+		repairer.OnTestingPiecesReportHook(piecesReport)
+	}
+
+	// Check if segment has been altered
+	checkSegmentError := repairer.checkIfSegmentAltered(ctx, segment)
+	if checkSegmentError != nil {
+		if segmentDeletedError.Has(checkSegmentError) {
+			repairer.log.Debug("segment deleted during migration")
+			return true, nil
+		}
+		if segmentModifiedError.Has(checkSegmentError) {
+			repairer.log.Debug("segment modified during migration")
+			return true, nil
+		}
+		return false, segmentVerificationError.Wrap(checkSegmentError)
+	}
+
+	repairer.recordAudits(ctx, cachedNodesInfo, piecesReport)
+
+	if err != nil {
+		if ctxErr := ctx.Err(); ctxErr != nil {
+			return false, ctxErr
+		}
+		if removeErr := repairer.removeFailedPieces(ctx, segment, piecesReport); removeErr != nil {
+			return false, errs.Combine(repairPutError.Wrap(err), removeErr)
+		}
+		return false, repairPutError.Wrap(err)
+	}
+
+	var copiedPieces, toRemove metabase.Pieces
+	for _, piece := range misplacedPieces {
+		node := successfulNodes[piece.Number]
+		if node == nil {
+			continue
+		}
+		copiedPieces = append(copiedPieces, metabase.Piece{
+			Number:      piece.Number,
+			StorageNode: node.Id,
+		})
+		toRemove = append(toRemove, piece)
+	}
+
+	// add pieces that failed piece hashes verification to the removal list
+	for _, outcome := range piecesReport.Failed {
+		toRemove = append(toRemove, outcome.Piece)
+	}
+
+	newPieces, err := segment.Pieces.Update(copiedPieces, toRemove)
+	if err != nil {
+		return false, repairPutError.Wrap(err)
+	}
+
+	err = repairer.metabase.UpdateSegmentPieces(ctx, metabase.UpdateSegmentPieces{
+		StreamID: segment.StreamID,
+		Position: segment.Position,
+
+		OldPieces:     segment.Pieces,
+		NewRedundancy: segment.Redundancy,
+		NewPieces:     newPieces,
+
+		NewRepairedAt: time.Now(),
+	})
+	if err != nil {
+		return false, metainfoPutError.Wrap(err)
+	}
+
+	mon.Meter("repair_bytes_uploaded").Mark64(pieceSize * int64(len(copiedPieces)))
+	if len(copiedPieces) == len(misplacedPieces) {
+		mon.Meter("repair_migration_success").Mark(1)
+	} else {
+		mon.Meter("repair_migration_partial").Mark(1)
+	}
+
+	return true, nil
+}
+
 // checkIfSegmentAltered checks if oldSegment has been altered since it was selected for audit.
 func (repairer *SegmentRepairer) checkIfSegmentAltered(ctx context.Context, oldSegment metabase.Segment) (err error) {
 	defer mon.Task()(&ctx)(&err)
@@ -597,6 +803,65 @@ func (repairer *SegmentRepairer) checkIfSegmentAltered(ctx context.Context, oldS
 	return nil
 }
 
+// recordAudits updates the reputation of the nodes according to the results
+// of downloading their pieces, when enabled.
+func (repairer *SegmentRepairer) recordAudits(ctx context.Context, cachedNodesInfo map[storj.NodeID]overlay.NodeReputation, piecesReport FetchResultReport) {
+	cachedNodesReputation := make(map[storj.NodeID]overlay.ReputationStatus, len(cachedNodesInfo))
+	for id, info := range cachedNodesInfo {
+		cachedNodesReputation[id] = info.Reputation
+	}
+
+	report := audit.Report{
+		NodesReputation: cachedNodesReputation,
+	}
+
+	for _, outcome := range piecesReport.Successful {
+		report.Successes = append(report.Successes, outcome.Piece.StorageNode)
+	}
+	for _, outcome := range piecesReport.Failed {
+		report.Fails = append(report.Fails, outcome.Piece.StorageNode)
+	}
+	for _, outcome := range piecesReport.Offline {
+		report.Offlines = append(report.Offlines, outcome.Piece.StorageNode)
+	}
+	for _, outcome := range piecesReport.Unknown {
+		report.Unknown = append(report.Unknown, outcome.Piece.StorageNode)
+	}
+	if repairer.reputationUpdateEnabled {
+		repairer.reporter.RecordAudits(ctx, report)
+	}
+}
+
+// removeFailedPieces removes the pieces which failed verification from the
+// segment, without repairing it.
+func (repairer *SegmentRepairer) removeFailedPieces(ctx context.Context, segment metabase.Segment, piecesReport FetchResultReport) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if len(piecesReport.Failed) == 0 {
+		return nil
+	}
+
+	var toRemove metabase.Pieces
+	for _, outcome := range piecesReport.Failed {
+		toRemove = append(toRemove, outcome.Piece)
+	}
+
+	newPieces, err := segment.Pieces.Update(nil, toRemove)
+	if err != nil {
+		return metainfoPutError.Wrap(err)
+	}
+
+	err = repairer.metabase.UpdateSegmentPieces(ctx, metabase.UpdateSegmentPieces{
+		StreamID: segment.StreamID,
+		Position: segment.Position,
+
+		OldPieces:     segment.Pieces,
+		NewRedundancy: segment.Redundancy,
+		NewPieces:     newPieces,
+	})
+	return metainfoPutError.Wrap(err)
+}
+
 func (repairer *SegmentRepairer) getStatsByRS(redundancy *pb.RedundancyScheme) *stats {
 	rsString := getRSString(repairer.loadRedundancy(redundancy))
 	return repairer.statsCollector.getStatsByRS(rsString)
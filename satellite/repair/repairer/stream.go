@@ -0,0 +1,571 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package repairer
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"sync"
+	"time"
+
+	"github.com/vivint/infectious"
+	"github.com/zeebo/errs"
+
+	"storj.io/common/pb"
+	"storj.io/common/storj"
+	"storj.io/storj/satellite/metabase"
+	"storj.io/storj/satellite/overlay"
+	"storj.io/uplink/private/eestream"
+	"storj.io/uplink/private/piecestore"
+)
+
+var (
+	// errStreamAborted is the errs class used when a streaming repair can't be
+	// completed because a downloaded piece failed its verification, so that
+	// the uploads have to be aborted.
+	errStreamAborted = errs.Class("streaming repair aborted")
+
+	// errStreamSourceLost is the errs class used when a downloaded piece
+	// failed after the repaired pieces started uploading. The windows decoded
+	// so far can't be verified anymore, so the uploads are aborted and the
+	// segment has to be repaired without streaming.
+	errStreamSourceLost = errs.Class("streaming repair lost a piece")
+)
+
+// maxUploadLag is how long a streaming repair waits for an upload which
+// doesn't keep up with the others, when enough uploads do.
+const maxUploadLag = 10 * time.Second
+
+// StreamRepair downloads the pieces of a segment using the get order limits
+// and uploads the repaired pieces to the nodes of the put order limits, stripe
+// by stripe. It decodes a window of stripes at a time, sized so that the
+// buffers of the downloaded and the repaired pieces fit into maxBufferMem,
+// instead of reconstructing the whole segment first.
+//
+// The downloaded pieces are hashed while they are streamed and verified at
+// their end, before the uploads are allowed to finish. When a downloaded piece
+// fails its verification the uploads are aborted and the error is of class
+// errStreamAborted, when it fails during the stream the error is of class
+// errStreamSourceLost. Pieces which fail before the stream starts are replaced
+// from the remaining get order limits, like Get does.
+func (ec *ECRepairer) StreamRepair(ctx context.Context, getLimits []*pb.AddressedOrderLimit, cachedNodesInfo map[storj.NodeID]overlay.NodeReputation, getPrivateKey storj.PiecePrivateKey, putLimits []*pb.AddressedOrderLimit, putPrivateKey storj.PiecePrivateKey, rs eestream.RedundancyStrategy, dataSize int64, maxBufferMem int, timeout time.Duration, successfulNeeded int) (successfulNodes []*pb.Node, successfulHashes []*pb.PieceHash, pieces FetchResultReport, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if len(getLimits) != rs.TotalCount() {
+		return nil, nil, pieces, Error.New("number of limits slice (%d) does not match total count (%d) of erasure scheme", len(getLimits), rs.TotalCount())
+	}
+	if nonNilCount(getLimits) < rs.RequiredCount() {
+		return nil, nil, pieces, Error.New("number of non-nil limits (%d) is less than required count (%d) of erasure scheme", nonNilCount(getLimits), rs.RequiredCount())
+	}
+	if len(putLimits) != rs.TotalCount() {
+		return nil, nil, pieces, Error.New("size of limits slice (%d) does not match total count (%d) of erasure scheme", len(putLimits), rs.TotalCount())
+	}
+	if !unique(putLimits) {
+		return nil, nil, pieces, Error.New("duplicated nodes are not allowed")
+	}
+
+	fec, err := infectious.NewFEC(rs.RequiredCount(), rs.TotalCount())
+	if err != nil {
+		return nil, nil, pieces, Error.Wrap(err)
+	}
+
+	stream := &repairStream{
+		ec:              ec,
+		scheme:          eestream.NewUnsafeRSScheme(fec, rs.ErasureShareSize()),
+		cachedNodesInfo: cachedNodesInfo,
+		privateKey:      getPrivateKey,
+		pieceSize:       eestream.CalcPieceSize(dataSize, rs),
+		outputs:         make(map[int]*streamedPiece),
+	}
+
+	readers := make([]io.ReadCloser, len(putLimits))
+	for i, limit := range putLimits {
+		if limit == nil {
+			readers[i] = io.NopCloser(bytes.NewReader(nil))
+			continue
+		}
+		stream.outputs[i] = newStreamedPiece()
+		readers[i] = stream.outputs[i]
+	}
+	stream.windowSize = streamWindowSize(rs, len(stream.outputs), maxBufferMem)
+
+	streamCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	streamErr := make(chan error, 1)
+	go func() {
+		streamErr <- stream.run(streamCtx, getLimits, successfulNeeded)
+	}()
+
+	successfulNodes, successfulHashes, err = ec.putPieces(ctx, putLimits, putPrivateKey, rs, readers, timeout, successfulNeeded)
+
+	// the stream is finished, unless all uploads failed
+	cancel()
+	if serr := <-streamErr; serr != nil {
+		var irreparableErr *irreparableError
+		if errStreamAborted.Has(serr) || errStreamSourceLost.Has(serr) || errors.As(serr, &irreparableErr) || err == nil {
+			return nil, nil, stream.report, serr
+		}
+	}
+	return successfulNodes, successfulHashes, stream.report, err
+}
+
+// streamWindowSize returns the size of the window of each piece, which is
+// decoded at once. The window holds whole erasure shares, and the windows of
+// the downloaded pieces and of the uploaded pieces, which are buffered up to
+// three times, fit into maxBufferMem. The window holds at least one stripe, so
+// the buffers exceed maxBufferMem when a single stripe doesn't fit into it.
+func streamWindowSize(rs eestream.RedundancyStrategy, uploads, maxBufferMem int) int {
+	shareSize := rs.ErasureShareSize()
+	stripes := maxBufferMem / (shareSize * (rs.RequiredCount() + 3*uploads))
+	if stripes < 1 {
+		stripes = 1
+	}
+	return stripes * shareSize
+}
+
+// repairStream decodes the downloaded pieces and encodes the repaired pieces
+// of a streaming repair window by window.
+type repairStream struct {
+	ec              *ECRepairer
+	scheme          eestream.ErasureScheme
+	cachedNodesInfo map[storj.NodeID]overlay.NodeReputation
+	privateKey      storj.PiecePrivateKey
+	pieceSize       int64
+	windowSize      int
+
+	mu      sync.Mutex
+	report  FetchResultReport
+	sources map[int]*streamSource
+
+	outputs map[int]*streamedPiece
+}
+
+// run streams the pieces until the end of the pieces, or until it fails. The
+// repaired pieces end successfully only if run succeeds.
+func (stream *repairStream) run(ctx context.Context, limits []*pb.AddressedOrderLimit, successfulNeeded int) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	defer func() { stream.closeOutputs(err) }()
+	defer stream.closeSources()
+
+	for offset := int64(0); offset < stream.pieceSize; offset += int64(stream.windowSize) {
+		length := stream.windowSize
+		if remaining := stream.pieceSize - offset; remaining < int64(length) {
+			length = int(remaining)
+		}
+
+		if offset == 0 {
+			err = stream.openSources(ctx, limits, length)
+		} else {
+			err = stream.readSources(length)
+		}
+		if err != nil {
+			return err
+		}
+
+		if offset+int64(length) == stream.pieceSize {
+			if err := stream.verifySources(ctx); err != nil {
+				return err
+			}
+		}
+
+		windows, err := stream.encodeWindow(length)
+		if err != nil {
+			return err
+		}
+		if err := stream.push(ctx, windows, successfulNeeded); err != nil {
+			return err
+		}
+
+		if hook := stream.ec.OnTestingStreamWindowHook; hook != nil {
+			hook(offset, stream.sourceNodes())
+		}
+	}
+
+	return nil
+}
+
+// openSources starts downloading the required number of pieces and reads
+// their first window. Pieces failing to start are replaced by the remaining
+// limits.
+func (stream *repairStream) openSources(ctx context.Context, limits []*pb.AddressedOrderLimit, length int) error {
+	required := stream.scheme.RequiredCount()
+
+	var unused []int
+	for num, limit := range limits {
+		if limit != nil {
+			unused = append(unused, num)
+		}
+	}
+
+	stream.sources = make(map[int]*streamSource, required)
+
+	var wg sync.WaitGroup
+	for i := 0; i < required; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for {
+				stream.mu.Lock()
+				if len(unused) == 0 || len(stream.sources) >= required {
+					stream.mu.Unlock()
+					return
+				}
+				num := unused[0]
+				unused = unused[1:]
+				stream.mu.Unlock()
+
+				source, err := stream.openSource(ctx, limits[num], num, length)
+
+				stream.mu.Lock()
+				if err != nil {
+					stream.ec.recordFetchFailure(&stream.report, limits[num], source.piece(), err)
+					stream.mu.Unlock()
+					continue
+				}
+				stream.sources[num] = source
+				stream.mu.Unlock()
+				return
+			}
+		}()
+	}
+	wg.Wait()
+
+	if len(stream.sources) < required {
+		return &irreparableError{
+			piecesAvailable: int32(len(stream.sources)),
+			piecesRequired:  int32(required),
+		}
+	}
+	return nil
+}
+
+// openSource starts downloading the piece and reads its first window.
+func (stream *repairStream) openSource(ctx context.Context, limit *pb.AddressedOrderLimit, num, length int) (source *streamSource, err error) {
+	info := stream.cachedNodesInfo[limit.GetLimit().StorageNodeId]
+	address := limit.GetStorageNodeAddress().GetAddress()
+	var triedLastIPPort bool
+	if info.LastIPPort != "" && info.LastIPPort != address {
+		address = info.LastIPPort
+		triedLastIPPort = true
+	}
+
+	source = &streamSource{
+		num:    num,
+		limit:  limit,
+		window: make([]byte, stream.windowSize),
+	}
+
+	err = source.open(ctx, stream.ec, address, stream.privateKey, stream.pieceSize)
+	// if piecestore dial with last ip:port failed try again with node address
+	if triedLastIPPort && ErrDialFailed.Has(err) {
+		err = source.open(ctx, stream.ec, limit.GetStorageNodeAddress().GetAddress(), stream.privateKey, stream.pieceSize)
+	}
+	if err == nil {
+		err = source.read(length)
+	}
+	if err != nil {
+		source.close()
+		return source, err
+	}
+	return source, nil
+}
+
+// readSources reads the next window of the downloaded pieces. A piece failing
+// here aborts the stream, because the windows decoded so far can't be
+// verified anymore.
+func (stream *repairStream) readSources(length int) error {
+	var group errs.Group
+	var wg sync.WaitGroup
+	for _, source := range stream.sources {
+		source := source
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if err := source.read(length); err != nil {
+				stream.mu.Lock()
+				defer stream.mu.Unlock()
+				stream.ec.recordFetchFailure(&stream.report, source.limit, source.piece(), err)
+				group.Add(err)
+			}
+		}()
+	}
+	wg.Wait()
+
+	return errStreamSourceLost.Wrap(group.Err())
+}
+
+// sourceNodes returns the nodes of the downloaded pieces.
+func (stream *repairStream) sourceNodes() []storj.NodeID {
+	nodes := make([]storj.NodeID, 0, len(stream.sources))
+	for _, source := range stream.sources {
+		nodes = append(nodes, source.limit.GetLimit().StorageNodeId)
+	}
+	return nodes
+}
+
+// verifySources verifies the downloaded pieces once they are completely read.
+func (stream *repairStream) verifySources(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var group errs.Group
+	for _, source := range stream.sources {
+		if err := source.verify(ctx, stream.ec); err != nil {
+			stream.ec.recordFetchFailure(&stream.report, source.limit, source.piece(), err)
+			group.Add(err)
+			continue
+		}
+		stream.report.Successful = append(stream.report.Successful, PieceFetchResult{Piece: source.piece()})
+	}
+
+	return errStreamAborted.Wrap(group.Err())
+}
+
+// encodeWindow decodes the current window of the downloaded pieces and
+// encodes the windows of the repaired pieces.
+func (stream *repairStream) encodeWindow(length int) (map[int][]byte, error) {
+	shareSize := stream.scheme.ErasureShareSize()
+
+	windows := make(map[int][]byte, len(stream.outputs))
+	for num, output := range stream.outputs {
+		if !output.failed {
+			windows[num] = make([]byte, length)
+		}
+	}
+
+	shares := make(map[int][]byte, len(stream.sources))
+	stripe := make([]byte, 0, stream.scheme.StripeSize())
+	for start := 0; start < length; start += shareSize {
+		for num, source := range stream.sources {
+			shares[num] = source.window[start : start+shareSize]
+		}
+
+		var err error
+		stripe, err = stream.scheme.Decode(stripe[:0], shares)
+		if err != nil {
+			return nil, Error.Wrap(err)
+		}
+
+		for num, window := range windows {
+			if err := stream.scheme.EncodeSingle(stripe, window[start:start+shareSize], num); err != nil {
+				return nil, Error.Wrap(err)
+			}
+		}
+	}
+
+	return windows, nil
+}
+
+// push passes the windows to the uploads of the repaired pieces. Uploads which
+// don't accept the window within maxUploadLag, while at least successfulNeeded
+// uploads did, are cut from the stream.
+func (stream *repairStream) push(ctx context.Context, windows map[int][]byte, successfulNeeded int) error {
+	var pending []int
+	accepted := 0
+	for num, window := range windows {
+		output := stream.outputs[num]
+		select {
+		case output.windows <- window:
+			accepted++
+		case <-output.closed:
+			output.failed = true
+		default:
+			pending = append(pending, num)
+		}
+	}
+
+	lagged := make(chan struct{})
+	var timer *time.Timer
+	defer func() {
+		if timer != nil {
+			timer.Stop()
+		}
+	}()
+
+	for _, num := range pending {
+		if timer == nil && accepted >= successfulNeeded {
+			timer = time.AfterFunc(maxUploadLag, func() { close(lagged) })
+		}
+
+		output := stream.outputs[num]
+		select {
+		case output.windows <- windows[num]:
+			accepted++
+		case <-output.closed:
+			output.failed = true
+		case <-lagged:
+			output.fail(Error.New("upload fell behind the streaming repair"))
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+
+	if accepted == 0 {
+		return Error.New("repair to all nodes failed")
+	}
+	return nil
+}
+
+// closeSources closes the downloads of the pieces.
+func (stream *repairStream) closeSources() {
+	for _, source := range stream.sources {
+		source.close()
+	}
+}
+
+// closeOutputs ends the repaired pieces with the error, or successfully when
+// err is nil.
+func (stream *repairStream) closeOutputs(err error) {
+	if err == nil {
+		err = io.EOF
+	}
+	for _, output := range stream.outputs {
+		if !output.failed {
+			output.fail(err)
+		}
+	}
+}
+
+// streamSource is a piece downloaded for a streaming repair, which is hashed
+// while it is read.
+type streamSource struct {
+	num   int
+	limit *pb.AddressedOrderLimit
+
+	cancel     func()
+	client     *piecestore.Client
+	downloader *piecestore.Download
+	hash       *lazyHashWriter
+	reader     io.Reader
+
+	window []byte
+}
+
+// piece returns the segment piece of the source.
+func (source *streamSource) piece() metabase.Piece {
+	return metabase.Piece{
+		Number:      uint16(source.num),
+		StorageNode: source.limit.GetLimit().StorageNodeId,
+	}
+}
+
+// open starts the download of the piece from the address.
+func (source *streamSource) open(ctx context.Context, ec *ECRepairer, address string, privateKey storj.PiecePrivateKey, pieceSize int64) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	ctx, cancel := context.WithTimeout(ctx, ec.downloadTimeout)
+
+	client, err := ec.dialPiecestore(ctx, storj.NodeURL{
+		ID:      source.limit.GetLimit().StorageNodeId,
+		Address: address,
+	})
+	if err != nil {
+		cancel()
+		return err
+	}
+
+	downloader, err := client.Download(ctx, source.limit.GetLimit(), privateKey, 0, pieceSize)
+	if err != nil {
+		cancel()
+		return errs.Combine(err, client.Close())
+	}
+
+	source.cancel, source.client, source.downloader = cancel, client, downloader
+	source.hash = &lazyHashWriter{downloader: downloader}
+	source.reader = io.TeeReader(downloader, source.hash)
+	return nil
+}
+
+// read reads the next window of the piece.
+func (source *streamSource) read(length int) error {
+	n, err := io.ReadFull(source.reader, source.window[:length])
+	mon.Meter("repair_bytes_downloaded").Mark64(int64(n))
+	if errors.Is(err, io.ErrUnexpectedEOF) || errors.Is(err, io.EOF) {
+		return Error.New("didn't download the correct amount of data: %v", err)
+	}
+	return err
+}
+
+// verify checks that the piece ended and verifies its hash.
+func (source *streamSource) verify(ctx context.Context, ec *ECRepairer) error {
+	n, err := io.CopyN(io.Discard, source.reader, 1)
+	if err != nil && !errors.Is(err, io.EOF) {
+		return err
+	}
+	if n > 0 {
+		return Error.New("didn't download the correct amount of data, got more than the piece size")
+	}
+
+	_, _, err = ec.verifyDownload(ctx, source.downloader, source.hash.Sum(nil))
+	return err
+}
+
+// close closes the download of the piece.
+func (source *streamSource) close() {
+	if source.downloader != nil {
+		_ = source.downloader.Close()
+		source.downloader = nil
+	}
+	if source.client != nil {
+		_ = source.client.Close()
+		source.client = nil
+	}
+	if source.cancel != nil {
+		source.cancel()
+		source.cancel = nil
+	}
+}
+
+// streamedPiece is a repaired piece, which is passed window by window from
+// the stream to its upload.
+type streamedPiece struct {
+	windows chan []byte
+	current []byte
+	err     error
+
+	closed    chan struct{}
+	closeOnce sync.Once
+
+	// failed is accessed only by the stream.
+	failed bool
+}
+
+func newStreamedPiece() *streamedPiece {
+	return &streamedPiece{
+		windows: make(chan []byte, 1),
+		closed:  make(chan struct{}),
+	}
+}
+
+// Read reads the repaired piece.
+func (piece *streamedPiece) Read(p []byte) (n int, err error) {
+	for len(piece.current) == 0 {
+		window, ok := <-piece.windows
+		if !ok {
+			return 0, piece.err
+		}
+		piece.current = window
+	}
+
+	n = copy(p, piece.current)
+	piece.current = piece.current[n:]
+	return n, nil
+}
+
+// Close is called by the upload when it finished with the piece.
+func (piece *streamedPiece) Close() error {
+	piece.closeOnce.Do(func() { close(piece.closed) })
+	return nil
+}
+
+// fail ends the piece with the error, which is io.EOF when the piece is
+// complete. It must be called by the stream at most once.
+func (piece *streamedPiece) fail(err error) {
+	piece.failed = true
+	piece.err = err
+	close(piece.windows)
+}
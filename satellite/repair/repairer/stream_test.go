@@ -0,0 +1,45 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package repairer
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"storj.io/common/memory"
+	"storj.io/common/storj"
+	"storj.io/uplink/private/eestream"
+)
+
+func TestStreamWindowSize(t *testing.T) {
+	rs, err := eestream.NewRedundancyStrategyFromStorj(storj.RedundancyScheme{
+		Algorithm:      storj.ReedSolomon,
+		ShareSize:      256,
+		RequiredShares: 29,
+		RepairShares:   35,
+		OptimalShares:  80,
+		TotalShares:    110,
+	})
+	require.NoError(t, err)
+
+	const uploads = 10
+	// the memory of a stripe: one share of each downloaded piece and three
+	// of each uploaded piece.
+	stripeMem := 256 * (29 + 3*uploads)
+
+	for _, maxBufferMem := range []int{stripeMem, stripeMem + 1, 2*stripeMem - 1, 4 * memory.MiB.Int()} {
+		windowSize := streamWindowSize(rs, uploads, maxBufferMem)
+		require.Zero(t, windowSize%256, maxBufferMem)
+
+		stripes := windowSize / 256
+		require.LessOrEqual(t, stripes*stripeMem, maxBufferMem)
+		require.Greater(t, (stripes+1)*stripeMem, maxBufferMem)
+	}
+
+	// a single stripe is decoded at once, even when it doesn't fit.
+	for _, maxBufferMem := range []int{0, 1, memory.KiB.Int(), stripeMem - 1} {
+		require.Equal(t, 256, streamWindowSize(rs, uploads, maxBufferMem), maxBufferMem)
+	}
+}
@@ -0,0 +1,151 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package satellitedb
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/zeebo/errs"
+
+	"storj.io/common/storj"
+	"storj.io/private/dbutil/pgutil"
+	"storj.io/storj/satellite/audit"
+	"storj.io/storj/satellite/overlay"
+)
+
+// auditCoverage implements storj.io/storj/satellite/audit.CoverageDB.
+type auditCoverage struct {
+	db *satelliteDB
+}
+
+var _ audit.CoverageDB = (*auditCoverage)(nil)
+
+// RecordAudited sets the time when the nodes were last audited. It never
+// moves the last audit of a node back in time.
+func (coverage *auditCoverage) RecordAudited(ctx context.Context, nodeIDs storj.NodeIDList, auditedAt time.Time) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if len(nodeIDs) == 0 {
+		return nil
+	}
+
+	// a node can't be upserted twice by the same statement.
+	unique := make(storj.NodeIDList, 0, len(nodeIDs))
+	seen := make(map[storj.NodeID]struct{}, len(nodeIDs))
+	for _, nodeID := range nodeIDs {
+		if _, ok := seen[nodeID]; ok {
+			continue
+		}
+		seen[nodeID] = struct{}{}
+		unique = append(unique, nodeID)
+	}
+
+	_, err = coverage.db.DB.ExecContext(ctx, `
+		INSERT INTO node_audit_coverage (node_id, last_audited_at)
+		SELECT unnest($1::bytea[]), $2
+		ON CONFLICT (node_id) DO UPDATE
+		SET last_audited_at = GREATEST(node_audit_coverage.last_audited_at, EXCLUDED.last_audited_at)
+	`, pgutil.NodeIDArray(unique), auditedAt.UTC())
+	return Error.Wrap(err)
+}
+
+// LastAudited returns when the nodes which weren't disqualified nor exited were
+// last audited. The nodes audited before their audits were recorded are
+// considered audited when their reputation was last updated, and the nodes
+// never audited when they were created.
+func (coverage *auditCoverage) LastAudited(ctx context.Context) (_ map[storj.NodeID]time.Time, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	rows, err := coverage.db.Query(ctx, `
+		SELECT n.id, COALESCE(
+			c.last_audited_at,
+			CASE WHEN r.total_audit_count > 0 THEN r.updated_at END,
+			n.created_at
+		)
+		FROM nodes n
+		LEFT JOIN reputations r ON r.id = n.id
+		LEFT JOIN node_audit_coverage c ON c.node_id = n.id
+		WHERE n.disqualified IS NULL
+			AND n.exit_finished_at IS NULL
+	`)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	defer func() { err = errs.Combine(err, rows.Close()) }()
+
+	lastAudited := make(map[storj.NodeID]time.Time)
+	for rows.Next() {
+		var nodeID storj.NodeID
+		var auditedAt time.Time
+		if err := rows.Scan(&nodeID, &auditedAt); err != nil {
+			return nil, Error.Wrap(err)
+		}
+		lastAudited[nodeID] = auditedAt
+	}
+	return lastAudited, Error.Wrap(rows.Err())
+}
+
+// Get returns the audit coverage of the node.
+func (coverage *auditCoverage) Get(ctx context.Context, nodeID storj.NodeID) (_ audit.NodeCoverage, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var node audit.NodeCoverage
+	err = coverage.db.QueryRowContext(ctx, `
+		SELECT n.id, n.created_at, n.vetted_at, COALESCE(r.total_audit_count, 0), c.last_audited_at
+		FROM nodes n
+		LEFT JOIN reputations r ON r.id = n.id
+		LEFT JOIN node_audit_coverage c ON c.node_id = n.id
+		WHERE n.id = $1
+	`, nodeID).Scan(&node.NodeID, &node.CreatedAt, &node.VettedAt, &node.TotalAuditCount, &node.LastAuditedAt)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return audit.NodeCoverage{}, overlay.ErrNodeNotFound.New("%v", nodeID)
+		}
+		return audit.NodeCoverage{}, Error.Wrap(err)
+	}
+	return node, nil
+}
+
+// List returns the audit coverage of the nodes which weren't disqualified nor
+// exited, least recently audited first.
+func (coverage *auditCoverage) List(ctx context.Context, auditedBefore time.Time, limit int) (_ []audit.NodeCoverage, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	query := `
+		SELECT n.id, n.created_at, n.vetted_at, COALESCE(r.total_audit_count, 0), c.last_audited_at
+		FROM nodes n
+		LEFT JOIN reputations r ON r.id = n.id
+		LEFT JOIN node_audit_coverage c ON c.node_id = n.id
+		WHERE n.disqualified IS NULL
+			AND n.exit_finished_at IS NULL
+	`
+	args := []interface{}{limit}
+	if !auditedBefore.IsZero() {
+		query += ` AND (c.last_audited_at IS NULL OR c.last_audited_at < $2)`
+		args = append(args, auditedBefore.UTC())
+	}
+	query += `
+		ORDER BY c.last_audited_at IS NOT NULL, c.last_audited_at, n.id
+		LIMIT $1
+	`
+
+	rows, err := coverage.db.Query(ctx, query, args...)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	defer func() { err = errs.Combine(err, rows.Close()) }()
+
+	var nodes []audit.NodeCoverage
+	for rows.Next() {
+		var node audit.NodeCoverage
+		if err := rows.Scan(&node.NodeID, &node.CreatedAt, &node.VettedAt, &node.TotalAuditCount, &node.LastAuditedAt); err != nil {
+			return nil, Error.Wrap(err)
+		}
+		nodes = append(nodes, node)
+	}
+	return nodes, Error.Wrap(rows.Err())
+}
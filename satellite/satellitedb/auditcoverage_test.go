@@ -0,0 +1,89 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package satellitedb_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"storj.io/common/pb"
+	"storj.io/common/storj"
+	"storj.io/common/testcontext"
+	"storj.io/common/testrand"
+	"storj.io/storj/satellite"
+	"storj.io/storj/satellite/overlay"
+	"storj.io/storj/satellite/satellitedb/satellitedbtest"
+)
+
+func TestAuditCoverage(t *testing.T) {
+	satellitedbtest.Run(t, func(ctx *testcontext.Context, t *testing.T, db satellite.DB) {
+		coverage := db.AuditCoverage()
+		cache := db.OverlayCache()
+
+		now := time.Now().Truncate(time.Microsecond)
+
+		audited := testrand.NodeID()
+		stale := testrand.NodeID()
+		neverAudited := testrand.NodeID()
+		disqualified := testrand.NodeID()
+
+		for _, nodeID := range []storj.NodeID{audited, stale, neverAudited, disqualified} {
+			require.NoError(t, cache.UpdateCheckIn(ctx, overlay.NodeCheckInInfo{
+				NodeID:  nodeID,
+				IsUp:    true,
+				Address: &pb.NodeAddress{Address: "1.2.3.4"},
+				Version: &pb.NodeVersion{Version: "v0.0.0"},
+			}, now, overlay.NodeSelectionConfig{}))
+		}
+		_, err := cache.DisqualifyNode(ctx, disqualified, now, overlay.DisqualificationReasonUnknown)
+		require.NoError(t, err)
+
+		require.NoError(t, coverage.RecordAudited(ctx, storj.NodeIDList{stale, disqualified}, now.Add(-96*time.Hour)))
+		require.NoError(t, coverage.RecordAudited(ctx, storj.NodeIDList{audited, audited}, now))
+		// an older audit doesn't move the last audit back in time.
+		require.NoError(t, coverage.RecordAudited(ctx, storj.NodeIDList{audited}, now.Add(-time.Hour)))
+
+		// the disqualified node isn't audited anymore and the node which was
+		// never audited is due since it was created.
+		lastAudited, err := coverage.LastAudited(ctx)
+		require.NoError(t, err)
+		require.Len(t, lastAudited, 3)
+		require.WithinDuration(t, now, lastAudited[audited], time.Second)
+		require.WithinDuration(t, now.Add(-96*time.Hour), lastAudited[stale], time.Second)
+		require.WithinDuration(t, now, lastAudited[neverAudited], time.Minute)
+		require.NotContains(t, lastAudited, disqualified)
+
+		node, err := coverage.Get(ctx, neverAudited)
+		require.NoError(t, err)
+		require.Equal(t, neverAudited, node.NodeID)
+		require.Nil(t, node.LastAuditedAt)
+
+		node, err = coverage.Get(ctx, audited)
+		require.NoError(t, err)
+		require.NotNil(t, node.LastAuditedAt)
+		require.WithinDuration(t, now, *node.LastAuditedAt, time.Second)
+
+		_, err = coverage.Get(ctx, testrand.NodeID())
+		require.True(t, overlay.ErrNodeNotFound.Has(err))
+
+		nodes, err := coverage.List(ctx, time.Time{}, 10)
+		require.NoError(t, err)
+		require.Len(t, nodes, 3)
+		require.Equal(t, neverAudited, nodes[0].NodeID)
+		require.Equal(t, stale, nodes[1].NodeID)
+		require.Equal(t, audited, nodes[2].NodeID)
+
+		nodes, err = coverage.List(ctx, now.Add(-72*time.Hour), 10)
+		require.NoError(t, err)
+		require.Len(t, nodes, 2)
+		require.Equal(t, neverAudited, nodes[0].NodeID)
+		require.Equal(t, stale, nodes[1].NodeID)
+
+		nodes, err = coverage.List(ctx, time.Time{}, 1)
+		require.NoError(t, err)
+		require.Len(t, nodes, 1)
+	})
+}
@@ -204,6 +204,11 @@ func (dbc *satelliteDBCollection) ReverifyQueue() audit.ReverifyQueue {
 	return &reverifyQueue{db: dbc.getByName("reverifyqueue")}
 }
 
+// AuditCoverage is a getter for AuditCoverage database.
+func (dbc *satelliteDBCollection) AuditCoverage() audit.CoverageDB {
+	return &auditCoverage{db: dbc.getByName("auditcoverage")}
+}
+
 // StoragenodeAccounting returns database for tracking storagenode usage.
 func (dbc *satelliteDBCollection) StoragenodeAccounting() accounting.StoragenodeAccounting {
 	return &StoragenodeAccounting{db: dbc.getByName("storagenodeaccounting")}
@@ -434,6 +434,18 @@ CREATE TABLE graceful_exit_segment_transfer_queue (
 	order_limit_send_count integer NOT NULL DEFAULT 0,
 	PRIMARY KEY ( node_id, stream_id, position, piece_num )
 );
+CREATE TABLE graceful_exit_shrinks (
+	node_id bytea NOT NULL,
+	requested_bytes bigint NOT NULL,
+	selected_bytes bigint NOT NULL DEFAULT 0,
+	bytes_transferred bigint NOT NULL DEFAULT 0,
+	pieces_transferred bigint NOT NULL DEFAULT 0,
+	pieces_failed bigint NOT NULL DEFAULT 0,
+	created_at timestamp with time zone NOT NULL,
+	selected_at timestamp with time zone,
+	finished_at timestamp with time zone,
+	PRIMARY KEY ( node_id )
+);
 CREATE TABLE nodes (
 	id bytea NOT NULL,
 	address text NOT NULL DEFAULT '',
@@ -473,6 +485,7 @@ CREATE TABLE nodes (
 	last_software_update_email timestamp with time zone,
 	noise_proto integer,
 	noise_public_key bytea,
+	asn bigint,
 	PRIMARY KEY ( id )
 );
 CREATE TABLE node_api_versions (
@@ -482,6 +495,11 @@ CREATE TABLE node_api_versions (
 	updated_at timestamp with time zone NOT NULL,
 	PRIMARY KEY ( id )
 );
+CREATE TABLE node_audit_coverage (
+	node_id bytea NOT NULL,
+	last_audited_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( node_id )
+);
 CREATE TABLE node_events (
 	id bytea NOT NULL,
 	email text NOT NULL,
@@ -492,6 +510,23 @@ CREATE TABLE node_events (
 	email_sent timestamp with time zone,
 	PRIMARY KEY ( id )
 );
+CREATE TABLE node_performance (
+	node_id bytea NOT NULL,
+	updated_at timestamp with time zone NOT NULL,
+	attempts double precision NOT NULL,
+	successes double precision NOT NULL,
+	latencies double precision NOT NULL,
+	latency double precision NOT NULL,
+	PRIMARY KEY ( node_id )
+);
+CREATE TABLE node_tags (
+	node_id bytea NOT NULL,
+	name text NOT NULL,
+	value text NOT NULL,
+	signed_at timestamp with time zone NOT NULL,
+	signer bytea NOT NULL,
+	PRIMARY KEY ( node_id, name, signer )
+);
 CREATE TABLE oauth_clients (
 	id bytea NOT NULL,
 	encrypted_secret bytea NOT NULL,
@@ -574,6 +609,10 @@ CREATE TABLE repair_queue (
 	updated_at timestamp with time zone NOT NULL DEFAULT current_timestamp,
 	inserted_at timestamp with time zone NOT NULL DEFAULT current_timestamp,
 	segment_health double precision NOT NULL DEFAULT 1,
+	node_risk double precision NOT NULL DEFAULT 1,
+	tier double precision NOT NULL DEFAULT 1,
+	segment_created_at timestamp with time zone,
+	priority double precision NOT NULL DEFAULT 1,
 	PRIMARY KEY ( stream_id, position )
 );
 CREATE TABLE reputations (
@@ -874,7 +913,7 @@ CREATE INDEX oauth_tokens_client_id_index ON oauth_tokens ( client_id ) ;
 CREATE INDEX projects_public_id_index ON projects ( public_id ) ;
 CREATE INDEX project_bandwidth_daily_rollup_interval_day_index ON project_bandwidth_daily_rollups ( interval_day ) ;
 CREATE INDEX repair_queue_updated_at_index ON repair_queue ( updated_at ) ;
-CREATE INDEX repair_queue_num_healthy_pieces_attempted_at_index ON repair_queue ( segment_health, attempted_at ) ;
+CREATE INDEX repair_queue_priority_attempted_at_index ON repair_queue ( priority, attempted_at ) ;
 CREATE INDEX reverification_audits_inserted_at_index ON reverification_audits ( inserted_at ) ;
 CREATE INDEX storagenode_bandwidth_rollups_interval_start_index ON storagenode_bandwidth_rollups ( interval_start ) ;
 CREATE INDEX storagenode_bandwidth_rollup_archives_interval_start_index ON storagenode_bandwidth_rollup_archives ( interval_start ) ;
@@ -1099,6 +1138,18 @@ CREATE TABLE graceful_exit_segment_transfer_queue (
 	order_limit_send_count integer NOT NULL DEFAULT 0,
 	PRIMARY KEY ( node_id, stream_id, position, piece_num )
 );
+CREATE TABLE graceful_exit_shrinks (
+	node_id bytea NOT NULL,
+	requested_bytes bigint NOT NULL,
+	selected_bytes bigint NOT NULL DEFAULT 0,
+	bytes_transferred bigint NOT NULL DEFAULT 0,
+	pieces_transferred bigint NOT NULL DEFAULT 0,
+	pieces_failed bigint NOT NULL DEFAULT 0,
+	created_at timestamp with time zone NOT NULL,
+	selected_at timestamp with time zone,
+	finished_at timestamp with time zone,
+	PRIMARY KEY ( node_id )
+);
 CREATE TABLE nodes (
 	id bytea NOT NULL,
 	address text NOT NULL DEFAULT '',
@@ -1138,6 +1189,7 @@ CREATE TABLE nodes (
 	last_software_update_email timestamp with time zone,
 	noise_proto integer,
 	noise_public_key bytea,
+	asn bigint,
 	PRIMARY KEY ( id )
 );
 CREATE TABLE node_api_versions (
@@ -1147,6 +1199,11 @@ CREATE TABLE node_api_versions (
 	updated_at timestamp with time zone NOT NULL,
 	PRIMARY KEY ( id )
 );
+CREATE TABLE node_audit_coverage (
+	node_id bytea NOT NULL,
+	last_audited_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( node_id )
+);
 CREATE TABLE node_events (
 	id bytea NOT NULL,
 	email text NOT NULL,
@@ -1157,6 +1214,23 @@ CREATE TABLE node_events (
 	email_sent timestamp with time zone,
 	PRIMARY KEY ( id )
 );
+CREATE TABLE node_performance (
+	node_id bytea NOT NULL,
+	updated_at timestamp with time zone NOT NULL,
+	attempts double precision NOT NULL,
+	successes double precision NOT NULL,
+	latencies double precision NOT NULL,
+	latency double precision NOT NULL,
+	PRIMARY KEY ( node_id )
+);
+CREATE TABLE node_tags (
+	node_id bytea NOT NULL,
+	name text NOT NULL,
+	value text NOT NULL,
+	signed_at timestamp with time zone NOT NULL,
+	signer bytea NOT NULL,
+	PRIMARY KEY ( node_id, name, signer )
+);
 CREATE TABLE oauth_clients (
 	id bytea NOT NULL,
 	encrypted_secret bytea NOT NULL,
@@ -1239,6 +1313,10 @@ CREATE TABLE repair_queue (
 	updated_at timestamp with time zone NOT NULL DEFAULT current_timestamp,
 	inserted_at timestamp with time zone NOT NULL DEFAULT current_timestamp,
 	segment_health double precision NOT NULL DEFAULT 1,
+	node_risk double precision NOT NULL DEFAULT 1,
+	tier double precision NOT NULL DEFAULT 1,
+	segment_created_at timestamp with time zone,
+	priority double precision NOT NULL DEFAULT 1,
 	PRIMARY KEY ( stream_id, position )
 );
 CREATE TABLE reputations (
@@ -1539,7 +1617,7 @@ CREATE INDEX oauth_tokens_client_id_index ON oauth_tokens ( client_id ) ;
 CREATE INDEX projects_public_id_index ON projects ( public_id ) ;
 CREATE INDEX project_bandwidth_daily_rollup_interval_day_index ON project_bandwidth_daily_rollups ( interval_day ) ;
 CREATE INDEX repair_queue_updated_at_index ON repair_queue ( updated_at ) ;
-CREATE INDEX repair_queue_num_healthy_pieces_attempted_at_index ON repair_queue ( segment_health, attempted_at ) ;
+CREATE INDEX repair_queue_priority_attempted_at_index ON repair_queue ( priority, attempted_at ) ;
 CREATE INDEX reverification_audits_inserted_at_index ON reverification_audits ( inserted_at ) ;
 CREATE INDEX storagenode_bandwidth_rollups_interval_start_index ON storagenode_bandwidth_rollups ( interval_start ) ;
 CREATE INDEX storagenode_bandwidth_rollup_archives_interval_start_index ON storagenode_bandwidth_rollup_archives ( interval_start ) ;
@@ -3528,6 +3606,241 @@ func (GracefulExitSegmentTransfer_OrderLimitSendCount_Field) _Column() string {
 	return "order_limit_send_count"
 }
 
+type GracefulExitShrink struct {
+	NodeId            []byte
+	RequestedBytes    int64
+	SelectedBytes     int64
+	BytesTransferred  int64
+	PiecesTransferred int64
+	PiecesFailed      int64
+	CreatedAt         time.Time
+	SelectedAt        *time.Time
+	FinishedAt        *time.Time
+}
+
+func (GracefulExitShrink) _Table() string { return "graceful_exit_shrinks" }
+
+type GracefulExitShrink_Create_Fields struct {
+	SelectedBytes     GracefulExitShrink_SelectedBytes_Field
+	BytesTransferred  GracefulExitShrink_BytesTransferred_Field
+	PiecesTransferred GracefulExitShrink_PiecesTransferred_Field
+	PiecesFailed      GracefulExitShrink_PiecesFailed_Field
+	SelectedAt        GracefulExitShrink_SelectedAt_Field
+	FinishedAt        GracefulExitShrink_FinishedAt_Field
+}
+
+type GracefulExitShrink_Update_Fields struct {
+	RequestedBytes    GracefulExitShrink_RequestedBytes_Field
+	SelectedBytes     GracefulExitShrink_SelectedBytes_Field
+	BytesTransferred  GracefulExitShrink_BytesTransferred_Field
+	PiecesTransferred GracefulExitShrink_PiecesTransferred_Field
+	PiecesFailed      GracefulExitShrink_PiecesFailed_Field
+	CreatedAt         GracefulExitShrink_CreatedAt_Field
+	SelectedAt        GracefulExitShrink_SelectedAt_Field
+	FinishedAt        GracefulExitShrink_FinishedAt_Field
+}
+
+type GracefulExitShrink_NodeId_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func GracefulExitShrink_NodeId(v []byte) GracefulExitShrink_NodeId_Field {
+	return GracefulExitShrink_NodeId_Field{_set: true, _value: v}
+}
+
+func (f GracefulExitShrink_NodeId_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (GracefulExitShrink_NodeId_Field) _Column() string { return "node_id" }
+
+type GracefulExitShrink_RequestedBytes_Field struct {
+	_set   bool
+	_null  bool
+	_value int64
+}
+
+func GracefulExitShrink_RequestedBytes(v int64) GracefulExitShrink_RequestedBytes_Field {
+	return GracefulExitShrink_RequestedBytes_Field{_set: true, _value: v}
+}
+
+func (f GracefulExitShrink_RequestedBytes_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (GracefulExitShrink_RequestedBytes_Field) _Column() string { return "requested_bytes" }
+
+type GracefulExitShrink_SelectedBytes_Field struct {
+	_set   bool
+	_null  bool
+	_value int64
+}
+
+func GracefulExitShrink_SelectedBytes(v int64) GracefulExitShrink_SelectedBytes_Field {
+	return GracefulExitShrink_SelectedBytes_Field{_set: true, _value: v}
+}
+
+func (f GracefulExitShrink_SelectedBytes_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (GracefulExitShrink_SelectedBytes_Field) _Column() string { return "selected_bytes" }
+
+type GracefulExitShrink_BytesTransferred_Field struct {
+	_set   bool
+	_null  bool
+	_value int64
+}
+
+func GracefulExitShrink_BytesTransferred(v int64) GracefulExitShrink_BytesTransferred_Field {
+	return GracefulExitShrink_BytesTransferred_Field{_set: true, _value: v}
+}
+
+func (f GracefulExitShrink_BytesTransferred_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (GracefulExitShrink_BytesTransferred_Field) _Column() string { return "bytes_transferred" }
+
+type GracefulExitShrink_PiecesTransferred_Field struct {
+	_set   bool
+	_null  bool
+	_value int64
+}
+
+func GracefulExitShrink_PiecesTransferred(v int64) GracefulExitShrink_PiecesTransferred_Field {
+	return GracefulExitShrink_PiecesTransferred_Field{_set: true, _value: v}
+}
+
+func (f GracefulExitShrink_PiecesTransferred_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (GracefulExitShrink_PiecesTransferred_Field) _Column() string { return "pieces_transferred" }
+
+type GracefulExitShrink_PiecesFailed_Field struct {
+	_set   bool
+	_null  bool
+	_value int64
+}
+
+func GracefulExitShrink_PiecesFailed(v int64) GracefulExitShrink_PiecesFailed_Field {
+	return GracefulExitShrink_PiecesFailed_Field{_set: true, _value: v}
+}
+
+func (f GracefulExitShrink_PiecesFailed_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (GracefulExitShrink_PiecesFailed_Field) _Column() string { return "pieces_failed" }
+
+type GracefulExitShrink_CreatedAt_Field struct {
+	_set   bool
+	_null  bool
+	_value time.Time
+}
+
+func GracefulExitShrink_CreatedAt(v time.Time) GracefulExitShrink_CreatedAt_Field {
+	return GracefulExitShrink_CreatedAt_Field{_set: true, _value: v}
+}
+
+func (f GracefulExitShrink_CreatedAt_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (GracefulExitShrink_CreatedAt_Field) _Column() string { return "created_at" }
+
+type GracefulExitShrink_SelectedAt_Field struct {
+	_set   bool
+	_null  bool
+	_value *time.Time
+}
+
+func GracefulExitShrink_SelectedAt(v time.Time) GracefulExitShrink_SelectedAt_Field {
+	return GracefulExitShrink_SelectedAt_Field{_set: true, _value: &v}
+}
+
+func GracefulExitShrink_SelectedAt_Raw(v *time.Time) GracefulExitShrink_SelectedAt_Field {
+	if v == nil {
+		return GracefulExitShrink_SelectedAt_Null()
+	}
+	return GracefulExitShrink_SelectedAt(*v)
+}
+
+func GracefulExitShrink_SelectedAt_Null() GracefulExitShrink_SelectedAt_Field {
+	return GracefulExitShrink_SelectedAt_Field{_set: true, _null: true}
+}
+
+func (f GracefulExitShrink_SelectedAt_Field) isnull() bool {
+	return !f._set || f._null || f._value == nil
+}
+
+func (f GracefulExitShrink_SelectedAt_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (GracefulExitShrink_SelectedAt_Field) _Column() string { return "selected_at" }
+
+type GracefulExitShrink_FinishedAt_Field struct {
+	_set   bool
+	_null  bool
+	_value *time.Time
+}
+
+func GracefulExitShrink_FinishedAt(v time.Time) GracefulExitShrink_FinishedAt_Field {
+	return GracefulExitShrink_FinishedAt_Field{_set: true, _value: &v}
+}
+
+func GracefulExitShrink_FinishedAt_Raw(v *time.Time) GracefulExitShrink_FinishedAt_Field {
+	if v == nil {
+		return GracefulExitShrink_FinishedAt_Null()
+	}
+	return GracefulExitShrink_FinishedAt(*v)
+}
+
+func GracefulExitShrink_FinishedAt_Null() GracefulExitShrink_FinishedAt_Field {
+	return GracefulExitShrink_FinishedAt_Field{_set: true, _null: true}
+}
+
+func (f GracefulExitShrink_FinishedAt_Field) isnull() bool {
+	return !f._set || f._null || f._value == nil
+}
+
+func (f GracefulExitShrink_FinishedAt_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (GracefulExitShrink_FinishedAt_Field) _Column() string { return "finished_at" }
+
 type Node struct {
 	Id                      []byte
 	Address                 string
@@ -3567,6 +3880,7 @@ type Node struct {
 	LastSoftwareUpdateEmail *time.Time
 	NoiseProto              *int
 	NoisePublicKey          []byte
+	Asn                     *int64
 }
 
 func (Node) _Table() string { return "nodes" }
@@ -3603,6 +3917,7 @@ type Node_Create_Fields struct {
 	LastSoftwareUpdateEmail Node_LastSoftwareUpdateEmail_Field
 	NoiseProto              Node_NoiseProto_Field
 	NoisePublicKey          Node_NoisePublicKey_Field
+	Asn                     Node_Asn_Field
 }
 
 type Node_Update_Fields struct {
@@ -3641,6 +3956,7 @@ type Node_Update_Fields struct {
 	LastSoftwareUpdateEmail Node_LastSoftwareUpdateEmail_Field
 	NoiseProto              Node_NoiseProto_Field
 	NoisePublicKey          Node_NoisePublicKey_Field
+	Asn                     Node_Asn_Field
 }
 
 type Node_Id_Field struct {
@@ -4577,6 +4893,38 @@ func (f Node_NoisePublicKey_Field) value() interface{} {
 
 func (Node_NoisePublicKey_Field) _Column() string { return "noise_public_key" }
 
+type Node_Asn_Field struct {
+	_set   bool
+	_null  bool
+	_value *int64
+}
+
+func Node_Asn(v int64) Node_Asn_Field {
+	return Node_Asn_Field{_set: true, _value: &v}
+}
+
+func Node_Asn_Raw(v *int64) Node_Asn_Field {
+	if v == nil {
+		return Node_Asn_Null()
+	}
+	return Node_Asn(*v)
+}
+
+func Node_Asn_Null() Node_Asn_Field {
+	return Node_Asn_Field{_set: true, _null: true}
+}
+
+func (f Node_Asn_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+
+func (f Node_Asn_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Node_Asn_Field) _Column() string { return "asn" }
+
 type NodeApiVersion struct {
 	Id         []byte
 	ApiVersion int
@@ -4666,6 +5014,55 @@ func (f NodeApiVersion_UpdatedAt_Field) value() interface{} {
 
 func (NodeApiVersion_UpdatedAt_Field) _Column() string { return "updated_at" }
 
+type NodeAuditCoverage struct {
+	NodeId        []byte
+	LastAuditedAt time.Time
+}
+
+func (NodeAuditCoverage) _Table() string { return "node_audit_coverage" }
+
+type NodeAuditCoverage_Update_Fields struct {
+	LastAuditedAt NodeAuditCoverage_LastAuditedAt_Field
+}
+
+type NodeAuditCoverage_NodeId_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func NodeAuditCoverage_NodeId(v []byte) NodeAuditCoverage_NodeId_Field {
+	return NodeAuditCoverage_NodeId_Field{_set: true, _value: v}
+}
+
+func (f NodeAuditCoverage_NodeId_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (NodeAuditCoverage_NodeId_Field) _Column() string { return "node_id" }
+
+type NodeAuditCoverage_LastAuditedAt_Field struct {
+	_set   bool
+	_null  bool
+	_value time.Time
+}
+
+func NodeAuditCoverage_LastAuditedAt(v time.Time) NodeAuditCoverage_LastAuditedAt_Field {
+	return NodeAuditCoverage_LastAuditedAt_Field{_set: true, _value: v}
+}
+
+func (f NodeAuditCoverage_LastAuditedAt_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (NodeAuditCoverage_LastAuditedAt_Field) _Column() string { return "last_audited_at" }
+
 type NodeEvent struct {
 	Id            []byte
 	Email         string
@@ -4676,177 +5073,418 @@ type NodeEvent struct {
 	EmailSent     *time.Time
 }
 
-func (NodeEvent) _Table() string { return "node_events" }
+func (NodeEvent) _Table() string { return "node_events" }
+
+type NodeEvent_Create_Fields struct {
+	CreatedAt     NodeEvent_CreatedAt_Field
+	LastAttempted NodeEvent_LastAttempted_Field
+	EmailSent     NodeEvent_EmailSent_Field
+}
+
+type NodeEvent_Update_Fields struct {
+	LastAttempted NodeEvent_LastAttempted_Field
+	EmailSent     NodeEvent_EmailSent_Field
+}
+
+type NodeEvent_Id_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func NodeEvent_Id(v []byte) NodeEvent_Id_Field {
+	return NodeEvent_Id_Field{_set: true, _value: v}
+}
+
+func (f NodeEvent_Id_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (NodeEvent_Id_Field) _Column() string { return "id" }
+
+type NodeEvent_Email_Field struct {
+	_set   bool
+	_null  bool
+	_value string
+}
+
+func NodeEvent_Email(v string) NodeEvent_Email_Field {
+	return NodeEvent_Email_Field{_set: true, _value: v}
+}
+
+func (f NodeEvent_Email_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (NodeEvent_Email_Field) _Column() string { return "email" }
+
+type NodeEvent_NodeId_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func NodeEvent_NodeId(v []byte) NodeEvent_NodeId_Field {
+	return NodeEvent_NodeId_Field{_set: true, _value: v}
+}
+
+func (f NodeEvent_NodeId_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (NodeEvent_NodeId_Field) _Column() string { return "node_id" }
+
+type NodeEvent_Event_Field struct {
+	_set   bool
+	_null  bool
+	_value int
+}
+
+func NodeEvent_Event(v int) NodeEvent_Event_Field {
+	return NodeEvent_Event_Field{_set: true, _value: v}
+}
+
+func (f NodeEvent_Event_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (NodeEvent_Event_Field) _Column() string { return "event" }
+
+type NodeEvent_CreatedAt_Field struct {
+	_set   bool
+	_null  bool
+	_value time.Time
+}
+
+func NodeEvent_CreatedAt(v time.Time) NodeEvent_CreatedAt_Field {
+	return NodeEvent_CreatedAt_Field{_set: true, _value: v}
+}
+
+func (f NodeEvent_CreatedAt_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (NodeEvent_CreatedAt_Field) _Column() string { return "created_at" }
+
+type NodeEvent_LastAttempted_Field struct {
+	_set   bool
+	_null  bool
+	_value *time.Time
+}
+
+func NodeEvent_LastAttempted(v time.Time) NodeEvent_LastAttempted_Field {
+	return NodeEvent_LastAttempted_Field{_set: true, _value: &v}
+}
+
+func NodeEvent_LastAttempted_Raw(v *time.Time) NodeEvent_LastAttempted_Field {
+	if v == nil {
+		return NodeEvent_LastAttempted_Null()
+	}
+	return NodeEvent_LastAttempted(*v)
+}
+
+func NodeEvent_LastAttempted_Null() NodeEvent_LastAttempted_Field {
+	return NodeEvent_LastAttempted_Field{_set: true, _null: true}
+}
+
+func (f NodeEvent_LastAttempted_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+
+func (f NodeEvent_LastAttempted_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (NodeEvent_LastAttempted_Field) _Column() string { return "last_attempted" }
+
+type NodeEvent_EmailSent_Field struct {
+	_set   bool
+	_null  bool
+	_value *time.Time
+}
+
+func NodeEvent_EmailSent(v time.Time) NodeEvent_EmailSent_Field {
+	return NodeEvent_EmailSent_Field{_set: true, _value: &v}
+}
+
+func NodeEvent_EmailSent_Raw(v *time.Time) NodeEvent_EmailSent_Field {
+	if v == nil {
+		return NodeEvent_EmailSent_Null()
+	}
+	return NodeEvent_EmailSent(*v)
+}
+
+func NodeEvent_EmailSent_Null() NodeEvent_EmailSent_Field {
+	return NodeEvent_EmailSent_Field{_set: true, _null: true}
+}
+
+func (f NodeEvent_EmailSent_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+
+func (f NodeEvent_EmailSent_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (NodeEvent_EmailSent_Field) _Column() string { return "email_sent" }
+
+type NodePerformance struct {
+	NodeId    []byte
+	UpdatedAt time.Time
+	Attempts  float64
+	Successes float64
+	Latencies float64
+	Latency   float64
+}
+
+func (NodePerformance) _Table() string { return "node_performance" }
+
+type NodePerformance_Update_Fields struct {
+	UpdatedAt NodePerformance_UpdatedAt_Field
+	Attempts  NodePerformance_Attempts_Field
+	Successes NodePerformance_Successes_Field
+	Latencies NodePerformance_Latencies_Field
+	Latency   NodePerformance_Latency_Field
+}
+
+type NodePerformance_NodeId_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func NodePerformance_NodeId(v []byte) NodePerformance_NodeId_Field {
+	return NodePerformance_NodeId_Field{_set: true, _value: v}
+}
+
+func (f NodePerformance_NodeId_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (NodePerformance_NodeId_Field) _Column() string { return "node_id" }
 
-type NodeEvent_Create_Fields struct {
-	CreatedAt     NodeEvent_CreatedAt_Field
-	LastAttempted NodeEvent_LastAttempted_Field
-	EmailSent     NodeEvent_EmailSent_Field
+type NodePerformance_UpdatedAt_Field struct {
+	_set   bool
+	_null  bool
+	_value time.Time
 }
 
-type NodeEvent_Update_Fields struct {
-	LastAttempted NodeEvent_LastAttempted_Field
-	EmailSent     NodeEvent_EmailSent_Field
+func NodePerformance_UpdatedAt(v time.Time) NodePerformance_UpdatedAt_Field {
+	return NodePerformance_UpdatedAt_Field{_set: true, _value: v}
 }
 
-type NodeEvent_Id_Field struct {
+func (f NodePerformance_UpdatedAt_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (NodePerformance_UpdatedAt_Field) _Column() string { return "updated_at" }
+
+type NodePerformance_Attempts_Field struct {
 	_set   bool
 	_null  bool
-	_value []byte
+	_value float64
 }
 
-func NodeEvent_Id(v []byte) NodeEvent_Id_Field {
-	return NodeEvent_Id_Field{_set: true, _value: v}
+func NodePerformance_Attempts(v float64) NodePerformance_Attempts_Field {
+	return NodePerformance_Attempts_Field{_set: true, _value: v}
 }
 
-func (f NodeEvent_Id_Field) value() interface{} {
+func (f NodePerformance_Attempts_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (NodeEvent_Id_Field) _Column() string { return "id" }
+func (NodePerformance_Attempts_Field) _Column() string { return "attempts" }
 
-type NodeEvent_Email_Field struct {
+type NodePerformance_Successes_Field struct {
 	_set   bool
 	_null  bool
-	_value string
+	_value float64
 }
 
-func NodeEvent_Email(v string) NodeEvent_Email_Field {
-	return NodeEvent_Email_Field{_set: true, _value: v}
+func NodePerformance_Successes(v float64) NodePerformance_Successes_Field {
+	return NodePerformance_Successes_Field{_set: true, _value: v}
 }
 
-func (f NodeEvent_Email_Field) value() interface{} {
+func (f NodePerformance_Successes_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (NodeEvent_Email_Field) _Column() string { return "email" }
+func (NodePerformance_Successes_Field) _Column() string { return "successes" }
 
-type NodeEvent_NodeId_Field struct {
+type NodePerformance_Latencies_Field struct {
 	_set   bool
 	_null  bool
-	_value []byte
+	_value float64
 }
 
-func NodeEvent_NodeId(v []byte) NodeEvent_NodeId_Field {
-	return NodeEvent_NodeId_Field{_set: true, _value: v}
+func NodePerformance_Latencies(v float64) NodePerformance_Latencies_Field {
+	return NodePerformance_Latencies_Field{_set: true, _value: v}
 }
 
-func (f NodeEvent_NodeId_Field) value() interface{} {
+func (f NodePerformance_Latencies_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (NodeEvent_NodeId_Field) _Column() string { return "node_id" }
+func (NodePerformance_Latencies_Field) _Column() string { return "latencies" }
 
-type NodeEvent_Event_Field struct {
+type NodePerformance_Latency_Field struct {
 	_set   bool
 	_null  bool
-	_value int
+	_value float64
 }
 
-func NodeEvent_Event(v int) NodeEvent_Event_Field {
-	return NodeEvent_Event_Field{_set: true, _value: v}
+func NodePerformance_Latency(v float64) NodePerformance_Latency_Field {
+	return NodePerformance_Latency_Field{_set: true, _value: v}
 }
 
-func (f NodeEvent_Event_Field) value() interface{} {
+func (f NodePerformance_Latency_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (NodeEvent_Event_Field) _Column() string { return "event" }
+func (NodePerformance_Latency_Field) _Column() string { return "latency" }
 
-type NodeEvent_CreatedAt_Field struct {
+type NodeTag struct {
+	NodeId   []byte
+	Name     string
+	Value    string
+	SignedAt time.Time
+	Signer   []byte
+}
+
+func (NodeTag) _Table() string { return "node_tags" }
+
+type NodeTag_Update_Fields struct {
+}
+
+type NodeTag_NodeId_Field struct {
 	_set   bool
 	_null  bool
-	_value time.Time
+	_value []byte
 }
 
-func NodeEvent_CreatedAt(v time.Time) NodeEvent_CreatedAt_Field {
-	return NodeEvent_CreatedAt_Field{_set: true, _value: v}
+func NodeTag_NodeId(v []byte) NodeTag_NodeId_Field {
+	return NodeTag_NodeId_Field{_set: true, _value: v}
 }
 
-func (f NodeEvent_CreatedAt_Field) value() interface{} {
+func (f NodeTag_NodeId_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (NodeEvent_CreatedAt_Field) _Column() string { return "created_at" }
+func (NodeTag_NodeId_Field) _Column() string { return "node_id" }
 
-type NodeEvent_LastAttempted_Field struct {
+type NodeTag_Name_Field struct {
 	_set   bool
 	_null  bool
-	_value *time.Time
+	_value string
 }
 
-func NodeEvent_LastAttempted(v time.Time) NodeEvent_LastAttempted_Field {
-	return NodeEvent_LastAttempted_Field{_set: true, _value: &v}
+func NodeTag_Name(v string) NodeTag_Name_Field {
+	return NodeTag_Name_Field{_set: true, _value: v}
 }
 
-func NodeEvent_LastAttempted_Raw(v *time.Time) NodeEvent_LastAttempted_Field {
-	if v == nil {
-		return NodeEvent_LastAttempted_Null()
+func (f NodeTag_Name_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
 	}
-	return NodeEvent_LastAttempted(*v)
+	return f._value
 }
 
-func NodeEvent_LastAttempted_Null() NodeEvent_LastAttempted_Field {
-	return NodeEvent_LastAttempted_Field{_set: true, _null: true}
+func (NodeTag_Name_Field) _Column() string { return "name" }
+
+type NodeTag_Value_Field struct {
+	_set   bool
+	_null  bool
+	_value string
 }
 
-func (f NodeEvent_LastAttempted_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+func NodeTag_Value(v string) NodeTag_Value_Field {
+	return NodeTag_Value_Field{_set: true, _value: v}
+}
 
-func (f NodeEvent_LastAttempted_Field) value() interface{} {
+func (f NodeTag_Value_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (NodeEvent_LastAttempted_Field) _Column() string { return "last_attempted" }
+func (NodeTag_Value_Field) _Column() string { return "value" }
 
-type NodeEvent_EmailSent_Field struct {
+type NodeTag_SignedAt_Field struct {
 	_set   bool
 	_null  bool
-	_value *time.Time
+	_value time.Time
 }
 
-func NodeEvent_EmailSent(v time.Time) NodeEvent_EmailSent_Field {
-	return NodeEvent_EmailSent_Field{_set: true, _value: &v}
+func NodeTag_SignedAt(v time.Time) NodeTag_SignedAt_Field {
+	return NodeTag_SignedAt_Field{_set: true, _value: v}
 }
 
-func NodeEvent_EmailSent_Raw(v *time.Time) NodeEvent_EmailSent_Field {
-	if v == nil {
-		return NodeEvent_EmailSent_Null()
+func (f NodeTag_SignedAt_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
 	}
-	return NodeEvent_EmailSent(*v)
+	return f._value
 }
 
-func NodeEvent_EmailSent_Null() NodeEvent_EmailSent_Field {
-	return NodeEvent_EmailSent_Field{_set: true, _null: true}
+func (NodeTag_SignedAt_Field) _Column() string { return "signed_at" }
+
+type NodeTag_Signer_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
 }
 
-func (f NodeEvent_EmailSent_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+func NodeTag_Signer(v []byte) NodeTag_Signer_Field {
+	return NodeTag_Signer_Field{_set: true, _value: v}
+}
 
-func (f NodeEvent_EmailSent_Field) value() interface{} {
+func (f NodeTag_Signer_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (NodeEvent_EmailSent_Field) _Column() string { return "email_sent" }
+func (NodeTag_Signer_Field) _Column() string { return "signer" }
 
 type OauthClient struct {
 	Id              []byte
@@ -6203,21 +6841,29 @@ func (f RegistrationToken_CreatedAt_Field) value() interface{} {
 func (RegistrationToken_CreatedAt_Field) _Column() string { return "created_at" }
 
 type RepairQueue struct {
-	StreamId      []byte
-	Position      uint64
-	AttemptedAt   *time.Time
-	UpdatedAt     time.Time
-	InsertedAt    time.Time
-	SegmentHealth float64
+	StreamId         []byte
+	Position         uint64
+	AttemptedAt      *time.Time
+	UpdatedAt        time.Time
+	InsertedAt       time.Time
+	SegmentHealth    float64
+	NodeRisk         float64
+	Tier             float64
+	SegmentCreatedAt *time.Time
+	Priority         float64
 }
 
 func (RepairQueue) _Table() string { return "repair_queue" }
 
 type RepairQueue_Create_Fields struct {
-	AttemptedAt   RepairQueue_AttemptedAt_Field
-	UpdatedAt     RepairQueue_UpdatedAt_Field
-	InsertedAt    RepairQueue_InsertedAt_Field
-	SegmentHealth RepairQueue_SegmentHealth_Field
+	AttemptedAt      RepairQueue_AttemptedAt_Field
+	UpdatedAt        RepairQueue_UpdatedAt_Field
+	InsertedAt       RepairQueue_InsertedAt_Field
+	SegmentHealth    RepairQueue_SegmentHealth_Field
+	NodeRisk         RepairQueue_NodeRisk_Field
+	Tier             RepairQueue_Tier_Field
+	SegmentCreatedAt RepairQueue_SegmentCreatedAt_Field
+	Priority         RepairQueue_Priority_Field
 }
 
 type RepairQueue_Update_Fields struct {
@@ -6352,6 +6998,97 @@ func (f RepairQueue_SegmentHealth_Field) value() interface{} {
 
 func (RepairQueue_SegmentHealth_Field) _Column() string { return "segment_health" }
 
+type RepairQueue_NodeRisk_Field struct {
+	_set   bool
+	_null  bool
+	_value float64
+}
+
+func RepairQueue_NodeRisk(v float64) RepairQueue_NodeRisk_Field {
+	return RepairQueue_NodeRisk_Field{_set: true, _value: v}
+}
+
+func (f RepairQueue_NodeRisk_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (RepairQueue_NodeRisk_Field) _Column() string { return "node_risk" }
+
+type RepairQueue_Tier_Field struct {
+	_set   bool
+	_null  bool
+	_value float64
+}
+
+func RepairQueue_Tier(v float64) RepairQueue_Tier_Field {
+	return RepairQueue_Tier_Field{_set: true, _value: v}
+}
+
+func (f RepairQueue_Tier_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (RepairQueue_Tier_Field) _Column() string { return "tier" }
+
+type RepairQueue_SegmentCreatedAt_Field struct {
+	_set   bool
+	_null  bool
+	_value *time.Time
+}
+
+func RepairQueue_SegmentCreatedAt(v time.Time) RepairQueue_SegmentCreatedAt_Field {
+	return RepairQueue_SegmentCreatedAt_Field{_set: true, _value: &v}
+}
+
+func RepairQueue_SegmentCreatedAt_Raw(v *time.Time) RepairQueue_SegmentCreatedAt_Field {
+	if v == nil {
+		return RepairQueue_SegmentCreatedAt_Null()
+	}
+	return RepairQueue_SegmentCreatedAt(*v)
+}
+
+func RepairQueue_SegmentCreatedAt_Null() RepairQueue_SegmentCreatedAt_Field {
+	return RepairQueue_SegmentCreatedAt_Field{_set: true, _null: true}
+}
+
+func (f RepairQueue_SegmentCreatedAt_Field) isnull() bool {
+	return !f._set || f._null || f._value == nil
+}
+
+func (f RepairQueue_SegmentCreatedAt_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (RepairQueue_SegmentCreatedAt_Field) _Column() string { return "segment_created_at" }
+
+type RepairQueue_Priority_Field struct {
+	_set   bool
+	_null  bool
+	_value float64
+}
+
+func RepairQueue_Priority(v float64) RepairQueue_Priority_Field {
+	return RepairQueue_Priority_Field{_set: true, _value: v}
+}
+
+func (f RepairQueue_Priority_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (RepairQueue_Priority_Field) _Column() string { return "priority" }
+
 type Reputation struct {
 	Id                          []byte
 	AuditSuccessCount           int64
@@ -14478,7 +15215,7 @@ func (obj *pgxImpl) Get_Node_By_Id(ctx context.Context,
 	node *Node, err error) {
 	defer mon.Task()(&ctx)(&err)
 
-	var __embed_stmt = __sqlbundle_Literal("SELECT nodes.id, nodes.address, nodes.last_net, nodes.last_ip_port, nodes.country_code, nodes.protocol, nodes.type, nodes.email, nodes.wallet, nodes.wallet_features, nodes.free_disk, nodes.piece_count, nodes.major, nodes.minor, nodes.patch, nodes.hash, nodes.timestamp, nodes.release, nodes.latency_90, nodes.vetted_at, nodes.created_at, nodes.updated_at, nodes.last_contact_success, nodes.last_contact_failure, nodes.disqualified, nodes.disqualification_reason, nodes.unknown_audit_suspended, nodes.offline_suspended, nodes.under_review, nodes.exit_initiated_at, nodes.exit_loop_completed_at, nodes.exit_finished_at, nodes.exit_success, nodes.contained, nodes.last_offline_email, nodes.last_software_update_email, nodes.noise_proto, nodes.noise_public_key FROM nodes WHERE nodes.id = ?")
+	var __embed_stmt = __sqlbundle_Literal("SELECT nodes.id, nodes.address, nodes.last_net, nodes.last_ip_port, nodes.country_code, nodes.protocol, nodes.type, nodes.email, nodes.wallet, nodes.wallet_features, nodes.free_disk, nodes.piece_count, nodes.major, nodes.minor, nodes.patch, nodes.hash, nodes.timestamp, nodes.release, nodes.latency_90, nodes.vetted_at, nodes.created_at, nodes.updated_at, nodes.last_contact_success, nodes.last_contact_failure, nodes.disqualified, nodes.disqualification_reason, nodes.unknown_audit_suspended, nodes.offline_suspended, nodes.under_review, nodes.exit_initiated_at, nodes.exit_loop_completed_at, nodes.exit_finished_at, nodes.exit_success, nodes.contained, nodes.last_offline_email, nodes.last_software_update_email, nodes.noise_proto, nodes.noise_public_key, nodes.asn FROM nodes WHERE nodes.id = ?")
 
 	var __values []interface{}
 	__values = append(__values, node_id.value())
@@ -14487,7 +15224,7 @@ func (obj *pgxImpl) Get_Node_By_Id(ctx context.Context,
 	obj.logStmt(__stmt, __values...)
 
 	node = &Node{}
-	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&node.Id, &node.Address, &node.LastNet, &node.LastIpPort, &node.CountryCode, &node.Protocol, &node.Type, &node.Email, &node.Wallet, &node.WalletFeatures, &node.FreeDisk, &node.PieceCount, &node.Major, &node.Minor, &node.Patch, &node.Hash, &node.Timestamp, &node.Release, &node.Latency90, &node.VettedAt, &node.CreatedAt, &node.UpdatedAt, &node.LastContactSuccess, &node.LastContactFailure, &node.Disqualified, &node.DisqualificationReason, &node.UnknownAuditSuspended, &node.OfflineSuspended, &node.UnderReview, &node.ExitInitiatedAt, &node.ExitLoopCompletedAt, &node.ExitFinishedAt, &node.ExitSuccess, &node.Contained, &node.LastOfflineEmail, &node.LastSoftwareUpdateEmail, &node.NoiseProto, &node.NoisePublicKey)
+	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&node.Id, &node.Address, &node.LastNet, &node.LastIpPort, &node.CountryCode, &node.Protocol, &node.Type, &node.Email, &node.Wallet, &node.WalletFeatures, &node.FreeDisk, &node.PieceCount, &node.Major, &node.Minor, &node.Patch, &node.Hash, &node.Timestamp, &node.Release, &node.Latency90, &node.VettedAt, &node.CreatedAt, &node.UpdatedAt, &node.LastContactSuccess, &node.LastContactFailure, &node.Disqualified, &node.DisqualificationReason, &node.UnknownAuditSuspended, &node.OfflineSuspended, &node.UnderReview, &node.ExitInitiatedAt, &node.ExitLoopCompletedAt, &node.ExitFinishedAt, &node.ExitSuccess, &node.Contained, &node.LastOfflineEmail, &node.LastSoftwareUpdateEmail, &node.NoiseProto, &node.NoisePublicKey, &node.Asn)
 	if err != nil {
 		return (*Node)(nil), obj.makeErr(err)
 	}
@@ -14543,9 +15280,9 @@ func (obj *pgxImpl) Paged_Node(ctx context.Context,
 	rows []*Node, next *Paged_Node_Continuation, err error) {
 	defer mon.Task()(&ctx)(&err)
 
-	var __embed_stmt = __sqlbundle_Literal("SELECT nodes.id, nodes.address, nodes.last_net, nodes.last_ip_port, nodes.country_code, nodes.protocol, nodes.type, nodes.email, nodes.wallet, nodes.wallet_features, nodes.free_disk, nodes.piece_count, nodes.major, nodes.minor, nodes.patch, nodes.hash, nodes.timestamp, nodes.release, nodes.latency_90, nodes.vetted_at, nodes.created_at, nodes.updated_at, nodes.last_contact_success, nodes.last_contact_failure, nodes.disqualified, nodes.disqualification_reason, nodes.unknown_audit_suspended, nodes.offline_suspended, nodes.under_review, nodes.exit_initiated_at, nodes.exit_loop_completed_at, nodes.exit_finished_at, nodes.exit_success, nodes.contained, nodes.last_offline_email, nodes.last_software_update_email, nodes.noise_proto, nodes.noise_public_key, nodes.id FROM nodes WHERE (nodes.id) > ? ORDER BY nodes.id LIMIT ?")
+	var __embed_stmt = __sqlbundle_Literal("SELECT nodes.id, nodes.address, nodes.last_net, nodes.last_ip_port, nodes.country_code, nodes.protocol, nodes.type, nodes.email, nodes.wallet, nodes.wallet_features, nodes.free_disk, nodes.piece_count, nodes.major, nodes.minor, nodes.patch, nodes.hash, nodes.timestamp, nodes.release, nodes.latency_90, nodes.vetted_at, nodes.created_at, nodes.updated_at, nodes.last_contact_success, nodes.last_contact_failure, nodes.disqualified, nodes.disqualification_reason, nodes.unknown_audit_suspended, nodes.offline_suspended, nodes.under_review, nodes.exit_initiated_at, nodes.exit_loop_completed_at, nodes.exit_finished_at, nodes.exit_success, nodes.contained, nodes.last_offline_email, nodes.last_software_update_email, nodes.noise_proto, nodes.noise_public_key, nodes.asn, nodes.id FROM nodes WHERE (nodes.id) > ? ORDER BY nodes.id LIMIT ?")
 
-	var __embed_first_stmt = __sqlbundle_Literal("SELECT nodes.id, nodes.address, nodes.last_net, nodes.last_ip_port, nodes.country_code, nodes.protocol, nodes.type, nodes.email, nodes.wallet, nodes.wallet_features, nodes.free_disk, nodes.piece_count, nodes.major, nodes.minor, nodes.patch, nodes.hash, nodes.timestamp, nodes.release, nodes.latency_90, nodes.vetted_at, nodes.created_at, nodes.updated_at, nodes.last_contact_success, nodes.last_contact_failure, nodes.disqualified, nodes.disqualification_reason, nodes.unknown_audit_suspended, nodes.offline_suspended, nodes.under_review, nodes.exit_initiated_at, nodes.exit_loop_completed_at, nodes.exit_finished_at, nodes.exit_success, nodes.contained, nodes.last_offline_email, nodes.last_software_update_email, nodes.noise_proto, nodes.noise_public_key, nodes.id FROM nodes ORDER BY nodes.id LIMIT ?")
+	var __embed_first_stmt = __sqlbundle_Literal("SELECT nodes.id, nodes.address, nodes.last_net, nodes.last_ip_port, nodes.country_code, nodes.protocol, nodes.type, nodes.email, nodes.wallet, nodes.wallet_features, nodes.free_disk, nodes.piece_count, nodes.major, nodes.minor, nodes.patch, nodes.hash, nodes.timestamp, nodes.release, nodes.latency_90, nodes.vetted_at, nodes.created_at, nodes.updated_at, nodes.last_contact_success, nodes.last_contact_failure, nodes.disqualified, nodes.disqualification_reason, nodes.unknown_audit_suspended, nodes.offline_suspended, nodes.under_review, nodes.exit_initiated_at, nodes.exit_loop_completed_at, nodes.exit_finished_at, nodes.exit_success, nodes.contained, nodes.last_offline_email, nodes.last_software_update_email, nodes.noise_proto, nodes.noise_public_key, nodes.asn, nodes.id FROM nodes ORDER BY nodes.id LIMIT ?")
 
 	var __values []interface{}
 
@@ -14572,7 +15309,7 @@ func (obj *pgxImpl) Paged_Node(ctx context.Context,
 
 			for __rows.Next() {
 				node := &Node{}
-				err = __rows.Scan(&node.Id, &node.Address, &node.LastNet, &node.LastIpPort, &node.CountryCode, &node.Protocol, &node.Type, &node.Email, &node.Wallet, &node.WalletFeatures, &node.FreeDisk, &node.PieceCount, &node.Major, &node.Minor, &node.Patch, &node.Hash, &node.Timestamp, &node.Release, &node.Latency90, &node.VettedAt, &node.CreatedAt, &node.UpdatedAt, &node.LastContactSuccess, &node.LastContactFailure, &node.Disqualified, &node.DisqualificationReason, &node.UnknownAuditSuspended, &node.OfflineSuspended, &node.UnderReview, &node.ExitInitiatedAt, &node.ExitLoopCompletedAt, &node.ExitFinishedAt, &node.ExitSuccess, &node.Contained, &node.LastOfflineEmail, &node.LastSoftwareUpdateEmail, &node.NoiseProto, &node.NoisePublicKey, &__continuation._value_id)
+				err = __rows.Scan(&node.Id, &node.Address, &node.LastNet, &node.LastIpPort, &node.CountryCode, &node.Protocol, &node.Type, &node.Email, &node.Wallet, &node.WalletFeatures, &node.FreeDisk, &node.PieceCount, &node.Major, &node.Minor, &node.Patch, &node.Hash, &node.Timestamp, &node.Release, &node.Latency90, &node.VettedAt, &node.CreatedAt, &node.UpdatedAt, &node.LastContactSuccess, &node.LastContactFailure, &node.Disqualified, &node.DisqualificationReason, &node.UnknownAuditSuspended, &node.OfflineSuspended, &node.UnderReview, &node.ExitInitiatedAt, &node.ExitLoopCompletedAt, &node.ExitFinishedAt, &node.ExitSuccess, &node.Contained, &node.LastOfflineEmail, &node.LastSoftwareUpdateEmail, &node.NoiseProto, &node.NoisePublicKey, &node.Asn, &__continuation._value_id)
 				if err != nil {
 					return nil, nil, err
 				}
@@ -16637,7 +17374,7 @@ func (obj *pgxImpl) Update_Node_By_Id(ctx context.Context,
 	defer mon.Task()(&ctx)(&err)
 	var __sets = &__sqlbundle_Hole{}
 
-	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("UPDATE nodes SET "), __sets, __sqlbundle_Literal(" WHERE nodes.id = ? RETURNING nodes.id, nodes.address, nodes.last_net, nodes.last_ip_port, nodes.country_code, nodes.protocol, nodes.type, nodes.email, nodes.wallet, nodes.wallet_features, nodes.free_disk, nodes.piece_count, nodes.major, nodes.minor, nodes.patch, nodes.hash, nodes.timestamp, nodes.release, nodes.latency_90, nodes.vetted_at, nodes.created_at, nodes.updated_at, nodes.last_contact_success, nodes.last_contact_failure, nodes.disqualified, nodes.disqualification_reason, nodes.unknown_audit_suspended, nodes.offline_suspended, nodes.under_review, nodes.exit_initiated_at, nodes.exit_loop_completed_at, nodes.exit_finished_at, nodes.exit_success, nodes.contained, nodes.last_offline_email, nodes.last_software_update_email, nodes.noise_proto, nodes.noise_public_key")}}
+	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("UPDATE nodes SET "), __sets, __sqlbundle_Literal(" WHERE nodes.id = ? RETURNING nodes.id, nodes.address, nodes.last_net, nodes.last_ip_port, nodes.country_code, nodes.protocol, nodes.type, nodes.email, nodes.wallet, nodes.wallet_features, nodes.free_disk, nodes.piece_count, nodes.major, nodes.minor, nodes.patch, nodes.hash, nodes.timestamp, nodes.release, nodes.latency_90, nodes.vetted_at, nodes.created_at, nodes.updated_at, nodes.last_contact_success, nodes.last_contact_failure, nodes.disqualified, nodes.disqualification_reason, nodes.unknown_audit_suspended, nodes.offline_suspended, nodes.under_review, nodes.exit_initiated_at, nodes.exit_loop_completed_at, nodes.exit_finished_at, nodes.exit_success, nodes.contained, nodes.last_offline_email, nodes.last_software_update_email, nodes.noise_proto, nodes.noise_public_key, nodes.asn")}}
 
 	__sets_sql := __sqlbundle_Literals{Join: ", "}
 	var __values []interface{}
@@ -16818,6 +17555,11 @@ func (obj *pgxImpl) Update_Node_By_Id(ctx context.Context,
 		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("noise_public_key = ?"))
 	}
 
+	if update.Asn._set {
+		__values = append(__values, update.Asn.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("asn = ?"))
+	}
+
 	__now := obj.db.Hooks.Now().UTC()
 
 	__values = append(__values, __now)
@@ -16832,7 +17574,7 @@ func (obj *pgxImpl) Update_Node_By_Id(ctx context.Context,
 	obj.logStmt(__stmt, __values...)
 
 	node = &Node{}
-	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&node.Id, &node.Address, &node.LastNet, &node.LastIpPort, &node.CountryCode, &node.Protocol, &node.Type, &node.Email, &node.Wallet, &node.WalletFeatures, &node.FreeDisk, &node.PieceCount, &node.Major, &node.Minor, &node.Patch, &node.Hash, &node.Timestamp, &node.Release, &node.Latency90, &node.VettedAt, &node.CreatedAt, &node.UpdatedAt, &node.LastContactSuccess, &node.LastContactFailure, &node.Disqualified, &node.DisqualificationReason, &node.UnknownAuditSuspended, &node.OfflineSuspended, &node.UnderReview, &node.ExitInitiatedAt, &node.ExitLoopCompletedAt, &node.ExitFinishedAt, &node.ExitSuccess, &node.Contained, &node.LastOfflineEmail, &node.LastSoftwareUpdateEmail, &node.NoiseProto, &node.NoisePublicKey)
+	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&node.Id, &node.Address, &node.LastNet, &node.LastIpPort, &node.CountryCode, &node.Protocol, &node.Type, &node.Email, &node.Wallet, &node.WalletFeatures, &node.FreeDisk, &node.PieceCount, &node.Major, &node.Minor, &node.Patch, &node.Hash, &node.Timestamp, &node.Release, &node.Latency90, &node.VettedAt, &node.CreatedAt, &node.UpdatedAt, &node.LastContactSuccess, &node.LastContactFailure, &node.Disqualified, &node.DisqualificationReason, &node.UnknownAuditSuspended, &node.OfflineSuspended, &node.UnderReview, &node.ExitInitiatedAt, &node.ExitLoopCompletedAt, &node.ExitFinishedAt, &node.ExitSuccess, &node.Contained, &node.LastOfflineEmail, &node.LastSoftwareUpdateEmail, &node.NoiseProto, &node.NoisePublicKey, &node.Asn)
 	if err == sql.ErrNoRows {
 		return nil, nil
 	}
@@ -17030,6 +17772,11 @@ func (obj *pgxImpl) UpdateNoReturn_Node_By_Id(ctx context.Context,
 		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("noise_public_key = ?"))
 	}
 
+	if update.Asn._set {
+		__values = append(__values, update.Asn.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("asn = ?"))
+	}
+
 	__now := obj.db.Hooks.Now().UTC()
 
 	__values = append(__values, __now)
@@ -17238,6 +17985,11 @@ func (obj *pgxImpl) UpdateNoReturn_Node_By_Id_And_Disqualified_Is_Null_And_ExitF
 		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("noise_public_key = ?"))
 	}
 
+	if update.Asn._set {
+		__values = append(__values, update.Asn.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("asn = ?"))
+	}
+
 	__now := obj.db.Hooks.Now().UTC()
 
 	__values = append(__values, __now)
@@ -19098,6 +19850,26 @@ func (obj *pgxImpl) deleteAll(ctx context.Context) (count int64, err error) {
 		return 0, obj.makeErr(err)
 	}
 
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM node_tags;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM node_performance;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
 	__count, err = __res.RowsAffected()
 	if err != nil {
 		return 0, obj.makeErr(err)
@@ -19108,6 +19880,16 @@ func (obj *pgxImpl) deleteAll(ctx context.Context) (count int64, err error) {
 		return 0, obj.makeErr(err)
 	}
 
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM node_audit_coverage;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
 	__count, err = __res.RowsAffected()
 	if err != nil {
 		return 0, obj.makeErr(err)
@@ -19128,6 +19910,16 @@ func (obj *pgxImpl) deleteAll(ctx context.Context) (count int64, err error) {
 		return 0, obj.makeErr(err)
 	}
 
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM graceful_exit_shrinks;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
 	__count, err = __res.RowsAffected()
 	if err != nil {
 		return 0, obj.makeErr(err)
@@ -21991,7 +22783,7 @@ func (obj *pgxcockroachImpl) Get_Node_By_Id(ctx context.Context,
 	node *Node, err error) {
 	defer mon.Task()(&ctx)(&err)
 
-	var __embed_stmt = __sqlbundle_Literal("SELECT nodes.id, nodes.address, nodes.last_net, nodes.last_ip_port, nodes.country_code, nodes.protocol, nodes.type, nodes.email, nodes.wallet, nodes.wallet_features, nodes.free_disk, nodes.piece_count, nodes.major, nodes.minor, nodes.patch, nodes.hash, nodes.timestamp, nodes.release, nodes.latency_90, nodes.vetted_at, nodes.created_at, nodes.updated_at, nodes.last_contact_success, nodes.last_contact_failure, nodes.disqualified, nodes.disqualification_reason, nodes.unknown_audit_suspended, nodes.offline_suspended, nodes.under_review, nodes.exit_initiated_at, nodes.exit_loop_completed_at, nodes.exit_finished_at, nodes.exit_success, nodes.contained, nodes.last_offline_email, nodes.last_software_update_email, nodes.noise_proto, nodes.noise_public_key FROM nodes WHERE nodes.id = ?")
+	var __embed_stmt = __sqlbundle_Literal("SELECT nodes.id, nodes.address, nodes.last_net, nodes.last_ip_port, nodes.country_code, nodes.protocol, nodes.type, nodes.email, nodes.wallet, nodes.wallet_features, nodes.free_disk, nodes.piece_count, nodes.major, nodes.minor, nodes.patch, nodes.hash, nodes.timestamp, nodes.release, nodes.latency_90, nodes.vetted_at, nodes.created_at, nodes.updated_at, nodes.last_contact_success, nodes.last_contact_failure, nodes.disqualified, nodes.disqualification_reason, nodes.unknown_audit_suspended, nodes.offline_suspended, nodes.under_review, nodes.exit_initiated_at, nodes.exit_loop_completed_at, nodes.exit_finished_at, nodes.exit_success, nodes.contained, nodes.last_offline_email, nodes.last_software_update_email, nodes.noise_proto, nodes.noise_public_key, nodes.asn FROM nodes WHERE nodes.id = ?")
 
 	var __values []interface{}
 	__values = append(__values, node_id.value())
@@ -22000,7 +22792,7 @@ func (obj *pgxcockroachImpl) Get_Node_By_Id(ctx context.Context,
 	obj.logStmt(__stmt, __values...)
 
 	node = &Node{}
-	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&node.Id, &node.Address, &node.LastNet, &node.LastIpPort, &node.CountryCode, &node.Protocol, &node.Type, &node.Email, &node.Wallet, &node.WalletFeatures, &node.FreeDisk, &node.PieceCount, &node.Major, &node.Minor, &node.Patch, &node.Hash, &node.Timestamp, &node.Release, &node.Latency90, &node.VettedAt, &node.CreatedAt, &node.UpdatedAt, &node.LastContactSuccess, &node.LastContactFailure, &node.Disqualified, &node.DisqualificationReason, &node.UnknownAuditSuspended, &node.OfflineSuspended, &node.UnderReview, &node.ExitInitiatedAt, &node.ExitLoopCompletedAt, &node.ExitFinishedAt, &node.ExitSuccess, &node.Contained, &node.LastOfflineEmail, &node.LastSoftwareUpdateEmail, &node.NoiseProto, &node.NoisePublicKey)
+	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&node.Id, &node.Address, &node.LastNet, &node.LastIpPort, &node.CountryCode, &node.Protocol, &node.Type, &node.Email, &node.Wallet, &node.WalletFeatures, &node.FreeDisk, &node.PieceCount, &node.Major, &node.Minor, &node.Patch, &node.Hash, &node.Timestamp, &node.Release, &node.Latency90, &node.VettedAt, &node.CreatedAt, &node.UpdatedAt, &node.LastContactSuccess, &node.LastContactFailure, &node.Disqualified, &node.DisqualificationReason, &node.UnknownAuditSuspended, &node.OfflineSuspended, &node.UnderReview, &node.ExitInitiatedAt, &node.ExitLoopCompletedAt, &node.ExitFinishedAt, &node.ExitSuccess, &node.Contained, &node.LastOfflineEmail, &node.LastSoftwareUpdateEmail, &node.NoiseProto, &node.NoisePublicKey, &node.Asn)
 	if err != nil {
 		return (*Node)(nil), obj.makeErr(err)
 	}
@@ -22056,9 +22848,9 @@ func (obj *pgxcockroachImpl) Paged_Node(ctx context.Context,
 	rows []*Node, next *Paged_Node_Continuation, err error) {
 	defer mon.Task()(&ctx)(&err)
 
-	var __embed_stmt = __sqlbundle_Literal("SELECT nodes.id, nodes.address, nodes.last_net, nodes.last_ip_port, nodes.country_code, nodes.protocol, nodes.type, nodes.email, nodes.wallet, nodes.wallet_features, nodes.free_disk, nodes.piece_count, nodes.major, nodes.minor, nodes.patch, nodes.hash, nodes.timestamp, nodes.release, nodes.latency_90, nodes.vetted_at, nodes.created_at, nodes.updated_at, nodes.last_contact_success, nodes.last_contact_failure, nodes.disqualified, nodes.disqualification_reason, nodes.unknown_audit_suspended, nodes.offline_suspended, nodes.under_review, nodes.exit_initiated_at, nodes.exit_loop_completed_at, nodes.exit_finished_at, nodes.exit_success, nodes.contained, nodes.last_offline_email, nodes.last_software_update_email, nodes.noise_proto, nodes.noise_public_key, nodes.id FROM nodes WHERE (nodes.id) > ? ORDER BY nodes.id LIMIT ?")
+	var __embed_stmt = __sqlbundle_Literal("SELECT nodes.id, nodes.address, nodes.last_net, nodes.last_ip_port, nodes.country_code, nodes.protocol, nodes.type, nodes.email, nodes.wallet, nodes.wallet_features, nodes.free_disk, nodes.piece_count, nodes.major, nodes.minor, nodes.patch, nodes.hash, nodes.timestamp, nodes.release, nodes.latency_90, nodes.vetted_at, nodes.created_at, nodes.updated_at, nodes.last_contact_success, nodes.last_contact_failure, nodes.disqualified, nodes.disqualification_reason, nodes.unknown_audit_suspended, nodes.offline_suspended, nodes.under_review, nodes.exit_initiated_at, nodes.exit_loop_completed_at, nodes.exit_finished_at, nodes.exit_success, nodes.contained, nodes.last_offline_email, nodes.last_software_update_email, nodes.noise_proto, nodes.noise_public_key, nodes.asn, nodes.id FROM nodes WHERE (nodes.id) > ? ORDER BY nodes.id LIMIT ?")
 
-	var __embed_first_stmt = __sqlbundle_Literal("SELECT nodes.id, nodes.address, nodes.last_net, nodes.last_ip_port, nodes.country_code, nodes.protocol, nodes.type, nodes.email, nodes.wallet, nodes.wallet_features, nodes.free_disk, nodes.piece_count, nodes.major, nodes.minor, nodes.patch, nodes.hash, nodes.timestamp, nodes.release, nodes.latency_90, nodes.vetted_at, nodes.created_at, nodes.updated_at, nodes.last_contact_success, nodes.last_contact_failure, nodes.disqualified, nodes.disqualification_reason, nodes.unknown_audit_suspended, nodes.offline_suspended, nodes.under_review, nodes.exit_initiated_at, nodes.exit_loop_completed_at, nodes.exit_finished_at, nodes.exit_success, nodes.contained, nodes.last_offline_email, nodes.last_software_update_email, nodes.noise_proto, nodes.noise_public_key, nodes.id FROM nodes ORDER BY nodes.id LIMIT ?")
+	var __embed_first_stmt = __sqlbundle_Literal("SELECT nodes.id, nodes.address, nodes.last_net, nodes.last_ip_port, nodes.country_code, nodes.protocol, nodes.type, nodes.email, nodes.wallet, nodes.wallet_features, nodes.free_disk, nodes.piece_count, nodes.major, nodes.minor, nodes.patch, nodes.hash, nodes.timestamp, nodes.release, nodes.latency_90, nodes.vetted_at, nodes.created_at, nodes.updated_at, nodes.last_contact_success, nodes.last_contact_failure, nodes.disqualified, nodes.disqualification_reason, nodes.unknown_audit_suspended, nodes.offline_suspended, nodes.under_review, nodes.exit_initiated_at, nodes.exit_loop_completed_at, nodes.exit_finished_at, nodes.exit_success, nodes.contained, nodes.last_offline_email, nodes.last_software_update_email, nodes.noise_proto, nodes.noise_public_key, nodes.asn, nodes.id FROM nodes ORDER BY nodes.id LIMIT ?")
 
 	var __values []interface{}
 
@@ -22085,7 +22877,7 @@ func (obj *pgxcockroachImpl) Paged_Node(ctx context.Context,
 
 			for __rows.Next() {
 				node := &Node{}
-				err = __rows.Scan(&node.Id, &node.Address, &node.LastNet, &node.LastIpPort, &node.CountryCode, &node.Protocol, &node.Type, &node.Email, &node.Wallet, &node.WalletFeatures, &node.FreeDisk, &node.PieceCount, &node.Major, &node.Minor, &node.Patch, &node.Hash, &node.Timestamp, &node.Release, &node.Latency90, &node.VettedAt, &node.CreatedAt, &node.UpdatedAt, &node.LastContactSuccess, &node.LastContactFailure, &node.Disqualified, &node.DisqualificationReason, &node.UnknownAuditSuspended, &node.OfflineSuspended, &node.UnderReview, &node.ExitInitiatedAt, &node.ExitLoopCompletedAt, &node.ExitFinishedAt, &node.ExitSuccess, &node.Contained, &node.LastOfflineEmail, &node.LastSoftwareUpdateEmail, &node.NoiseProto, &node.NoisePublicKey, &__continuation._value_id)
+				err = __rows.Scan(&node.Id, &node.Address, &node.LastNet, &node.LastIpPort, &node.CountryCode, &node.Protocol, &node.Type, &node.Email, &node.Wallet, &node.WalletFeatures, &node.FreeDisk, &node.PieceCount, &node.Major, &node.Minor, &node.Patch, &node.Hash, &node.Timestamp, &node.Release, &node.Latency90, &node.VettedAt, &node.CreatedAt, &node.UpdatedAt, &node.LastContactSuccess, &node.LastContactFailure, &node.Disqualified, &node.DisqualificationReason, &node.UnknownAuditSuspended, &node.OfflineSuspended, &node.UnderReview, &node.ExitInitiatedAt, &node.ExitLoopCompletedAt, &node.ExitFinishedAt, &node.ExitSuccess, &node.Contained, &node.LastOfflineEmail, &node.LastSoftwareUpdateEmail, &node.NoiseProto, &node.NoisePublicKey, &node.Asn, &__continuation._value_id)
 				if err != nil {
 					return nil, nil, err
 				}
@@ -24150,7 +24942,7 @@ func (obj *pgxcockroachImpl) Update_Node_By_Id(ctx context.Context,
 	defer mon.Task()(&ctx)(&err)
 	var __sets = &__sqlbundle_Hole{}
 
-	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("UPDATE nodes SET "), __sets, __sqlbundle_Literal(" WHERE nodes.id = ? RETURNING nodes.id, nodes.address, nodes.last_net, nodes.last_ip_port, nodes.country_code, nodes.protocol, nodes.type, nodes.email, nodes.wallet, nodes.wallet_features, nodes.free_disk, nodes.piece_count, nodes.major, nodes.minor, nodes.patch, nodes.hash, nodes.timestamp, nodes.release, nodes.latency_90, nodes.vetted_at, nodes.created_at, nodes.updated_at, nodes.last_contact_success, nodes.last_contact_failure, nodes.disqualified, nodes.disqualification_reason, nodes.unknown_audit_suspended, nodes.offline_suspended, nodes.under_review, nodes.exit_initiated_at, nodes.exit_loop_completed_at, nodes.exit_finished_at, nodes.exit_success, nodes.contained, nodes.last_offline_email, nodes.last_software_update_email, nodes.noise_proto, nodes.noise_public_key")}}
+	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("UPDATE nodes SET "), __sets, __sqlbundle_Literal(" WHERE nodes.id = ? RETURNING nodes.id, nodes.address, nodes.last_net, nodes.last_ip_port, nodes.country_code, nodes.protocol, nodes.type, nodes.email, nodes.wallet, nodes.wallet_features, nodes.free_disk, nodes.piece_count, nodes.major, nodes.minor, nodes.patch, nodes.hash, nodes.timestamp, nodes.release, nodes.latency_90, nodes.vetted_at, nodes.created_at, nodes.updated_at, nodes.last_contact_success, nodes.last_contact_failure, nodes.disqualified, nodes.disqualification_reason, nodes.unknown_audit_suspended, nodes.offline_suspended, nodes.under_review, nodes.exit_initiated_at, nodes.exit_loop_completed_at, nodes.exit_finished_at, nodes.exit_success, nodes.contained, nodes.last_offline_email, nodes.last_software_update_email, nodes.noise_proto, nodes.noise_public_key, nodes.asn")}}
 
 	__sets_sql := __sqlbundle_Literals{Join: ", "}
 	var __values []interface{}
@@ -24331,6 +25123,11 @@ func (obj *pgxcockroachImpl) Update_Node_By_Id(ctx context.Context,
 		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("noise_public_key = ?"))
 	}
 
+	if update.Asn._set {
+		__values = append(__values, update.Asn.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("asn = ?"))
+	}
+
 	__now := obj.db.Hooks.Now().UTC()
 
 	__values = append(__values, __now)
@@ -24345,7 +25142,7 @@ func (obj *pgxcockroachImpl) Update_Node_By_Id(ctx context.Context,
 	obj.logStmt(__stmt, __values...)
 
 	node = &Node{}
-	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&node.Id, &node.Address, &node.LastNet, &node.LastIpPort, &node.CountryCode, &node.Protocol, &node.Type, &node.Email, &node.Wallet, &node.WalletFeatures, &node.FreeDisk, &node.PieceCount, &node.Major, &node.Minor, &node.Patch, &node.Hash, &node.Timestamp, &node.Release, &node.Latency90, &node.VettedAt, &node.CreatedAt, &node.UpdatedAt, &node.LastContactSuccess, &node.LastContactFailure, &node.Disqualified, &node.DisqualificationReason, &node.UnknownAuditSuspended, &node.OfflineSuspended, &node.UnderReview, &node.ExitInitiatedAt, &node.ExitLoopCompletedAt, &node.ExitFinishedAt, &node.ExitSuccess, &node.Contained, &node.LastOfflineEmail, &node.LastSoftwareUpdateEmail, &node.NoiseProto, &node.NoisePublicKey)
+	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&node.Id, &node.Address, &node.LastNet, &node.LastIpPort, &node.CountryCode, &node.Protocol, &node.Type, &node.Email, &node.Wallet, &node.WalletFeatures, &node.FreeDisk, &node.PieceCount, &node.Major, &node.Minor, &node.Patch, &node.Hash, &node.Timestamp, &node.Release, &node.Latency90, &node.VettedAt, &node.CreatedAt, &node.UpdatedAt, &node.LastContactSuccess, &node.LastContactFailure, &node.Disqualified, &node.DisqualificationReason, &node.UnknownAuditSuspended, &node.OfflineSuspended, &node.UnderReview, &node.ExitInitiatedAt, &node.ExitLoopCompletedAt, &node.ExitFinishedAt, &node.ExitSuccess, &node.Contained, &node.LastOfflineEmail, &node.LastSoftwareUpdateEmail, &node.NoiseProto, &node.NoisePublicKey, &node.Asn)
 	if err == sql.ErrNoRows {
 		return nil, nil
 	}
@@ -24543,6 +25340,11 @@ func (obj *pgxcockroachImpl) UpdateNoReturn_Node_By_Id(ctx context.Context,
 		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("noise_public_key = ?"))
 	}
 
+	if update.Asn._set {
+		__values = append(__values, update.Asn.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("asn = ?"))
+	}
+
 	__now := obj.db.Hooks.Now().UTC()
 
 	__values = append(__values, __now)
@@ -24751,6 +25553,11 @@ func (obj *pgxcockroachImpl) UpdateNoReturn_Node_By_Id_And_Disqualified_Is_Null_
 		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("noise_public_key = ?"))
 	}
 
+	if update.Asn._set {
+		__values = append(__values, update.Asn.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("asn = ?"))
+	}
+
 	__now := obj.db.Hooks.Now().UTC()
 
 	__values = append(__values, __now)
@@ -26611,6 +27418,26 @@ func (obj *pgxcockroachImpl) deleteAll(ctx context.Context) (count int64, err er
 		return 0, obj.makeErr(err)
 	}
 
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM node_tags;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM node_performance;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
 	__count, err = __res.RowsAffected()
 	if err != nil {
 		return 0, obj.makeErr(err)
@@ -26621,6 +27448,16 @@ func (obj *pgxcockroachImpl) deleteAll(ctx context.Context) (count int64, err er
 		return 0, obj.makeErr(err)
 	}
 
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM node_audit_coverage;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
 	__count, err = __res.RowsAffected()
 	if err != nil {
 		return 0, obj.makeErr(err)
@@ -26641,6 +27478,16 @@ func (obj *pgxcockroachImpl) deleteAll(ctx context.Context) (count int64, err er
 		return 0, obj.makeErr(err)
 	}
 
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM graceful_exit_shrinks;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
 	__count, err = __res.RowsAffected()
 	if err != nil {
 		return 0, obj.makeErr(err)
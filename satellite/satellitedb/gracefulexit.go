@@ -539,6 +539,125 @@ func (db *gracefulexitDB) CountFinishedTransferQueueItemsByNode(ctx context.Cont
 	return nodesItemsCount, Error.Wrap(rows.Err())
 }
 
+// CreateShrink creates a shrink for the node, unless the node has an
+// unfinished shrink already. It returns the current shrink of the node.
+func (db *gracefulexitDB) CreateShrink(ctx context.Context, nodeID storj.NodeID, requestedBytes int64, createdAt time.Time) (_ *gracefulexit.Shrink, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	// a finished shrink is replaced by the new one.
+	statement := db.db.Rebind(
+		`INSERT INTO graceful_exit_shrinks (node_id, requested_bytes, created_at) VALUES (?, ?, ?)
+		 ON CONFLICT(node_id)
+		 DO UPDATE SET requested_bytes = excluded.requested_bytes,
+			selected_bytes = 0,
+			bytes_transferred = 0,
+			pieces_transferred = 0,
+			pieces_failed = 0,
+			created_at = excluded.created_at,
+			selected_at = NULL,
+			finished_at = NULL
+		 WHERE graceful_exit_shrinks.finished_at IS NOT NULL`,
+	)
+	_, err = db.db.ExecContext(ctx, statement, nodeID, requestedBytes, createdAt)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	return db.GetShrink(ctx, nodeID)
+}
+
+// GetShrink gets the last shrink of the node.
+func (db *gracefulexitDB) GetShrink(ctx context.Context, nodeID storj.NodeID) (_ *gracefulexit.Shrink, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	row := db.db.QueryRowContext(ctx, db.db.Rebind(`
+		SELECT node_id, requested_bytes, selected_bytes, bytes_transferred, pieces_transferred, pieces_failed, created_at, selected_at, finished_at
+		FROM graceful_exit_shrinks
+		WHERE node_id = ?`), nodeID)
+
+	shrink, err := scanShrink(row)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, gracefulexit.ErrNodeNotFound.Wrap(err)
+	}
+	return shrink, Error.Wrap(err)
+}
+
+// GetUnselectedShrinks gets the unfinished shrinks whose pieces have not been added to the transfer queue yet.
+func (db *gracefulexitDB) GetUnselectedShrinks(ctx context.Context) (_ []*gracefulexit.Shrink, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	rows, err := db.db.QueryContext(ctx, `
+		SELECT node_id, requested_bytes, selected_bytes, bytes_transferred, pieces_transferred, pieces_failed, created_at, selected_at, finished_at
+		FROM graceful_exit_shrinks
+		WHERE selected_at IS NULL AND finished_at IS NULL`)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	defer func() { err = errs.Combine(err, Error.Wrap(rows.Close())) }()
+
+	var shrinks []*gracefulexit.Shrink
+	for rows.Next() {
+		shrink, err := scanShrink(rows)
+		if err != nil {
+			return nil, Error.Wrap(err)
+		}
+		shrinks = append(shrinks, shrink)
+	}
+	return shrinks, Error.Wrap(rows.Err())
+}
+
+// MarkShrinkSelected records that the pieces of the shrink have been added to the transfer queue.
+func (db *gracefulexitDB) MarkShrinkSelected(ctx context.Context, nodeID storj.NodeID, selectedBytes int64, selectedAt time.Time) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	_, err = db.db.ExecContext(ctx, db.db.Rebind(`
+		UPDATE graceful_exit_shrinks SET selected_bytes = ?, selected_at = ?
+		WHERE node_id = ? AND selected_at IS NULL AND finished_at IS NULL`),
+		selectedBytes, selectedAt, nodeID)
+	return Error.Wrap(err)
+}
+
+// IncrementShrinkProgress increments transfer stats of the unfinished shrink of a node.
+func (db *gracefulexitDB) IncrementShrinkProgress(ctx context.Context, nodeID storj.NodeID, bytes int64, successfulTransfers int64, failedTransfers int64) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	_, err = db.db.ExecContext(ctx, db.db.Rebind(`
+		UPDATE graceful_exit_shrinks SET
+			bytes_transferred = bytes_transferred + ?,
+			pieces_transferred = pieces_transferred + ?,
+			pieces_failed = pieces_failed + ?
+		WHERE node_id = ? AND finished_at IS NULL`),
+		bytes, successfulTransfers, failedTransfers, nodeID)
+	return Error.Wrap(err)
+}
+
+// FinishShrink marks the unfinished shrink of a node as finished.
+func (db *gracefulexitDB) FinishShrink(ctx context.Context, nodeID storj.NodeID, finishedAt time.Time) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	_, err = db.db.ExecContext(ctx, db.db.Rebind(`
+		UPDATE graceful_exit_shrinks SET finished_at = ?
+		WHERE node_id = ? AND finished_at IS NULL`),
+		finishedAt, nodeID)
+	return Error.Wrap(err)
+}
+
+// shrinkScanner is implemented by both a row and rows.
+type shrinkScanner interface {
+	Scan(dest ...interface{}) error
+}
+
+func scanShrink(row shrinkScanner) (*gracefulexit.Shrink, error) {
+	shrink := &gracefulexit.Shrink{}
+	err := row.Scan(&shrink.NodeID, &shrink.RequestedBytes, &shrink.SelectedBytes,
+		&shrink.BytesTransferred, &shrink.PiecesTransferred, &shrink.PiecesFailed,
+		&shrink.CreatedAt, &shrink.SelectedAt, &shrink.FinishedAt)
+	if err != nil {
+		return nil, err
+	}
+	return shrink, nil
+}
+
 func scanRows(rows tagsql.Rows) (transferQueueItemRows []*gracefulexit.TransferQueueItem, err error) {
 	for rows.Next() {
 		transferQueueItem := &gracefulexit.TransferQueueItem{}
@@ -2244,6 +2244,101 @@ func (db *satelliteDB) ProductionMigration() *migrate.Migration {
 					`ALTER TABLE users DROP COLUMN last_verification_reminder;`,
 				},
 			},
+			{
+				DB:          &db.migrationDB,
+				Description: "create graceful_exit_shrinks table",
+				Version:     226,
+				Action: migrate.SQL{
+					`CREATE TABLE graceful_exit_shrinks (
+						node_id bytea NOT NULL,
+						requested_bytes bigint NOT NULL,
+						selected_bytes bigint NOT NULL DEFAULT 0,
+						bytes_transferred bigint NOT NULL DEFAULT 0,
+						pieces_transferred bigint NOT NULL DEFAULT 0,
+						pieces_failed bigint NOT NULL DEFAULT 0,
+						created_at timestamp with time zone NOT NULL,
+						selected_at timestamp with time zone,
+						finished_at timestamp with time zone,
+						PRIMARY KEY ( node_id )
+					);`,
+				},
+			},
+			{
+				DB:          &db.migrationDB,
+				Description: "add asn column to nodes table",
+				Version:     227,
+				Action: migrate.SQL{
+					`ALTER TABLE nodes ADD COLUMN asn bigint;`,
+				},
+			},
+			{
+				DB:          &db.migrationDB,
+				Description: "add node_tags table",
+				Version:     228,
+				Action: migrate.SQL{
+					`CREATE TABLE node_tags (
+						node_id bytea NOT NULL,
+						name text NOT NULL,
+						value text NOT NULL,
+						signed_at timestamp with time zone NOT NULL,
+						signer bytea NOT NULL,
+						PRIMARY KEY ( node_id, name, signer )
+					);`,
+				},
+			},
+			{
+				DB:          &db.migrationDB,
+				Description: "add repair priority factors to repair_queue",
+				Version:     229,
+				SeparateTx:  true,
+				// The priority of the queued segments isn't backfilled, the checker
+				// overwrites it when it inserts the segments again. The statements
+				// run outside of the migration transaction, so that the index is
+				// built without blocking the repair queue.
+				Action: migrate.Func(func(ctx context.Context, log *zap.Logger, db tagsql.DB, tx tagsql.Tx) error {
+					for _, statement := range []string{
+						`ALTER TABLE repair_queue ADD COLUMN IF NOT EXISTS node_risk double precision NOT NULL DEFAULT 1;`,
+						`ALTER TABLE repair_queue ADD COLUMN IF NOT EXISTS tier double precision NOT NULL DEFAULT 1;`,
+						`ALTER TABLE repair_queue ADD COLUMN IF NOT EXISTS segment_created_at timestamp with time zone;`,
+						`ALTER TABLE repair_queue ADD COLUMN IF NOT EXISTS priority double precision NOT NULL DEFAULT 1;`,
+						`CREATE INDEX CONCURRENTLY IF NOT EXISTS repair_queue_priority_attempted_at_index ON repair_queue ( priority, attempted_at );`,
+						`DROP INDEX IF EXISTS repair_queue_num_healthy_pieces_attempted_at_index;`,
+					} {
+						if _, err := db.Exec(ctx, statement); err != nil {
+							return ErrMigrate.Wrap(err)
+						}
+					}
+					return nil
+				}),
+			},
+			{
+				DB:          &db.migrationDB,
+				Description: "add node_audit_coverage table",
+				Version:     230,
+				Action: migrate.SQL{
+					`CREATE TABLE node_audit_coverage (
+						node_id bytea NOT NULL,
+						last_audited_at timestamp with time zone NOT NULL,
+						PRIMARY KEY ( node_id )
+					);`,
+				},
+			},
+			{
+				DB:          &db.migrationDB,
+				Description: "add node_performance table",
+				Version:     231,
+				Action: migrate.SQL{
+					`CREATE TABLE node_performance (
+						node_id bytea NOT NULL,
+						updated_at timestamp with time zone NOT NULL,
+						attempts double precision NOT NULL,
+						successes double precision NOT NULL,
+						latencies double precision NOT NULL,
+						latency double precision NOT NULL,
+						PRIMARY KEY ( node_id )
+					);`,
+				},
+			},
 			// NB: after updating testdata in `testdata`, run
 			//     `go generate` to update `migratez.go`.
 		},
@@ -0,0 +1,86 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package satellitedb
+
+import (
+	"context"
+	"time"
+
+	"github.com/zeebo/errs"
+
+	"storj.io/common/storj"
+	"storj.io/private/dbutil/pgutil"
+	"storj.io/storj/satellite/overlay"
+)
+
+// UpdateNodePerformance decays the stored statistics of the nodes to the
+// update time of the given statistics, and adds the given statistics. A node
+// may only appear once in the given statistics.
+func (cache *overlaycache) UpdateNodePerformance(ctx context.Context, stats []overlay.NodeTransferStats, halfLife time.Duration) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if len(stats) == 0 {
+		return nil
+	}
+
+	var (
+		nodeIDs   = make([]storj.NodeID, len(stats))
+		updatedAt = make([]time.Time, len(stats))
+		attempts  = make([]float64, len(stats))
+		successes = make([]float64, len(stats))
+		latencies = make([]float64, len(stats))
+		latency   = make([]float64, len(stats))
+	)
+	for i, nodeStats := range stats {
+		nodeIDs[i] = nodeStats.NodeID
+		updatedAt[i] = nodeStats.UpdatedAt.UTC()
+		attempts[i] = nodeStats.Attempts
+		successes[i] = nodeStats.Successes
+		latencies[i] = nodeStats.Latencies
+		latency[i] = nodeStats.Latency
+	}
+
+	// the decay factor of the stored statistics is 0.5^(elapsed/halfLife).
+	_, err = cache.db.ExecContext(ctx, `
+		INSERT INTO node_performance (node_id, updated_at, attempts, successes, latencies, latency)
+		SELECT unnest($1::bytea[]), unnest($2::timestamptz[]), unnest($3::float8[]), unnest($4::float8[]), unnest($5::float8[]), unnest($6::float8[])
+		ON CONFLICT (node_id) DO UPDATE SET
+			attempts = node_performance.attempts * power(0.5, GREATEST(EXTRACT(EPOCH FROM EXCLUDED.updated_at - node_performance.updated_at)::float8, 0) / $7::float8) + EXCLUDED.attempts,
+			successes = node_performance.successes * power(0.5, GREATEST(EXTRACT(EPOCH FROM EXCLUDED.updated_at - node_performance.updated_at)::float8, 0) / $7::float8) + EXCLUDED.successes,
+			latencies = node_performance.latencies * power(0.5, GREATEST(EXTRACT(EPOCH FROM EXCLUDED.updated_at - node_performance.updated_at)::float8, 0) / $7::float8) + EXCLUDED.latencies,
+			latency = node_performance.latency * power(0.5, GREATEST(EXTRACT(EPOCH FROM EXCLUDED.updated_at - node_performance.updated_at)::float8, 0) / $7::float8) + EXCLUDED.latency,
+			updated_at = GREATEST(node_performance.updated_at, EXCLUDED.updated_at)
+	`, pgutil.NodeIDArray(nodeIDs), pgutil.TimestampTZArray(updatedAt),
+		pgutil.Float8Array(attempts), pgutil.Float8Array(successes),
+		pgutil.Float8Array(latencies), pgutil.Float8Array(latency),
+		halfLife.Seconds())
+	return Error.Wrap(err)
+}
+
+// GetNodePerformance returns the stored statistics of the nodes.
+func (cache *overlaycache) GetNodePerformance(ctx context.Context) (_ []overlay.NodeTransferStats, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	rows, err := cache.db.Query(ctx, `
+		SELECT node_id, updated_at, attempts, successes, latencies, latency
+		FROM node_performance
+	`)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	defer func() { err = errs.Combine(err, rows.Close()) }()
+
+	var stats []overlay.NodeTransferStats
+	for rows.Next() {
+		var nodeStats overlay.NodeTransferStats
+		err := rows.Scan(&nodeStats.NodeID, &nodeStats.UpdatedAt,
+			&nodeStats.Attempts, &nodeStats.Successes,
+			&nodeStats.Latencies, &nodeStats.Latency)
+		if err != nil {
+			return nil, Error.Wrap(err)
+		}
+		stats = append(stats, nodeStats)
+	}
+	return stats, Error.Wrap(rows.Err())
+}
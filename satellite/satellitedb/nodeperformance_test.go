@@ -0,0 +1,66 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package satellitedb_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"storj.io/common/storj"
+	"storj.io/common/testcontext"
+	"storj.io/common/testrand"
+	"storj.io/storj/satellite"
+	"storj.io/storj/satellite/overlay"
+	"storj.io/storj/satellite/satellitedb/satellitedbtest"
+)
+
+func TestNodePerformance(t *testing.T) {
+	satellitedbtest.Run(t, func(ctx *testcontext.Context, t *testing.T, db satellite.DB) {
+		cache := db.OverlayCache()
+
+		now := time.Now().Truncate(time.Microsecond).UTC()
+		halfLife := time.Hour
+		first, second := testrand.NodeID(), testrand.NodeID()
+
+		stats, err := cache.GetNodePerformance(ctx)
+		require.NoError(t, err)
+		require.Empty(t, stats)
+
+		require.NoError(t, cache.UpdateNodePerformance(ctx, []overlay.NodeTransferStats{
+			{NodeID: first, UpdatedAt: now, Attempts: 4, Successes: 2, Latencies: 2, Latency: 1},
+		}, halfLife))
+
+		// the stored statistics are decayed before adding the new ones
+		require.NoError(t, cache.UpdateNodePerformance(ctx, []overlay.NodeTransferStats{
+			{NodeID: first, UpdatedAt: now.Add(halfLife), Attempts: 1, Successes: 1},
+			{NodeID: second, UpdatedAt: now.Add(halfLife), Attempts: 3},
+		}, halfLife))
+
+		// statistics older than the stored ones aren't moving the update time back
+		require.NoError(t, cache.UpdateNodePerformance(ctx, []overlay.NodeTransferStats{
+			{NodeID: second, UpdatedAt: now, Attempts: 1},
+		}, halfLife))
+
+		stats, err = cache.GetNodePerformance(ctx)
+		require.NoError(t, err)
+		require.Len(t, stats, 2)
+
+		byID := map[storj.NodeID]overlay.NodeTransferStats{}
+		for _, nodeStats := range stats {
+			byID[nodeStats.NodeID] = nodeStats
+		}
+
+		require.WithinDuration(t, now.Add(halfLife), byID[first].UpdatedAt, time.Second)
+		require.InDelta(t, 3, byID[first].Attempts, 1e-6)
+		require.InDelta(t, 2, byID[first].Successes, 1e-6)
+		require.InDelta(t, 1, byID[first].Latencies, 1e-6)
+		require.InDelta(t, 0.5, byID[first].Latency, 1e-6)
+
+		require.WithinDuration(t, now.Add(halfLife), byID[second].UpdatedAt, time.Second)
+		require.InDelta(t, 4, byID[second].Attempts, 1e-6)
+		require.Zero(t, byID[second].Successes)
+	})
+}
@@ -12,6 +12,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/blang/semver"
 	"github.com/spacemonkeygo/monkit/v3"
 	"github.com/zeebo/errs"
 	"go.uber.org/zap"
@@ -23,6 +24,7 @@ import (
 	"storj.io/private/dbutil/pgutil"
 	"storj.io/private/tagsql"
 	"storj.io/private/version"
+	"storj.io/storj/satellite/nodeselection/uploadselection"
 	"storj.io/storj/satellite/overlay"
 	"storj.io/storj/satellite/satellitedb/dbx"
 )
@@ -57,7 +59,8 @@ func (cache *overlaycache) selectAllStorageNodesUpload(ctx context.Context, sele
 	defer mon.Task()(&ctx)(&err)
 
 	query := `
-		SELECT id, address, last_net, last_ip_port, vetted_at, country_code, noise_proto, noise_public_key
+		SELECT id, address, last_net, last_ip_port, vetted_at, country_code, noise_proto, noise_public_key,
+			email, wallet, asn, major, minor, patch
 			FROM nodes
 			` + cache.db.impl.AsOfSystemInterval(selectionCfg.AsOfSystemTime.Interval()) + `
 			WHERE disqualified IS NULL
@@ -102,13 +105,20 @@ func (cache *overlaycache) selectAllStorageNodesUpload(ctx context.Context, sele
 		var lastIPPort sql.NullString
 		var vettedAt *time.Time
 		var noise noiseScanner
-		err = rows.Scan(&node.ID, &node.Address.Address, &node.LastNet, &lastIPPort, &vettedAt, &node.CountryCode, &noise.Proto, &noise.PublicKey)
+		var asn sql.NullInt64
+		var major, minor, patch int64
+		err = rows.Scan(&node.ID, &node.Address.Address, &node.LastNet, &lastIPPort, &vettedAt, &node.CountryCode, &noise.Proto, &noise.PublicKey,
+			&node.Email, &node.Wallet, &asn, &major, &minor, &patch)
 		if err != nil {
 			return nil, nil, err
 		}
+		node.Version = nodeVersion(major, minor, patch)
 		if lastIPPort.Valid {
 			node.LastIPPort = lastIPPort.String
 		}
+		if asn.Valid {
+			node.ASN = uint32(asn.Int64)
+		}
 		node.Address.NoiseInfo = noise.Convert()
 
 		if vettedAt == nil {
@@ -117,8 +127,19 @@ func (cache *overlaycache) selectAllStorageNodesUpload(ctx context.Context, sele
 		}
 		reputableNodes = append(reputableNodes, &node)
 	}
+	if err := rows.Err(); err != nil {
+		return nil, nil, Error.Wrap(err)
+	}
+
+	asOf := cache.db.impl.AsOfSystemInterval(selectionCfg.AsOfSystemTime.Interval())
+	if err := cache.attachNodeTags(ctx, asOf, reputableNodes, nil); err != nil {
+		return nil, nil, err
+	}
+	if err := cache.attachNodeTags(ctx, asOf, newNodes, nil); err != nil {
+		return nil, nil, err
+	}
 
-	return reputableNodes, newNodes, Error.Wrap(rows.Err())
+	return reputableNodes, newNodes, nil
 }
 
 // SelectAllStorageNodesDownload returns all nodes that qualify to store data, organized as reputable nodes and new nodes.
@@ -654,6 +675,33 @@ func (cache *overlaycache) Reliable(ctx context.Context, criteria *overlay.NodeC
 }
 
 func (cache *overlaycache) reliable(ctx context.Context, criteria *overlay.NodeCriteria) (nodes storj.NodeIDList, err error) {
+	reliable, err := cache.reliableNodes(ctx, criteria)
+	if err != nil {
+		return nil, err
+	}
+	for _, node := range reliable {
+		nodes = append(nodes, node.ID)
+	}
+	return nodes, nil
+}
+
+// ReliableNodes returns all nodes that are reliable, with their failure domain attributes.
+func (cache *overlaycache) ReliableNodes(ctx context.Context, criteria *overlay.NodeCriteria) (nodes []*overlay.SelectedNode, err error) {
+	for {
+		nodes, err = cache.reliableNodes(ctx, criteria)
+		if err != nil {
+			if cockroachutil.NeedsRetry(err) {
+				continue
+			}
+			return nodes, err
+		}
+		break
+	}
+
+	return nodes, err
+}
+
+func (cache *overlaycache) reliableNodes(ctx context.Context, criteria *overlay.NodeCriteria) (nodes []*overlay.SelectedNode, err error) {
 	args := []interface{}{
 		time.Now().Add(-criteria.OnlineWindow),
 	}
@@ -668,7 +716,8 @@ func (cache *overlaycache) reliable(ctx context.Context, criteria *overlay.NodeC
 
 	// get reliable and online nodes
 	rows, err := cache.db.Query(ctx, cache.db.Rebind(`
-		SELECT id
+		SELECT id, email, wallet, asn, country_code, major, minor, patch,
+			`+reliabilityColumn+`
 		FROM nodes
 		`+cache.db.impl.AsOfSystemInterval(criteria.AsOfSystemInterval)+`
 		WHERE disqualified IS NULL
@@ -685,13 +734,84 @@ func (cache *overlaycache) reliable(ctx context.Context, criteria *overlay.NodeC
 		err = errs.Combine(err, rows.Close())
 	}()
 
+	nodes, err = scanReliableNodes(rows)
+	if err != nil {
+		return nil, err
+	}
+	return nodes, cache.attachNodeTags(ctx, cache.db.impl.AsOfSystemInterval(criteria.AsOfSystemInterval), nodes, nil)
+}
+
+// KnownReliableNodes filters a set of nodes to reliable (online and qualified) nodes, with their placement attributes.
+func (cache *overlaycache) KnownReliableNodes(ctx context.Context, onlineWindow time.Duration, nodeIDs storj.NodeIDList) (nodes []*overlay.SelectedNode, err error) {
+	for {
+		nodes, err = cache.knownReliableNodes(ctx, onlineWindow, nodeIDs)
+		if err != nil {
+			if cockroachutil.NeedsRetry(err) {
+				continue
+			}
+			return nodes, err
+		}
+		break
+	}
+
+	return nodes, err
+}
+
+func (cache *overlaycache) knownReliableNodes(ctx context.Context, onlineWindow time.Duration, nodeIDs storj.NodeIDList) (nodes []*overlay.SelectedNode, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if len(nodeIDs) == 0 {
+		return nil, Error.New("no ids provided")
+	}
+
+	rows, err := cache.db.Query(ctx, cache.db.Rebind(`
+		SELECT id, email, wallet, asn, country_code, major, minor, patch,
+			`+reliabilityColumn+`
+			FROM nodes
+			WHERE id = any($1::bytea[])
+			AND disqualified IS NULL
+			AND unknown_audit_suspended IS NULL
+			AND offline_suspended IS NULL
+			AND exit_finished_at IS NULL
+			AND last_contact_success > $2
+		`), pgutil.NodeIDArray(nodeIDs), time.Now().Add(-onlineWindow),
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { err = errs.Combine(err, rows.Close()) }()
+
+	nodes, err = scanReliableNodes(rows)
+	if err != nil {
+		return nil, err
+	}
+	return nodes, cache.attachNodeTags(ctx, "", nodes, nodeIDs)
+}
+
+// reliabilityColumn selects the reliability of the node from its reputation,
+// which is the product of its online score and its audit score. Nodes without
+// a reputation are considered reliable.
+const reliabilityColumn = `COALESCE((
+	SELECT online_score * audit_reputation_alpha / NULLIF(audit_reputation_alpha + audit_reputation_beta, 0)
+	FROM reputations WHERE reputations.id = nodes.id
+), 1)`
+
+// scanReliableNodes scans the id, email, wallet, asn, country_code, major,
+// minor, patch and reliability columns of the rows into selected nodes.
+func scanReliableNodes(rows tagsql.Rows) (nodes []*overlay.SelectedNode, err error) {
 	for rows.Next() {
-		var id storj.NodeID
-		err = rows.Scan(&id)
+		var node overlay.SelectedNode
+		var asn sql.NullInt64
+		var major, minor, patch int64
+		err = rows.Scan(&node.ID, &node.Email, &node.Wallet, &asn, &node.CountryCode, &major, &minor, &patch, &node.Reliability)
 		if err != nil {
 			return nil, err
 		}
-		nodes = append(nodes, id)
+		node.Version = nodeVersion(major, minor, patch)
+		if asn.Valid {
+			node.ASN = uint32(asn.Int64)
+		}
+		nodes = append(nodes, &node)
 	}
 	return nodes, Error.Wrap(rows.Err())
 }
@@ -1153,6 +1273,9 @@ func convertDBNode(ctx context.Context, info *dbx.Node) (_ *overlay.NodeDossier,
 	if info.CountryCode != nil {
 		node.CountryCode = location.ToCountryCode(*info.CountryCode)
 	}
+	if info.Asn != nil {
+		node.ASN = uint32(*info.Asn)
+	}
 	if info.Contained != nil {
 		node.Contained = true
 	}
@@ -1160,6 +1283,22 @@ func convertDBNode(ctx context.Context, info *dbx.Node) (_ *overlay.NodeDossier,
 	return node, nil
 }
 
+// asnValue converts the autonomous system number to its column value, the
+// unknown number 0 is stored as NULL.
+func asnValue(asn uint32) sql.NullInt64 {
+	return sql.NullInt64{Int64: int64(asn), Valid: asn != 0}
+}
+
+// nodeVersion returns the software version stored in the major, minor and
+// patch columns of a node.
+func nodeVersion(major, minor, patch int64) version.SemVer {
+	return version.SemVer{Version: semver.Version{
+		Major: uint64(major),
+		Minor: uint64(minor),
+		Patch: uint64(patch),
+	}}
+}
+
 // encodeWalletFeatures encodes wallet features into comma separated list string.
 func encodeWalletFeatures(features []string) (string, error) {
 	var errGroup errs.Group
@@ -1323,6 +1462,7 @@ func (cache *overlaycache) updateCheckInDirectUpdate(ctx context.Context, node o
 			country_code=$18,
 			noise_proto=$21,
 			noise_public_key=$22,
+			asn=$23,
 			last_software_update_email = CASE
 				WHEN $19::bool IS TRUE THEN $15::timestamptz
 				WHEN $20::bool IS FALSE THEN NULL
@@ -1353,6 +1493,8 @@ func (cache *overlaycache) updateCheckInDirectUpdate(ctx context.Context, node o
 		node.SoftwareUpdateEmailSent, node.VersionBelowMin,
 		// args $21 - $22
 		noiseProto, noisePublicKey,
+		// args $23
+		asnValue(node.ASN),
 	)
 
 	if err != nil {
@@ -1410,7 +1552,7 @@ func (cache *overlaycache) UpdateCheckIn(ctx context.Context, node overlay.NodeC
 				last_contact_failure,
 				major, minor, patch, hash, timestamp, release,
 				last_ip_port, wallet_features, country_code,
-				noise_proto, noise_public_key
+				noise_proto, noise_public_key, asn
 			)
 			VALUES (
 				$1, $2, $3, $4, $5,
@@ -1423,7 +1565,7 @@ func (cache *overlaycache) UpdateCheckIn(ctx context.Context, node overlay.NodeC
 				END,
 				$10, $11, $12, $13, $14, $15,
 				$17, $18, $19,
-				$22, $23
+				$22, $23, $24
 			)
 			ON CONFLICT (id)
 			DO UPDATE
@@ -1448,6 +1590,7 @@ func (cache *overlaycache) UpdateCheckIn(ctx context.Context, node overlay.NodeC
 				country_code=$19,
 				noise_proto=$22,
 				noise_public_key=$23,
+				asn=$24,
 				last_software_update_email = CASE
 					WHEN $20::bool IS TRUE THEN $16::timestamptz
 					WHEN $21::bool IS FALSE THEN NULL
@@ -1474,6 +1617,8 @@ func (cache *overlaycache) UpdateCheckIn(ctx context.Context, node overlay.NodeC
 		node.SoftwareUpdateEmailSent, node.VersionBelowMin,
 		// args $22 - $23
 		noiseProto, noisePublicKey,
+		// args $24
+		asnValue(node.ASN),
 	)
 	if err != nil {
 		return Error.Wrap(err)
@@ -1681,3 +1826,83 @@ func (n *noiseScanner) Convert() *pb.NoiseInfo {
 		PublicKey: n.PublicKey,
 	}
 }
+
+// UpdateNodeTags replaces the tags of the node.
+func (cache *overlaycache) UpdateNodeTags(ctx context.Context, nodeID storj.NodeID, tags uploadselection.NodeTags) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	return cache.db.WithTx(ctx, func(ctx context.Context, tx *dbx.Tx) error {
+		_, err := tx.Tx.ExecContext(ctx, `DELETE FROM node_tags WHERE node_id = $1`, nodeID)
+		if err != nil {
+			return Error.Wrap(err)
+		}
+		for _, tag := range tags {
+			_, err := tx.Tx.ExecContext(ctx, `
+				INSERT INTO node_tags (node_id, name, value, signed_at, signer)
+				VALUES ($1, $2, $3, $4, $5)
+				ON CONFLICT (node_id, name, signer) DO UPDATE
+				SET value = EXCLUDED.value, signed_at = EXCLUDED.signed_at
+			`, nodeID, tag.Name, tag.Value, tag.SignedAt, tag.Signer)
+			if err != nil {
+				return Error.Wrap(err)
+			}
+		}
+		return nil
+	})
+}
+
+// GetNodeTags returns the tags of the node.
+func (cache *overlaycache) GetNodeTags(ctx context.Context, nodeID storj.NodeID) (_ uploadselection.NodeTags, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	tags, err := cache.getNodeTags(ctx, "", storj.NodeIDList{nodeID})
+	if err != nil {
+		return nil, err
+	}
+	return tags[nodeID], nil
+}
+
+// getNodeTags returns the tags of the nodes, or the tags of all nodes when
+// nodeIDs is nil.
+func (cache *overlaycache) getNodeTags(ctx context.Context, asOfSystemInterval string, nodeIDs storj.NodeIDList) (_ map[storj.NodeID]uploadselection.NodeTags, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	query := `
+		SELECT node_id, name, value, signed_at, signer
+		FROM node_tags
+		` + asOfSystemInterval
+	var args []interface{}
+	if nodeIDs != nil {
+		query += ` WHERE node_id = any($1::bytea[])`
+		args = append(args, pgutil.NodeIDArray(nodeIDs))
+	}
+	query += ` ORDER BY node_id, name, signer`
+
+	rows, err := cache.db.Query(ctx, query, args...)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	defer func() { err = errs.Combine(err, rows.Close()) }()
+
+	tags := make(map[storj.NodeID]uploadselection.NodeTags)
+	for rows.Next() {
+		var tag uploadselection.NodeTag
+		if err := rows.Scan(&tag.NodeID, &tag.Name, &tag.Value, &tag.SignedAt, &tag.Signer); err != nil {
+			return nil, Error.Wrap(err)
+		}
+		tags[tag.NodeID] = append(tags[tag.NodeID], tag)
+	}
+	return tags, Error.Wrap(rows.Err())
+}
+
+// attachNodeTags loads the tags of the nodes into the nodes.
+func (cache *overlaycache) attachNodeTags(ctx context.Context, asOfSystemInterval string, nodes []*overlay.SelectedNode, nodeIDs storj.NodeIDList) error {
+	tags, err := cache.getNodeTags(ctx, asOfSystemInterval, nodeIDs)
+	if err != nil {
+		return err
+	}
+	for _, node := range nodes {
+		node.Tags = tags[node.ID]
+	}
+	return nil
+}
@@ -41,14 +41,17 @@ func (r *repairQueue) Insert(ctx context.Context, seg *queue.InjuredSegment) (al
 		query = `
 			INSERT INTO repair_queue
 			(
-				stream_id, position, segment_health
+				stream_id, position, segment_health,
+				node_risk, tier, segment_created_at, priority
 			)
 			VALUES (
-				$1, $2, $3
+				$1, $2, $3,
+				$4, $5, $6, $7
 			)
 			ON CONFLICT (stream_id, position)
 			DO UPDATE
-			SET segment_health=$3, updated_at=current_timestamp
+			SET segment_health=$3, node_risk=$4, tier=$5, segment_created_at=$6, priority=$7,
+				updated_at=current_timestamp
 			RETURNING (xmax != 0) AS alreadyInserted
 		`
 	case dbutil.Cockroach:
@@ -60,18 +63,23 @@ func (r *repairQueue) Insert(ctx context.Context, seg *queue.InjuredSegment) (al
 			)
 			INSERT INTO repair_queue
 			(
-				stream_id, position, segment_health
+				stream_id, position, segment_health,
+				node_risk, tier, segment_created_at, priority
 			)
 			VALUES (
-				$1, $2, $3
+				$1, $2, $3,
+				$4, $5, $6, $7
 			)
 			ON CONFLICT (stream_id, position)
 			DO UPDATE
-			SET segment_health=$3, updated_at=current_timestamp
+			SET segment_health=$3, node_risk=$4, tier=$5, segment_created_at=$6, priority=$7,
+				updated_at=current_timestamp
 			RETURNING (SELECT alreadyInserted FROM inserted)
 		`
 	}
-	rows, err := r.db.QueryContext(ctx, query, seg.StreamID, seg.Position.Encode(), seg.SegmentHealth)
+	nodeRisk, tier, priority := priorityFactors(seg)
+	rows, err := r.db.QueryContext(ctx, query, seg.StreamID, seg.Position.Encode(), seg.SegmentHealth,
+		nodeRisk, tier, seg.SegmentCreatedAt, priority)
 	if err != nil {
 		return false, err
 	}
@@ -109,16 +117,27 @@ func (r *repairQueue) InsertBatch(
 		query = `
 			INSERT INTO repair_queue
 			(
-				stream_id, position, segment_health
+				stream_id, position, segment_health,
+				node_risk, tier, segment_created_at, priority
 			)
 			VALUES (
 				UNNEST($1::BYTEA[]),
 				UNNEST($2::INT8[]),
-				UNNEST($3::double precision[])
+				UNNEST($3::double precision[]),
+				UNNEST($4::double precision[]),
+				UNNEST($5::double precision[]),
+				UNNEST($6::timestamptz[]),
+				UNNEST($7::double precision[])
 			)
 			ON CONFLICT (stream_id, position)
 			DO UPDATE
-			SET segment_health=EXCLUDED.segment_health, updated_at=current_timestamp
+			SET
+				segment_health=EXCLUDED.segment_health,
+				node_risk=EXCLUDED.node_risk,
+				tier=EXCLUDED.tier,
+				segment_created_at=EXCLUDED.segment_created_at,
+				priority=EXCLUDED.priority,
+				updated_at=current_timestamp
 			RETURNING NOT(xmax != 0) AS newlyInserted
 		`
 	case dbutil.Cockroach:
@@ -128,18 +147,29 @@ func (r *repairQueue) InsertBatch(
 				SELECT
 					UNNEST($1::BYTEA[]) AS stream_id,
 					UNNEST($2::INT8[]) AS position,
-					UNNEST($3::double precision[]) AS segment_health
+					UNNEST($3::double precision[]) AS segment_health,
+					UNNEST($4::double precision[]) AS node_risk,
+					UNNEST($5::double precision[]) AS tier,
+					UNNEST($6::timestamptz[]) AS segment_created_at,
+					UNNEST($7::double precision[]) AS priority
 			),
 			do_insert AS (
 				INSERT INTO repair_queue (
-					stream_id, position, segment_health
+					stream_id, position, segment_health,
+					node_risk, tier, segment_created_at, priority
 				)
-				SELECT stream_id, position, segment_health
+				SELECT
+					stream_id, position, segment_health,
+					node_risk, tier, segment_created_at, priority
 				FROM to_insert
 				ON CONFLICT (stream_id, position)
 				DO UPDATE
 				SET
 					segment_health=EXCLUDED.segment_health,
+					node_risk=EXCLUDED.node_risk,
+					tier=EXCLUDED.tier,
+					segment_created_at=EXCLUDED.segment_created_at,
+					priority=EXCLUDED.priority,
 					updated_at=current_timestamp
 				RETURNING false
 			)
@@ -156,12 +186,22 @@ func (r *repairQueue) InsertBatch(
 		StreamIDs      []uuid.UUID
 		Positions      []int64
 		SegmentHealths []float64
+		NodeRisks      []float64
+		Tiers          []float64
+		CreatedAts     []*time.Time
+		Priorities     []float64
 	}
 
 	for _, segment := range segments {
 		insertData.StreamIDs = append(insertData.StreamIDs, segment.StreamID)
 		insertData.Positions = append(insertData.Positions, int64(segment.Position.Encode()))
 		insertData.SegmentHealths = append(insertData.SegmentHealths, segment.SegmentHealth)
+
+		nodeRisk, tier, priority := priorityFactors(segment)
+		insertData.NodeRisks = append(insertData.NodeRisks, nodeRisk)
+		insertData.Tiers = append(insertData.Tiers, tier)
+		insertData.CreatedAts = append(insertData.CreatedAts, segment.SegmentCreatedAt)
+		insertData.Priorities = append(insertData.Priorities, priority)
 	}
 
 	rows, err := r.db.QueryContext(
@@ -169,6 +209,10 @@ func (r *repairQueue) InsertBatch(
 		pgutil.UUIDArray(insertData.StreamIDs),
 		pgutil.Int8Array(insertData.Positions),
 		pgutil.Float8Array(insertData.SegmentHealths),
+		pgutil.Float8Array(insertData.NodeRisks),
+		pgutil.Float8Array(insertData.Tiers),
+		pgutil.NullTimestampTZArray(insertData.CreatedAts),
+		pgutil.Float8Array(insertData.Priorities),
 	)
 
 	if err != nil {
@@ -203,20 +247,24 @@ func (r *repairQueue) Select(ctx context.Context) (seg *queue.InjuredSegment, er
 		err = r.db.QueryRowContext(ctx, `
 				UPDATE repair_queue SET attempted_at = now()
 				WHERE attempted_at IS NULL OR attempted_at < now() - interval '6 hours'
-				ORDER BY segment_health ASC, attempted_at NULLS FIRST
+				ORDER BY priority ASC, attempted_at NULLS FIRST
 				LIMIT 1
-				RETURNING stream_id, position, attempted_at, updated_at, inserted_at, segment_health
+				RETURNING stream_id, position, attempted_at, updated_at, inserted_at, segment_health,
+					node_risk, tier, segment_created_at, priority
 		`).Scan(&segment.StreamID, &segment.Position, &segment.AttemptedAt,
-			&segment.UpdatedAt, &segment.InsertedAt, &segment.SegmentHealth)
+			&segment.UpdatedAt, &segment.InsertedAt, &segment.SegmentHealth,
+			&segment.NodeRisk, &segment.Tier, &segment.SegmentCreatedAt, &segment.Priority)
 	case dbutil.Postgres:
 		err = r.db.QueryRowContext(ctx, `
 				UPDATE repair_queue SET attempted_at = now() WHERE (stream_id, position) = (
 					SELECT stream_id, position FROM repair_queue
 					WHERE attempted_at IS NULL OR attempted_at < now() - interval '6 hours'
-					ORDER BY segment_health ASC, attempted_at NULLS FIRST FOR UPDATE SKIP LOCKED LIMIT 1
-				) RETURNING stream_id, position, attempted_at, updated_at, inserted_at, segment_health
+					ORDER BY priority ASC, attempted_at NULLS FIRST FOR UPDATE SKIP LOCKED LIMIT 1
+				) RETURNING stream_id, position, attempted_at, updated_at, inserted_at, segment_health,
+					node_risk, tier, segment_created_at, priority
 		`).Scan(&segment.StreamID, &segment.Position, &segment.AttemptedAt,
-			&segment.UpdatedAt, &segment.InsertedAt, &segment.SegmentHealth)
+			&segment.UpdatedAt, &segment.InsertedAt, &segment.SegmentHealth,
+			&segment.NodeRisk, &segment.Tier, &segment.SegmentCreatedAt, &segment.Priority)
 	default:
 		return seg, errs.New("unhandled database: %v", r.db.impl)
 	}
@@ -248,7 +296,8 @@ func (r *repairQueue) SelectN(ctx context.Context, limit int) (segs []queue.Inju
 	}
 	// TODO: strictly enforce order-by or change tests
 	rows, err := r.db.QueryContext(ctx,
-		r.db.Rebind(`SELECT stream_id, position, attempted_at, updated_at, segment_health
+		r.db.Rebind(`SELECT stream_id, position, attempted_at, updated_at, segment_health,
+						node_risk, tier, segment_created_at, priority
 					FROM repair_queue LIMIT ?`), limit,
 	)
 	if err != nil {
@@ -259,7 +308,8 @@ func (r *repairQueue) SelectN(ctx context.Context, limit int) (segs []queue.Inju
 	for rows.Next() {
 		var seg queue.InjuredSegment
 		err = rows.Scan(&seg.StreamID, &seg.Position, &seg.AttemptedAt,
-			&seg.UpdatedAt, &seg.SegmentHealth)
+			&seg.UpdatedAt, &seg.SegmentHealth,
+			&seg.NodeRisk, &seg.Tier, &seg.SegmentCreatedAt, &seg.Priority)
 		if err != nil {
 			return segs, Error.Wrap(err)
 		}
@@ -269,6 +319,59 @@ func (r *repairQueue) SelectN(ctx context.Context, limit int) (segs []queue.Inju
 	return segs, Error.Wrap(rows.Err())
 }
 
+func (r *repairQueue) List(ctx context.Context, cursor queue.ListCursor, limit int) (segs []queue.InjuredSegment, more bool, err error) {
+	defer mon.Task()(&ctx)(&err)
+	if limit <= 0 || limit > RepairQueueSelectLimit {
+		limit = RepairQueueSelectLimit
+	}
+
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT stream_id, position, attempted_at, updated_at, inserted_at, segment_health,
+			node_risk, tier, segment_created_at, priority
+		FROM repair_queue
+		WHERE (stream_id, position) > ($1, $2)
+		ORDER BY stream_id, position
+		LIMIT $3
+	`, cursor.StreamID, cursor.Position.Encode(), limit+1)
+	if err != nil {
+		return nil, false, Error.Wrap(err)
+	}
+	defer func() { err = errs.Combine(err, rows.Close()) }()
+
+	for rows.Next() {
+		var seg queue.InjuredSegment
+		err = rows.Scan(&seg.StreamID, &seg.Position, &seg.AttemptedAt,
+			&seg.UpdatedAt, &seg.InsertedAt, &seg.SegmentHealth,
+			&seg.NodeRisk, &seg.Tier, &seg.SegmentCreatedAt, &seg.Priority)
+		if err != nil {
+			return nil, false, Error.Wrap(err)
+		}
+		segs = append(segs, seg)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, false, Error.Wrap(err)
+	}
+
+	if len(segs) > limit {
+		return segs[:limit], true, nil
+	}
+	return segs, false, nil
+}
+
+func (r *repairQueue) UpdatePriority(ctx context.Context, streamID uuid.UUID, position metabase.SegmentPosition, priority float64) (updated bool, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	res, err := r.db.ExecContext(ctx, `
+		UPDATE repair_queue SET priority = $3
+		WHERE stream_id = $1 AND position = $2
+	`, streamID, position.Encode(), priority)
+	if err != nil {
+		return false, Error.Wrap(err)
+	}
+	count, err := res.RowsAffected()
+	return count > 0, Error.Wrap(err)
+}
+
 func (r *repairQueue) Count(ctx context.Context) (count int, err error) {
 	defer mon.Task()(&ctx)(&err)
 
@@ -293,3 +396,20 @@ func (r *repairQueue) TestingSetAttemptedTime(ctx context.Context, streamID uuid
 	count, err := res.RowsAffected()
 	return count, Error.Wrap(err)
 }
+
+// priorityFactors returns the priority factors of the segment which are
+// stored in the repair queue. Segments inserted without a priority are
+// prioritized by their health.
+func priorityFactors(seg *queue.InjuredSegment) (nodeRisk, tier, priority float64) {
+	nodeRisk, tier, priority = seg.NodeRisk, seg.Tier, seg.Priority
+	if nodeRisk <= 0 {
+		nodeRisk = 1
+	}
+	if tier <= 0 {
+		tier = 1
+	}
+	if priority == 0 {
+		priority = seg.SegmentHealth
+	}
+	return nodeRisk, tier, priority
+}
@@ -68,6 +68,49 @@ func (vq *verifyQueue) Push(ctx context.Context, segments []audit.Segment, maxBa
 	return nil
 }
 
+func (vq *verifyQueue) PushOverdue(ctx context.Context, segments []audit.OverdueSegment, maxBatchSize int) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	streamIDSlice := make([]uuid.UUID, maxBatchSize)
+	positionSlice := make([]int64, maxBatchSize)
+	expirationSlice := make([]*time.Time, maxBatchSize)
+	encryptedSizeSlice := make([]int32, maxBatchSize)
+	insertedAtSlice := make([]time.Time, maxBatchSize)
+
+	// the segments are inserted as if they were pushed when the audit of
+	// their node became due, so Next returns them before the others. A
+	// segment still queued from a previous push has the same due time, so
+	// it's skipped.
+	segmentsIndex := 0
+	for segmentsIndex < len(segments) {
+		batchIndex := 0
+		for batchIndex < maxBatchSize && segmentsIndex < len(segments) {
+			streamIDSlice[batchIndex] = segments[segmentsIndex].StreamID
+			positionSlice[batchIndex] = int64(segments[segmentsIndex].Position.Encode())
+			expirationSlice[batchIndex] = segments[segmentsIndex].ExpiresAt
+			encryptedSizeSlice[batchIndex] = segments[segmentsIndex].EncryptedSize
+			insertedAtSlice[batchIndex] = segments[segmentsIndex].DueAt
+			batchIndex++
+			segmentsIndex++
+		}
+		_, err = vq.db.DB.ExecContext(ctx, `
+		INSERT INTO verification_audits (stream_id, position, expires_at, encrypted_size, inserted_at)
+		SELECT unnest($1::bytea[]), unnest($2::int8[]), unnest($3::timestamptz[]), unnest($4::int4[]), unnest($5::timestamptz[])
+		ON CONFLICT DO NOTHING
+	`,
+			pgutil.UUIDArray(streamIDSlice[:batchIndex]),
+			pgutil.Int8Array(positionSlice[:batchIndex]),
+			pgutil.NullTimestampTZArray(expirationSlice[:batchIndex]),
+			pgutil.Int4Array(encryptedSizeSlice[:batchIndex]),
+			pgutil.TimestampTZArray(insertedAtSlice[:batchIndex]),
+		)
+		if err != nil {
+			return Error.Wrap(err)
+		}
+	}
+	return nil
+}
+
 func (vq *verifyQueue) Next(ctx context.Context) (seg audit.Segment, err error) {
 	defer mon.Task()(&ctx)(&err)
 
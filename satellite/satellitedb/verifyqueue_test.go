@@ -114,3 +114,42 @@ func TestMultipleBatches(t *testing.T) {
 		}
 	})
 }
+
+func TestVerifyQueuePushOverdue(t *testing.T) {
+	satellitedbtest.Run(t, func(ctx *testcontext.Context, t *testing.T, db satellite.DB) {
+		verifyQueue := db.VerifyQueue()
+
+		newSegment := func() audit.Segment {
+			return audit.Segment{
+				StreamID:      testrand.UUID(),
+				Position:      metabase.SegmentPositionFromEncoded(rand.Uint64()),
+				EncryptedSize: rand.Int31(),
+			}
+		}
+
+		regular := []audit.Segment{newSegment(), newSegment()}
+		sort.Sort(audit.ByStreamIDAndPosition(regular))
+		require.NoError(t, verifyQueue.Push(ctx, regular, 10))
+
+		// overdue segments are returned before the regular ones, the earliest
+		// due first, even when pushed later.
+		now := time.Now()
+		overdue := []audit.OverdueSegment{
+			{Segment: newSegment(), DueAt: now.Add(-time.Hour)},
+			{Segment: newSegment(), DueAt: now.Add(-72 * time.Hour)},
+			{Segment: newSegment(), DueAt: now.Add(-24 * time.Hour)},
+		}
+		require.NoError(t, verifyQueue.PushOverdue(ctx, overdue, 2))
+		// the segments still queued from the previous push are skipped.
+		require.NoError(t, verifyQueue.PushOverdue(ctx, overdue, 2))
+
+		for _, expected := range []audit.Segment{overdue[1].Segment, overdue[2].Segment, overdue[0].Segment, regular[0], regular[1]} {
+			popped, err := verifyQueue.Next(ctx)
+			require.NoError(t, err)
+			require.Equal(t, expected, popped)
+		}
+
+		_, err := verifyQueue.Next(ctx)
+		require.True(t, audit.ErrEmptyQueue.Has(err))
+	})
+}
@@ -99,6 +99,32 @@ func (dir *Dir) garbagedir() string { return filepath.Join(dir.path, "garbage")
 // trashdir contains files staged for deletion for a period of time.
 func (dir *Dir) trashdir() string { return filepath.Join(dir.path, "trash") }
 
+// BlobsPath returns the path of the directory containing the blobs.
+func (dir *Dir) BlobsPath() string { return dir.blobsdir() }
+
+// TrashPath returns the path of the directory containing the trashed blobs.
+func (dir *Dir) TrashPath() string { return dir.trashdir() }
+
+// NamespacePath returns the path of the directory containing the blobs of the namespace.
+func (dir *Dir) NamespacePath(namespace []byte) string {
+	return filepath.Join(dir.blobsdir(), pathEncoding.EncodeToString(namespace))
+}
+
+// BlobPaths returns the paths where the blob may be stored, either as a blob or in the trash,
+// for every supported storage format version.
+func (dir *Dir) BlobPaths(ref storage.BlobRef) (paths []string, err error) {
+	for _, subDir := range []string{dir.blobsdir(), dir.trashdir()} {
+		basePath, err := dir.refToDirPath(ref, subDir)
+		if err != nil {
+			return nil, err
+		}
+		for formatVer := MinFormatVersionSupported; formatVer <= MaxFormatVersionSupported; formatVer++ {
+			paths = append(paths, blobPathForFormatVersion(basePath, formatVer))
+		}
+	}
+	return paths, nil
+}
+
 // CreateVerificationFile creates a file to be used for storage directory verification.
 func (dir *Dir) CreateVerificationFile(ctx context.Context, id storj.NodeID) error {
 	f, err := os.Create(filepath.Join(dir.path, verificationFileName))
@@ -158,6 +184,39 @@ func (dir *Dir) DeleteTemporary(ctx context.Context, file *os.File) (err error)
 	return errs.Combine(closeErr, os.Remove(file.Name()))
 }
 
+// WalkTemporaryFiles executes walkFunc for each file in the temp directory. Files in the
+// temp directory are uploads in progress, so when the node is not running all of them
+// are left over from interrupted uploads.
+func (dir *Dir) WalkTemporaryFiles(ctx context.Context, walkFunc func(path string, info os.FileInfo) error) (err error) {
+	defer mon.Task()(&ctx)(&err)
+	entries, err := os.ReadDir(dir.tempdir())
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil
+		}
+		return err
+	}
+	for _, entry := range entries {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		if entry.IsDir() {
+			continue
+		}
+		info, err := entry.Info()
+		if err != nil {
+			if os.IsNotExist(err) {
+				continue
+			}
+			return err
+		}
+		if err := walkFunc(filepath.Join(dir.tempdir(), entry.Name()), info); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 // blobToBasePath converts a blob reference to a filepath in permanent storage. This may not be the
 // entire path; blobPathForFormatVersion() must also be used. This is a separate call because this
 // part of the filepath is constant, and blobPathForFormatVersion may need to be called multiple
@@ -703,6 +762,23 @@ func (dir *Dir) WalkNamespace(ctx context.Context, namespace []byte, walkFunc fu
 	return dir.walkNamespaceInPath(ctx, namespace, dir.blobsdir(), walkFunc)
 }
 
+// ListTrashNamespaces finds all namespace IDs which have a directory in the trash.
+func (dir *Dir) ListTrashNamespaces(ctx context.Context) (ids [][]byte, err error) {
+	defer mon.Task()(&ctx)(&err)
+	ids, err = dir.listNamespacesInPath(ctx, dir.trashdir())
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	return ids, err
+}
+
+// WalkTrashNamespace executes walkFunc for each blob in the trash of the given namespace.
+// The file modification time of a trashed blob is the time it was moved to the trash.
+func (dir *Dir) WalkTrashNamespace(ctx context.Context, namespace []byte, walkFunc func(storage.BlobInfo) error) (err error) {
+	defer mon.Task()(&ctx)(&err)
+	return dir.walkNamespaceInPath(ctx, namespace, dir.trashdir(), walkFunc)
+}
+
 func (dir *Dir) walkNamespaceInPath(ctx context.Context, namespace []byte, path string, walkFunc func(storage.BlobInfo) error) (err error) {
 	defer mon.Task()(&ctx)(&err)
 	namespaceDir := pathEncoding.EncodeToString(namespace)
@@ -0,0 +1,91 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package consoleapi
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/storj/storagenode/maintenance"
+)
+
+// ErrMaintenanceAPI - console maintenance api error type.
+var ErrMaintenanceAPI = errs.Class("consoleapi maintenance")
+
+// Maintenance is an api controller that exposes maintenance mode related api.
+type Maintenance struct {
+	service *maintenance.Service
+
+	log *zap.Logger
+}
+
+// NewMaintenance is a constructor for maintenance controller.
+func NewMaintenance(log *zap.Logger, service *maintenance.Service) *Maintenance {
+	return &Maintenance{
+		log:     log,
+		service: service,
+	}
+}
+
+// State returns the current maintenance mode state.
+func (controller *Maintenance) State(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	w.Header().Set(contentType, applicationJSON)
+
+	if err := json.NewEncoder(w).Encode(controller.service.State()); err != nil {
+		controller.log.Error("failed to encode json response", zap.Error(ErrMaintenanceAPI.Wrap(err)))
+		return
+	}
+}
+
+// SetState turns the maintenance mode on or off.
+func (controller *Maintenance) SetState(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	w.Header().Set(contentType, applicationJSON)
+
+	var request struct {
+		Enabled bool `json:"enabled"`
+	}
+	if err = json.NewDecoder(r.Body).Decode(&request); err != nil {
+		controller.serveJSONError(w, http.StatusBadRequest, ErrMaintenanceAPI.Wrap(err))
+		return
+	}
+
+	state, err := controller.service.SetEnabled(ctx, request.Enabled)
+	if err != nil {
+		controller.serveJSONError(w, http.StatusInternalServerError, ErrMaintenanceAPI.Wrap(err))
+		return
+	}
+
+	if err := json.NewEncoder(w).Encode(state); err != nil {
+		controller.log.Error("failed to encode json response", zap.Error(ErrMaintenanceAPI.Wrap(err)))
+		return
+	}
+}
+
+// serveJSONError writes JSON error to response output stream.
+func (controller *Maintenance) serveJSONError(w http.ResponseWriter, status int, err error) {
+	w.WriteHeader(status)
+
+	var response struct {
+		Error string `json:"error"`
+	}
+
+	response.Error = err.Error()
+
+	err = json.NewEncoder(w).Encode(response)
+	if err != nil {
+		controller.log.Error("failed to write json error response", zap.Error(ErrMaintenanceAPI.Wrap(err)))
+		return
+	}
+}
@@ -0,0 +1,53 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package consoleapi
+
+import (
+	"net/http"
+
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/storj/private/openmetrics"
+	"storj.io/storj/storagenode/console"
+)
+
+// ErrMetricsAPI - console metrics api error type.
+var ErrMetricsAPI = errs.Class("consoleapi metrics")
+
+// Metrics is an api controller that exposes the dashboard data in the Prometheus text format.
+type Metrics struct {
+	service *console.Service
+
+	log *zap.Logger
+}
+
+// NewMetrics is a constructor for metrics controller.
+func NewMetrics(log *zap.Logger, service *console.Service) *Metrics {
+	return &Metrics{
+		log:     log,
+		service: service,
+	}
+}
+
+// Metrics writes the dashboard metrics.
+func (controller *Metrics) Metrics(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	families, err := controller.service.GetMetrics(ctx)
+	if err != nil {
+		controller.log.Error("failed to collect metrics", zap.Error(ErrMetricsAPI.Wrap(err)))
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set(contentType, openmetrics.ContentType)
+
+	if err := openmetrics.Write(w, families); err != nil {
+		controller.log.Error("failed to write metrics", zap.Error(ErrMetricsAPI.Wrap(err)))
+		return
+	}
+}
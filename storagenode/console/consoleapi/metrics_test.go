@@ -0,0 +1,50 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package consoleapi_test
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"storj.io/common/pb"
+	"storj.io/common/testcontext"
+	"storj.io/storj/private/openmetrics"
+	"storj.io/storj/private/testplanet"
+)
+
+func TestMetrics(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 1, UplinkCount: 0,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		satellite := planet.Satellites[0]
+		sno := planet.StorageNodes[0]
+
+		err := sno.DB.Bandwidth().Add(ctx, satellite.ID(), pb.PieceAction_GET, 1000, time.Now())
+		require.NoError(t, err)
+
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, fmt.Sprintf("http://%s/metrics", sno.Console.Listener.Addr()), nil)
+		require.NoError(t, err)
+
+		res, err := http.DefaultClient.Do(req)
+		require.NoError(t, err)
+		defer func() { require.NoError(t, res.Body.Close()) }()
+
+		require.Equal(t, http.StatusOK, res.StatusCode)
+		require.Equal(t, openmetrics.ContentType, res.Header.Get("Content-Type"))
+
+		body, err := io.ReadAll(res.Body)
+		require.NoError(t, err)
+
+		labels := fmt.Sprintf(`satellite=%q,url=%q`, satellite.ID().String(), satellite.NodeURL().Address)
+		require.Contains(t, string(body), "# TYPE storj_node_disk_free_bytes gauge\n")
+		require.Contains(t, string(body), "storj_node_satellite_bandwidth_bytes{"+labels+`,action="get"} 1000`+"\n")
+		require.Contains(t, string(body), "storj_node_satellite_audit_score{"+labels+"}")
+		require.Contains(t, string(body), "storj_node_delete_queue_length 0\n")
+	})
+}
@@ -144,6 +144,26 @@ func (notification *Notifications) ListNotifications(w http.ResponseWriter, r *h
 	}
 }
 
+// TestSend sends a test notification through all configured notification channels.
+func (notification *Notifications) TestSend(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	w.Header().Set(contentType, applicationJSON)
+
+	results, err := notification.service.TestSend(ctx)
+	if err != nil {
+		notification.serveJSONError(w, http.StatusInternalServerError, ErrNotificationsAPI.Wrap(err))
+		return
+	}
+
+	if err := json.NewEncoder(w).Encode(results); err != nil {
+		notification.log.Error("failed to encode json test send response", zap.Error(ErrNotificationsAPI.Wrap(err)))
+		return
+	}
+}
+
 // serveJSONError writes JSON error to response output stream.
 func (notification *Notifications) serveJSONError(w http.ResponseWriter, status int, err error) {
 	w.WriteHeader(status)
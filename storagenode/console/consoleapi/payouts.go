@@ -6,6 +6,7 @@ package consoleapi
 import (
 	"encoding/json"
 	"net/http"
+	"time"
 
 	"github.com/gorilla/mux"
 	"github.com/zeebo/errs"
@@ -13,6 +14,7 @@ import (
 
 	"storj.io/common/storj"
 	"storj.io/storj/storagenode/payouts"
+	"storj.io/storj/storagenode/payouts/statements"
 )
 
 // ErrPayoutAPI - console payouts api error type.
@@ -20,16 +22,18 @@ var ErrPayoutAPI = errs.Class("consoleapi payouts")
 
 // Payout is an api controller that exposes all payouts related api.
 type Payout struct {
-	service *payouts.Service
+	service    *payouts.Service
+	statements *statements.Service
 
 	log *zap.Logger
 }
 
 // NewPayout is a constructor for payouts controller.
-func NewPayout(log *zap.Logger, service *payouts.Service) *Payout {
+func NewPayout(log *zap.Logger, service *payouts.Service, statements *statements.Service) *Payout {
 	return &Payout{
-		log:     log,
-		service: service,
+		log:        log,
+		service:    service,
+		statements: statements,
 	}
 }
 
@@ -244,6 +248,71 @@ func (payout *Payout) HeldAmountPeriods(w http.ResponseWriter, r *http.Request)
 	}
 }
 
+// Statements exports the payout statements and their yearly totals for selected range of months
+// for all satellites or specified satellite by query parameter id.
+// The format query parameter selects between json, the default, and csv.
+func (payout *Payout) Statements(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	segmentParams := mux.Vars(r)
+	queryParams := r.URL.Query()
+
+	w.Header().Set(contentType, applicationJSON)
+
+	start, ok := segmentParams["start"]
+	if !ok {
+		payout.serveJSONError(w, http.StatusBadRequest, ErrPayoutAPI.New("start period is missing"))
+		return
+	}
+
+	end, ok := segmentParams["end"]
+	if !ok {
+		payout.serveJSONError(w, http.StatusBadRequest, ErrPayoutAPI.New("end period is missing"))
+		return
+	}
+
+	format := queryParams.Get("format")
+	if format != "" && format != "json" && format != "csv" {
+		payout.serveJSONError(w, http.StatusBadRequest, ErrPayoutAPI.New("unknown format %q, expected json or csv", format))
+		return
+	}
+
+	var satelliteID storj.NodeID
+	if id := queryParams.Get("id"); id != "" {
+		satelliteID, err = storj.NodeIDFromString(id)
+		if err != nil {
+			payout.serveJSONError(w, http.StatusBadRequest, ErrPayoutAPI.Wrap(err))
+			return
+		}
+	}
+
+	report, err := payout.statements.Report(ctx, satelliteID, start, end, time.Now().UTC())
+	if err != nil {
+		if payouts.ErrBadPeriod.Has(err) {
+			payout.serveJSONError(w, http.StatusBadRequest, ErrPayoutAPI.Wrap(err))
+			return
+		}
+
+		payout.serveJSONError(w, http.StatusInternalServerError, ErrPayoutAPI.Wrap(err))
+		return
+	}
+
+	if format == "csv" {
+		w.Header().Set(contentType, "text/csv")
+		if err := statements.WriteCSV(w, report); err != nil {
+			payout.log.Error("failed to write csv response", zap.Error(ErrPayoutAPI.Wrap(err)))
+		}
+		return
+	}
+
+	if err := json.NewEncoder(w).Encode(report); err != nil {
+		payout.log.Error("failed to encode json response", zap.Error(ErrPayoutAPI.Wrap(err)))
+		return
+	}
+}
+
 // serveJSONError writes JSON error to response output stream.
 func (payout *Payout) serveJSONError(w http.ResponseWriter, status int, err error) {
 	w.WriteHeader(status)
@@ -0,0 +1,97 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package consoleapi
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/storj/storagenode/piecemigration"
+)
+
+// ErrStorageMigrationAPI - console storage migration api error type.
+var ErrStorageMigrationAPI = errs.Class("consoleapi storage migration")
+
+// StorageMigration is an api controller that exposes moving the pieces to a new storage directory.
+type StorageMigration struct {
+	service *piecemigration.Service
+
+	log *zap.Logger
+}
+
+// NewStorageMigration is a constructor for storage migration controller.
+func NewStorageMigration(log *zap.Logger, service *piecemigration.Service) *StorageMigration {
+	return &StorageMigration{
+		log:     log,
+		service: service,
+	}
+}
+
+// Progress returns the progress of the migration.
+func (controller *StorageMigration) Progress(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	w.Header().Set(contentType, applicationJSON)
+
+	progress, ok := controller.service.Progress()
+	if !ok {
+		controller.serveJSONError(w, http.StatusNotFound, ErrStorageMigrationAPI.New("no migration in progress"))
+		return
+	}
+
+	if err := json.NewEncoder(w).Encode(progress); err != nil {
+		controller.log.Error("failed to encode json response", zap.Error(ErrStorageMigrationAPI.Wrap(err)))
+		return
+	}
+}
+
+// Start starts moving the pieces to a new storage directory.
+func (controller *StorageMigration) Start(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	w.Header().Set(contentType, applicationJSON)
+
+	var request struct {
+		Destination string `json:"destination"`
+	}
+	if err = json.NewDecoder(r.Body).Decode(&request); err != nil {
+		controller.serveJSONError(w, http.StatusBadRequest, ErrStorageMigrationAPI.Wrap(err))
+		return
+	}
+
+	progress, err := controller.service.Start(ctx, request.Destination)
+	if err != nil {
+		controller.serveJSONError(w, http.StatusBadRequest, ErrStorageMigrationAPI.Wrap(err))
+		return
+	}
+
+	if err := json.NewEncoder(w).Encode(progress); err != nil {
+		controller.log.Error("failed to encode json response", zap.Error(ErrStorageMigrationAPI.Wrap(err)))
+		return
+	}
+}
+
+// serveJSONError writes JSON error to response output stream.
+func (controller *StorageMigration) serveJSONError(w http.ResponseWriter, status int, err error) {
+	w.WriteHeader(status)
+
+	var response struct {
+		Error string `json:"error"`
+	}
+
+	response.Error = err.Error()
+
+	err = json.NewEncoder(w).Encode(response)
+	if err != nil {
+		controller.log.Error("failed to write json error response", zap.Error(ErrStorageMigrationAPI.Wrap(err)))
+		return
+	}
+}
@@ -14,6 +14,7 @@ import (
 
 	"storj.io/common/storj"
 	"storj.io/storj/storagenode/console"
+	"storj.io/storj/storagenode/reputation"
 )
 
 // ErrStorageNodeAPI - console storagenode api error type.
@@ -112,6 +113,75 @@ func (dashboard *StorageNode) Satellite(w http.ResponseWriter, r *http.Request)
 	}
 }
 
+// ReputationHistory returns reputation snapshots of specific satellite.
+//
+// The range is given by the optional from and to query parameters formatted as
+// RFC 3339 timestamps or dates, by default the last 30 days are returned.
+func (dashboard *StorageNode) ReputationHistory(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	w.Header().Set(contentType, applicationJSON)
+
+	satelliteID, err := storj.NodeIDFromString(mux.Vars(r)["id"])
+	if err != nil {
+		dashboard.serveJSONError(w, http.StatusBadRequest, ErrStorageNodeAPI.Wrap(err))
+		return
+	}
+
+	if err = dashboard.service.VerifySatelliteID(ctx, satelliteID); err != nil {
+		dashboard.serveJSONError(w, http.StatusNotFound, ErrStorageNodeAPI.Wrap(err))
+		return
+	}
+
+	to := time.Now()
+	if param := r.URL.Query().Get("to"); param != "" {
+		to, err = parseTimeParam(param)
+		if err != nil {
+			dashboard.serveJSONError(w, http.StatusBadRequest, ErrStorageNodeAPI.Wrap(err))
+			return
+		}
+	}
+
+	from := to.AddDate(0, 0, -30)
+	if param := r.URL.Query().Get("from"); param != "" {
+		from, err = parseTimeParam(param)
+		if err != nil {
+			dashboard.serveJSONError(w, http.StatusBadRequest, ErrStorageNodeAPI.Wrap(err))
+			return
+		}
+	}
+
+	if !from.Before(to) {
+		dashboard.serveJSONError(w, http.StatusBadRequest, ErrStorageNodeAPI.New("from must be before to"))
+		return
+	}
+
+	history, err := dashboard.service.GetReputationHistory(ctx, satelliteID, from, to)
+	if err != nil {
+		dashboard.serveJSONError(w, http.StatusInternalServerError, ErrStorageNodeAPI.Wrap(err))
+		return
+	}
+
+	if history == nil {
+		history = []reputation.Snapshot{}
+	}
+
+	if err := json.NewEncoder(w).Encode(history); err != nil {
+		dashboard.log.Error("failed to encode json response", zap.Error(ErrStorageNodeAPI.Wrap(err)))
+		return
+	}
+}
+
+// parseTimeParam parses RFC 3339 timestamp or date.
+func parseTimeParam(param string) (time.Time, error) {
+	if t, err := time.Parse(time.RFC3339, param); err == nil {
+		return t, nil
+	}
+	return time.Parse("2006-01-02", param)
+}
+
 // EstimatedPayout returns estimated payouts from specific satellite or all satellites if current traffic level remains same.
 func (dashboard *StorageNode) EstimatedPayout(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
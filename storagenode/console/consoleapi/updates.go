@@ -0,0 +1,101 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package consoleapi
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/storj/private/version/checker"
+)
+
+// ErrUpdatesAPI - console updates api error type.
+var ErrUpdatesAPI = errs.Class("consoleapi updates")
+
+// Updates is an api controller that exposes the settings of the storage node updater.
+type Updates struct {
+	settingsPath string
+
+	log *zap.Logger
+}
+
+// NewUpdates is a constructor for updates controller.
+func NewUpdates(log *zap.Logger, settingsPath string) *Updates {
+	return &Updates{
+		log:          log,
+		settingsPath: settingsPath,
+	}
+}
+
+// Settings returns the update settings of the storage node updater.
+func (controller *Updates) Settings(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	w.Header().Set(contentType, applicationJSON)
+
+	settings, err := checker.LoadUpdateSettings(controller.settingsPath)
+	if err != nil {
+		controller.serveJSONError(w, http.StatusInternalServerError, ErrUpdatesAPI.Wrap(err))
+		return
+	}
+
+	if err := json.NewEncoder(w).Encode(settings); err != nil {
+		controller.log.Error("failed to encode json response", zap.Error(ErrUpdatesAPI.Wrap(err)))
+		return
+	}
+}
+
+// SetSettings changes the update settings of the storage node updater.
+// Empty values fall back to the configuration of the updater.
+func (controller *Updates) SetSettings(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	w.Header().Set(contentType, applicationJSON)
+
+	var settings checker.UpdateSettings
+	if err = json.NewDecoder(r.Body).Decode(&settings); err != nil {
+		controller.serveJSONError(w, http.StatusBadRequest, ErrUpdatesAPI.Wrap(err))
+		return
+	}
+	if err = settings.Validate(); err != nil {
+		controller.serveJSONError(w, http.StatusBadRequest, ErrUpdatesAPI.Wrap(err))
+		return
+	}
+
+	if err = checker.SaveUpdateSettings(controller.settingsPath, settings); err != nil {
+		controller.serveJSONError(w, http.StatusInternalServerError, ErrUpdatesAPI.Wrap(err))
+		return
+	}
+
+	controller.log.Info("Update settings changed.", zap.String("Channel", settings.Channel), zap.String("Window", settings.Window))
+
+	if err := json.NewEncoder(w).Encode(settings); err != nil {
+		controller.log.Error("failed to encode json response", zap.Error(ErrUpdatesAPI.Wrap(err)))
+		return
+	}
+}
+
+// serveJSONError writes JSON error to response output stream.
+func (controller *Updates) serveJSONError(w http.ResponseWriter, status int, err error) {
+	w.WriteHeader(status)
+
+	var response struct {
+		Error string `json:"error"`
+	}
+
+	response.Error = err.Error()
+
+	err = json.NewEncoder(w).Encode(response)
+	if err != nil {
+		controller.log.Error("failed to write json error response", zap.Error(ErrUpdatesAPI.Wrap(err)))
+		return
+	}
+}
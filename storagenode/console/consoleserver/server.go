@@ -21,8 +21,11 @@ import (
 	"storj.io/storj/private/web"
 	"storj.io/storj/storagenode/console"
 	"storj.io/storj/storagenode/console/consoleapi"
+	"storj.io/storj/storagenode/maintenance"
 	"storj.io/storj/storagenode/notifications"
 	"storj.io/storj/storagenode/payouts"
+	"storj.io/storj/storagenode/payouts/statements"
+	"storj.io/storj/storagenode/piecemigration"
 )
 
 var (
@@ -35,6 +38,8 @@ var (
 type Config struct {
 	Address   string `help:"server address of the api gateway and frontend app" default:"127.0.0.1:14002"`
 	StaticDir string `help:"path to static resources" default:""`
+
+	UpdaterSettingsPath string `help:"path to the file where the update settings changed from the dashboard are persisted for the storage node updater" default:"$CONFDIR/updater-settings.json"`
 }
 
 // Server represents storagenode console web server.
@@ -46,14 +51,19 @@ type Server struct {
 	service       *console.Service
 	notifications *notifications.Service
 	payout        *payouts.Service
+	statements    *statements.Service
+	maintenance   *maintenance.Service
+	migration     *piecemigration.Service
 	listener      net.Listener
 	assets        fs.FS
 
+	updaterSettingsPath string
+
 	server http.Server
 }
 
 // NewServer creates new instance of storagenode console web server.
-func NewServer(logger *zap.Logger, assets fs.FS, notifications *notifications.Service, service *console.Service, payout *payouts.Service, listener net.Listener) *Server {
+func NewServer(logger *zap.Logger, assets fs.FS, notifications *notifications.Service, service *console.Service, payout *payouts.Service, statements *statements.Service, maintenance *maintenance.Service, migration *piecemigration.Service, updaterSettingsPath string, listener net.Listener) *Server {
 	server := Server{
 		log:           logger,
 		service:       service,
@@ -61,6 +71,11 @@ func NewServer(logger *zap.Logger, assets fs.FS, notifications *notifications.Se
 		assets:        assets,
 		notifications: notifications,
 		payout:        payout,
+		statements:    statements,
+		maintenance:   maintenance,
+		migration:     migration,
+
+		updaterSettingsPath: updaterSettingsPath,
 	}
 
 	router := mux.NewRouter()
@@ -72,16 +87,30 @@ func NewServer(logger *zap.Logger, assets fs.FS, notifications *notifications.Se
 	storageNodeRouter.HandleFunc("/", storageNodeController.StorageNode).Methods(http.MethodGet)
 	storageNodeRouter.HandleFunc("/satellites", storageNodeController.Satellites).Methods(http.MethodGet)
 	storageNodeRouter.HandleFunc("/satellite/{id}", storageNodeController.Satellite).Methods(http.MethodGet)
+	storageNodeRouter.HandleFunc("/satellite/{id}/reputation-history", storageNodeController.ReputationHistory).Methods(http.MethodGet)
 	storageNodeRouter.HandleFunc("/estimated-payout", storageNodeController.EstimatedPayout).Methods(http.MethodGet)
 
+	maintenanceController := consoleapi.NewMaintenance(server.log, server.maintenance)
+	storageNodeRouter.HandleFunc("/maintenance", maintenanceController.State).Methods(http.MethodGet)
+	storageNodeRouter.HandleFunc("/maintenance", maintenanceController.SetState).Methods(http.MethodPost)
+
+	migrationController := consoleapi.NewStorageMigration(server.log, server.migration)
+	storageNodeRouter.HandleFunc("/storage-migration", migrationController.Progress).Methods(http.MethodGet)
+	storageNodeRouter.HandleFunc("/storage-migration", migrationController.Start).Methods(http.MethodPost)
+
+	updatesController := consoleapi.NewUpdates(server.log, server.updaterSettingsPath)
+	storageNodeRouter.HandleFunc("/updates", updatesController.Settings).Methods(http.MethodGet)
+	storageNodeRouter.HandleFunc("/updates", updatesController.SetSettings).Methods(http.MethodPost)
+
 	notificationController := consoleapi.NewNotifications(server.log, server.notifications)
 	notificationRouter := router.PathPrefix("/api/notifications").Subrouter()
 	notificationRouter.StrictSlash(true)
 	notificationRouter.HandleFunc("/list", notificationController.ListNotifications).Methods(http.MethodGet)
 	notificationRouter.HandleFunc("/{id}/read", notificationController.ReadNotification).Methods(http.MethodPost)
 	notificationRouter.HandleFunc("/readall", notificationController.ReadAllNotifications).Methods(http.MethodPost)
+	notificationRouter.HandleFunc("/test", notificationController.TestSend).Methods(http.MethodPost)
 
-	payoutController := consoleapi.NewPayout(server.log, server.payout)
+	payoutController := consoleapi.NewPayout(server.log, server.payout, server.statements)
 	payoutRouter := router.PathPrefix("/api/heldamount").Subrouter()
 	payoutRouter.StrictSlash(true)
 	payoutRouter.HandleFunc("/paystubs/{period}", payoutController.PayStubMonthly).Methods(http.MethodGet)
@@ -89,6 +118,10 @@ func NewServer(logger *zap.Logger, assets fs.FS, notifications *notifications.Se
 	payoutRouter.HandleFunc("/held-history", payoutController.HeldHistory).Methods(http.MethodGet)
 	payoutRouter.HandleFunc("/periods", payoutController.HeldAmountPeriods).Methods(http.MethodGet)
 	payoutRouter.HandleFunc("/payout-history/{period}", payoutController.PayoutHistory).Methods(http.MethodGet)
+	payoutRouter.HandleFunc("/statements/{start}/{end}", payoutController.Statements).Methods(http.MethodGet)
+
+	metricsController := consoleapi.NewMetrics(server.log, server.service)
+	router.HandleFunc("/metrics", metricsController.Metrics).Methods(http.MethodGet)
 
 	staticServer := http.FileServer(http.FS(server.assets))
 	router.PathPrefix("/static/").Handler(web.CacheHandler(staticServer))
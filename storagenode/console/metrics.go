@@ -0,0 +1,158 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package console
+
+import (
+	"context"
+	"time"
+
+	"go.uber.org/zap"
+
+	"storj.io/storj/private/date"
+	"storj.io/storj/private/openmetrics"
+	"storj.io/storj/storagenode/bandwidth"
+)
+
+// GetMetrics returns the dashboard data as metric families.
+//
+// The metric names are part of the public interface of the node and must not
+// be changed, they are used by the operators' monitoring.
+func (s *Service) GetMetrics(ctx context.Context) (_ []*openmetrics.Family, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	now := time.Now()
+	from, to := date.MonthBoundary(now.UTC())
+
+	var (
+		info = openmetrics.NewGauge("storj_node_info", "Storage node information, the value is always 1.")
+		up   = openmetrics.NewGauge("storj_node_uptime_seconds", "Time since the storage node was started.")
+
+		allocated = openmetrics.NewGauge("storj_node_disk_allocated_bytes", "Disk space allocated for pieces.")
+		used      = openmetrics.NewGauge("storj_node_disk_used_bytes", "Disk space used by pieces.")
+		trash     = openmetrics.NewGauge("storj_node_disk_trash_bytes", "Disk space used by trash.")
+		free      = openmetrics.NewGauge("storj_node_disk_free_bytes", "Allocated disk space still available for pieces.")
+
+		satelliteUsed = openmetrics.NewGauge("storj_node_satellite_disk_used_bytes", "Disk space used by pieces of a satellite.")
+		bandwidthUsed = openmetrics.NewGauge("storj_node_satellite_bandwidth_bytes", "Bandwidth used in the current month by satellite and action.")
+
+		auditScore      = openmetrics.NewGauge("storj_node_satellite_audit_score", "Audit score.")
+		suspensionScore = openmetrics.NewGauge("storj_node_satellite_suspension_score", "Suspension (unknown audit) score.")
+		onlineScore     = openmetrics.NewGauge("storj_node_satellite_online_score", "Online score.")
+		disqualified    = openmetrics.NewGauge("storj_node_satellite_disqualified", "Whether the node is disqualified on the satellite.")
+		suspended       = openmetrics.NewGauge("storj_node_satellite_suspended", "Whether the node is suspended on the satellite.")
+
+		payout         = openmetrics.NewGauge("storj_node_satellite_estimated_payout_cents", "Estimated payout of the current month so far.")
+		payoutExpected = openmetrics.NewGauge("storj_node_satellite_expected_payout_cents", "Expected payout at the end of the current month.")
+
+		deleteQueue    = openmetrics.NewGauge("storj_node_delete_queue_length", "Piece deletes waiting to be processed.")
+		admissionLimit = openmetrics.NewGauge("storj_node_admission_limit", "Concurrent requests allowed before uploads are rejected.")
+		rejected       = openmetrics.NewCounter("storj_node_admission_rejected_total", "Uploads rejected by the admission control.")
+	)
+
+	upToDate := "false"
+	if _, ok := s.version.IsAllowed(ctx); ok {
+		upToDate = "true"
+	}
+	info.Add(1,
+		"node_id", s.contact.Local().ID.String(),
+		"version", s.versionInfo.Version.String(),
+		"up_to_date", upToDate)
+	up.Add(now.Sub(s.startedAt).Seconds())
+
+	pieceTotal, _, err := s.pieceStore.SpaceUsedForPieces(ctx)
+	if err != nil {
+		return nil, SNOServiceErr.Wrap(err)
+	}
+	trashTotal, err := s.pieceStore.SpaceUsedForTrash(ctx)
+	if err != nil {
+		return nil, SNOServiceErr.Wrap(err)
+	}
+	available := s.allocatedDiskSpace.Int64() - pieceTotal - trashTotal
+	if available < 0 {
+		available = 0
+	}
+	allocated.Add(float64(s.allocatedDiskSpace.Int64()))
+	used.Add(float64(pieceTotal))
+	trash.Add(float64(trashTotal))
+	free.Add(float64(available))
+
+	bandwidthBySatellite, err := s.bandwidthDB.SummaryBySatellite(ctx, from, to)
+	if err != nil {
+		return nil, SNOServiceErr.Wrap(err)
+	}
+
+	for _, satelliteID := range s.trust.GetSatellites(ctx) {
+		url, err := s.trust.GetNodeURL(ctx, satelliteID)
+		if err != nil {
+			s.log.Warn("unable to get Satellite URL", zap.String("Satellite ID", satelliteID.String()),
+				zap.Error(SNOServiceErr.Wrap(err)))
+			continue
+		}
+		labels := []string{"satellite", satelliteID.String(), "url", url.Address}
+
+		_, satelliteTotal, err := s.usageCache.SpaceUsedBySatellite(ctx, satelliteID)
+		if err != nil {
+			return nil, SNOServiceErr.Wrap(err)
+		}
+		satelliteUsed.Add(float64(satelliteTotal), labels...)
+
+		usage := bandwidthBySatellite[satelliteID]
+		if usage == nil {
+			usage = &bandwidth.Usage{}
+		}
+		for _, action := range []struct {
+			name   string
+			amount int64
+		}{
+			{"put", usage.Put},
+			{"get", usage.Get},
+			{"get_audit", usage.GetAudit},
+			{"get_repair", usage.GetRepair},
+			{"put_repair", usage.PutRepair},
+			{"delete", usage.Delete},
+		} {
+			bandwidthUsed.Add(float64(action.amount), "satellite", satelliteID.String(), "url", url.Address, "action", action.name)
+		}
+
+		stats, err := s.reputationDB.Get(ctx, satelliteID)
+		if err != nil {
+			return nil, SNOServiceErr.Wrap(err)
+		}
+		auditScore.Add(stats.Audit.Score, labels...)
+		suspensionScore.Add(stats.Audit.UnknownScore, labels...)
+		onlineScore.Add(stats.OnlineScore, labels...)
+		disqualified.Add(boolValue(stats.DisqualifiedAt != nil), labels...)
+		suspended.Add(boolValue(stats.SuspendedAt != nil || stats.OfflineSuspendedAt != nil), labels...)
+
+		if stats.DisqualifiedAt == nil {
+			estimated, err := s.estimation.GetSatelliteEstimatedPayout(ctx, satelliteID, now)
+			if err != nil {
+				return nil, SNOServiceErr.Wrap(err)
+			}
+			payout.Add(estimated.CurrentMonth.Payout, labels...)
+			payoutExpected.Add(float64(estimated.CurrentMonthExpectations), labels...)
+		}
+	}
+
+	deleteQueue.Add(float64(s.deleter.QueueLength()))
+	admissionStats := s.admission.Stats()
+	admissionLimit.Add(float64(admissionStats.Limit))
+	rejected.Add(float64(admissionStats.Rejected))
+
+	return []*openmetrics.Family{
+		info, up,
+		allocated, used, trash, free,
+		satelliteUsed, bandwidthUsed,
+		auditScore, suspensionScore, onlineScore, disqualified, suspended,
+		payout, payoutExpected,
+		deleteQueue, admissionLimit, rejected,
+	}, nil
+}
+
+func boolValue(v bool) float64 {
+	if v {
+		return 1
+	}
+	return 0
+}
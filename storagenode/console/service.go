@@ -22,6 +22,7 @@ import (
 	"storj.io/storj/storagenode/operator"
 	"storj.io/storj/storagenode/payouts/estimatedpayouts"
 	"storj.io/storj/storagenode/pieces"
+	"storj.io/storj/storagenode/piecestore/admission"
 	"storj.io/storj/storagenode/pricing"
 	"storj.io/storj/storagenode/reputation"
 	"storj.io/storj/storagenode/satellites"
@@ -64,6 +65,9 @@ type Service struct {
 
 	quicStats      *contact.QUICStats
 	configuredPort string
+
+	admission *admission.Limiter
+	deleter   *pieces.Deleter
 }
 
 // NewService returns new instance of Service.
@@ -71,7 +75,7 @@ func NewService(log *zap.Logger, bandwidth bandwidth.DB, pieceStore *pieces.Stor
 	allocatedDiskSpace memory.Size, walletAddress string, versionInfo version.Info, trust *trust.Pool,
 	reputationDB reputation.DB, storageUsageDB storageusage.DB, pricingDB pricing.DB, satelliteDB satellites.DB,
 	pingStats *contact.PingStats, contact *contact.Service, estimation *estimatedpayouts.Service, usageCache *pieces.BlobsUsageCache,
-	walletFeatures operator.WalletFeatures, port string, quicStats *contact.QUICStats) (*Service, error) {
+	walletFeatures operator.WalletFeatures, port string, quicStats *contact.QUICStats, admission *admission.Limiter, deleter *pieces.Deleter) (*Service, error) {
 	if log == nil {
 		return nil, errs.New("log can't be nil")
 	}
@@ -104,6 +108,14 @@ func NewService(log *zap.Logger, bandwidth bandwidth.DB, pieceStore *pieces.Stor
 		return nil, errs.New("estimation service can't be nil")
 	}
 
+	if admission == nil {
+		return nil, errs.New("admission limiter can't be nil")
+	}
+
+	if deleter == nil {
+		return nil, errs.New("piece deleter can't be nil")
+	}
+
 	return &Service{
 		log:                log,
 		trust:              trust,
@@ -125,6 +137,8 @@ func NewService(log *zap.Logger, bandwidth bandwidth.DB, pieceStore *pieces.Stor
 		walletFeatures:     walletFeatures,
 		quicStats:          quicStats,
 		configuredPort:     port,
+		admission:          admission,
+		deleter:            deleter,
 	}, nil
 }
 
@@ -159,6 +173,8 @@ type Dashboard struct {
 	ConfiguredPort   string    `json:"configuredPort"`
 	QUICStatus       string    `json:"quicStatus"`
 	LastQUICPingedAt time.Time `json:"lastQuicPingedAt"`
+
+	Admission admission.Stats `json:"admission"`
 }
 
 // GetDashboardData returns stale dashboard data.
@@ -178,6 +194,7 @@ func (s *Service) GetDashboardData(ctx context.Context) (_ *Dashboard, err error
 	data.QUICStatus = s.quicStats.Status()
 	data.LastQUICPingedAt = s.quicStats.WhenLastPinged()
 	data.ConfiguredPort = s.configuredPort
+	data.Admission = s.admission.Stats()
 
 	stats, err := s.reputationDB.All(ctx)
 	if err != nil {
@@ -451,6 +468,18 @@ func (s *Service) GetAllSatellitesData(ctx context.Context) (_ *Satellites, err
 	}, nil
 }
 
+// GetReputationHistory returns reputation snapshots of a satellite taken between from (inclusive) and to (exclusive).
+func (s *Service) GetReputationHistory(ctx context.Context, satelliteID storj.NodeID, from, to time.Time) (_ []reputation.Snapshot, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	history, err := s.reputationDB.History(ctx, satelliteID, from, to)
+	if err != nil {
+		return nil, SNOServiceErr.Wrap(err)
+	}
+
+	return history, nil
+}
+
 // GetSatelliteEstimatedPayout returns estimated payouts for current and previous months for selected satellite.
 func (s *Service) GetSatelliteEstimatedPayout(ctx context.Context, satelliteID storj.NodeID, now time.Time) (estimatedPayout estimatedpayouts.EstimatedPayout, err error) {
 	estimatedPayout, err = s.estimation.GetSatelliteEstimatedPayout(ctx, satelliteID, now)
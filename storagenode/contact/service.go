@@ -14,10 +14,15 @@ import (
 	"go.uber.org/zap"
 	"golang.org/x/sync/errgroup"
 
+	"storj.io/common/errs2"
 	"storj.io/common/pb"
 	"storj.io/common/rpc"
+	"storj.io/common/rpc/rpcstatus"
 	"storj.io/common/storj"
 	"storj.io/common/sync2"
+	"storj.io/storj/private/nodetag"
+	"storj.io/storj/private/nodetagpb"
+	"storj.io/storj/storagenode/maintenance"
 	"storj.io/storj/storagenode/trust"
 )
 
@@ -35,6 +40,7 @@ const initialBackOff = time.Second
 // Config contains configurable values for contact service.
 type Config struct {
 	ExternalAddress string `user:"true" help:"the public address of the node, useful for nodes behind NAT" default:""`
+	Tags            string `user:"true" help:"signed node tags sent to the satellites, as encoded by the tag-signer tool" default:""`
 
 	// Chore config values
 	Interval time.Duration `help:"how frequently the node contact chore should run" releaseDefault:"1h" devDefault:"30s"`
@@ -48,6 +54,7 @@ type NodeInfo struct {
 	Capacity            pb.NodeCapacity
 	Operator            pb.NodeOperator
 	NoiseKeyAttestation *pb.NoiseKeyAttestation
+	Tags                []*nodetag.SignedTagSet
 }
 
 // Service is the contact service between storage nodes and satellites.
@@ -58,22 +65,32 @@ type Service struct {
 
 	mu   sync.Mutex
 	self NodeInfo
-
-	trust     *trust.Pool
-	quicStats *QUICStats
+	// satellitesFreeDisk contains the free space reported to satellites,
+	// which are only allowed to use a part of the node.
+	satellitesFreeDisk map[storj.NodeID]int64
+	// lastCheckIn is when the node last checked in successfully with any satellite.
+	lastCheckIn time.Time
+	// tagsSent contains the satellites which already received the node tags.
+	tagsSent map[storj.NodeID]bool
+
+	trust       *trust.Pool
+	quicStats   *QUICStats
+	maintenance *maintenance.Service
 
 	initialized sync2.Fence
 }
 
 // NewService creates a new contact service.
-func NewService(log *zap.Logger, dialer rpc.Dialer, self NodeInfo, trust *trust.Pool, quicStats *QUICStats) *Service {
+func NewService(log *zap.Logger, dialer rpc.Dialer, self NodeInfo, trust *trust.Pool, quicStats *QUICStats, maintenance *maintenance.Service) *Service {
 	return &Service{
-		log:       log,
-		rand:      rand.New(rand.NewSource(time.Now().UnixNano())),
-		dialer:    dialer,
-		trust:     trust,
-		self:      self,
-		quicStats: quicStats,
+		log:         log,
+		rand:        rand.New(rand.NewSource(time.Now().UnixNano())),
+		dialer:      dialer,
+		trust:       trust,
+		self:        self,
+		quicStats:   quicStats,
+		maintenance: maintenance,
+		tagsSent:    map[storj.NodeID]bool{},
 	}
 }
 
@@ -129,13 +146,22 @@ func (service *Service) pingSatelliteOnce(ctx context.Context, id storj.NodeID)
 	defer func() { err = errs.Combine(err, conn.Close()) }()
 
 	self := service.Local()
-	resp, err := pb.NewDRPCNodeClient(conn).CheckIn(ctx, &pb.CheckInRequest{
+	capacity := self.Capacity
+	if freeDisk, ok := service.SatelliteFreeDisk(id); ok && freeDisk < capacity.FreeDisk {
+		capacity.FreeDisk = freeDisk
+	}
+	if service.maintenance.Enabled() {
+		// satellites should not select the node for uploads while it is in maintenance.
+		capacity.FreeDisk = 0
+	}
+	req := &pb.CheckInRequest{
 		Address:             self.Address,
 		Version:             &self.Version,
-		Capacity:            &self.Capacity,
+		Capacity:            &capacity,
 		Operator:            &self.Operator,
 		NoiseKeyAttestation: self.NoiseKeyAttestation,
-	})
+	}
+	resp, err := pb.NewDRPCNodeClient(conn).CheckIn(ctx, req)
 	service.quicStats.SetStatus(false)
 	if err != nil {
 		return errPingSatellite.Wrap(err)
@@ -150,9 +176,38 @@ func (service *Service) pingSatelliteOnce(ctx context.Context, id storj.NodeID)
 	if resp.PingErrorMessage != "" {
 		service.log.Warn("Your node is still considered to be online but encountered an error.", zap.Stringer("Satellite ID", id), zap.String("Error", resp.GetPingErrorMessage()))
 	}
+
+	service.mu.Lock()
+	service.lastCheckIn = time.Now().UTC()
+	tagsSent := service.tagsSent[id]
+	service.mu.Unlock()
+
+	if !tagsSent {
+		service.sendTags(ctx, conn, id, self.Tags)
+	}
 	return nil
 }
 
+// sendTags sends the signed node tags to the satellite. An empty list is sent
+// as well, so the satellite can forget the previously reported tags.
+func (service *Service) sendTags(ctx context.Context, conn *rpc.Conn, id storj.NodeID, tags []*nodetag.SignedTagSet) {
+	_, err := nodetagpb.NewDRPCNodeTagsClient(conn).UpdateTags(ctx, &nodetagpb.UpdateTagsRequest{
+		TagSets: nodetag.ToProto(tags),
+	})
+	switch {
+	case err == nil:
+	case errs2.IsRPC(err, rpcstatus.Unimplemented):
+		service.log.Debug("satellite doesn't support node tags", zap.Stringer("Satellite ID", id))
+	default:
+		service.log.Warn("failed to send node tags", zap.Stringer("Satellite ID", id), zap.Error(err))
+		return
+	}
+
+	service.mu.Lock()
+	service.tagsSent[id] = true
+	service.mu.Unlock()
+}
+
 // RequestPingMeQUIC sends pings request to satellite for a pingBack via QUIC.
 func (service *Service) RequestPingMeQUIC(ctx context.Context) (stats *QUICStats, err error) {
 	defer mon.Task()(&ctx)(&err)
@@ -237,3 +292,28 @@ func (service *Service) UpdateSelf(capacity *pb.NodeCapacity) {
 	}
 	service.initialized.Release()
 }
+
+// UpdateSatellitesFreeDisk updates the free space reported to satellites
+// which have a space cap configured.
+func (service *Service) UpdateSatellitesFreeDisk(freeDisk map[storj.NodeID]int64) {
+	service.mu.Lock()
+	defer service.mu.Unlock()
+	service.satellitesFreeDisk = freeDisk
+}
+
+// SatelliteFreeDisk returns the free space reported to the satellite, if it
+// has a space cap configured.
+func (service *Service) SatelliteFreeDisk(satelliteID storj.NodeID) (int64, bool) {
+	service.mu.Lock()
+	defer service.mu.Unlock()
+	freeDisk, ok := service.satellitesFreeDisk[satelliteID]
+	return freeDisk, ok
+}
+
+// LastCheckIn returns when the node last checked in successfully with any
+// satellite. It is zero when the node hasn't checked in yet.
+func (service *Service) LastCheckIn() time.Time {
+	service.mu.Lock()
+	defer service.mu.Unlock()
+	return service.lastCheckIn
+}
@@ -0,0 +1,359 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+// Package fsck implements an offline consistency check of the piece storage
+// of a storage node.
+package fsck
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"os"
+	"time"
+
+	"github.com/spacemonkeygo/monkit/v3"
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/common/storj"
+	"storj.io/storj/storage"
+	"storj.io/storj/storage/filestore"
+	"storj.io/storj/storagenode/pieces"
+)
+
+var (
+	mon = monkit.Package()
+
+	// Error is the default error class for fsck package.
+	Error = errs.Class("fsck")
+)
+
+// Config contains configurable values for the consistency check.
+type Config struct {
+	// Repair enables fixing the found problems instead of only reporting them.
+	Repair bool
+	// TrashRetention is how long pieces are kept in the trash before they are deleted.
+	TrashRetention time.Duration
+}
+
+// ProblemKind describes the kind of inconsistency found.
+type ProblemKind string
+
+const (
+	// TemporaryFile is a partial upload left over in the temp directory.
+	TemporaryFile = ProblemKind("temporary file")
+	// UnreadableHeader is a piece whose header cannot be read.
+	UnreadableHeader = ProblemKind("unreadable piece header")
+	// OrphanedExpiration is an expiration entry for a piece which does not exist.
+	OrphanedExpiration = ProblemKind("expiration of missing piece")
+	// MissingV0PieceInfo is a V0 piece without pieceinfo database entry.
+	MissingV0PieceInfo = ProblemKind("v0 piece without pieceinfo")
+	// ExpiredTrash is a piece that has been in the trash longer than the retention window.
+	ExpiredTrash = ProblemKind("expired trash")
+)
+
+// ProblemKinds lists all the kinds of problems in the order they are checked.
+var ProblemKinds = []ProblemKind{TemporaryFile, UnreadableHeader, OrphanedExpiration, MissingV0PieceInfo, ExpiredTrash}
+
+// Problem is a single inconsistency found by the checker.
+type Problem struct {
+	Kind        ProblemKind
+	SatelliteID storj.NodeID
+	PieceID     storj.PieceID
+	Path        string
+	Size        int64
+	Details     string
+	Repaired    bool
+}
+
+// Report contains the result of a consistency check.
+type Report struct {
+	Problems []Problem
+}
+
+// Count returns the number of problems of the given kind.
+func (report *Report) Count(kind ProblemKind) (count int, size int64) {
+	for _, problem := range report.Problems {
+		if problem.Kind == kind {
+			count++
+			size += problem.Size
+		}
+	}
+	return count, size
+}
+
+// Checker checks the consistency between the piece blobs and the storage node databases.
+// It must only be used while the storage node is not running, otherwise uploads in progress
+// are reported as left over temporary files.
+type Checker struct {
+	log    *zap.Logger
+	config Config
+
+	dir         *filestore.Dir
+	blobs       storage.Blobs
+	store       *pieces.Store
+	v0PieceInfo pieces.V0PieceInfoDB
+	expirations pieces.PieceExpirationDB
+
+	now func() time.Time
+
+	trashedBefore time.Time
+	emptiedTrash  map[storj.NodeID]bool
+}
+
+// NewChecker creates a new consistency checker.
+func NewChecker(log *zap.Logger, config Config, dir *filestore.Dir, blobs storage.Blobs, v0PieceInfo pieces.V0PieceInfoDB, expirations pieces.PieceExpirationDB) *Checker {
+	return &Checker{
+		log:         log,
+		config:      config,
+		dir:         dir,
+		blobs:       blobs,
+		store:       pieces.NewStore(log, blobs, v0PieceInfo, expirations, nil, pieces.DefaultConfig),
+		v0PieceInfo: v0PieceInfo,
+		expirations: expirations,
+		now:         time.Now,
+
+		emptiedTrash: map[storj.NodeID]bool{},
+	}
+}
+
+// Check runs all the checks and repairs the problems when configured to.
+func (checker *Checker) Check(ctx context.Context) (_ *Report, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	report := &Report{}
+	checker.trashedBefore = checker.now().Add(-checker.config.TrashRetention)
+
+	checks := []func(ctx context.Context, report *Report) error{
+		checker.checkTemporaryFiles,
+		checker.checkBlobs,
+		checker.checkExpirations,
+		checker.checkTrash,
+	}
+	for _, check := range checks {
+		if err := check(ctx, report); err != nil {
+			return report, Error.Wrap(err)
+		}
+	}
+
+	if !checker.config.Repair {
+		return report, nil
+	}
+
+	var group errs.Group
+	for i := range report.Problems {
+		problem := &report.Problems[i]
+		if err := checker.repair(ctx, problem); err != nil {
+			checker.log.Error("failed to repair",
+				zap.String("Problem", string(problem.Kind)),
+				zap.Stringer("Satellite ID", problem.SatelliteID),
+				zap.Stringer("Piece ID", problem.PieceID),
+				zap.String("Path", problem.Path),
+				zap.Error(err))
+			group.Add(err)
+			continue
+		}
+		problem.Repaired = true
+	}
+	return report, Error.Wrap(group.Err())
+}
+
+// checkTemporaryFiles reports all files in the temp directory.
+func (checker *Checker) checkTemporaryFiles(ctx context.Context, report *Report) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	return checker.dir.WalkTemporaryFiles(ctx, func(path string, info os.FileInfo) error {
+		report.Problems = append(report.Problems, Problem{
+			Kind: TemporaryFile,
+			Path: path,
+			Size: info.Size(),
+		})
+		return nil
+	})
+}
+
+// checkBlobs reports V1 pieces with unreadable headers and V0 pieces without pieceinfo entries.
+func (checker *Checker) checkBlobs(ctx context.Context, report *Report) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	namespaces, err := checker.blobs.ListNamespaces(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, namespace := range namespaces {
+		satelliteID, err := storj.NodeIDFromBytes(namespace)
+		if err != nil {
+			// not a satellite namespace.
+			continue
+		}
+
+		err = checker.blobs.WalkNamespace(ctx, namespace, func(info storage.BlobInfo) error {
+			pieceID, err := storj.PieceIDFromBytes(info.BlobRef().Key)
+			if err != nil {
+				// stray file, not a piece.
+				return nil //nolint: nilerr // we ignore other files
+			}
+
+			problem := Problem{
+				SatelliteID: satelliteID,
+				PieceID:     pieceID,
+			}
+			if path, err := info.FullPath(ctx); err == nil {
+				problem.Path = path
+			}
+			if stat, err := info.Stat(ctx); err == nil {
+				problem.Size = stat.Size()
+			}
+
+			if info.StorageFormatVersion() < filestore.FormatV1 {
+				_, err := checker.v0PieceInfo.Get(ctx, satelliteID, pieceID)
+				if errors.Is(err, sql.ErrNoRows) {
+					problem.Kind = MissingV0PieceInfo
+					report.Problems = append(report.Problems, problem)
+					return nil
+				}
+				return err
+			}
+
+			if err := checker.readHeader(ctx, satelliteID, pieceID, info.StorageFormatVersion()); err != nil {
+				problem.Kind = UnreadableHeader
+				problem.Details = err.Error()
+				report.Problems = append(report.Problems, problem)
+			}
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// readHeader tries to read and decode the header of a piece.
+func (checker *Checker) readHeader(ctx context.Context, satelliteID storj.NodeID, pieceID storj.PieceID, formatVersion storage.FormatVersion) (err error) {
+	reader, err := checker.store.ReaderWithStorageFormat(ctx, satelliteID, pieceID, formatVersion)
+	if err != nil {
+		return err
+	}
+	defer func() { err = errs.Combine(err, reader.Close()) }()
+
+	_, err = reader.GetPieceHeader()
+	return err
+}
+
+// checkExpirations reports expiration entries of pieces which are not stored.
+func (checker *Checker) checkExpirations(ctx context.Context, report *Report) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	infos, err := checker.expirations.GetAll(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, info := range infos {
+		_, err := checker.blobs.Stat(ctx, storage.BlobRef{
+			Namespace: info.SatelliteID.Bytes(),
+			Key:       info.PieceID.Bytes(),
+		})
+		if err == nil {
+			continue
+		}
+		if !errs.IsFunc(err, os.IsNotExist) {
+			return err
+		}
+		report.Problems = append(report.Problems, Problem{
+			Kind:        OrphanedExpiration,
+			SatelliteID: info.SatelliteID,
+			PieceID:     info.PieceID,
+		})
+	}
+	return nil
+}
+
+// checkTrash reports pieces which have been in the trash longer than the retention window.
+func (checker *Checker) checkTrash(ctx context.Context, report *Report) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	namespaces, err := checker.dir.ListTrashNamespaces(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, namespace := range namespaces {
+		satelliteID, err := storj.NodeIDFromBytes(namespace)
+		if err != nil {
+			// not a satellite namespace.
+			continue
+		}
+
+		err = checker.dir.WalkTrashNamespace(ctx, namespace, func(info storage.BlobInfo) error {
+			pieceID, err := storj.PieceIDFromBytes(info.BlobRef().Key)
+			if err != nil {
+				return nil //nolint: nilerr // we ignore other files
+			}
+			stat, err := info.Stat(ctx)
+			if err != nil {
+				if errs.IsFunc(err, os.IsNotExist) {
+					return nil
+				}
+				return err
+			}
+			if !stat.ModTime().Before(checker.trashedBefore) {
+				return nil
+			}
+
+			problem := Problem{
+				Kind:        ExpiredTrash,
+				SatelliteID: satelliteID,
+				PieceID:     pieceID,
+				Size:        stat.Size(),
+				Details:     "trashed at " + stat.ModTime().UTC().Format(time.RFC3339),
+			}
+			if path, err := info.FullPath(ctx); err == nil {
+				problem.Path = path
+			}
+			report.Problems = append(report.Problems, problem)
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// repair fixes a single problem.
+func (checker *Checker) repair(ctx context.Context, problem *Problem) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	switch problem.Kind {
+	case TemporaryFile:
+		err := os.Remove(problem.Path)
+		if errs.IsFunc(err, os.IsNotExist) {
+			return nil
+		}
+		return err
+	case UnreadableHeader, MissingV0PieceInfo:
+		// the piece can't be served anyway, but keep it in the trash in case it is
+		// needed to investigate the problem.
+		return checker.store.Trash(ctx, problem.SatelliteID, problem.PieceID)
+	case OrphanedExpiration:
+		_, err := checker.expirations.DeleteExpiration(ctx, problem.SatelliteID, problem.PieceID)
+		return err
+	case ExpiredTrash:
+		// emptying the trash removes every expired piece of the satellite at once.
+		if checker.emptiedTrash[problem.SatelliteID] {
+			return nil
+		}
+		err := checker.store.EmptyTrash(ctx, problem.SatelliteID, checker.trashedBefore)
+		if err != nil {
+			return err
+		}
+		checker.emptiedTrash[problem.SatelliteID] = true
+		return nil
+	default:
+		return Error.New("unknown problem kind %q", problem.Kind)
+	}
+}
@@ -0,0 +1,129 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package fsck_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+
+	"storj.io/common/pb"
+	"storj.io/common/storj"
+	"storj.io/common/testcontext"
+	"storj.io/common/testrand"
+	"storj.io/storj/storage"
+	"storj.io/storj/storage/filestore"
+	"storj.io/storj/storagenode"
+	"storj.io/storj/storagenode/fsck"
+	"storj.io/storj/storagenode/pieces"
+	"storj.io/storj/storagenode/storagenodedb/storagenodedbtest"
+)
+
+func TestChecker(t *testing.T) {
+	storagenodedbtest.Run(t, func(ctx *testcontext.Context, t *testing.T, db storagenode.DB) {
+		log := zaptest.NewLogger(t)
+
+		dir, err := filestore.NewDir(log, ctx.Dir("store"))
+		require.NoError(t, err)
+		blobs := filestore.New(log, dir, filestore.DefaultConfig)
+		defer ctx.Check(blobs.Close)
+
+		store := pieces.NewStore(log, blobs, db.V0PieceInfo(), db.PieceExpirationDB(), db.PieceSpaceUsedDB(), pieces.DefaultConfig)
+		testStore := pieces.StoreForTest{Store: store}
+
+		satelliteID := testrand.NodeID()
+
+		writePiece := func(pieceID storj.PieceID, formatVersion storage.FormatVersion) {
+			writer, err := testStore.WriterForFormatVersion(ctx, satelliteID, pieceID, formatVersion, pb.PieceHashAlgorithm_SHA256)
+			require.NoError(t, err)
+			_, err = writer.Write(testrand.Bytes(1024))
+			require.NoError(t, err)
+			require.NoError(t, writer.Commit(ctx, &pb.PieceHeader{}))
+		}
+
+		// a healthy piece.
+		healthy := testrand.PieceID()
+		writePiece(healthy, filestore.FormatV1)
+
+		// a left over partial upload.
+		tempFile, err := dir.CreateTemporaryFile(ctx, 0)
+		require.NoError(t, err)
+		require.NoError(t, tempFile.Close())
+
+		// a piece whose header was truncated.
+		corrupted := testrand.PieceID()
+		blobWriter, err := blobs.Create(ctx, storage.BlobRef{Namespace: satelliteID.Bytes(), Key: corrupted.Bytes()}, 0)
+		require.NoError(t, err)
+		_, err = blobWriter.Write(testrand.Bytes(100))
+		require.NoError(t, err)
+		require.NoError(t, blobWriter.Commit(ctx))
+
+		// a V0 piece without pieceinfo entry.
+		v0Piece := testrand.PieceID()
+		writePiece(v0Piece, filestore.FormatV0)
+
+		// an expiration of a piece which doesn't exist.
+		missing := testrand.PieceID()
+		require.NoError(t, store.SetExpiration(ctx, satelliteID, missing, time.Now().Add(time.Hour)))
+
+		// a piece which has been in the trash for too long.
+		trashed := testrand.PieceID()
+		writePiece(trashed, filestore.FormatV1)
+		dir.ReplaceTrashnow(func() time.Time { return time.Now().Add(-30 * 24 * time.Hour) })
+		require.NoError(t, store.Trash(ctx, satelliteID, trashed))
+		dir.ReplaceTrashnow(time.Now)
+
+		// a piece which was trashed recently.
+		recentlyTrashed := testrand.PieceID()
+		writePiece(recentlyTrashed, filestore.FormatV1)
+		require.NoError(t, store.Trash(ctx, satelliteID, recentlyTrashed))
+
+		config := fsck.Config{TrashRetention: 7 * 24 * time.Hour}
+
+		checker := fsck.NewChecker(log, config, dir, blobs, db.V0PieceInfo(), db.PieceExpirationDB())
+		report, err := checker.Check(ctx)
+		require.NoError(t, err)
+		require.Len(t, report.Problems, 5)
+
+		expected := map[fsck.ProblemKind]storj.PieceID{
+			fsck.UnreadableHeader:   corrupted,
+			fsck.MissingV0PieceInfo: v0Piece,
+			fsck.OrphanedExpiration: missing,
+			fsck.ExpiredTrash:       trashed,
+		}
+		for _, problem := range report.Problems {
+			require.False(t, problem.Repaired)
+			if problem.Kind == fsck.TemporaryFile {
+				require.Equal(t, tempFile.Name(), problem.Path)
+				continue
+			}
+			require.Equal(t, expected[problem.Kind], problem.PieceID, problem.Kind)
+			require.Equal(t, satelliteID, problem.SatelliteID)
+		}
+		for _, kind := range fsck.ProblemKinds {
+			count, _ := report.Count(kind)
+			require.Equal(t, 1, count, kind)
+		}
+
+		// repairing fixes all the problems.
+		config.Repair = true
+		checker = fsck.NewChecker(log, config, dir, blobs, db.V0PieceInfo(), db.PieceExpirationDB())
+		report, err = checker.Check(ctx)
+		require.NoError(t, err)
+		require.Len(t, report.Problems, 5)
+		for _, problem := range report.Problems {
+			require.True(t, problem.Repaired, problem.Kind)
+		}
+
+		report, err = checker.Check(ctx)
+		require.NoError(t, err)
+		require.Empty(t, report.Problems)
+
+		// the healthy piece is untouched.
+		_, err = store.Stat(ctx, satelliteID, healthy)
+		require.NoError(t, err)
+	})
+}
@@ -11,11 +11,12 @@ import (
 	"go.uber.org/zap"
 
 	"storj.io/common/rpc"
+	"storj.io/common/storj"
 	"storj.io/common/sync2"
 	"storj.io/storj/storagenode/piecetransfer"
 )
 
-// Chore checks for satellites that the node is exiting and creates a worker per satellite to complete the process.
+// Chore checks for satellites that the node is exiting or shrinking on and creates a worker per satellite to complete the process.
 //
 // architecture: Chore
 type Chore struct {
@@ -26,9 +27,10 @@ type Chore struct {
 	service         *Service
 	transferService piecetransfer.Service
 
-	exitingMap sync.Map
-	Loop       *sync2.Cycle
-	limiter    *sync2.Limiter
+	exitingMap   sync.Map
+	shrinkingMap sync.Map
+	Loop         *sync2.Cycle
+	limiter      *sync2.Limiter
 }
 
 // NewChore instantiates Chore.
@@ -61,14 +63,15 @@ func (chore *Chore) AddMissing(ctx context.Context) (err error) {
 		return nil
 	}
 
-	if len(geSatellites) == 0 {
-		return nil
+	if len(geSatellites) > 0 {
+		chore.log.Debug("exiting", zap.Int("satellites", len(geSatellites)))
 	}
-	chore.log.Debug("exiting", zap.Int("satellites", len(geSatellites)))
 
+	exiting := make(map[storj.NodeID]struct{}, len(geSatellites))
 	for _, satellite := range geSatellites {
 		mon.Meter("satellite_gracefulexit_request").Mark(1) //mon:locked
 		satellite := satellite
+		exiting[satellite.SatelliteID] = struct{}{}
 
 		worker := NewWorker(chore.log, chore.service, chore.transferService, chore.dialer, satellite.NodeURL, chore.config)
 		if _, ok := chore.exitingMap.LoadOrStore(satellite.SatelliteID, worker); ok {
@@ -89,6 +92,45 @@ func (chore *Chore) AddMissing(ctx context.Context) (err error) {
 		}
 	}
 
+	return chore.addMissingShrinks(ctx, exiting)
+}
+
+// addMissingShrinks starts any missing shrink worker for the satellites the
+// node is not exiting.
+func (chore *Chore) addMissingShrinks(ctx context.Context, exiting map[storj.NodeID]struct{}) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	shrinkSatellites, err := chore.service.ListPendingShrinks(ctx)
+	if err != nil {
+		chore.log.Error("error retrieving shrinking satellites.", zap.Error(err))
+		return nil
+	}
+
+	for _, nodeURL := range shrinkSatellites {
+		nodeURL := nodeURL
+		if _, ok := exiting[nodeURL.ID]; ok {
+			// the graceful exit transfers all the pieces anyway
+			continue
+		}
+
+		worker := NewShrinkWorker(chore.log, chore.service, chore.transferService, chore.dialer, nodeURL, chore.config)
+		if _, ok := chore.shrinkingMap.LoadOrStore(nodeURL.ID, worker); ok {
+			// already running a worker for this satellite
+			continue
+		}
+
+		started := chore.limiter.Go(ctx, func() {
+			defer chore.shrinkingMap.Delete(nodeURL.ID)
+			if err := worker.Run(ctx); err != nil {
+				chore.log.Error("shrink worker failed", zap.Error(err))
+			}
+		})
+		if !started {
+			chore.shrinkingMap.Delete(nodeURL.ID)
+			return ctx.Err()
+		}
+	}
+
 	return nil
 }
 
@@ -104,6 +146,10 @@ func (chore *Chore) TestWaitForNoWorkers(ctx context.Context) error {
 			found = true
 			return false
 		})
+		chore.shrinkingMap.Range(func(key, value interface{}) bool {
+			found = true
+			return false
+		})
 		if !found {
 			return nil
 		}
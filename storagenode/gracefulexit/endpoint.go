@@ -13,6 +13,7 @@ import (
 	"storj.io/common/pb"
 	"storj.io/common/rpc"
 	"storj.io/common/rpc/rpcstatus"
+	"storj.io/common/storj"
 	"storj.io/storj/storagenode/internalpb"
 	"storj.io/storj/storagenode/pieces"
 	"storj.io/storj/storagenode/satellites"
@@ -28,16 +29,18 @@ type Endpoint struct {
 	trust      *trust.Pool
 	satellites satellites.DB
 	dialer     rpc.Dialer
+	service    *Service
 }
 
 // NewEndpoint creates a new graceful exit endpoint.
-func NewEndpoint(log *zap.Logger, trust *trust.Pool, satellites satellites.DB, dialer rpc.Dialer, usageCache *pieces.BlobsUsageCache) *Endpoint {
+func NewEndpoint(log *zap.Logger, trust *trust.Pool, satellites satellites.DB, dialer rpc.Dialer, usageCache *pieces.BlobsUsageCache, service *Service) *Endpoint {
 	return &Endpoint{
 		log:        log,
 		usageCache: usageCache,
 		trust:      trust,
 		satellites: satellites,
 		dialer:     dialer,
+		service:    service,
 	}
 }
 
@@ -188,3 +191,67 @@ func (e *Endpoint) GracefulExitFeasibility(ctx context.Context, request *interna
 	response := (internalpb.GracefulExitFeasibilityResponse)(*feasibility)
 	return &response, nil
 }
+
+// InitiateShrink requests a satellite to move the given amount of data off the storagenode.
+func (e *Endpoint) InitiateShrink(ctx context.Context, req *internalpb.InitiateShrinkRequest) (*internalpb.ShrinkStatus, error) {
+	e.log.Debug("initialize shrink: start", zap.Stringer("Satellite ID", req.NodeId), zap.Int64("bytes", req.Bytes))
+
+	for _, exiting := range e.exitingSatellites(ctx) {
+		if exiting == req.NodeId {
+			return nil, rpcstatus.Error(rpcstatus.FailedPrecondition, "the node is gracefully exiting the satellite")
+		}
+	}
+
+	satellite, err := e.service.InitiateShrink(ctx, req.NodeId, req.Bytes)
+	if err != nil {
+		e.log.Debug("initialize shrink: request shrink from satellite", zap.Stringer("Satellite ID", req.NodeId), zap.Error(err))
+		return nil, rpcstatus.Wrap(rpcstatus.Code(err), err)
+	}
+
+	return toInternalShrinkStatus(satellite), nil
+}
+
+// GetShrinkStatus returns the status of the latest shrink on each satellite.
+func (e *Endpoint) GetShrinkStatus(ctx context.Context, req *internalpb.GetShrinkStatusRequest) (*internalpb.GetShrinkStatusResponse, error) {
+	resp := &internalpb.GetShrinkStatusResponse{}
+	for _, satelliteID := range e.trust.GetSatellites(ctx) {
+		satellite, err := e.service.GetShrinkStatus(ctx, satelliteID)
+		if err != nil {
+			e.log.Debug("shrink: get status from satellite", zap.Stringer("Satellite ID", satelliteID), zap.Error(err))
+			continue
+		}
+		if satellite.Status == nil {
+			continue
+		}
+		resp.Statuses = append(resp.Statuses, toInternalShrinkStatus(satellite))
+	}
+	return resp, nil
+}
+
+// exitingSatellites returns the satellites the node has started exiting.
+func (e *Endpoint) exitingSatellites(ctx context.Context) []storj.NodeID {
+	exitProgress, err := e.satellites.ListGracefulExits(ctx)
+	if err != nil {
+		e.log.Debug("graceful exit: list exiting satellites", zap.Error(err))
+		return nil
+	}
+	satelliteIDs := make([]storj.NodeID, 0, len(exitProgress))
+	for _, progress := range exitProgress {
+		satelliteIDs = append(satelliteIDs, progress.SatelliteID)
+	}
+	return satelliteIDs
+}
+
+func toInternalShrinkStatus(satellite ShrinkingSatellite) *internalpb.ShrinkStatus {
+	return &internalpb.ShrinkStatus{
+		DomainName:        satellite.NodeURL.Address,
+		NodeId:            satellite.NodeURL.ID,
+		RequestedBytes:    satellite.Status.RequestedBytes,
+		SelectedBytes:     satellite.Status.SelectedBytes,
+		BytesTransferred:  satellite.Status.BytesTransferred,
+		PiecesTransferred: satellite.Status.PiecesTransferred,
+		PiecesFailed:      satellite.Status.PiecesFailed,
+		Selected:          satellite.Status.Selected,
+		Finished:          satellite.Status.Finished,
+	}
+}
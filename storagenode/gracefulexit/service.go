@@ -6,6 +6,7 @@ package gracefulexit
 import (
 	"context"
 	"os"
+	"sync"
 	"time"
 
 	"github.com/zeebo/errs"
@@ -28,6 +29,12 @@ type Service struct {
 	store       *pieces.Store
 	trust       *trust.Pool
 	satelliteDB satellites.DB
+	dialer      rpc.Dialer
+
+	// shrinksMu protects the satellites with a pending shrink.
+	shrinksMu     sync.Mutex
+	shrinksLoaded bool
+	shrinking     map[storj.NodeID]struct{}
 
 	nowFunc func() time.Time
 }
@@ -39,6 +46,8 @@ func NewService(log *zap.Logger, store *pieces.Store, trust *trust.Pool, satelli
 		store:       store,
 		trust:       trust,
 		satelliteDB: satelliteDB,
+		dialer:      dialer,
+		shrinking:   make(map[storj.NodeID]struct{}),
 		nowFunc:     func() time.Time { return time.Now().UTC() },
 	}
 }
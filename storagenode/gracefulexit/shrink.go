@@ -0,0 +1,148 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package gracefulexit
+
+import (
+	"context"
+
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/common/errs2"
+	"storj.io/common/rpc/rpcstatus"
+	"storj.io/common/storj"
+	"storj.io/storj/private/shrinkpb"
+)
+
+// ShrinkingSatellite encapsulates a satellite address with the status of the
+// latest shrink on it.
+type ShrinkingSatellite struct {
+	NodeURL storj.NodeURL
+	Status  *shrinkpb.ShrinkStatus
+}
+
+// InitiateShrink requests the satellite to move the given amount of data
+// off the node. The data is transferred by the chore, once the satellite
+// has selected the pieces for the transfer.
+func (c *Service) InitiateShrink(ctx context.Context, satelliteID storj.NodeID, bytes int64) (_ ShrinkingSatellite, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	satellite, err := c.callShrink(ctx, satelliteID, func(client shrinkpb.DRPCSatelliteShrinkClient) (*shrinkpb.ShrinkStatus, error) {
+		return client.InitiateShrink(ctx, &shrinkpb.InitiateShrinkRequest{Bytes: bytes})
+	})
+	if err != nil {
+		return satellite, err
+	}
+
+	c.log.Info("shrink initiated", zap.Stringer("Satellite ID", satelliteID), zap.Int64("bytes", bytes))
+	return satellite, nil
+}
+
+// GetShrinkStatus returns the status of the latest shrink on the satellite.
+// The status is nil when no shrink was requested.
+func (c *Service) GetShrinkStatus(ctx context.Context, satelliteID storj.NodeID) (_ ShrinkingSatellite, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	satellite, err := c.callShrink(ctx, satelliteID, func(client shrinkpb.DRPCSatelliteShrinkClient) (*shrinkpb.ShrinkStatus, error) {
+		return client.GetShrinkStatus(ctx, &shrinkpb.GetShrinkStatusRequest{})
+	})
+	if errs2.IsRPC(err, rpcstatus.NotFound) {
+		return satellite, nil
+	}
+	return satellite, err
+}
+
+// callShrink calls the shrink endpoint of the satellite and keeps track of
+// whether the node has a pending shrink on it.
+func (c *Service) callShrink(ctx context.Context, satelliteID storj.NodeID, call func(shrinkpb.DRPCSatelliteShrinkClient) (*shrinkpb.ShrinkStatus, error)) (satellite ShrinkingSatellite, err error) {
+	satellite.NodeURL, err = c.trust.GetNodeURL(ctx, satelliteID)
+	if err != nil {
+		return satellite, Error.Wrap(err)
+	}
+
+	conn, err := c.dialer.DialNodeURL(ctx, satellite.NodeURL)
+	if err != nil {
+		return satellite, Error.Wrap(err)
+	}
+	defer func() {
+		err = errs.Combine(err, Error.Wrap(conn.Close()))
+	}()
+
+	satellite.Status, err = call(shrinkpb.NewDRPCSatelliteShrinkClient(conn))
+	if err != nil {
+		return satellite, Error.Wrap(err)
+	}
+
+	c.setShrinking(satelliteID, !satellite.Status.Finished)
+	return satellite, nil
+}
+
+// ListPendingShrinks returns the addresses of the satellites with a pending
+// shrink. The satellites are asked for their shrinks on the first call.
+func (c *Service) ListPendingShrinks(ctx context.Context) (_ []storj.NodeURL, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	c.loadShrinks(ctx)
+
+	c.shrinksMu.Lock()
+	satelliteIDs := make([]storj.NodeID, 0, len(c.shrinking))
+	for satelliteID := range c.shrinking {
+		satelliteIDs = append(satelliteIDs, satelliteID)
+	}
+	c.shrinksMu.Unlock()
+
+	nodeURLs := make([]storj.NodeURL, 0, len(satelliteIDs))
+	for _, satelliteID := range satelliteIDs {
+		nodeURL, err := c.trust.GetNodeURL(ctx, satelliteID)
+		if err != nil {
+			c.log.Error("failed to get satellite address", zap.Stringer("Satellite ID", satelliteID), zap.Error(err))
+			continue
+		}
+		nodeURLs = append(nodeURLs, nodeURL)
+	}
+	return nodeURLs, nil
+}
+
+// loadShrinks asks the trusted satellites for the shrinks which were pending
+// before the node was restarted.
+func (c *Service) loadShrinks(ctx context.Context) {
+	c.shrinksMu.Lock()
+	loaded := c.shrinksLoaded
+	c.shrinksLoaded = true
+	c.shrinksMu.Unlock()
+	if loaded {
+		return
+	}
+
+	for _, satelliteID := range c.trust.GetSatellites(ctx) {
+		if _, err := c.GetShrinkStatus(ctx, satelliteID); err != nil {
+			c.log.Debug("failed to get shrink status", zap.Stringer("Satellite ID", satelliteID), zap.Error(err))
+		}
+	}
+}
+
+// ShrinkFinished removes the satellite from the satellites with a pending
+// shrink.
+func (c *Service) ShrinkFinished(satelliteID storj.NodeID) {
+	c.setShrinking(satelliteID, false)
+}
+
+func (c *Service) setShrinking(satelliteID storj.NodeID, shrinking bool) {
+	c.shrinksMu.Lock()
+	defer c.shrinksMu.Unlock()
+
+	if shrinking {
+		c.shrinking[satelliteID] = struct{}{}
+	} else {
+		delete(c.shrinking, satelliteID)
+	}
+}
+
+// DeleteTransferredPiece deletes a piece which was transferred to another
+// node during a shrink.
+func (c *Service) DeleteTransferredPiece(ctx context.Context, satelliteID storj.NodeID, pieceID storj.PieceID) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	return Error.Wrap(c.store.Delete(ctx, satelliteID, pieceID))
+}
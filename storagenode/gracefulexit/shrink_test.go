@@ -0,0 +1,158 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package gracefulexit_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+
+	"storj.io/common/memory"
+	"storj.io/common/testcontext"
+	"storj.io/common/testrand"
+	"storj.io/storj/private/testplanet"
+	"storj.io/storj/storagenode"
+	"storj.io/storj/storagenode/gracefulexit"
+)
+
+func TestShrinkWorker(t *testing.T) {
+	const successThreshold = 4
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount:   1,
+		StorageNodeCount: successThreshold + 1,
+		UplinkCount:      1,
+		Reconfigure: testplanet.Reconfigure{
+			Satellite: testplanet.ReconfigureRS(2, 3, successThreshold, successThreshold),
+			StorageNode: func(index int, config *storagenode.Config) {
+				config.GracefulExit.NumWorkers = 2
+				config.GracefulExit.NumConcurrentTransfers = 2
+				config.GracefulExit.MinBytesPerSecond = 128
+				config.GracefulExit.MinDownloadTimeout = 2 * time.Minute
+			},
+		},
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		satellite := planet.Satellites[0]
+		ul := planet.Uplinks[0]
+
+		satellite.GracefulExit.Chore.Loop.Pause()
+
+		for _, path := range []string{"test/path1", "test/path2"} {
+			err := ul.Upload(ctx, satellite, "testbucket", path, testrand.Bytes(5*memory.KiB))
+			require.NoError(t, err)
+		}
+
+		shrinkingNode, err := findNodeToExit(ctx, planet)
+		require.NoError(t, err)
+		shrinkingNode.GracefulExit.Chore.Loop.Pause()
+		service := shrinkingNode.GracefulExit.Service
+
+		pending, err := service.ListPendingShrinks(ctx)
+		require.NoError(t, err)
+		require.Empty(t, pending)
+
+		// a single byte selects a single piece.
+		shrinking, err := service.InitiateShrink(ctx, satellite.ID(), 1)
+		require.NoError(t, err)
+		require.Equal(t, satellite.ID(), shrinking.NodeURL.ID)
+		require.False(t, shrinking.Status.Selected)
+
+		pending, err = service.ListPendingShrinks(ctx)
+		require.NoError(t, err)
+		require.Len(t, pending, 1)
+		require.Equal(t, satellite.ID(), pending[0].ID)
+
+		worker := gracefulexit.NewShrinkWorker(zaptest.NewLogger(t), service, shrinkingNode.PieceTransfer.Service, shrinkingNode.Dialer, satellite.NodeURL(), shrinkingNode.Config.GracefulExit)
+
+		// the worker stops when the pieces aren't selected yet, and the
+		// shrink stays pending.
+		require.NoError(t, worker.Run(ctx))
+		pending, err = service.ListPendingShrinks(ctx)
+		require.NoError(t, err)
+		require.Len(t, pending, 1)
+
+		// run the satellite chore to build the transfer queue.
+		satellite.GracefulExit.Chore.Loop.TriggerWait()
+
+		queueItems, err := satellite.DB.GracefulExit().GetIncomplete(ctx, shrinkingNode.ID(), 10, 0)
+		require.NoError(t, err)
+		require.Len(t, queueItems, 1)
+
+		pieceCounts, err := getNodePieceCounts(ctx, planet)
+		require.NoError(t, err)
+
+		require.NoError(t, worker.Run(ctx))
+
+		shrinking, err = service.GetShrinkStatus(ctx, satellite.ID())
+		require.NoError(t, err)
+		require.True(t, shrinking.Status.Finished)
+		require.EqualValues(t, 1, shrinking.Status.PiecesTransferred)
+		require.Zero(t, shrinking.Status.PiecesFailed)
+
+		pending, err = service.ListPendingShrinks(ctx)
+		require.NoError(t, err)
+		require.Empty(t, pending)
+
+		// the transferred piece was deleted from the node.
+		newPieceCounts, err := getNodePieceCounts(ctx, planet)
+		require.NoError(t, err)
+		require.Equal(t, pieceCounts[shrinkingNode.ID()]-1, newPieceCounts[shrinkingNode.ID()])
+
+		queueItems, err = satellite.DB.GracefulExit().GetIncomplete(ctx, shrinkingNode.ID(), 10, 0)
+		require.NoError(t, err)
+		require.Empty(t, queueItems)
+	})
+}
+
+func TestChoreShrink(t *testing.T) {
+	const successThreshold = 4
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount:   1,
+		StorageNodeCount: successThreshold + 1,
+		UplinkCount:      1,
+		Reconfigure: testplanet.Reconfigure{
+			Satellite: testplanet.ReconfigureRS(2, 3, successThreshold, successThreshold),
+		},
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		satellite := planet.Satellites[0]
+		ul := planet.Uplinks[0]
+
+		satellite.GracefulExit.Chore.Loop.Pause()
+
+		err := ul.Upload(ctx, satellite, "testbucket", "test/path1", testrand.Bytes(5*memory.KiB))
+		require.NoError(t, err)
+
+		shrinkingNode, err := findNodeToExit(ctx, planet)
+		require.NoError(t, err)
+		shrinkingNode.GracefulExit.Chore.Loop.Pause()
+
+		_, err = shrinkingNode.GracefulExit.Service.InitiateShrink(ctx, satellite.ID(), 1)
+		require.NoError(t, err)
+
+		// run the satellite chore to build the transfer queue.
+		satellite.GracefulExit.Chore.Loop.TriggerWait()
+
+		// a restarted node asks the satellites for its pending shrinks.
+		restarted := gracefulexit.NewService(zaptest.NewLogger(t), shrinkingNode.Storage2.Store, shrinkingNode.Storage2.Trust, shrinkingNode.DB.Satellites(), shrinkingNode.Dialer, shrinkingNode.Config.GracefulExit)
+		pending, err := restarted.ListPendingShrinks(ctx)
+		require.NoError(t, err)
+		require.Len(t, pending, 1)
+		require.Equal(t, satellite.ID(), pending[0].ID)
+
+		// run the SN chore to start the shrink worker.
+		shrinkingNode.GracefulExit.Chore.Loop.TriggerWait()
+		err = shrinkingNode.GracefulExit.Chore.TestWaitForNoWorkers(ctx)
+		require.NoError(t, err)
+
+		shrink, err := satellite.DB.GracefulExit().GetShrink(ctx, shrinkingNode.ID())
+		require.NoError(t, err)
+		require.NotNil(t, shrink.FinishedAt)
+		require.EqualValues(t, 1, shrink.PiecesTransferred)
+
+		pending, err = shrinkingNode.GracefulExit.Service.ListPendingShrinks(ctx)
+		require.NoError(t, err)
+		require.Empty(t, pending)
+	})
+}
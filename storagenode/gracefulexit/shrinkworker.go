@@ -0,0 +1,126 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package gracefulexit
+
+import (
+	"context"
+	"io"
+
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/common/errs2"
+	"storj.io/common/pb"
+	"storj.io/common/rpc"
+	"storj.io/common/rpc/rpcstatus"
+	"storj.io/common/storj"
+	"storj.io/common/sync2"
+	"storj.io/storj/private/shrinkpb"
+	"storj.io/storj/storagenode/piecetransfer"
+)
+
+// ShrinkWorker is responsible for transferring the pieces selected for a
+// shrink on a given satellite.
+type ShrinkWorker struct {
+	log *zap.Logger
+
+	service         *Service
+	transferService piecetransfer.Service
+
+	dialer              rpc.Dialer
+	satelliteURL        storj.NodeURL
+	concurrentTransfers int
+}
+
+// NewShrinkWorker instantiates ShrinkWorker.
+func NewShrinkWorker(log *zap.Logger, service *Service, transferService piecetransfer.Service, dialer rpc.Dialer, satelliteURL storj.NodeURL, config Config) *ShrinkWorker {
+	return &ShrinkWorker{
+		log:                 log.Named(satelliteURL.String()),
+		service:             service,
+		transferService:     transferService,
+		dialer:              dialer,
+		satelliteURL:        satelliteURL,
+		concurrentTransfers: config.NumConcurrentTransfers,
+	}
+}
+
+// Run calls the satellite endpoint, transfers pieces, validates, and responds with success or failure.
+// It also marks the shrink finished once all the selected pieces have been transferred.
+func (worker *ShrinkWorker) Run(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	worker.log.Debug("started")
+	defer worker.log.Debug("finished")
+
+	limiter := sync2.NewLimiter(worker.concurrentTransfers)
+	defer limiter.Wait()
+
+	conn, err := worker.dialer.DialNodeURL(ctx, worker.satelliteURL)
+	if err != nil {
+		return errs.Wrap(err)
+	}
+	defer func() {
+		err = errs.Combine(err, conn.Close())
+	}()
+
+	client := shrinkpb.NewDRPCSatelliteShrinkClient(conn)
+
+	c, err := client.ProcessShrink(ctx)
+	if err != nil {
+		return errs.Wrap(err)
+	}
+	defer func() { _ = c.CloseSend() }()
+
+	for {
+		response, err := c.Recv()
+		if errs.Is(err, io.EOF) {
+			// the satellite closes the stream once there is nothing left to transfer.
+			limiter.Wait()
+			worker.log.Info("shrink completed.", zap.Stringer("Satellite ID", worker.satelliteURL.ID))
+			worker.service.ShrinkFinished(worker.satelliteURL.ID)
+			return nil
+		}
+		if errs2.IsRPC(err, rpcstatus.FailedPrecondition) || errs2.IsRPC(err, rpcstatus.NotFound) {
+			worker.log.Error("shrink failed.", zap.Stringer("Satellite ID", worker.satelliteURL.ID), zap.Error(err))
+			worker.service.ShrinkFinished(worker.satelliteURL.ID)
+			return errs.Wrap(err)
+		}
+		if err != nil {
+			return errs.Wrap(err)
+		}
+
+		switch msg := response.GetMessage().(type) {
+		case *pb.SatelliteMessage_NotReady:
+			return nil
+
+		case *pb.SatelliteMessage_TransferPiece:
+			transferPieceMsg := msg.TransferPiece
+			limiter.Go(ctx, func() {
+				resp := worker.transferService.TransferPiece(ctx, worker.satelliteURL.ID, transferPieceMsg)
+				err := c.Send(resp)
+				if err != nil {
+					worker.log.Error("failed to send notification about piece transfer.",
+						zap.Stringer("Satellite ID", worker.satelliteURL.ID),
+						zap.Error(errs.Wrap(err)))
+				}
+			})
+
+		case *pb.SatelliteMessage_DeletePiece:
+			deletePieceMsg := msg.DeletePiece
+			limiter.Go(ctx, func() {
+				pieceID := deletePieceMsg.OriginalPieceId
+				err := worker.service.DeleteTransferredPiece(ctx, worker.satelliteURL.ID, pieceID)
+				if err != nil {
+					worker.log.Error("failed to delete piece.",
+						zap.Stringer("Satellite ID", worker.satelliteURL.ID),
+						zap.Stringer("Piece ID", pieceID),
+						zap.Error(errs.Wrap(err)))
+				}
+			})
+
+		default:
+			worker.log.Error("unknown shrink message.", zap.Stringer("Satellite ID", worker.satelliteURL.ID))
+		}
+	}
+}
@@ -0,0 +1,105 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package healthcheck
+
+import (
+	"context"
+	"time"
+
+	"storj.io/common/identity"
+	"storj.io/common/memory"
+	"storj.io/common/storj"
+	"storj.io/storj/storagenode/reputation"
+)
+
+// Names of the available checks.
+const (
+	// CheckDatabase checks that the database responds.
+	CheckDatabase = "database"
+	// CheckStorageDir checks that the storage directory is verified and writable.
+	CheckStorageDir = "storage-dir"
+	// CheckIdentity checks that the identity is loaded.
+	CheckIdentity = "identity"
+	// CheckContact checks that the node recently checked in with a satellite.
+	CheckContact = "contact"
+	// CheckDiskSpace checks that the node isn't full.
+	CheckDiskSpace = "disk-space"
+)
+
+// StorageDir is the storage directory verified by the storage-dir check.
+type StorageDir interface {
+	VerifyStorageDir(ctx context.Context, id storj.NodeID) error
+	CheckWritability(ctx context.Context) error
+}
+
+// DatabaseCheck returns a check which fails when the database doesn't respond.
+func DatabaseCheck(reputationDB reputation.DB) CheckFunc {
+	return func(ctx context.Context) error {
+		_, err := reputationDB.All(ctx)
+		return Err.Wrap(err)
+	}
+}
+
+// StorageDirCheck returns a check which fails when the storage directory
+// isn't the one of the node or isn't writable.
+func StorageDirCheck(dir StorageDir, nodeID storj.NodeID) CheckFunc {
+	return func(ctx context.Context) error {
+		if err := dir.VerifyStorageDir(ctx, nodeID); err != nil {
+			return Err.New("unable to verify storage directory: %w", err)
+		}
+		if err := dir.CheckWritability(ctx); err != nil {
+			return Err.New("storage directory is not writable: %w", err)
+		}
+		return nil
+	}
+}
+
+// IdentityCheck returns a check which fails when the identity isn't loaded
+// or doesn't match its certificate authority.
+func IdentityCheck(ident *identity.FullIdentity) CheckFunc {
+	return func(ctx context.Context) error {
+		if ident == nil || ident.CA == nil || ident.Leaf == nil || ident.Key == nil {
+			return Err.New("identity is not loaded")
+		}
+		id, err := identity.NodeIDFromCert(ident.CA)
+		if err != nil {
+			return Err.New("invalid identity: %w", err)
+		}
+		if id != ident.ID {
+			return Err.New("identity %s does not match its certificate authority %s", ident.ID, id)
+		}
+		return nil
+	}
+}
+
+// ContactCheck returns a check which fails until the node successfully
+// checked in with a satellite, and when the last successful check-in is older
+// than maxAge. A zero maxAge doesn't limit the age of the check-in.
+func ContactCheck(lastCheckIn func() time.Time, maxAge time.Duration) CheckFunc {
+	return func(ctx context.Context) error {
+		last := lastCheckIn()
+		if last.IsZero() {
+			return Err.New("the node has not checked in with any satellite yet")
+		}
+		if age := time.Since(last); maxAge > 0 && age > maxAge {
+			return Err.New("the last check-in with a satellite was %s ago, longer than %s", age.Round(time.Second), maxAge)
+		}
+		return nil
+	}
+}
+
+// DiskSpaceCheck returns a check which fails when the space available for
+// new pieces is below the minimum.
+func DiskSpaceCheck(availableSpace func(ctx context.Context) (int64, error), minimum memory.Size) CheckFunc {
+	return func(ctx context.Context) error {
+		available, err := availableSpace(ctx)
+		if err != nil {
+			return Err.Wrap(err)
+		}
+		if available < minimum.Int64() {
+			return Err.New("disk is full, available space %s is below %s", memory.Size(available).Base10String(), minimum.Base10String())
+		}
+		return nil
+	}
+}
@@ -3,8 +3,20 @@
 
 package healthcheck
 
+import (
+	"time"
+
+	"storj.io/common/memory"
+)
+
 // Config is the configuration for healthcheck service and endpoint.
 type Config struct {
 	Details bool `user:"true" help:"Enable additional details about the satellite connections via the HTTP healthcheck." default:"false"`
 	Enabled bool `user:"true" help:"Provide health endpoint (including suspension/audit failures) on main public port, but HTTP protocol." default:"true"`
+
+	LivenessChecks  string        `user:"true" help:"comma separated list of the checks of the liveness endpoint /health/live, out of database, storage-dir, identity, contact and disk-space" default:"database"`
+	ReadinessChecks string        `user:"true" help:"comma separated list of the checks of the readiness endpoint /health/ready, out of database, storage-dir, identity, contact and disk-space" default:"database,storage-dir,identity,contact,disk-space"`
+	CheckTimeout    time.Duration `help:"how long a single check of the liveness or readiness endpoint can take before it fails" default:"10s"`
+	MinDiskSpace    memory.Size   `user:"true" help:"available space below which the node is considered to be full by the disk-space check" default:"5GB"`
+	MaxCheckInAge   time.Duration `user:"true" help:"how long ago the last successful check-in with a satellite can be before the contact check fails, 0 means no limit" default:"3h0m0s"`
 }
@@ -8,9 +8,19 @@ import (
 	"net/http"
 )
 
+// Paths of the liveness and readiness endpoints. All other paths serve the
+// satellite related health.
+const (
+	LivenessPath  = "/health/live"
+	ReadinessPath = "/health/ready"
+)
+
 // Endpoint handles HTTP request for health endpoint.
 type Endpoint struct {
 	service *Service
+
+	liveness  *Probe
+	readiness *Probe
 }
 
 // NewEndpoint creates a new HTTP endpoint.
@@ -20,8 +30,25 @@ func NewEndpoint(service *Service) *Endpoint {
 	}
 }
 
+// SetProbes sets the probes of the liveness and readiness endpoints.
+//
+// It must be called before the endpoint serves any request.
+func (e *Endpoint) SetProbes(liveness, readiness *Probe) {
+	e.liveness = liveness
+	e.readiness = readiness
+}
+
 // HandleHTTP manages the HTTP conversion for the function call.
 func (e *Endpoint) HandleHTTP(writer http.ResponseWriter, request *http.Request) {
+	switch request.URL.Path {
+	case LivenessPath:
+		e.handleProbe(writer, request, e.liveness)
+		return
+	case ReadinessPath:
+		e.handleProbe(writer, request, e.readiness)
+		return
+	}
+
 	health, err := e.service.GetHealth(request.Context())
 	if err != nil {
 		writer.WriteHeader(http.StatusInternalServerError)
@@ -44,3 +71,29 @@ func (e *Endpoint) HandleHTTP(writer http.ResponseWriter, request *http.Request)
 
 	_, _ = writer.Write(out)
 }
+
+// handleProbe evaluates the probe and responds with the result of each check.
+func (e *Endpoint) handleProbe(writer http.ResponseWriter, request *http.Request, probe *Probe) {
+	if probe == nil {
+		writer.WriteHeader(http.StatusNotFound)
+		return
+	}
+
+	result := probe.Evaluate(request.Context())
+
+	out, err := json.MarshalIndent(result, "", "  ")
+	if err != nil {
+		writer.WriteHeader(http.StatusInternalServerError)
+		_, _ = writer.Write([]byte(err.Error()))
+		return
+	}
+
+	writer.Header().Set("Content-Type", "application/json")
+	if result.Healthy {
+		writer.WriteHeader(http.StatusOK)
+	} else {
+		writer.WriteHeader(http.StatusServiceUnavailable)
+	}
+
+	_, _ = writer.Write(out)
+}
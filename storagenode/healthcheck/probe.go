@@ -0,0 +1,136 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package healthcheck
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// CheckFunc returns an error when the criterion of a check isn't met.
+type CheckFunc func(ctx context.Context) error
+
+// Probe evaluates a configured set of checks, e.g. to tell whether the node
+// is alive or ready.
+type Probe struct {
+	names   []string
+	checks  map[string]CheckFunc
+	timeout time.Duration
+	nowFunc func() time.Time
+
+	mu    sync.Mutex
+	state map[string]checkState
+}
+
+// checkState is the latest result of a check.
+type checkState struct {
+	healthy bool
+	since   time.Time
+}
+
+// ProbeResult is the result of evaluating the checks of a probe.
+type ProbeResult struct {
+	Healthy bool          `json:"healthy"`
+	Checks  []CheckResult `json:"checks"`
+}
+
+// CheckResult is the result of a single check.
+type CheckResult struct {
+	Name    string `json:"name"`
+	Healthy bool   `json:"healthy"`
+	Error   string `json:"error,omitempty"`
+	// Since is when the check started to have its current result.
+	Since time.Time `json:"since"`
+}
+
+// NewProbe returns a probe evaluating the checks given as a comma separated
+// list of names. All the names have to be among the available checks.
+func NewProbe(names string, available map[string]CheckFunc, timeout time.Duration) (*Probe, error) {
+	probe := &Probe{
+		checks:  make(map[string]CheckFunc),
+		timeout: timeout,
+		nowFunc: func() time.Time { return time.Now().UTC() },
+		state:   make(map[string]checkState),
+	}
+
+	for _, name := range strings.Split(names, ",") {
+		name = strings.TrimSpace(name)
+		if name == "" {
+			continue
+		}
+		check, ok := available[name]
+		if !ok {
+			return nil, Err.New("unknown check %q, expected one of %s", name, strings.Join(checkNames(available), ", "))
+		}
+		if _, ok := probe.checks[name]; ok {
+			continue
+		}
+		probe.names = append(probe.names, name)
+		probe.checks[name] = check
+	}
+
+	return probe, nil
+}
+
+// Evaluate runs all the checks of the probe. The probe is healthy when all
+// of its checks pass.
+func (probe *Probe) Evaluate(ctx context.Context) (result ProbeResult) {
+	defer mon.Task()(&ctx)(nil)
+
+	result.Healthy = true
+	result.Checks = make([]CheckResult, 0, len(probe.names))
+	for _, name := range probe.names {
+		err := probe.run(ctx, probe.checks[name])
+
+		check := CheckResult{
+			Name:    name,
+			Healthy: err == nil,
+		}
+		if err != nil {
+			check.Error = err.Error()
+			result.Healthy = false
+		}
+		check.Since = probe.update(name, check.Healthy)
+
+		result.Checks = append(result.Checks, check)
+	}
+	return result
+}
+
+// run runs a single check with the timeout of the probe.
+func (probe *Probe) run(ctx context.Context, check CheckFunc) error {
+	if probe.timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, probe.timeout)
+		defer cancel()
+	}
+	return check(ctx)
+}
+
+// update records the result of the check and returns since when the check
+// has the result.
+func (probe *Probe) update(name string, healthy bool) time.Time {
+	probe.mu.Lock()
+	defer probe.mu.Unlock()
+
+	state, ok := probe.state[name]
+	if !ok || state.healthy != healthy {
+		state = checkState{healthy: healthy, since: probe.nowFunc()}
+		probe.state[name] = state
+	}
+	return state.since
+}
+
+// checkNames returns the sorted names of the checks.
+func checkNames(checks map[string]CheckFunc) []string {
+	names := make([]string, 0, len(checks))
+	for name := range checks {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
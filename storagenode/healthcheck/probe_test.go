@@ -0,0 +1,105 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package healthcheck_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"storj.io/common/identity/testidentity"
+	"storj.io/common/testcontext"
+	"storj.io/storj/storagenode/healthcheck"
+)
+
+func TestProbe(t *testing.T) {
+	ctx := testcontext.New(t)
+
+	var contactErr error
+	checks := map[string]healthcheck.CheckFunc{
+		"database": func(ctx context.Context) error { return nil },
+		"contact":  func(ctx context.Context) error { return contactErr },
+	}
+
+	_, err := healthcheck.NewProbe("database,unknown", checks, 0)
+	require.Error(t, err)
+
+	probe, err := healthcheck.NewProbe("database, contact,database", checks, 0)
+	require.NoError(t, err)
+
+	result := probe.Evaluate(ctx)
+	require.True(t, result.Healthy)
+	require.Len(t, result.Checks, 2)
+	require.Equal(t, "database", result.Checks[0].Name)
+	require.Equal(t, "contact", result.Checks[1].Name)
+	databaseSince := result.Checks[0].Since
+	require.False(t, databaseSince.IsZero())
+
+	contactErr = errors.New("not checked in")
+	result = probe.Evaluate(ctx)
+	require.False(t, result.Healthy)
+	require.True(t, result.Checks[0].Healthy)
+	require.Equal(t, databaseSince, result.Checks[0].Since)
+	require.False(t, result.Checks[1].Healthy)
+	require.Equal(t, "not checked in", result.Checks[1].Error)
+	failingSince := result.Checks[1].Since
+
+	// the failure keeps the time it started
+	result = probe.Evaluate(ctx)
+	require.False(t, result.Healthy)
+	require.Equal(t, failingSince, result.Checks[1].Since)
+
+	contactErr = nil
+	result = probe.Evaluate(ctx)
+	require.True(t, result.Healthy)
+	require.Empty(t, result.Checks[1].Error)
+	require.False(t, result.Checks[1].Since.Before(failingSince))
+}
+
+func TestProbeEmpty(t *testing.T) {
+	ctx := testcontext.New(t)
+
+	probe, err := healthcheck.NewProbe("", nil, 0)
+	require.NoError(t, err)
+
+	result := probe.Evaluate(ctx)
+	require.True(t, result.Healthy)
+	require.Empty(t, result.Checks)
+}
+
+func TestIdentityCheck(t *testing.T) {
+	ctx := testcontext.New(t)
+
+	require.Error(t, healthcheck.IdentityCheck(nil)(ctx))
+
+	ident, err := testidentity.NewTestIdentity(ctx)
+	require.NoError(t, err)
+	require.NoError(t, healthcheck.IdentityCheck(ident)(ctx))
+
+	other, err := testidentity.NewTestIdentity(ctx)
+	require.NoError(t, err)
+	mismatched := *ident
+	mismatched.ID = other.ID
+	require.Error(t, healthcheck.IdentityCheck(&mismatched)(ctx))
+}
+
+func TestContactCheck(t *testing.T) {
+	ctx := testcontext.New(t)
+
+	var lastCheckIn time.Time
+	check := healthcheck.ContactCheck(func() time.Time { return lastCheckIn }, time.Hour)
+	require.Error(t, check(ctx))
+
+	lastCheckIn = time.Now().Add(-time.Minute)
+	require.NoError(t, check(ctx))
+
+	// the node stopped checking in.
+	lastCheckIn = time.Now().Add(-2 * time.Hour)
+	require.Error(t, check(ctx))
+
+	require.NoError(t, healthcheck.ContactCheck(func() time.Time { return lastCheckIn }, 0)(ctx))
+}
@@ -386,6 +386,209 @@ func (m *GracefulExitFeasibilityResponse) GetIsAllowed() bool {
 	return false
 }
 
+type InitiateShrinkRequest struct {
+	NodeId               NodeID   `protobuf:"bytes,1,opt,name=node_id,json=nodeId,proto3,customtype=NodeID" json:"node_id"`
+	Bytes                int64    `protobuf:"varint,2,opt,name=bytes,proto3" json:"bytes,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *InitiateShrinkRequest) Reset()         { *m = InitiateShrinkRequest{} }
+func (m *InitiateShrinkRequest) String() string { return proto.CompactTextString(m) }
+func (*InitiateShrinkRequest) ProtoMessage()    {}
+func (*InitiateShrinkRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8f0acbf2ce5fa631, []int{9}
+}
+func (m *InitiateShrinkRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_InitiateShrinkRequest.Unmarshal(m, b)
+}
+func (m *InitiateShrinkRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_InitiateShrinkRequest.Marshal(b, m, deterministic)
+}
+func (m *InitiateShrinkRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InitiateShrinkRequest.Merge(m, src)
+}
+func (m *InitiateShrinkRequest) XXX_Size() int {
+	return xxx_messageInfo_InitiateShrinkRequest.Size(m)
+}
+func (m *InitiateShrinkRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_InitiateShrinkRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_InitiateShrinkRequest proto.InternalMessageInfo
+
+func (m *InitiateShrinkRequest) GetBytes() int64 {
+	if m != nil {
+		return m.Bytes
+	}
+	return 0
+}
+
+type GetShrinkStatusRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetShrinkStatusRequest) Reset()         { *m = GetShrinkStatusRequest{} }
+func (m *GetShrinkStatusRequest) String() string { return proto.CompactTextString(m) }
+func (*GetShrinkStatusRequest) ProtoMessage()    {}
+func (*GetShrinkStatusRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8f0acbf2ce5fa631, []int{10}
+}
+func (m *GetShrinkStatusRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetShrinkStatusRequest.Unmarshal(m, b)
+}
+func (m *GetShrinkStatusRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetShrinkStatusRequest.Marshal(b, m, deterministic)
+}
+func (m *GetShrinkStatusRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetShrinkStatusRequest.Merge(m, src)
+}
+func (m *GetShrinkStatusRequest) XXX_Size() int {
+	return xxx_messageInfo_GetShrinkStatusRequest.Size(m)
+}
+func (m *GetShrinkStatusRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetShrinkStatusRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetShrinkStatusRequest proto.InternalMessageInfo
+
+type GetShrinkStatusResponse struct {
+	Statuses             []*ShrinkStatus `protobuf:"bytes,1,rep,name=statuses,proto3" json:"statuses,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *GetShrinkStatusResponse) Reset()         { *m = GetShrinkStatusResponse{} }
+func (m *GetShrinkStatusResponse) String() string { return proto.CompactTextString(m) }
+func (*GetShrinkStatusResponse) ProtoMessage()    {}
+func (*GetShrinkStatusResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8f0acbf2ce5fa631, []int{11}
+}
+func (m *GetShrinkStatusResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetShrinkStatusResponse.Unmarshal(m, b)
+}
+func (m *GetShrinkStatusResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetShrinkStatusResponse.Marshal(b, m, deterministic)
+}
+func (m *GetShrinkStatusResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetShrinkStatusResponse.Merge(m, src)
+}
+func (m *GetShrinkStatusResponse) XXX_Size() int {
+	return xxx_messageInfo_GetShrinkStatusResponse.Size(m)
+}
+func (m *GetShrinkStatusResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetShrinkStatusResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetShrinkStatusResponse proto.InternalMessageInfo
+
+func (m *GetShrinkStatusResponse) GetStatuses() []*ShrinkStatus {
+	if m != nil {
+		return m.Statuses
+	}
+	return nil
+}
+
+// ShrinkStatus contains the progress of moving part of the data of a storagenode off to other nodes.
+type ShrinkStatus struct {
+	DomainName           string   `protobuf:"bytes,1,opt,name=domain_name,json=domainName,proto3" json:"domain_name,omitempty"`
+	NodeId               NodeID   `protobuf:"bytes,2,opt,name=node_id,json=nodeId,proto3,customtype=NodeID" json:"node_id"`
+	RequestedBytes       int64    `protobuf:"varint,3,opt,name=requested_bytes,json=requestedBytes,proto3" json:"requested_bytes,omitempty"`
+	SelectedBytes        int64    `protobuf:"varint,4,opt,name=selected_bytes,json=selectedBytes,proto3" json:"selected_bytes,omitempty"`
+	BytesTransferred     int64    `protobuf:"varint,5,opt,name=bytes_transferred,json=bytesTransferred,proto3" json:"bytes_transferred,omitempty"`
+	PiecesTransferred    int64    `protobuf:"varint,6,opt,name=pieces_transferred,json=piecesTransferred,proto3" json:"pieces_transferred,omitempty"`
+	PiecesFailed         int64    `protobuf:"varint,7,opt,name=pieces_failed,json=piecesFailed,proto3" json:"pieces_failed,omitempty"`
+	Selected             bool     `protobuf:"varint,8,opt,name=selected,proto3" json:"selected,omitempty"`
+	Finished             bool     `protobuf:"varint,9,opt,name=finished,proto3" json:"finished,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ShrinkStatus) Reset()         { *m = ShrinkStatus{} }
+func (m *ShrinkStatus) String() string { return proto.CompactTextString(m) }
+func (*ShrinkStatus) ProtoMessage()    {}
+func (*ShrinkStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8f0acbf2ce5fa631, []int{12}
+}
+func (m *ShrinkStatus) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ShrinkStatus.Unmarshal(m, b)
+}
+func (m *ShrinkStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ShrinkStatus.Marshal(b, m, deterministic)
+}
+func (m *ShrinkStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ShrinkStatus.Merge(m, src)
+}
+func (m *ShrinkStatus) XXX_Size() int {
+	return xxx_messageInfo_ShrinkStatus.Size(m)
+}
+func (m *ShrinkStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_ShrinkStatus.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ShrinkStatus proto.InternalMessageInfo
+
+func (m *ShrinkStatus) GetDomainName() string {
+	if m != nil {
+		return m.DomainName
+	}
+	return ""
+}
+
+func (m *ShrinkStatus) GetRequestedBytes() int64 {
+	if m != nil {
+		return m.RequestedBytes
+	}
+	return 0
+}
+
+func (m *ShrinkStatus) GetSelectedBytes() int64 {
+	if m != nil {
+		return m.SelectedBytes
+	}
+	return 0
+}
+
+func (m *ShrinkStatus) GetBytesTransferred() int64 {
+	if m != nil {
+		return m.BytesTransferred
+	}
+	return 0
+}
+
+func (m *ShrinkStatus) GetPiecesTransferred() int64 {
+	if m != nil {
+		return m.PiecesTransferred
+	}
+	return 0
+}
+
+func (m *ShrinkStatus) GetPiecesFailed() int64 {
+	if m != nil {
+		return m.PiecesFailed
+	}
+	return 0
+}
+
+func (m *ShrinkStatus) GetSelected() bool {
+	if m != nil {
+		return m.Selected
+	}
+	return false
+}
+
+func (m *ShrinkStatus) GetFinished() bool {
+	if m != nil {
+		return m.Finished
+	}
+	return false
+}
+
 func init() {
 	proto.RegisterType((*GetNonExitingSatellitesRequest)(nil), "storagenode.gracefulexit.GetNonExitingSatellitesRequest")
 	proto.RegisterType((*GetNonExitingSatellitesResponse)(nil), "storagenode.gracefulexit.GetNonExitingSatellitesResponse")
@@ -396,49 +599,66 @@ func init() {
 	proto.RegisterType((*ExitProgress)(nil), "storagenode.gracefulexit.ExitProgress")
 	proto.RegisterType((*GracefulExitFeasibilityRequest)(nil), "storagenode.gracefulexit.GracefulExitFeasibilityRequest")
 	proto.RegisterType((*GracefulExitFeasibilityResponse)(nil), "storagenode.gracefulexit.GracefulExitFeasibilityResponse")
+	proto.RegisterType((*InitiateShrinkRequest)(nil), "storagenode.gracefulexit.InitiateShrinkRequest")
+	proto.RegisterType((*GetShrinkStatusRequest)(nil), "storagenode.gracefulexit.GetShrinkStatusRequest")
+	proto.RegisterType((*GetShrinkStatusResponse)(nil), "storagenode.gracefulexit.GetShrinkStatusResponse")
+	proto.RegisterType((*ShrinkStatus)(nil), "storagenode.gracefulexit.ShrinkStatus")
 }
 
 func init() { proto.RegisterFile("gracefulexit.proto", fileDescriptor_8f0acbf2ce5fa631) }
 
 var fileDescriptor_8f0acbf2ce5fa631 = []byte{
-	// 612 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x53, 0x4f, 0x6f, 0xd3, 0x4e,
-	0x10, 0xfd, 0xb9, 0xff, 0x7e, 0xc9, 0xb4, 0x6a, 0xcb, 0x82, 0xc0, 0x0a, 0xa2, 0x8e, 0x2c, 0x41,
-	0xc3, 0xa1, 0x0e, 0x14, 0x21, 0xc1, 0xb1, 0x01, 0x5a, 0xe5, 0x40, 0x85, 0x16, 0xb8, 0x20, 0x21,
-	0x6b, 0x63, 0x4f, 0xcd, 0x56, 0xf6, 0xae, 0xeb, 0x5d, 0x43, 0xb9, 0xf0, 0x11, 0x10, 0xdf, 0x81,
-	0x2b, 0x1f, 0x84, 0x33, 0x47, 0x0e, 0xe5, 0xab, 0x20, 0xdb, 0xdb, 0x60, 0xda, 0xd8, 0x6a, 0xb9,
-	0x25, 0x6f, 0x66, 0x9e, 0x67, 0xde, 0xbe, 0x07, 0x24, 0xca, 0x58, 0x80, 0x07, 0x79, 0x8c, 0xc7,
-	0x5c, 0x7b, 0x69, 0x26, 0xb5, 0x24, 0xb6, 0xd2, 0x32, 0x63, 0x11, 0x0a, 0x19, 0xa2, 0x57, 0xaf,
-	0xf7, 0x20, 0x92, 0x91, 0xac, 0xba, 0x7a, 0x4e, 0x24, 0x65, 0x14, 0xe3, 0xb0, 0xfc, 0x37, 0xc9,
-	0x0f, 0x86, 0x9a, 0x27, 0xa8, 0x34, 0x4b, 0xd2, 0xaa, 0xc1, 0xed, 0xc3, 0xc6, 0x1e, 0xea, 0x7d,
-	0x29, 0x9e, 0x1d, 0x73, 0xcd, 0x45, 0xf4, 0x92, 0x69, 0x8c, 0x63, 0xae, 0x51, 0x51, 0x3c, 0xca,
-	0x51, 0x69, 0x37, 0x05, 0xa7, 0xb1, 0x43, 0xa5, 0x52, 0x28, 0x24, 0xcf, 0x01, 0xd4, 0x14, 0xb5,
-	0xad, 0xfe, 0xfc, 0x60, 0x79, 0x7b, 0xcb, 0x6b, 0x5a, 0xd0, 0x9b, 0xc1, 0x45, 0x6b, 0x04, 0xee,
-	0x27, 0xb8, 0x3a, 0xa3, 0x85, 0x6c, 0xc2, 0xff, 0x05, 0x97, 0xcf, 0x43, 0xdb, 0xea, 0x5b, 0x83,
-	0x95, 0xd1, 0xea, 0xf7, 0x13, 0xe7, 0xbf, 0x9f, 0x27, 0xce, 0xd2, 0xbe, 0x0c, 0x71, 0xfc, 0x94,
-	0x2e, 0x15, 0xe5, 0x71, 0x48, 0x1c, 0x58, 0x0e, 0x65, 0xc2, 0xb8, 0xf0, 0x05, 0x4b, 0xd0, 0x9e,
-	0xeb, 0x5b, 0x83, 0x2e, 0x85, 0x0a, 0xda, 0x67, 0x09, 0x92, 0x5b, 0x00, 0x2a, 0x65, 0x01, 0xfa,
-	0xb9, 0xc2, 0xd0, 0x9e, 0xef, 0x5b, 0x03, 0x8b, 0x76, 0x4b, 0xe4, 0xb5, 0xc2, 0xd0, 0xdd, 0x85,
-	0x9b, 0x63, 0xc1, 0x35, 0x67, 0x1a, 0xf7, 0xcc, 0xde, 0xc5, 0x32, 0x46, 0x90, 0x0b, 0xef, 0xe1,
-	0xda, 0x70, 0x7d, 0x0f, 0x75, 0x31, 0xfa, 0x22, 0x93, 0x51, 0x86, 0x6a, 0xaa, 0xe9, 0x5b, 0xb8,
-	0x71, 0xae, 0x62, 0xb4, 0x1c, 0x41, 0x27, 0x35, 0x98, 0x51, 0xf2, 0x4e, 0xb3, 0x92, 0x7f, 0x31,
-	0x4c, 0xe7, 0xdc, 0x1f, 0x16, 0xac, 0xd4, 0x4b, 0x67, 0x15, 0xb1, 0xce, 0x29, 0x52, 0xbb, 0x69,
-	0xae, 0x55, 0xdb, 0xbb, 0xb0, 0x9e, 0x62, 0x16, 0xa0, 0xd0, 0x7e, 0x20, 0x93, 0x34, 0x46, 0x8d,
-	0xa5, 0x80, 0x73, 0x74, 0xcd, 0xe0, 0x4f, 0x0c, 0x4c, 0x36, 0x00, 0x54, 0x1e, 0x04, 0xa8, 0xd4,
-	0x41, 0x1e, 0xdb, 0x0b, 0x7d, 0x6b, 0xd0, 0xa1, 0x35, 0x84, 0x6c, 0x01, 0x31, 0x14, 0x5c, 0x0a,
-	0x3f, 0xc3, 0x00, 0x79, 0xaa, 0xed, 0xc5, 0xe2, 0xf3, 0xf4, 0xca, 0x9f, 0x0a, 0xad, 0x0a, 0xee,
-	0x18, 0x36, 0xea, 0xaf, 0xb1, 0x8b, 0x4c, 0xf1, 0x09, 0x8f, 0xb9, 0xfe, 0x78, 0xe9, 0x87, 0xf9,
-	0x66, 0x81, 0xd3, 0xc8, 0x65, 0xde, 0x61, 0x07, 0xba, 0x87, 0x92, 0x0b, 0x0c, 0x7d, 0xa6, 0x4b,
-	0xba, 0xe5, 0xed, 0x9e, 0x57, 0xa5, 0xc9, 0x3b, 0x4d, 0x93, 0xf7, 0xea, 0x34, 0x4d, 0xa3, 0x4e,
-	0xf1, 0xa9, 0x2f, 0xbf, 0x1c, 0x8b, 0x76, 0xaa, 0xb1, 0x9d, 0x62, 0x9f, 0xb5, 0x44, 0x0a, 0xfd,
-	0x4e, 0xf9, 0x19, 0x1e, 0xe5, 0x3c, 0xc3, 0x4a, 0xdc, 0x45, 0xba, 0x5a, 0xc1, 0xd4, 0xa0, 0x85,
-	0x1f, 0xb9, 0xf2, 0x59, 0x1c, 0xcb, 0x0f, 0xc6, 0x8f, 0x1d, 0xda, 0xe5, 0x6a, 0xa7, 0x02, 0xb6,
-	0xbf, 0x2e, 0xc0, 0x7a, 0x71, 0x41, 0x7d, 0x65, 0xf2, 0xd9, 0x2a, 0x3d, 0x34, 0x2b, 0x97, 0xe4,
-	0x51, 0xb3, 0x63, 0xda, 0xc3, 0xde, 0x7b, 0xfc, 0x0f, 0x93, 0x46, 0xb0, 0x1c, 0xae, 0xcd, 0x4a,
-	0x0d, 0x79, 0xd8, 0x4c, 0xd9, 0x92, 0xb2, 0xde, 0x05, 0x5d, 0x4f, 0xde, 0xc3, 0xda, 0x99, 0x28,
-	0x91, 0x7b, 0xad, 0x47, 0xcc, 0xc8, 0x63, 0xef, 0xfe, 0x25, 0x26, 0xcc, 0xb9, 0xa5, 0xfe, 0xb3,
-	0x3d, 0xd4, 0xaa, 0x7f, 0xab, 0x85, 0x5b, 0xf5, 0x6f, 0x37, 0xec, 0x68, 0xf3, 0xcd, 0xed, 0x62,
-	0xf6, 0xd0, 0xe3, 0x72, 0x58, 0xfe, 0x18, 0xd6, 0xa8, 0x86, 0x5c, 0x68, 0xcc, 0x04, 0x8b, 0xd3,
-	0xc9, 0x64, 0xa9, 0xb4, 0xef, 0x83, 0xdf, 0x01, 0x00, 0x00, 0xff, 0xff, 0x0e, 0x75, 0x55, 0x23,
-	0x56, 0x06, 0x00, 0x00,
+	// 819 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x55, 0xcf, 0x6f, 0x1b, 0x45,
+	0x14, 0x66, 0x93, 0xc6, 0xb5, 0x5f, 0x52, 0x27, 0x19, 0x0a, 0xac, 0x16, 0x11, 0x5b, 0x8b, 0x4a,
+	0x8c, 0x50, 0xd7, 0x34, 0x08, 0x89, 0x1e, 0x63, 0x20, 0x51, 0x0e, 0x44, 0x68, 0x53, 0x38, 0x20,
+	0xa1, 0xd5, 0x78, 0xf7, 0x79, 0x33, 0x65, 0x3d, 0xb3, 0xdd, 0x99, 0x85, 0xf6, 0xc2, 0x91, 0x23,
+	0xe2, 0x0f, 0xe1, 0x0f, 0xe1, 0xcc, 0x91, 0x43, 0x11, 0xff, 0x09, 0x9a, 0x1f, 0x76, 0xd6, 0xa9,
+	0x6d, 0x92, 0x8a, 0x9b, 0xe7, 0xfb, 0xbe, 0xf7, 0xf6, 0xbd, 0x6f, 0xde, 0x3c, 0x03, 0xc9, 0x2b,
+	0x9a, 0xe2, 0xa4, 0x2e, 0xf0, 0x39, 0x53, 0x51, 0x59, 0x09, 0x25, 0x88, 0x2f, 0x95, 0xa8, 0x68,
+	0x8e, 0x5c, 0x64, 0x18, 0x35, 0xf9, 0x00, 0x72, 0x91, 0x0b, 0xab, 0x0a, 0x7a, 0xb9, 0x10, 0x79,
+	0x81, 0x43, 0x73, 0x1a, 0xd7, 0x93, 0xa1, 0x62, 0x53, 0x94, 0x8a, 0x4e, 0x4b, 0x2b, 0x08, 0xfb,
+	0x70, 0x70, 0x8a, 0xea, 0x5c, 0xf0, 0x2f, 0x9f, 0x33, 0xc5, 0x78, 0x7e, 0x41, 0x15, 0x16, 0x05,
+	0x53, 0x28, 0x63, 0x7c, 0x56, 0xa3, 0x54, 0x61, 0x09, 0xbd, 0x95, 0x0a, 0x59, 0x0a, 0x2e, 0x91,
+	0x7c, 0x05, 0x20, 0xe7, 0xa8, 0xef, 0xf5, 0x37, 0x07, 0xdb, 0x47, 0x0f, 0xa3, 0x55, 0x05, 0x46,
+	0x4b, 0x72, 0xc5, 0x8d, 0x04, 0xe1, 0xcf, 0xf0, 0xe6, 0x12, 0x09, 0x39, 0x84, 0xbb, 0x3a, 0x57,
+	0xc2, 0x32, 0xdf, 0xeb, 0x7b, 0x83, 0x9d, 0x51, 0xf7, 0x8f, 0x97, 0xbd, 0x37, 0xfe, 0x7a, 0xd9,
+	0x6b, 0x9d, 0x8b, 0x0c, 0xcf, 0xbe, 0x88, 0x5b, 0x9a, 0x3e, 0xcb, 0x48, 0x0f, 0xb6, 0x33, 0x31,
+	0xa5, 0x8c, 0x27, 0x9c, 0x4e, 0xd1, 0xdf, 0xe8, 0x7b, 0x83, 0x4e, 0x0c, 0x16, 0x3a, 0xa7, 0x53,
+	0x24, 0xef, 0x01, 0xc8, 0x92, 0xa6, 0x98, 0xd4, 0x12, 0x33, 0x7f, 0xb3, 0xef, 0x0d, 0xbc, 0xb8,
+	0x63, 0x90, 0x6f, 0x24, 0x66, 0xe1, 0x09, 0xbc, 0x7b, 0xc6, 0x99, 0x62, 0x54, 0xe1, 0xa9, 0xab,
+	0x5b, 0x17, 0xe3, 0x0c, 0xb9, 0x71, 0x1d, 0xa1, 0x0f, 0x6f, 0x9f, 0xa2, 0xd2, 0xa1, 0x5f, 0x57,
+	0x22, 0xaf, 0x50, 0xce, 0x3d, 0xfd, 0x1e, 0xde, 0x79, 0x85, 0x71, 0x5e, 0x8e, 0xa0, 0x5d, 0x3a,
+	0xcc, 0x39, 0xf9, 0xc1, 0x6a, 0x27, 0x17, 0x32, 0xcc, 0xe3, 0xc2, 0x3f, 0x3d, 0xd8, 0x69, 0x52,
+	0xd7, 0x1d, 0xf1, 0x5e, 0x71, 0xa4, 0xd1, 0xd3, 0xc6, 0x5a, 0x6f, 0x3f, 0x84, 0xbd, 0x12, 0xab,
+	0x14, 0xb9, 0x4a, 0x52, 0x31, 0x2d, 0x0b, 0x54, 0x68, 0x0c, 0xdc, 0x88, 0x77, 0x1d, 0xfe, 0xb9,
+	0x83, 0xc9, 0x01, 0x80, 0xac, 0xd3, 0x14, 0xa5, 0x9c, 0xd4, 0x85, 0x7f, 0xa7, 0xef, 0x0d, 0xda,
+	0x71, 0x03, 0x21, 0x0f, 0x81, 0xb8, 0x14, 0x4c, 0xf0, 0xa4, 0xc2, 0x14, 0x59, 0xa9, 0xfc, 0x2d,
+	0xfd, 0xf9, 0x78, 0xff, 0x8a, 0x89, 0x2d, 0x11, 0x9e, 0xc1, 0x41, 0xf3, 0x36, 0x4e, 0x90, 0x4a,
+	0x36, 0x66, 0x05, 0x53, 0x2f, 0x6e, 0x7d, 0x31, 0xbf, 0x7b, 0xd0, 0x5b, 0x99, 0xcb, 0xdd, 0xc3,
+	0x31, 0x74, 0x9e, 0x0a, 0xc6, 0x31, 0x4b, 0xa8, 0x32, 0xe9, 0xb6, 0x8f, 0x82, 0xc8, 0xbe, 0xa6,
+	0x68, 0xf6, 0x9a, 0xa2, 0x27, 0xb3, 0xd7, 0x34, 0x6a, 0xeb, 0x4f, 0xfd, 0xf6, 0x77, 0xcf, 0x8b,
+	0xdb, 0x36, 0xec, 0x58, 0xd7, 0xb3, 0x3b, 0x15, 0x5c, 0x5d, 0xca, 0xa4, 0xc2, 0x67, 0x35, 0xab,
+	0xd0, 0x9a, 0xbb, 0x15, 0x77, 0x2d, 0x1c, 0x3b, 0x54, 0xcf, 0x23, 0x93, 0x09, 0x2d, 0x0a, 0xf1,
+	0x93, 0x9b, 0xc7, 0x76, 0xdc, 0x61, 0xf2, 0xd8, 0x02, 0xe1, 0xb7, 0xf0, 0xd6, 0x6c, 0x1e, 0x2f,
+	0x2e, 0x2b, 0xc6, 0x7f, 0xb8, 0x6d, 0xc3, 0xe4, 0x3e, 0x6c, 0x8d, 0x5f, 0xe8, 0xb7, 0xa9, 0xbf,
+	0xbf, 0x19, 0xdb, 0x83, 0x9b, 0x4f, 0x9b, 0xf2, 0x42, 0x51, 0x55, 0x5f, 0x9b, 0xcf, 0x45, 0xe6,
+	0x6a, 0x3e, 0xa5, 0x41, 0xf0, 0x06, 0xf3, 0xb9, 0x90, 0x61, 0x1e, 0x17, 0xfe, 0xb3, 0x01, 0x3b,
+	0x4d, 0xea, 0x7f, 0x9c, 0xcf, 0x43, 0xd8, 0xad, 0x6c, 0x13, 0x98, 0x25, 0xb6, 0xe7, 0x4d, 0xd3,
+	0x73, 0x77, 0x0e, 0x8f, 0x34, 0x4a, 0x1e, 0x40, 0x57, 0x62, 0x81, 0xe9, 0x95, 0xee, 0x8e, 0xd1,
+	0xdd, 0x9b, 0xa1, 0x56, 0xf6, 0x11, 0xec, 0x1b, 0x36, 0x51, 0x15, 0xe5, 0x72, 0x82, 0x95, 0xbe,
+	0xc5, 0x2d, 0xa3, 0xdc, 0x33, 0xc4, 0x93, 0x2b, 0x5c, 0x4f, 0x74, 0xc9, 0x30, 0xbd, 0xa6, 0x6e,
+	0x19, 0xf5, 0xbe, 0x65, 0x9a, 0xf2, 0xf7, 0xe1, 0x9e, 0x93, 0x4f, 0x28, 0x2b, 0x30, 0xf3, 0xef,
+	0x1a, 0xe5, 0x8e, 0x05, 0x4f, 0x0c, 0x46, 0x02, 0x68, 0xcf, 0x2a, 0xf2, 0xdb, 0x66, 0x32, 0xe6,
+	0x67, 0xcd, 0x4d, 0x18, 0x67, 0xf2, 0x12, 0x33, 0xbf, 0x63, 0xb9, 0xd9, 0xf9, 0xe8, 0x97, 0x16,
+	0xec, 0x69, 0x6f, 0x9a, 0x73, 0x4e, 0x7e, 0xf5, 0xcc, 0xc5, 0x2e, 0x5b, 0xe6, 0xe4, 0xb3, 0xd5,
+	0xd7, 0xb8, 0xfe, 0x1f, 0x22, 0x78, 0xfc, 0x1a, 0x91, 0x6e, 0x9a, 0x6a, 0xb8, 0xbf, 0x6c, 0xd5,
+	0x92, 0x4f, 0x57, 0xa7, 0x5c, 0xb3, 0x9a, 0x83, 0x1b, 0xae, 0x4a, 0xf2, 0x23, 0xec, 0x5e, 0xdb,
+	0xbf, 0xe4, 0xe3, 0xb5, 0x4d, 0x2c, 0x59, 0xe2, 0xc1, 0xa3, 0x5b, 0x44, 0xb8, 0x76, 0x8d, 0xff,
+	0xcb, 0x17, 0xcf, 0x5a, 0xff, 0xd7, 0xee, 0xbd, 0xe0, 0xf1, 0x6b, 0x44, 0xba, 0x82, 0x18, 0x74,
+	0x17, 0x57, 0x0b, 0x19, 0xfe, 0xb7, 0xf3, 0x0b, 0x4b, 0x28, 0xb8, 0xe1, 0xf3, 0x77, 0x9e, 0x2f,
+	0x40, 0xeb, 0x3d, 0x5f, 0xb2, 0x98, 0x82, 0x47, 0xb7, 0x88, 0xb0, 0x2d, 0x8e, 0x0e, 0xbf, 0x7b,
+	0xa0, 0x63, 0x9e, 0x46, 0x4c, 0x0c, 0xcd, 0x8f, 0x61, 0x23, 0xc5, 0x90, 0x71, 0x85, 0x15, 0xa7,
+	0x45, 0x39, 0x1e, 0xb7, 0xcc, 0x5a, 0xff, 0xe4, 0xdf, 0x01, 0x00, 0x50, 0x50, 0xf6, 0xc1, 0x6e,
+	0x09, 0x00, 0x00,
 }
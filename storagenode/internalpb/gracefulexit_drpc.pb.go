@@ -1,5 +1,5 @@
 // Code generated by protoc-gen-go-drpc. DO NOT EDIT.
-// protoc-gen-go-drpc version: v0.0.20
+// protoc-gen-go-drpc version: v0.0.32
 // source: gracefulexit.proto
 
 package internalpb
@@ -46,6 +46,8 @@ type DRPCNodeGracefulExitClient interface {
 	InitiateGracefulExit(ctx context.Context, in *InitiateGracefulExitRequest) (*ExitProgress, error)
 	GetExitProgress(ctx context.Context, in *GetExitProgressRequest) (*GetExitProgressResponse, error)
 	GracefulExitFeasibility(ctx context.Context, in *GracefulExitFeasibilityRequest) (*GracefulExitFeasibilityResponse, error)
+	InitiateShrink(ctx context.Context, in *InitiateShrinkRequest) (*ShrinkStatus, error)
+	GetShrinkStatus(ctx context.Context, in *GetShrinkStatusRequest) (*GetShrinkStatusResponse, error)
 }
 
 type drpcNodeGracefulExitClient struct {
@@ -94,34 +96,62 @@ func (c *drpcNodeGracefulExitClient) GracefulExitFeasibility(ctx context.Context
 	return out, nil
 }
 
+func (c *drpcNodeGracefulExitClient) InitiateShrink(ctx context.Context, in *InitiateShrinkRequest) (*ShrinkStatus, error) {
+	out := new(ShrinkStatus)
+	err := c.cc.Invoke(ctx, "/storagenode.gracefulexit.NodeGracefulExit/InitiateShrink", drpcEncoding_File_gracefulexit_proto{}, in, out)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *drpcNodeGracefulExitClient) GetShrinkStatus(ctx context.Context, in *GetShrinkStatusRequest) (*GetShrinkStatusResponse, error) {
+	out := new(GetShrinkStatusResponse)
+	err := c.cc.Invoke(ctx, "/storagenode.gracefulexit.NodeGracefulExit/GetShrinkStatus", drpcEncoding_File_gracefulexit_proto{}, in, out)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 type DRPCNodeGracefulExitServer interface {
 	GetNonExitingSatellites(context.Context, *GetNonExitingSatellitesRequest) (*GetNonExitingSatellitesResponse, error)
 	InitiateGracefulExit(context.Context, *InitiateGracefulExitRequest) (*ExitProgress, error)
 	GetExitProgress(context.Context, *GetExitProgressRequest) (*GetExitProgressResponse, error)
 	GracefulExitFeasibility(context.Context, *GracefulExitFeasibilityRequest) (*GracefulExitFeasibilityResponse, error)
+	InitiateShrink(context.Context, *InitiateShrinkRequest) (*ShrinkStatus, error)
+	GetShrinkStatus(context.Context, *GetShrinkStatusRequest) (*GetShrinkStatusResponse, error)
 }
 
 type DRPCNodeGracefulExitUnimplementedServer struct{}
 
 func (s *DRPCNodeGracefulExitUnimplementedServer) GetNonExitingSatellites(context.Context, *GetNonExitingSatellitesRequest) (*GetNonExitingSatellitesResponse, error) {
-	return nil, drpcerr.WithCode(errors.New("Unimplemented"), 12)
+	return nil, drpcerr.WithCode(errors.New("Unimplemented"), drpcerr.Unimplemented)
 }
 
 func (s *DRPCNodeGracefulExitUnimplementedServer) InitiateGracefulExit(context.Context, *InitiateGracefulExitRequest) (*ExitProgress, error) {
-	return nil, drpcerr.WithCode(errors.New("Unimplemented"), 12)
+	return nil, drpcerr.WithCode(errors.New("Unimplemented"), drpcerr.Unimplemented)
 }
 
 func (s *DRPCNodeGracefulExitUnimplementedServer) GetExitProgress(context.Context, *GetExitProgressRequest) (*GetExitProgressResponse, error) {
-	return nil, drpcerr.WithCode(errors.New("Unimplemented"), 12)
+	return nil, drpcerr.WithCode(errors.New("Unimplemented"), drpcerr.Unimplemented)
 }
 
 func (s *DRPCNodeGracefulExitUnimplementedServer) GracefulExitFeasibility(context.Context, *GracefulExitFeasibilityRequest) (*GracefulExitFeasibilityResponse, error) {
-	return nil, drpcerr.WithCode(errors.New("Unimplemented"), 12)
+	return nil, drpcerr.WithCode(errors.New("Unimplemented"), drpcerr.Unimplemented)
+}
+
+func (s *DRPCNodeGracefulExitUnimplementedServer) InitiateShrink(context.Context, *InitiateShrinkRequest) (*ShrinkStatus, error) {
+	return nil, drpcerr.WithCode(errors.New("Unimplemented"), drpcerr.Unimplemented)
+}
+
+func (s *DRPCNodeGracefulExitUnimplementedServer) GetShrinkStatus(context.Context, *GetShrinkStatusRequest) (*GetShrinkStatusResponse, error) {
+	return nil, drpcerr.WithCode(errors.New("Unimplemented"), drpcerr.Unimplemented)
 }
 
 type DRPCNodeGracefulExitDescription struct{}
 
-func (DRPCNodeGracefulExitDescription) NumMethods() int { return 4 }
+func (DRPCNodeGracefulExitDescription) NumMethods() int { return 6 }
 
 func (DRPCNodeGracefulExitDescription) Method(n int) (string, drpc.Encoding, drpc.Receiver, interface{}, bool) {
 	switch n {
@@ -161,6 +191,24 @@ func (DRPCNodeGracefulExitDescription) Method(n int) (string, drpc.Encoding, drp
 						in1.(*GracefulExitFeasibilityRequest),
 					)
 			}, DRPCNodeGracefulExitServer.GracefulExitFeasibility, true
+	case 4:
+		return "/storagenode.gracefulexit.NodeGracefulExit/InitiateShrink", drpcEncoding_File_gracefulexit_proto{},
+			func(srv interface{}, ctx context.Context, in1, in2 interface{}) (drpc.Message, error) {
+				return srv.(DRPCNodeGracefulExitServer).
+					InitiateShrink(
+						ctx,
+						in1.(*InitiateShrinkRequest),
+					)
+			}, DRPCNodeGracefulExitServer.InitiateShrink, true
+	case 5:
+		return "/storagenode.gracefulexit.NodeGracefulExit/GetShrinkStatus", drpcEncoding_File_gracefulexit_proto{},
+			func(srv interface{}, ctx context.Context, in1, in2 interface{}) (drpc.Message, error) {
+				return srv.(DRPCNodeGracefulExitServer).
+					GetShrinkStatus(
+						ctx,
+						in1.(*GetShrinkStatusRequest),
+					)
+			}, DRPCNodeGracefulExitServer.GetShrinkStatus, true
 	default:
 		return "", nil, nil, nil, false
 	}
@@ -233,3 +281,35 @@ func (x *drpcNodeGracefulExit_GracefulExitFeasibilityStream) SendAndClose(m *Gra
 	}
 	return x.CloseSend()
 }
+
+type DRPCNodeGracefulExit_InitiateShrinkStream interface {
+	drpc.Stream
+	SendAndClose(*ShrinkStatus) error
+}
+
+type drpcNodeGracefulExit_InitiateShrinkStream struct {
+	drpc.Stream
+}
+
+func (x *drpcNodeGracefulExit_InitiateShrinkStream) SendAndClose(m *ShrinkStatus) error {
+	if err := x.MsgSend(m, drpcEncoding_File_gracefulexit_proto{}); err != nil {
+		return err
+	}
+	return x.CloseSend()
+}
+
+type DRPCNodeGracefulExit_GetShrinkStatusStream interface {
+	drpc.Stream
+	SendAndClose(*GetShrinkStatusResponse) error
+}
+
+type drpcNodeGracefulExit_GetShrinkStatusStream struct {
+	drpc.Stream
+}
+
+func (x *drpcNodeGracefulExit_GetShrinkStatusStream) SendAndClose(m *GetShrinkStatusResponse) error {
+	if err := x.MsgSend(m, drpcEncoding_File_gracefulexit_proto{}); err != nil {
+		return err
+	}
+	return x.CloseSend()
+}
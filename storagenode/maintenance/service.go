@@ -0,0 +1,143 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package maintenance
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/spacemonkeygo/monkit/v3"
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/common/fpath"
+)
+
+var (
+	mon = monkit.Package()
+
+	// Error is the default error class for maintenance package.
+	Error = errs.Class("maintenance")
+)
+
+// Config contains configurable values for maintenance mode.
+type Config struct {
+	Path string `help:"path to the file where the maintenance mode state is persisted" default:"$CONFDIR/maintenance.json"`
+}
+
+// State represents the maintenance mode state of the node.
+type State struct {
+	Enabled bool      `json:"enabled"`
+	Since   time.Time `json:"since"`
+}
+
+// Service keeps track of whether the node is in maintenance mode.
+//
+// While in maintenance mode the node does not accept new uploads and reports
+// no free space to satellites, but it continues to serve downloads, audits
+// and garbage collection. The state is persisted, so it survives restarts.
+//
+// architecture: Service
+type Service struct {
+	log      *zap.Logger
+	path     string
+	onChange func(context.Context)
+
+	mu    sync.Mutex
+	state State
+}
+
+// NewService loads the persisted maintenance state and returns a new service.
+// onChange, when not nil, is called every time the state changes.
+func NewService(log *zap.Logger, config Config, onChange func(context.Context)) (*Service, error) {
+	if config.Path == "" {
+		return nil, Error.New("path cannot be empty")
+	}
+
+	service := &Service{
+		log:      log,
+		path:     config.Path,
+		onChange: onChange,
+	}
+
+	data, err := os.ReadFile(config.Path)
+	switch {
+	case err == nil:
+		if err := json.Unmarshal(data, &service.state); err != nil {
+			return nil, Error.New("malformed state file: %w", err)
+		}
+	case errs.IsFunc(err, os.IsNotExist):
+	default:
+		return nil, Error.Wrap(err)
+	}
+
+	if service.state.Enabled {
+		log.Warn("Storage node is in maintenance mode, new uploads are not accepted.", zap.Time("Since", service.state.Since))
+	}
+
+	return service, nil
+}
+
+// Enabled returns true when the node is in maintenance mode.
+func (service *Service) Enabled() bool {
+	service.mu.Lock()
+	defer service.mu.Unlock()
+	return service.state.Enabled
+}
+
+// State returns the current maintenance state.
+func (service *Service) State() State {
+	service.mu.Lock()
+	defer service.mu.Unlock()
+	return service.state
+}
+
+// SetEnabled turns the maintenance mode on or off and persists the new state.
+func (service *Service) SetEnabled(ctx context.Context, enabled bool) (_ State, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	service.mu.Lock()
+	if service.state.Enabled == enabled {
+		state := service.state
+		service.mu.Unlock()
+		return state, nil
+	}
+
+	state := State{Enabled: enabled, Since: time.Now().UTC()}
+	if err := save(service.path, state); err != nil {
+		service.mu.Unlock()
+		return State{}, err
+	}
+	service.state = state
+	service.mu.Unlock()
+
+	if enabled {
+		service.log.Info("Maintenance mode enabled.")
+	} else {
+		service.log.Info("Maintenance mode disabled.")
+	}
+
+	if service.onChange != nil {
+		service.onChange(ctx)
+	}
+
+	return state, nil
+}
+
+// save persists the state to the given path.
+func save(path string, state State) error {
+	if err := os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+		return Error.New("unable to make state parent directory: %w", err)
+	}
+
+	data, err := json.MarshalIndent(state, "", "  ")
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	return Error.Wrap(fpath.AtomicWriteFile(path, data, 0644))
+}
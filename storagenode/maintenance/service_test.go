@@ -0,0 +1,66 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package maintenance_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+
+	"storj.io/common/testcontext"
+	"storj.io/storj/storagenode/maintenance"
+)
+
+func TestService(t *testing.T) {
+	ctx := testcontext.New(t)
+	log := zaptest.NewLogger(t)
+
+	config := maintenance.Config{
+		Path: ctx.File("maintenance", "state.json"),
+	}
+
+	changes := 0
+	service, err := maintenance.NewService(log, config, func(context.Context) { changes++ })
+	require.NoError(t, err)
+	require.False(t, service.Enabled())
+
+	state, err := service.SetEnabled(ctx, true)
+	require.NoError(t, err)
+	require.True(t, state.Enabled)
+	require.False(t, state.Since.IsZero())
+	require.True(t, service.Enabled())
+	require.Equal(t, 1, changes)
+
+	// setting the same state again is a no-op.
+	_, err = service.SetEnabled(ctx, true)
+	require.NoError(t, err)
+	require.Equal(t, 1, changes)
+
+	// the state survives a restart.
+	reloaded, err := maintenance.NewService(log, config, nil)
+	require.NoError(t, err)
+	require.True(t, reloaded.Enabled())
+	require.True(t, state.Since.Equal(reloaded.State().Since))
+
+	_, err = reloaded.SetEnabled(ctx, false)
+	require.NoError(t, err)
+
+	reloaded, err = maintenance.NewService(log, config, nil)
+	require.NoError(t, err)
+	require.False(t, reloaded.Enabled())
+}
+
+func TestServiceMalformedState(t *testing.T) {
+	ctx := testcontext.New(t)
+
+	path := filepath.Join(ctx.Dir("maintenance"), "state.json")
+	require.NoError(t, os.WriteFile(path, []byte("{"), 0644))
+
+	_, err := maintenance.NewService(zaptest.NewLogger(t), maintenance.Config{Path: path}, nil)
+	require.Error(t, err)
+}
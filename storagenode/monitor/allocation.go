@@ -0,0 +1,128 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package monitor
+
+import (
+	"strconv"
+	"strings"
+
+	"storj.io/common/memory"
+	"storj.io/common/storj"
+)
+
+// SatelliteAllocation caps the space a single satellite can use on the node.
+type SatelliteAllocation struct {
+	SatelliteID storj.NodeID
+	// Size is the absolute cap, used when Percent is zero.
+	Size memory.Size
+	// Percent is the cap as a percentage of the allocated disk space.
+	Percent float64
+}
+
+// ParseSatelliteAllocation parses an allocation in the form of
+// <satellite id>:<size>, e.g. "12EayRS2V1kEsWESU9QMRseFhdxYxKicsiFmxrsLZHeLUtdps3S:2TB",
+// or <satellite id>:<percent>%, e.g. "12EayRS2V1kEsWESU9QMRseFhdxYxKicsiFmxrsLZHeLUtdps3S:25%".
+func ParseSatelliteAllocation(text string) (SatelliteAllocation, error) {
+	var allocation SatelliteAllocation
+
+	id, value, ok := strings.Cut(strings.TrimSpace(text), ":")
+	if !ok {
+		return allocation, Error.New("invalid satellite allocation %q, expected <satellite id>:<size or percent>", text)
+	}
+
+	var err error
+	allocation.SatelliteID, err = storj.NodeIDFromString(id)
+	if err != nil {
+		return allocation, Error.New("invalid satellite ID %q: %w", id, err)
+	}
+
+	if strings.HasSuffix(value, "%") {
+		allocation.Percent, err = strconv.ParseFloat(strings.TrimSuffix(value, "%"), 64)
+		if err != nil || allocation.Percent <= 0 || allocation.Percent > 100 {
+			return allocation, Error.New("invalid percentage %q, expected a number in (0, 100]", value)
+		}
+		return allocation, nil
+	}
+
+	// memory.ParseString doesn't handle values without digits.
+	if value == "" || value[0] < '0' || value[0] > '9' {
+		return allocation, Error.New("invalid size %q", value)
+	}
+	size, err := memory.ParseString(value)
+	if err != nil {
+		return allocation, Error.New("invalid size %q: %w", value, err)
+	}
+	if size <= 0 {
+		return allocation, Error.New("invalid size %q, expected a positive size", value)
+	}
+	allocation.Size = memory.Size(size)
+	return allocation, nil
+}
+
+// Limit returns the space the satellite is allowed to use given the allocated
+// disk space of the node.
+func (allocation SatelliteAllocation) Limit(allocatedDiskSpace int64) int64 {
+	if allocation.Percent > 0 {
+		return int64(float64(allocatedDiskSpace) * allocation.Percent / 100)
+	}
+	return allocation.Size.Int64()
+}
+
+// String returns the string representation of the allocation.
+func (allocation SatelliteAllocation) String() string {
+	if allocation.Percent > 0 {
+		return allocation.SatelliteID.String() + ":" + strconv.FormatFloat(allocation.Percent, 'f', -1, 64) + "%"
+	}
+	return allocation.SatelliteID.String() + ":" + allocation.Size.String()
+}
+
+// SatelliteAllocations is a list of per-satellite allocations that implements pflag.Value.
+type SatelliteAllocations []SatelliteAllocation
+
+// String returns the string representation of the config.
+func (allocations SatelliteAllocations) String() string {
+	s := make([]string, 0, len(allocations))
+	for _, allocation := range allocations {
+		s = append(s, allocation.String())
+	}
+	return strings.Join(s, ",")
+}
+
+// Set implements pflag.Value by parsing a comma separated list of allocations.
+func (allocations *SatelliteAllocations) Set(value string) error {
+	var toSet SatelliteAllocations
+	seen := map[storj.NodeID]bool{}
+	for _, entry := range strings.Split(value, ",") {
+		if strings.TrimSpace(entry) == "" {
+			continue
+		}
+		allocation, err := ParseSatelliteAllocation(entry)
+		if err != nil {
+			return err
+		}
+		if seen[allocation.SatelliteID] {
+			return Error.New("duplicate allocation for satellite %s", allocation.SatelliteID)
+		}
+		seen[allocation.SatelliteID] = true
+		toSet = append(toSet, allocation)
+	}
+
+	*allocations = toSet
+	return nil
+}
+
+// Type returns the type of the pflag.Value.
+func (allocations SatelliteAllocations) Type() string {
+	return "satellite-allocations"
+}
+
+// Lookup returns the allocation for the satellite, if one is configured.
+func (allocations SatelliteAllocations) Lookup(satelliteID storj.NodeID) (SatelliteAllocation, bool) {
+	for _, allocation := range allocations {
+		if allocation.SatelliteID == satelliteID {
+			return allocation, true
+		}
+	}
+	return SatelliteAllocation{}, false
+}
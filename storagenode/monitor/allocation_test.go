@@ -0,0 +1,71 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package monitor_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"storj.io/common/memory"
+	"storj.io/common/testrand"
+	"storj.io/storj/storagenode/monitor"
+)
+
+func TestParseSatelliteAllocation(t *testing.T) {
+	satelliteID := testrand.NodeID()
+
+	allocation, err := monitor.ParseSatelliteAllocation(satelliteID.String() + ":2TB")
+	require.NoError(t, err)
+	require.Equal(t, monitor.SatelliteAllocation{SatelliteID: satelliteID, Size: 2 * memory.TB}, allocation)
+	require.Equal(t, 2*memory.TB.Int64(), allocation.Limit(10*memory.TB.Int64()))
+
+	allocation, err = monitor.ParseSatelliteAllocation(satelliteID.String() + ":12.5%")
+	require.NoError(t, err)
+	require.Equal(t, monitor.SatelliteAllocation{SatelliteID: satelliteID, Percent: 12.5}, allocation)
+	require.Equal(t, memory.TB.Int64()/8, allocation.Limit(memory.TB.Int64()))
+
+	for _, invalid := range []string{
+		"",
+		satelliteID.String(),
+		"abc:1TB",
+		satelliteID.String() + ":",
+		satelliteID.String() + ":TB",
+		satelliteID.String() + ":0GB",
+		satelliteID.String() + ":0%",
+		satelliteID.String() + ":101%",
+		satelliteID.String() + ":x%",
+	} {
+		_, err := monitor.ParseSatelliteAllocation(invalid)
+		require.Error(t, err, invalid)
+	}
+}
+
+func TestSatelliteAllocationsConfig(t *testing.T) {
+	satellite1, satellite2 := testrand.NodeID(), testrand.NodeID()
+
+	var allocations monitor.SatelliteAllocations
+	require.Equal(t, "satellite-allocations", allocations.Type())
+	require.Equal(t, "", allocations.String())
+
+	require.NoError(t, allocations.Set(satellite1.String()+":1TB,"+satellite2.String()+":25%"))
+	require.Len(t, allocations, 2)
+
+	var reparsed monitor.SatelliteAllocations
+	require.NoError(t, reparsed.Set(allocations.String()))
+	require.Equal(t, allocations, reparsed)
+
+	allocation, ok := allocations.Lookup(satellite2)
+	require.True(t, ok)
+	require.Equal(t, 25.0, allocation.Percent)
+	_, ok = allocations.Lookup(testrand.NodeID())
+	require.False(t, ok)
+
+	// Assert that a failure to set does not modify the current allocations
+	require.Error(t, allocations.Set(satellite1.String()+":1TB,"+satellite1.String()+":2TB"))
+	require.Len(t, allocations, 2)
+
+	require.NoError(t, allocations.Set(""))
+	require.Empty(t, allocations)
+}
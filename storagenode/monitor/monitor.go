@@ -7,6 +7,7 @@ package monitor
 
 import (
 	"context"
+	"sync"
 	"time"
 
 	"github.com/spacemonkeygo/monkit/v3"
@@ -16,10 +17,13 @@ import (
 
 	"storj.io/common/memory"
 	"storj.io/common/pb"
+	"storj.io/common/storj"
 	"storj.io/common/sync2"
 	"storj.io/storj/storagenode/bandwidth"
 	"storj.io/storj/storagenode/contact"
+	"storj.io/storj/storagenode/notifications"
 	"storj.io/storj/storagenode/pieces"
+	"storj.io/storj/storagenode/piecestore/shaping"
 )
 
 var (
@@ -41,12 +45,14 @@ type DiskSpace struct {
 
 // Config defines parameters for storage node disk and bandwidth usage monitoring.
 type Config struct {
-	Interval                  time.Duration `help:"how frequently Kademlia bucket should be refreshed with node stats" default:"1h0m0s"`
-	VerifyDirReadableInterval time.Duration `help:"how frequently to verify the location and readability of the storage directory" releaseDefault:"1m" devDefault:"30s"`
-	VerifyDirWritableInterval time.Duration `help:"how frequently to verify writability of storage directory" releaseDefault:"5m" devDefault:"30s"`
-	MinimumDiskSpace          memory.Size   `help:"how much disk space a node at minimum has to advertise" default:"500GB"`
-	MinimumBandwidth          memory.Size   `help:"how much bandwidth a node at minimum has to advertise (deprecated)" default:"0TB"`
-	NotifyLowDiskCooldown     time.Duration `help:"minimum length of time between capacity reports" default:"10m" hidden:"true"`
+	Interval                  time.Duration        `help:"how frequently Kademlia bucket should be refreshed with node stats" default:"1h0m0s"`
+	VerifyDirReadableInterval time.Duration        `help:"how frequently to verify the location and readability of the storage directory" releaseDefault:"1m" devDefault:"30s"`
+	VerifyDirWritableInterval time.Duration        `help:"how frequently to verify writability of storage directory" releaseDefault:"5m" devDefault:"30s"`
+	MinimumDiskSpace          memory.Size          `help:"how much disk space a node at minimum has to advertise" default:"500GB"`
+	MinimumBandwidth          memory.Size          `help:"how much bandwidth a node at minimum has to advertise (deprecated)" default:"0TB"`
+	LowDiskSpaceThreshold     memory.Size          `help:"free disk space below which the operator is notified about the disk running out of space" default:"5GB"`
+	NotifyLowDiskCooldown     time.Duration        `help:"minimum length of time between capacity reports" default:"10m" hidden:"true"`
+	SatelliteAllocations      SatelliteAllocations `help:"optional comma separated per-satellite space caps as <satellite id>:<size> or <satellite id>:<percent of allocated space>%" default:""`
 }
 
 // Service which monitors disk usage.
@@ -58,25 +64,34 @@ type Service struct {
 	contact               *contact.Service
 	usageDB               bandwidth.DB
 	allocatedDiskSpace    int64
+	shaper                *shaping.Shaper
+	throttled             bool
+	notifications         *notifications.Service
+	lowDiskSpaceMu        sync.Mutex
+	lowDiskSpace          bool
 	cooldown              *sync2.Cooldown
 	Loop                  *sync2.Cycle
 	VerifyDirReadableLoop *sync2.Cycle
 	VerifyDirWritableLoop *sync2.Cycle
+	ShapingLoop           *sync2.Cycle
 	Config                Config
 }
 
 // NewService creates a new storage node monitoring service.
-func NewService(log *zap.Logger, store *pieces.Store, contact *contact.Service, usageDB bandwidth.DB, allocatedDiskSpace int64, interval time.Duration, reportCapacity func(context.Context), config Config) *Service {
+func NewService(log *zap.Logger, store *pieces.Store, contact *contact.Service, usageDB bandwidth.DB, allocatedDiskSpace int64, interval time.Duration, reportCapacity func(context.Context), shaper *shaping.Shaper, notifications *notifications.Service, config Config) *Service {
 	return &Service{
 		log:                   log,
 		store:                 store,
 		contact:               contact,
 		usageDB:               usageDB,
 		allocatedDiskSpace:    allocatedDiskSpace,
+		shaper:                shaper,
+		notifications:         notifications,
 		cooldown:              sync2.NewCooldown(config.NotifyLowDiskCooldown),
 		Loop:                  sync2.NewCycle(interval),
 		VerifyDirReadableLoop: sync2.NewCycle(config.VerifyDirReadableInterval),
 		VerifyDirWritableLoop: sync2.NewCycle(config.VerifyDirWritableInterval),
+		ShapingLoop:           sync2.NewCycle(time.Minute),
 		Config:                config,
 	}
 }
@@ -153,6 +168,12 @@ func (service *Service) Run(ctx context.Context) (err error) {
 			return nil
 		})
 	})
+	group.Go(func() error {
+		return service.ShapingLoop.Run(ctx, func(ctx context.Context) error {
+			service.checkThrottling()
+			return nil
+		})
+	})
 	service.cooldown.Start(ctx, group, func(ctx context.Context) error {
 		err := service.updateNodeInformation(ctx)
 		if err != nil {
@@ -175,9 +196,62 @@ func (service *Service) NotifyLowDisk() {
 	service.cooldown.Trigger()
 }
 
+// checkLowDiskSpace notifies the operator when the free disk space drops below the threshold.
+func (service *Service) checkLowDiskSpace(ctx context.Context) {
+	if service.notifications == nil || service.Config.LowDiskSpaceThreshold <= 0 {
+		return
+	}
+
+	status, err := service.store.StorageStatus(ctx)
+	if err != nil {
+		service.log.Error("failed to get storage status", zap.Error(err))
+		return
+	}
+
+	lowDiskSpace := status.DiskFree < service.Config.LowDiskSpaceThreshold.Int64()
+	service.lowDiskSpaceMu.Lock()
+	changed := lowDiskSpace != service.lowDiskSpace
+	service.lowDiskSpace = lowDiskSpace
+	service.lowDiskSpaceMu.Unlock()
+	if !changed || !lowDiskSpace {
+		return
+	}
+
+	_, err = service.notifications.Receive(ctx, notifications.NewNotification{
+		SenderID: service.contact.Local().ID,
+		Type:     notifications.TypeLowDiskSpace,
+		Title:    "Your Node is running out of disk space",
+		Message:  "Only " + memory.Size(status.DiskFree).Base10String() + " of disk space is left on the storage directory of your StorageNode.",
+	})
+	if err != nil {
+		service.log.Error("failed to notify about low disk space", zap.Error(err))
+	}
+}
+
+// checkThrottling reports the capacity to the satellites when the bandwidth
+// shaping schedule starts or stops throttling the node.
+func (service *Service) checkThrottling() {
+	if service.shaper == nil {
+		return
+	}
+	throttled := service.shaper.Throttled()
+	if throttled == service.throttled {
+		return
+	}
+	service.throttled = throttled
+
+	if throttled {
+		service.log.Info("Bandwidth is limited, reporting no free space to satellites.")
+	} else {
+		service.log.Info("Bandwidth is no longer limited, reporting free space to satellites.")
+	}
+	service.NotifyLowDisk()
+}
+
 // Close stops the monitor service.
 func (service *Service) Close() (err error) {
 	service.Loop.Close()
+	service.ShapingLoop.Close()
 	service.cooldown.Close()
 	return nil
 }
@@ -189,10 +263,25 @@ func (service *Service) updateNodeInformation(ctx context.Context) (err error) {
 	if err != nil {
 		return err
 	}
+	service.checkLowDiskSpace(ctx)
+	if service.shaper != nil && service.shaper.Throttled() {
+		// the node can't keep up with uploads while its bandwidth is limited.
+		freeSpace = 0
+	}
 	service.contact.UpdateSelf(&pb.NodeCapacity{
 		FreeDisk: freeSpace,
 	})
 
+	satellitesFreeDisk := make(map[storj.NodeID]int64, len(service.Config.SatelliteAllocations))
+	for _, allocation := range service.Config.SatelliteAllocations {
+		satelliteFreeSpace, err := service.limitForSatellite(ctx, allocation, freeSpace)
+		if err != nil {
+			return err
+		}
+		satellitesFreeDisk[allocation.SatelliteID] = satelliteFreeSpace
+	}
+	service.contact.UpdateSatellitesFreeDisk(satellitesFreeDisk)
+
 	return nil
 }
 
@@ -222,6 +311,43 @@ func (service *Service) AvailableSpace(ctx context.Context) (_ int64, err error)
 	return freeSpaceForStorj, nil
 }
 
+// AvailableSpaceForSatellite returns available disk space for uploads from
+// the satellite, taking into account the space cap configured for it.
+func (service *Service) AvailableSpaceForSatellite(ctx context.Context, satelliteID storj.NodeID) (_ int64, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	freeSpace, err := service.AvailableSpace(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	allocation, ok := service.Config.SatelliteAllocations.Lookup(satelliteID)
+	if !ok {
+		return freeSpace, nil
+	}
+	return service.limitForSatellite(ctx, allocation, freeSpace)
+}
+
+// limitForSatellite limits the free space by the space remaining in the
+// allocation of the satellite.
+func (service *Service) limitForSatellite(ctx context.Context, allocation SatelliteAllocation, freeSpace int64) (_ int64, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	usedBySatellite, _, err := service.store.SpaceUsedBySatellite(ctx, allocation.SatelliteID)
+	if err != nil {
+		return 0, Error.Wrap(err)
+	}
+
+	remaining := allocation.Limit(service.allocatedDiskSpace) - usedBySatellite
+	if remaining < 0 {
+		remaining = 0
+	}
+	if freeSpace < remaining {
+		remaining = freeSpace
+	}
+	return remaining, nil
+}
+
 // DiskSpace returns consolidated disk space state info.
 func (service *Service) DiskSpace(ctx context.Context) (_ DiskSpace, err error) {
 	defer mon.Task()(&ctx)(&err)
@@ -43,3 +43,37 @@ func TestMonitor(t *testing.T) {
 		assert.NotZero(t, nodeAssertions, "No storage node were verifed")
 	})
 }
+
+func TestMonitorSatelliteAllocation(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 2, StorageNodeCount: 1, UplinkCount: 0,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		capped, uncapped := planet.Satellites[0].ID(), planet.Satellites[1].ID()
+		storageNode := planet.StorageNodes[0]
+		service := storageNode.Storage2.Monitor
+		service.Loop.Pause()
+
+		require.NoError(t, service.Config.SatelliteAllocations.Set(capped.String()+":1MB"))
+
+		available, err := service.AvailableSpace(ctx)
+		require.NoError(t, err)
+		require.Greater(t, available, memory.MB.Int64())
+
+		cappedAvailable, err := service.AvailableSpaceForSatellite(ctx, capped)
+		require.NoError(t, err)
+		require.Equal(t, memory.MB.Int64(), cappedAvailable)
+
+		uncappedAvailable, err := service.AvailableSpaceForSatellite(ctx, uncapped)
+		require.NoError(t, err)
+		require.Greater(t, uncappedAvailable, memory.MB.Int64())
+
+		service.Loop.TriggerWait()
+
+		freeDisk, ok := storageNode.Contact.Service.SatelliteFreeDisk(capped)
+		require.True(t, ok)
+		require.Equal(t, memory.MB.Int64(), freeDisk)
+
+		_, ok = storageNode.Contact.Service.SatelliteFreeDisk(uncapped)
+		require.False(t, ok)
+	})
+}
@@ -128,6 +128,35 @@ func (node *NodeEndpoint) Reputation(ctx context.Context, req *multinodepb.Reput
 	}, nil
 }
 
+// ReputationHistory returns reputation snapshots for specific satellite.
+func (node *NodeEndpoint) ReputationHistory(ctx context.Context, req *multinodepb.ReputationHistoryRequest) (_ *multinodepb.ReputationHistoryResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if err = authenticate(ctx, node.apiKeys, req.GetHeader()); err != nil {
+		return nil, rpcstatus.Wrap(rpcstatus.Unauthenticated, err)
+	}
+
+	history, err := node.reputation.History(ctx, req.SatelliteId, req.From, req.To)
+	if err != nil {
+		return nil, rpcstatus.Wrap(rpcstatus.Internal, err)
+	}
+
+	response := new(multinodepb.ReputationHistoryResponse)
+	for _, snapshot := range history {
+		response.Snapshots = append(response.Snapshots, &multinodepb.ReputationHistoryResponse_Snapshot{
+			Timestamp:          snapshot.Timestamp,
+			AuditScore:         snapshot.AuditScore,
+			SuspensionScore:    snapshot.SuspensionScore,
+			OnlineScore:        snapshot.OnlineScore,
+			DisqualifiedAt:     snapshot.DisqualifiedAt,
+			SuspendedAt:        snapshot.SuspendedAt,
+			OfflineSuspendedAt: snapshot.OfflineSuspendedAt,
+		})
+	}
+
+	return response, nil
+}
+
 // TrustedSatellites returns list of trusted satellites node urls.
 func (node *NodeEndpoint) TrustedSatellites(ctx context.Context, req *multinodepb.TrustedSatellitesRequest) (_ *multinodepb.TrustedSatellitesResponse, err error) {
 	defer mon.Task()(&ctx)(&err)
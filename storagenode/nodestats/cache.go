@@ -27,6 +27,8 @@ type Config struct {
 	MaxSleep       time.Duration `help:"maximum duration to wait before requesting data" releaseDefault:"300s" devDefault:"1s"`
 	ReputationSync time.Duration `help:"how often to sync reputation" releaseDefault:"4h" devDefault:"1m"`
 	StorageSync    time.Duration `help:"how often to sync storage" releaseDefault:"12h" devDefault:"2m"`
+
+	ReputationHistoryRetention time.Duration `help:"how long to keep the reputation history" default:"8760h0m0s"`
 }
 
 // CacheStorage encapsulates cache DBs.
@@ -49,9 +51,10 @@ type Cache struct {
 	reputationService *reputation.Service
 	trust             *trust.Pool
 
-	maxSleep   time.Duration
-	Reputation *sync2.Cycle
-	Storage    *sync2.Cycle
+	maxSleep         time.Duration
+	historyRetention time.Duration
+	Reputation       *sync2.Cycle
+	Storage          *sync2.Cycle
 }
 
 // NewCache creates new caching service instance.
@@ -66,6 +69,7 @@ func NewCache(log *zap.Logger, config Config, db CacheStorage, service *Service,
 		reputationService: reputationService,
 		trust:             trust,
 		maxSleep:          config.MaxSleep,
+		historyRetention:  config.ReputationHistoryRetention,
 		Reputation:        sync2.NewCycle(config.ReputationSync),
 		Storage:           sync2.NewCycle(config.StorageSync),
 	}
@@ -148,7 +152,7 @@ func (cache *Cache) Run(ctx context.Context) error {
 func (cache *Cache) CacheReputationStats(ctx context.Context) (err error) {
 	defer mon.Task()(&ctx)(&err)
 
-	return cache.satelliteLoop(ctx, func(satellite storj.NodeID) error {
+	err = cache.satelliteLoop(ctx, func(satellite storj.NodeID) error {
 		stats, err := cache.service.GetReputationStats(ctx, satellite)
 		if err != nil {
 			return err
@@ -161,6 +165,15 @@ func (cache *Cache) CacheReputationStats(ctx context.Context) (err error) {
 
 		return nil
 	})
+
+	if cache.historyRetention > 0 {
+		_, historyErr := cache.db.Reputation.DeleteHistoryBefore(ctx, time.Now().Add(-cache.historyRetention))
+		if historyErr != nil {
+			cache.log.Error("failed to delete old reputation history", zap.Error(historyErr))
+		}
+	}
+
+	return err
 }
 
 // CacheSpaceUsage queries disk space usage from all the satellites
@@ -0,0 +1,266 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package notifications
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/mail"
+	"os"
+	"os/exec"
+	"strings"
+	"time"
+
+	"github.com/zeebo/errs"
+
+	"storj.io/storj/private/post"
+)
+
+// Config defines the channels used to send notifications to the operator.
+type Config struct {
+	RateLimit time.Duration `help:"minimum time between two notifications of the same type sent through a channel" default:"1h0m0s"`
+
+	Email   EmailConfig
+	Webhook WebhookConfig
+	Script  ScriptConfig
+}
+
+// Filter selects the notifications sent through a channel.
+type Filter struct {
+	MinSeverity string `help:"minimum severity of the notifications to send (info, warning or critical)" default:"warning"`
+	Types       string `help:"comma separated list of the notification types to send (custom, audit-check-failure, disqualification, suspension, low-disk-space), empty sends all types" default:""`
+}
+
+// EmailConfig defines the email notification channel.
+type EmailConfig struct {
+	SMTPServerAddress string `help:"smtp server address used to send notifications, empty disables email notifications" default:""`
+	From              string `help:"sender email address" default:""`
+	To                string `help:"comma separated list of recipient email addresses" default:""`
+	Username          string `help:"smtp login" default:""`
+	Password          string `help:"smtp password" default:""`
+
+	Filter Filter
+}
+
+// WebhookConfig defines the webhook notification channel.
+type WebhookConfig struct {
+	URL string `help:"url notifications are posted to as JSON, empty disables webhook notifications" default:""`
+
+	Filter Filter
+}
+
+// ScriptConfig defines the script notification channel.
+type ScriptConfig struct {
+	Path string `help:"script executed for every notification, empty disables script notifications" default:""`
+
+	Filter Filter
+}
+
+// Notifier sends notifications to the operator outside of the dashboard.
+type Notifier interface {
+	Notify(ctx context.Context, notification Notification) error
+}
+
+// Channel is a notifier together with the notifications it sends.
+type Channel struct {
+	Name     string
+	Notifier Notifier
+
+	MinSeverity Severity
+	// Types limits the notification types sent through the channel, empty means all types.
+	Types map[Type]bool
+}
+
+// Accepts returns true when the notification type should be sent through the channel.
+func (channel *Channel) Accepts(t Type) bool {
+	if t.Severity() < channel.MinSeverity {
+		return false
+	}
+	return len(channel.Types) == 0 || channel.Types[t]
+}
+
+// NewChannels creates the configured notification channels.
+func NewChannels(config Config) (channels []Channel, err error) {
+	if config.Email.SMTPServerAddress != "" {
+		notifier, err := NewEmailNotifier(config.Email)
+		if err != nil {
+			return nil, err
+		}
+		channel, err := newChannel("email", notifier, config.Email.Filter)
+		if err != nil {
+			return nil, err
+		}
+		channels = append(channels, channel)
+	}
+
+	if config.Webhook.URL != "" {
+		channel, err := newChannel("webhook", &WebhookNotifier{URL: config.Webhook.URL}, config.Webhook.Filter)
+		if err != nil {
+			return nil, err
+		}
+		channels = append(channels, channel)
+	}
+
+	if config.Script.Path != "" {
+		channel, err := newChannel("script", &ScriptNotifier{Path: config.Script.Path}, config.Script.Filter)
+		if err != nil {
+			return nil, err
+		}
+		channels = append(channels, channel)
+	}
+
+	return channels, nil
+}
+
+func newChannel(name string, notifier Notifier, filter Filter) (Channel, error) {
+	channel := Channel{
+		Name:     name,
+		Notifier: notifier,
+		Types:    map[Type]bool{},
+	}
+
+	var err error
+	if filter.MinSeverity != "" {
+		channel.MinSeverity, err = ParseSeverity(filter.MinSeverity)
+		if err != nil {
+			return Channel{}, Error.New("%s: %w", name, err)
+		}
+	}
+
+	for _, typeName := range strings.Split(filter.Types, ",") {
+		typeName = strings.TrimSpace(typeName)
+		if typeName == "" {
+			continue
+		}
+		t, err := ParseType(typeName)
+		if err != nil {
+			return Channel{}, Error.New("%s: %w", name, err)
+		}
+		channel.Types[t] = true
+	}
+
+	return channel, nil
+}
+
+// EmailNotifier sends notifications as emails.
+type EmailNotifier struct {
+	Sender *post.SMTPSender
+	To     []post.Address
+}
+
+// NewEmailNotifier creates a new email notifier.
+func NewEmailNotifier(config EmailConfig) (*EmailNotifier, error) {
+	from, err := mail.ParseAddress(config.From)
+	if err != nil {
+		return nil, Error.New("invalid sender address: %w", err)
+	}
+	to, err := mail.ParseAddressList(config.To)
+	if err != nil {
+		return nil, Error.New("invalid recipient address: %w", err)
+	}
+
+	notifier := &EmailNotifier{
+		Sender: &post.SMTPSender{
+			ServerAddress: config.SMTPServerAddress,
+			From:          *from,
+			Auth: post.LoginAuth{
+				Username: config.Username,
+				Password: config.Password,
+			},
+		},
+	}
+	for _, address := range to {
+		notifier.To = append(notifier.To, *address)
+	}
+	return notifier, nil
+}
+
+// Notify implements Notifier.
+func (notifier *EmailNotifier) Notify(ctx context.Context, notification Notification) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	return notifier.Sender.SendEmail(ctx, &post.Message{
+		From:      notifier.Sender.From,
+		To:        notifier.To,
+		Subject:   fmt.Sprintf("[Storage Node] %s", notification.Title),
+		Date:      notification.CreatedAt,
+		PlainText: notification.Message,
+	})
+}
+
+// WebhookMessage is the JSON body posted by WebhookNotifier.
+type WebhookMessage struct {
+	Notification
+
+	TypeName string `json:"typeName"`
+	Severity string `json:"severity"`
+}
+
+// WebhookNotifier posts notifications as JSON to an url.
+type WebhookNotifier struct {
+	URL    string
+	Client http.Client
+}
+
+// Notify implements Notifier.
+func (notifier *WebhookNotifier) Notify(ctx context.Context, notification Notification) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	body, err := json.Marshal(WebhookMessage{
+		Notification: notification,
+		TypeName:     notification.Type.String(),
+		Severity:     notification.Type.Severity().String(),
+	})
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, notifier.URL, bytes.NewReader(body))
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := notifier.Client.Do(req)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	defer func() { err = errs.Combine(err, resp.Body.Close()) }()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return Error.New("webhook responded with status %d", resp.StatusCode)
+	}
+	return nil
+}
+
+// ScriptNotifier executes a script for every notification. The notification
+// is passed in environment variables.
+type ScriptNotifier struct {
+	Path string
+}
+
+// Notify implements Notifier.
+func (notifier *ScriptNotifier) Notify(ctx context.Context, notification Notification) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	cmd := exec.CommandContext(ctx, notifier.Path)
+	cmd.Env = append(os.Environ(),
+		"STORJ_NOTIFICATION_ID="+notification.ID.String(),
+		"STORJ_NOTIFICATION_TYPE="+notification.Type.String(),
+		"STORJ_NOTIFICATION_SEVERITY="+notification.Type.Severity().String(),
+		"STORJ_NOTIFICATION_SENDER="+notification.SenderID.String(),
+		"STORJ_NOTIFICATION_TITLE="+notification.Title,
+		"STORJ_NOTIFICATION_MESSAGE="+notification.Message,
+		"STORJ_NOTIFICATION_CREATED_AT="+notification.CreatedAt.UTC().Format(time.RFC3339),
+	)
+
+	output, err := cmd.CombinedOutput()
+	if err != nil {
+		return Error.New("script failed: %w: %s", err, bytes.TrimSpace(output))
+	}
+	return nil
+}
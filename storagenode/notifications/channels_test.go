@@ -0,0 +1,207 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package notifications_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"runtime"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/zeebo/errs"
+	"go.uber.org/zap/zaptest"
+
+	"storj.io/common/testcontext"
+	"storj.io/common/testrand"
+	"storj.io/storj/storagenode"
+	"storj.io/storj/storagenode/notifications"
+	"storj.io/storj/storagenode/storagenodedb/storagenodedbtest"
+)
+
+type recordingNotifier struct {
+	mu   sync.Mutex
+	fail int
+	sent []notifications.Type
+}
+
+func (notifier *recordingNotifier) Notify(ctx context.Context, notification notifications.Notification) error {
+	notifier.mu.Lock()
+	defer notifier.mu.Unlock()
+	if notifier.fail > 0 {
+		notifier.fail--
+		return errs.New("notifier failure")
+	}
+	notifier.sent = append(notifier.sent, notification.Type)
+	return nil
+}
+
+func TestServiceChannels(t *testing.T) {
+	storagenodedbtest.Run(t, func(ctx *testcontext.Context, t *testing.T, db storagenode.DB) {
+		critical := &recordingNotifier{}
+		suspensions := &recordingNotifier{}
+
+		service := notifications.NewService(zaptest.NewLogger(t), db.Notifications(), time.Hour,
+			notifications.Channel{
+				Name:        "critical",
+				Notifier:    critical,
+				MinSeverity: notifications.SeverityCritical,
+			},
+			notifications.Channel{
+				Name:     "suspensions",
+				Notifier: suspensions,
+				Types:    map[notifications.Type]bool{notifications.TypeSuspension: true},
+			},
+		)
+
+		now := time.Now()
+		service.TestSetNow(func() time.Time { return now })
+
+		runCtx, cancel := context.WithCancel(ctx)
+		defer cancel()
+		ctx.Go(func() error { return service.Run(runCtx) })
+
+		receive := func(typ notifications.Type) {
+			_, err := service.Receive(ctx, notifications.NewNotification{
+				SenderID: testrand.NodeID(),
+				Type:     typ,
+				Title:    "title",
+				Message:  "message",
+			})
+			require.NoError(t, err)
+			service.TestWaitSent()
+		}
+
+		receive(notifications.TypeCustom)
+		receive(notifications.TypeSuspension)
+		receive(notifications.TypeDisqualification)
+		// rate limited.
+		receive(notifications.TypeSuspension)
+		receive(notifications.TypeDisqualification)
+
+		require.Equal(t, []notifications.Type{notifications.TypeDisqualification}, critical.sent)
+		require.Equal(t, []notifications.Type{notifications.TypeSuspension}, suspensions.sent)
+
+		now = now.Add(time.Hour)
+		receive(notifications.TypeSuspension)
+		require.Equal(t, []notifications.Type{notifications.TypeSuspension, notifications.TypeSuspension}, suspensions.sent)
+
+		// failed sends don't count for the rate limit.
+		now = now.Add(time.Hour)
+		suspensions.fail = 1
+		receive(notifications.TypeSuspension)
+		require.Len(t, suspensions.sent, 2)
+		receive(notifications.TypeSuspension)
+		require.Len(t, suspensions.sent, 3)
+
+		// all notifications are stored for the dashboard regardless of the channels.
+		amount, err := service.UnreadAmount(ctx)
+		require.NoError(t, err)
+		require.Equal(t, 8, amount)
+
+		// test notifications are sent through every channel.
+		results, err := service.TestSend(ctx)
+		require.NoError(t, err)
+		require.Equal(t, []notifications.ChannelResult{{Channel: "critical"}, {Channel: "suspensions"}}, results)
+		require.Len(t, critical.sent, 2)
+		require.Len(t, suspensions.sent, 4)
+	})
+}
+
+func TestNewChannels(t *testing.T) {
+	channels, err := notifications.NewChannels(notifications.Config{})
+	require.NoError(t, err)
+	require.Empty(t, channels)
+
+	var config notifications.Config
+	config.Webhook.URL = "http://localhost/hook"
+	config.Webhook.Filter.MinSeverity = "info"
+	config.Webhook.Filter.Types = "suspension, low-disk-space"
+	config.Script.Path = "/usr/local/bin/notify"
+	config.Script.Filter.MinSeverity = "critical"
+
+	channels, err = notifications.NewChannels(config)
+	require.NoError(t, err)
+	require.Len(t, channels, 2)
+	require.Equal(t, "webhook", channels[0].Name)
+	require.True(t, channels[0].Accepts(notifications.TypeLowDiskSpace))
+	require.False(t, channels[0].Accepts(notifications.TypeDisqualification))
+	require.Equal(t, "script", channels[1].Name)
+	require.True(t, channels[1].Accepts(notifications.TypeDisqualification))
+	require.False(t, channels[1].Accepts(notifications.TypeSuspension))
+
+	config.Webhook.Filter.Types = "unknown"
+	_, err = notifications.NewChannels(config)
+	require.Error(t, err)
+
+	config.Webhook.Filter.Types = ""
+	config.Webhook.Filter.MinSeverity = "loud"
+	_, err = notifications.NewChannels(config)
+	require.Error(t, err)
+}
+
+func TestWebhookNotifier(t *testing.T) {
+	ctx := testcontext.New(t)
+
+	received := make(chan notifications.WebhookMessage, 1)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var message notifications.WebhookMessage
+		if err := json.NewDecoder(r.Body).Decode(&message); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		received <- message
+	}))
+	defer server.Close()
+
+	notifier := &notifications.WebhookNotifier{URL: server.URL}
+	err := notifier.Notify(ctx, notifications.Notification{
+		Type:  notifications.TypeDisqualification,
+		Title: "disqualified",
+	})
+	require.NoError(t, err)
+
+	message := <-received
+	require.Equal(t, "disqualified", message.Title)
+	require.Equal(t, "disqualification", message.TypeName)
+	require.Equal(t, "critical", message.Severity)
+
+	failing := httptest.NewServer(http.NotFoundHandler())
+	defer failing.Close()
+
+	notifier = &notifications.WebhookNotifier{URL: failing.URL}
+	require.Error(t, notifier.Notify(ctx, notifications.Notification{}))
+}
+
+func TestScriptNotifier(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("uses a shell script")
+	}
+
+	ctx := testcontext.New(t)
+
+	output := ctx.File("output")
+	script := ctx.File("notify.sh")
+	require.NoError(t, os.WriteFile(script, []byte("#!/bin/sh\necho \"$STORJ_NOTIFICATION_SEVERITY $STORJ_NOTIFICATION_TITLE\" > "+output+"\n"), 0755))
+
+	notifier := &notifications.ScriptNotifier{Path: script}
+	err := notifier.Notify(ctx, notifications.Notification{
+		Type:  notifications.TypeLowDiskSpace,
+		Title: "low disk",
+	})
+	require.NoError(t, err)
+
+	data, err := os.ReadFile(output)
+	require.NoError(t, err)
+	require.Equal(t, "warning low disk\n", string(data))
+
+	notifier = &notifications.ScriptNotifier{Path: filepath.Join(ctx.Dir(), "missing.sh")}
+	require.Error(t, notifier.Notify(ctx, notifications.Notification{}))
+}
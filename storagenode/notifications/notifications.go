@@ -5,6 +5,7 @@ package notifications
 
 import (
 	"context"
+	"strconv"
 	"time"
 
 	"storj.io/common/storj"
@@ -35,8 +36,86 @@ const (
 	TypeDisqualification Type = 2
 	// TypeSuspension is a notification type which describes node's suspension status.
 	TypeSuspension Type = 3
+	// TypeLowDiskSpace is a notification type which describes node running out of disk space.
+	TypeLowDiskSpace Type = 4
 )
 
+// String returns the name of the notification type.
+func (t Type) String() string {
+	switch t {
+	case TypeCustom:
+		return "custom"
+	case TypeAuditCheckFailure:
+		return "audit-check-failure"
+	case TypeDisqualification:
+		return "disqualification"
+	case TypeSuspension:
+		return "suspension"
+	case TypeLowDiskSpace:
+		return "low-disk-space"
+	default:
+		return "type(" + strconv.Itoa(int(t)) + ")"
+	}
+}
+
+// Severity returns how important the notifications of the type are.
+func (t Type) Severity() Severity {
+	switch t {
+	case TypeDisqualification:
+		return SeverityCritical
+	case TypeAuditCheckFailure, TypeSuspension, TypeLowDiskSpace:
+		return SeverityWarning
+	default:
+		return SeverityInfo
+	}
+}
+
+// ParseType parses the name of a notification type.
+func ParseType(name string) (Type, error) {
+	for _, t := range []Type{TypeCustom, TypeAuditCheckFailure, TypeDisqualification, TypeSuspension, TypeLowDiskSpace} {
+		if t.String() == name {
+			return t, nil
+		}
+	}
+	return 0, Error.New("unknown notification type %q", name)
+}
+
+// Severity describes how important a notification is.
+type Severity int
+
+const (
+	// SeverityInfo is used for notifications which don't require any action.
+	SeverityInfo Severity = 0
+	// SeverityWarning is used for notifications which require attention.
+	SeverityWarning Severity = 1
+	// SeverityCritical is used for notifications which require immediate action.
+	SeverityCritical Severity = 2
+)
+
+// String returns the name of the severity.
+func (severity Severity) String() string {
+	switch severity {
+	case SeverityInfo:
+		return "info"
+	case SeverityWarning:
+		return "warning"
+	case SeverityCritical:
+		return "critical"
+	default:
+		return "severity(" + strconv.Itoa(int(severity)) + ")"
+	}
+}
+
+// ParseSeverity parses the name of a severity.
+func ParseSeverity(name string) (Severity, error) {
+	for _, severity := range []Severity{SeverityInfo, SeverityWarning, SeverityCritical} {
+		if severity.String() == name {
+			return severity, nil
+		}
+	}
+	return 0, Error.New("unknown severity %q", name)
+}
+
 // NewNotification holds notification entity info which is being received from satellite or local client.
 type NewNotification struct {
 	SenderID storj.NodeID
@@ -5,8 +5,11 @@ package notifications
 
 import (
 	"context"
+	"sync"
+	"time"
 
 	"github.com/spacemonkeygo/monkit/v3"
+	"github.com/zeebo/errs"
 	"go.uber.org/zap"
 
 	"storj.io/common/uuid"
@@ -14,8 +17,18 @@ import (
 
 var (
 	mon = monkit.Package()
+
+	// Error is the default error class for notifications package.
+	Error = errs.Class("notifications")
 )
 
+// notifyTimeout is the maximum time spent sending a notification through a single channel.
+const notifyTimeout = 30 * time.Second
+
+// queueSize is the number of notifications waiting to be sent through the
+// channels, newer notifications are dropped when the queue is full.
+const queueSize = 100
+
 // TimesNotified is a numeric value of amount of notifications being sent to user.
 type TimesNotified int
 
@@ -31,17 +44,56 @@ const (
 )
 
 // Service is the notification service between storage nodes and satellites.
+//
+// Besides storing the notifications for the dashboard, it sends them through
+// the configured channels in the background, at most once per RateLimit for
+// every type.
+//
 // architecture: Service
 type Service struct {
-	log *zap.Logger
-	db  DB
+	log       *zap.Logger
+	db        DB
+	channels  []Channel
+	rateLimit time.Duration
+
+	queue   chan Notification
+	pending sync.WaitGroup
+
+	mu       sync.Mutex
+	lastSent map[channelType]time.Time
+	now      func() time.Time
+}
+
+type channelType struct {
+	channel int
+	typ     Type
 }
 
 // NewService creates a new notification service.
-func NewService(log *zap.Logger, db DB) *Service {
+func NewService(log *zap.Logger, db DB, rateLimit time.Duration, channels ...Channel) *Service {
 	return &Service{
-		log: log,
-		db:  db,
+		log:       log,
+		db:        db,
+		channels:  channels,
+		rateLimit: rateLimit,
+		queue:     make(chan Notification, queueSize),
+		lastSent:  map[channelType]time.Time{},
+		now:       time.Now,
+	}
+}
+
+// Run sends the received notifications through the channels.
+func (service *Service) Run(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case notification := <-service.queue:
+			service.deliver(ctx, notification)
+			service.pending.Done()
+		}
 	}
 }
 
@@ -52,9 +104,120 @@ func (service *Service) Receive(ctx context.Context, newNotification NewNotifica
 		return Notification{}, err
 	}
 
+	accepted := false
+	for i := range service.channels {
+		accepted = accepted || service.channels[i].Accepts(notification.Type)
+	}
+	if !accepted {
+		return notification, nil
+	}
+
+	service.pending.Add(1)
+	select {
+	case service.queue <- notification:
+	default:
+		service.pending.Done()
+		service.log.Warn("too many notifications waiting to be sent, dropping",
+			zap.Stringer("Type", notification.Type))
+	}
+
 	return notification, nil
 }
 
+// deliver sends the notification through the channels which accept it and
+// didn't send the same type recently.
+func (service *Service) deliver(ctx context.Context, notification Notification) {
+	for i := range service.channels {
+		channel := &service.channels[i]
+		if !channel.Accepts(notification.Type) || !service.due(i, notification.Type) {
+			continue
+		}
+		if err := service.send(ctx, channel, notification); err != nil {
+			service.log.Error("failed to send notification",
+				zap.String("Channel", channel.Name),
+				zap.Stringer("Type", notification.Type),
+				zap.Error(err))
+			continue
+		}
+		service.markSent(i, notification.Type)
+	}
+}
+
+// ChannelResult is the result of sending a notification through a channel.
+type ChannelResult struct {
+	Channel string `json:"channel"`
+	Error   string `json:"error,omitempty"`
+}
+
+// TestSend sends a test notification through all the channels, regardless
+// of their filters and rate limits.
+func (service *Service) TestSend(ctx context.Context) (results []ChannelResult, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	id, err := uuid.New()
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	notification := Notification{
+		ID:        id,
+		Type:      TypeCustom,
+		Title:     "Test notification",
+		Message:   "This is a test notification sent by your storage node.",
+		CreatedAt: service.now().UTC(),
+	}
+
+	results = []ChannelResult{}
+	for i := range service.channels {
+		channel := &service.channels[i]
+		result := ChannelResult{Channel: channel.Name}
+		if err := service.send(ctx, channel, notification); err != nil {
+			result.Error = err.Error()
+		}
+		results = append(results, result)
+	}
+	return results, nil
+}
+
+// due returns true when the type wasn't sent through the channel recently.
+func (service *Service) due(channel int, typ Type) bool {
+	service.mu.Lock()
+	defer service.mu.Unlock()
+
+	last, ok := service.lastSent[channelType{channel: channel, typ: typ}]
+	return !ok || service.now().Sub(last) >= service.rateLimit
+}
+
+// markSent records that the type was sent through the channel.
+func (service *Service) markSent(channel int, typ Type) {
+	service.mu.Lock()
+	defer service.mu.Unlock()
+
+	service.lastSent[channelType{channel: channel, typ: typ}] = service.now()
+}
+
+// send sends the notification through the channel.
+func (service *Service) send(ctx context.Context, channel *Channel, notification Notification) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	ctx, cancel := context.WithTimeout(ctx, notifyTimeout)
+	defer cancel()
+
+	return channel.Notifier.Notify(ctx, notification)
+}
+
+// TestWaitSent waits until the received notifications were sent through the
+// channels, it's only intended for testing.
+func (service *Service) TestWaitSent() {
+	service.pending.Wait()
+}
+
+// TestSetNow replaces the time source, it's only intended for testing.
+func (service *Service) TestSetNow(now func() time.Time) {
+	service.mu.Lock()
+	defer service.mu.Unlock()
+	service.now = now
+}
+
 // Read - change notification status to Read by ID.
 func (service *Service) Read(ctx context.Context, notificationID uuid.UUID) (err error) {
 	defer mon.Task()(&ctx)(&err)
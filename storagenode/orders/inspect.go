@@ -0,0 +1,240 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package orders
+
+import (
+	"context"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"time"
+
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/common/storj"
+	"storj.io/storj/storagenode/orders/ordersfile"
+)
+
+// UnsentFileSummary summarizes an unsent orders file, i.e. the orders of a
+// satellite for a single window.
+type UnsentFileSummary struct {
+	SatelliteID   storj.NodeID
+	CreatedAtHour time.Time
+	Version       ordersfile.Version
+	Path          string
+
+	// Orders is the number of readable orders.
+	Orders int
+	// Amount is the total amount of the readable orders in bytes.
+	Amount int64
+	// CorruptEntries is the number of corrupt entries found in the file.
+	CorruptEntries int
+	// Repaired is true, when the file was rewritten with only its readable orders.
+	Repaired bool
+}
+
+// InspectUnsent summarizes all unsent orders files, including the ones which
+// can still be appended to.
+func (store *FileStore) InspectUnsent(ctx context.Context) (_ []UnsentFileSummary, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	store.unsentMu.Lock()
+	defer store.unsentMu.Unlock()
+
+	summaries, _, err := store.inspectUnsentLocked(ctx)
+	return summaries, err
+}
+
+// RepairUnsent rewrites the unsent orders files with corrupt entries, so that
+// they only contain their readable orders in the V2 format. Files without
+// any readable orders are removed.
+//
+// It must not be called while the storage node is running.
+func (store *FileStore) RepairUnsent(ctx context.Context) (_ []UnsentFileSummary, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	store.unsentMu.Lock()
+	defer store.unsentMu.Unlock()
+
+	summaries, infos, err := store.inspectUnsentLocked(ctx)
+	if err != nil {
+		return summaries, err
+	}
+
+	var group errs.Group
+	for i := range summaries {
+		summary := &summaries[i]
+		if summary.CorruptEntries == 0 {
+			continue
+		}
+		if err := store.rewriteUnsent(summary, infos[i]); err != nil {
+			group.Add(err)
+			continue
+		}
+		summary.Repaired = true
+		store.log.Info("Repaired unsent orders file",
+			zap.Stringer("Satellite ID", summary.SatelliteID),
+			zap.Time("Created At", summary.CreatedAtHour),
+			zap.Int("Orders", summary.Orders),
+			zap.Int("Corrupt Entries", summary.CorruptEntries))
+	}
+	return summaries, group.Err()
+}
+
+// inspectUnsentLocked reads all unsent orders files. It returns the
+// summaries sorted by satellite and window, and the readable orders of each file.
+func (store *FileStore) inspectUnsentLocked(ctx context.Context) (summaries []UnsentFileSummary, infos [][]*ordersfile.Info, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	entries, err := os.ReadDir(store.unsentDir)
+	if err != nil {
+		return nil, nil, OrderError.Wrap(err)
+	}
+
+	var group errs.Group
+	for _, entry := range entries {
+		if entry.IsDir() {
+			continue
+		}
+		info, err := entry.Info()
+		if err != nil {
+			group.Add(OrderError.Wrap(err))
+			continue
+		}
+		fileInfo, err := ordersfile.GetUnsentInfo(info)
+		if err != nil {
+			group.Add(OrderError.Wrap(err))
+			continue
+		}
+
+		summary := UnsentFileSummary{
+			SatelliteID:   fileInfo.SatelliteID,
+			CreatedAtHour: fileInfo.CreatedAtHour,
+			Version:       fileInfo.Version,
+			Path:          filepath.Join(store.unsentDir, entry.Name()),
+		}
+		fileInfos, err := readUnsentFile(&summary)
+		if err != nil {
+			group.Add(err)
+			continue
+		}
+
+		summaries = append(summaries, summary)
+		infos = append(infos, fileInfos)
+	}
+
+	sort.Sort(bySatelliteAndWindow{summaries, infos})
+	return summaries, infos, group.Err()
+}
+
+// readUnsentFile reads the readable orders of the file and updates the
+// summary with them.
+func readUnsentFile(summary *UnsentFileSummary) (infos []*ordersfile.Info, err error) {
+	of, err := ordersfile.OpenReadable(summary.Path, summary.Version)
+	if err != nil {
+		return nil, OrderError.Wrap(err)
+	}
+	defer func() {
+		err = errs.Combine(err, OrderError.Wrap(of.Close()))
+	}()
+
+	for {
+		info, err := of.ReadOne()
+		if err != nil {
+			if errs.Is(err, io.EOF) {
+				break
+			}
+			if ordersfile.ErrEntryCorrupt.Has(err) {
+				summary.CorruptEntries++
+				// there is no use in trying to read from the file again.
+				if errs.Is(err, io.ErrUnexpectedEOF) {
+					break
+				}
+				continue
+			}
+			return nil, OrderError.Wrap(err)
+		}
+
+		infos = append(infos, info)
+		summary.Orders++
+		summary.Amount += info.Order.Amount
+	}
+	return infos, nil
+}
+
+// rewriteUnsent replaces the unsent orders file with a V2 file containing
+// only the readable orders.
+func (store *FileStore) rewriteUnsent(summary *UnsentFileSummary, infos []*ordersfile.Info) (err error) {
+	if len(infos) == 0 {
+		return OrderError.Wrap(os.Remove(summary.Path))
+	}
+
+	newPath := filepath.Join(store.unsentDir, ordersfile.UnsentFileName(summary.SatelliteID, summary.CreatedAtHour, ordersfile.V2))
+	if newPath != summary.Path {
+		if _, err := os.Stat(newPath); !os.IsNotExist(err) {
+			return OrderError.New("unable to repair %q: %q already exists", summary.Path, newPath)
+		}
+	}
+
+	// the file is written outside of the unsent directory, so that a partial
+	// file is never listed as unsent orders.
+	tempPath := filepath.Join(filepath.Dir(store.unsentDir), "repair-"+filepath.Base(summary.Path))
+	if err := os.Remove(tempPath); err != nil && !os.IsNotExist(err) {
+		return OrderError.Wrap(err)
+	}
+	defer func() {
+		if err != nil {
+			_ = os.Remove(tempPath)
+		}
+	}()
+
+	of, err := ordersfile.OpenWritableV2(tempPath, summary.SatelliteID, summary.CreatedAtHour)
+	if err != nil {
+		return OrderError.Wrap(err)
+	}
+	for _, info := range infos {
+		if err := of.Append(info); err != nil {
+			return errs.Combine(OrderError.Wrap(err), OrderError.Wrap(of.Close()))
+		}
+	}
+	if err := of.Close(); err != nil {
+		return OrderError.Wrap(err)
+	}
+
+	if err := os.Rename(tempPath, newPath); err != nil {
+		return OrderError.Wrap(err)
+	}
+	if newPath != summary.Path {
+		if err := os.Remove(summary.Path); err != nil {
+			return OrderError.Wrap(err)
+		}
+	}
+
+	summary.Path = newPath
+	summary.Version = ordersfile.V2
+	return nil
+}
+
+// bySatelliteAndWindow sorts summaries and their orders by satellite and window.
+type bySatelliteAndWindow struct {
+	summaries []UnsentFileSummary
+	infos     [][]*ordersfile.Info
+}
+
+func (s bySatelliteAndWindow) Len() int { return len(s.summaries) }
+
+func (s bySatelliteAndWindow) Less(i, j int) bool {
+	a, b := s.summaries[i], s.summaries[j]
+	if a.SatelliteID != b.SatelliteID {
+		return a.SatelliteID.Less(b.SatelliteID)
+	}
+	return a.CreatedAtHour.Before(b.CreatedAtHour)
+}
+
+func (s bySatelliteAndWindow) Swap(i, j int) {
+	s.summaries[i], s.summaries[j] = s.summaries[j], s.summaries[i]
+	s.infos[i], s.infos[j] = s.infos[j], s.infos[i]
+}
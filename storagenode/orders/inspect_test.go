@@ -0,0 +1,123 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package orders_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+
+	"storj.io/common/pb"
+	"storj.io/common/storj"
+	"storj.io/common/testcontext"
+	"storj.io/common/testrand"
+	"storj.io/storj/storagenode/orders"
+	"storj.io/storj/storagenode/orders/ordersfile"
+)
+
+func TestOrdersStore_InspectAndRepairUnsent(t *testing.T) {
+	ctx := testcontext.New(t)
+	defer ctx.Cleanup()
+	dirName := ctx.Dir("test-orders")
+	unsentDir := filepath.Join(dirName, "unsent")
+	now := time.Now()
+	tomorrow := now.Add(24 * time.Hour)
+
+	ordersStore, err := orders.NewFileStore(zaptest.NewLogger(t), dirName, time.Hour)
+	require.NoError(t, err)
+
+	newInfo := func(satelliteID storj.NodeID, amount int64) *ordersfile.Info {
+		sn := testrand.SerialNumber()
+		return &ordersfile.Info{
+			Limit: &pb.OrderLimit{
+				SerialNumber:  sn,
+				SatelliteId:   satelliteID,
+				Action:        pb.PieceAction_GET,
+				OrderCreation: now,
+			},
+			Order: &pb.Order{
+				SerialNumber: sn,
+				Amount:       amount,
+			},
+		}
+	}
+
+	healthy, corruptV1, corruptV2 := testrand.NodeID(), testrand.NodeID(), testrand.NodeID()
+
+	// healthy V2 file
+	require.NoError(t, ordersStore.Enqueue(newInfo(healthy, 100)))
+	require.NoError(t, ordersStore.Enqueue(newInfo(healthy, 200)))
+
+	// V1 file with a truncated last entry
+	v1Path := filepath.Join(unsentDir, ordersfile.UnsentFileName(corruptV1, now, ordersfile.V1))
+	of, err := ordersfile.OpenWritableV1(v1Path, corruptV1, now)
+	require.NoError(t, err)
+	require.NoError(t, of.Append(newInfo(corruptV1, 10)))
+	require.NoError(t, of.Append(newInfo(corruptV1, 20)))
+	require.NoError(t, of.Close())
+	truncateLastByte(t, v1Path)
+
+	// V2 file with a truncated last entry
+	require.NoError(t, ordersStore.Enqueue(newInfo(corruptV2, 1)))
+	require.NoError(t, ordersStore.Enqueue(newInfo(corruptV2, 2)))
+	v2Path := filepath.Join(unsentDir, ordersfile.UnsentFileName(corruptV2, now, ordersfile.V2))
+	truncateLastByte(t, v2Path)
+
+	summaries, err := ordersStore.InspectUnsent(ctx)
+	require.NoError(t, err)
+	require.Len(t, summaries, 3)
+
+	bySatellite := map[storj.NodeID]orders.UnsentFileSummary{}
+	for _, summary := range summaries {
+		bySatellite[summary.SatelliteID] = summary
+	}
+	require.Equal(t, 2, bySatellite[healthy].Orders)
+	require.EqualValues(t, 300, bySatellite[healthy].Amount)
+	require.Zero(t, bySatellite[healthy].CorruptEntries)
+
+	require.Equal(t, ordersfile.V1, bySatellite[corruptV1].Version)
+	require.Equal(t, 1, bySatellite[corruptV1].Orders)
+	require.EqualValues(t, 10, bySatellite[corruptV1].Amount)
+	require.Equal(t, 1, bySatellite[corruptV1].CorruptEntries)
+
+	require.Equal(t, 1, bySatellite[corruptV2].Orders)
+	require.EqualValues(t, 1, bySatellite[corruptV2].Amount)
+	require.Equal(t, 1, bySatellite[corruptV2].CorruptEntries)
+
+	summaries, err = ordersStore.RepairUnsent(ctx)
+	require.NoError(t, err)
+	for _, summary := range summaries {
+		require.Equal(t, summary.CorruptEntries > 0, summary.Repaired)
+		require.Equal(t, ordersfile.V2, summary.Version)
+	}
+
+	// the V1 file was replaced by a V2 file
+	_, err = os.Stat(v1Path)
+	require.True(t, os.IsNotExist(err))
+
+	summaries, err = ordersStore.InspectUnsent(ctx)
+	require.NoError(t, err)
+	require.Len(t, summaries, 3)
+	for _, summary := range summaries {
+		require.Zero(t, summary.CorruptEntries)
+		require.Equal(t, ordersfile.V2, summary.Version)
+	}
+
+	unsent, err := ordersStore.ListUnsentBySatellite(ctx, tomorrow)
+	require.NoError(t, err)
+	require.Len(t, unsent, 3)
+	require.Len(t, unsent[healthy].InfoList, 2)
+	require.Len(t, unsent[corruptV1].InfoList, 1)
+	require.Len(t, unsent[corruptV2].InfoList, 1)
+}
+
+func truncateLastByte(t *testing.T, path string) {
+	stat, err := os.Stat(path)
+	require.NoError(t, err)
+	require.NoError(t, os.Truncate(path, stat.Size()-1))
+}
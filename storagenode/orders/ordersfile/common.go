@@ -27,6 +27,9 @@ const (
 	V0 = Version("v0")
 	// V1 is the second orders file version. It includes a checksum for each entry so that file corruption is handled better.
 	V1 = Version("v1")
+	// V2 is the third orders file version. Each entry is framed by its size and checksum, so that the valid
+	// prefix of a truncated or corrupted file can be recovered.
+	V2 = Version("v2")
 
 	unsentFilePrefix  = "unsent-orders-"
 	archiveFilePrefix = "archived-orders-"
@@ -62,22 +65,38 @@ type Readable interface {
 	Close() error
 }
 
+// UnsentSizes remembers the size of the valid prefix of the V2 unsent orders
+// files opened for writing, so that a file is validated only the first time
+// it's opened instead of on every append. It isn't safe for concurrent use.
+type UnsentSizes map[string]int64
+
+// Forget drops the size of the unsent orders file for a given satellite ID and creation hour, e.g. when it's archived.
+func (sizes UnsentSizes) Forget(unsentDir string, satelliteID storj.NodeID, creationTime time.Time) {
+	delete(sizes, filepath.Join(unsentDir, UnsentFileName(satelliteID, creationTime, V2)))
+}
+
 // OpenWritableUnsent creates or opens for appending the unsent orders file for a given satellite ID and creation hour.
 func OpenWritableUnsent(unsentDir string, satelliteID storj.NodeID, creationTime time.Time) (Writable, error) {
-	// if V0 file already exists, use that. Otherwise use V1 file.
-	versionToUse := V0
-	fileName := UnsentFileName(satelliteID, creationTime, V0)
-	filePath := filepath.Join(unsentDir, fileName)
-	if _, err := os.Stat(filePath); os.IsNotExist(err) {
-		fileName = UnsentFileName(satelliteID, creationTime, V1)
-		filePath = filepath.Join(unsentDir, fileName)
-		versionToUse = V1
-	}
+	return UnsentSizes(nil).OpenWritableUnsent(unsentDir, satelliteID, creationTime)
+}
 
-	if versionToUse == V0 {
-		return OpenWritableV0(filePath)
+// OpenWritableUnsent creates or opens for appending the unsent orders file for a given satellite ID and creation hour,
+// using and updating the known size of its valid prefix.
+func (sizes UnsentSizes) OpenWritableUnsent(unsentDir string, satelliteID storj.NodeID, creationTime time.Time) (Writable, error) {
+	// if V0 or V1 file already exists, use that. Otherwise use V2 file.
+	for _, version := range []Version{V0, V1} {
+		filePath := filepath.Join(unsentDir, UnsentFileName(satelliteID, creationTime, version))
+		if _, err := os.Stat(filePath); os.IsNotExist(err) {
+			continue
+		}
+		if version == V0 {
+			return OpenWritableV0(filePath)
+		}
+		return OpenWritableV1(filePath, satelliteID, creationTime)
 	}
-	return OpenWritableV1(filePath, satelliteID, creationTime)
+
+	filePath := filepath.Join(unsentDir, UnsentFileName(satelliteID, creationTime, V2))
+	return openWritableV2(filePath, satelliteID, creationTime, sizes)
 }
 
 // UnsentInfo contains information relevant to an unsent orders file, as well as information necessary to open it for reading.
@@ -129,10 +148,14 @@ func GetArchivedInfo(info os.FileInfo) (*ArchivedInfo, error) {
 // OpenReadable opens for reading the unsent or archived orders file at a given path.
 // It assumes the path has already been validated with GetUnsentInfo or GetArchivedInfo.
 func OpenReadable(path string, version Version) (Readable, error) {
-	if version == V0 {
+	switch version {
+	case V0:
 		return OpenReadableV0(path)
+	case V1:
+		return OpenReadableV1(path)
+	default:
+		return OpenReadableV2(path)
 	}
-	return OpenReadableV1(path)
 }
 
 // MoveUnsent moves an unsent orders file to the archived orders file directory.
@@ -251,8 +274,11 @@ func getCreationHourString(t time.Time) string {
 
 func getVersion(filename string) (trimmedPath string, version Version) {
 	ext := filepath.Ext(filename)
-	if ext == "."+string(V1) {
+	switch ext {
+	case "." + string(V1):
 		return strings.TrimSuffix(filename, ext), V1
+	case "." + string(V2):
+		return strings.TrimSuffix(filename, ext), V2
 	}
 	return filename, V0
 }
@@ -0,0 +1,306 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package ordersfile
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"hash/crc32"
+	"io"
+	"os"
+	"time"
+
+	"github.com/zeebo/errs"
+
+	"storj.io/common/pb"
+	"storj.io/common/storj"
+	"storj.io/storj/private/date"
+)
+
+var (
+	// fileMagicV2 used to identify header of a V2 file.
+	// "0ddba11 acc01ade5" with the last byte replaced by the version.
+	fileMagicV2 = [8]byte{0x0d, 0xdb, 0xa1, 0x1a, 0xcc, 0x01, 0xad, 0x02}
+
+	// castagnoli is the table used for all V2 checksums.
+	castagnoli = crc32.MakeTable(crc32.Castagnoli)
+)
+
+const (
+	// headerSizeV2 is the size of [fileMagicV2][satellite ID][creation hour][checksum].
+	headerSizeV2 = len(fileMagicV2) + len(storj.NodeID{}) + 8 + 4
+	// entryFrameSizeV2 is the size of [payloadSize][checksum] preceding every entry.
+	entryFrameSizeV2 = 4 + 4
+	// entryPayloadCapV2 is the maximum size of an entry payload.
+	entryPayloadCapV2 = 2 + int(orderLimitSizeCap) + 2 + int(orderSizeCap)
+)
+
+// fileV2 is a version 2 orders file.
+//
+// Unlike V1, entries are not searched for by markers. Every entry is framed
+// by its size and checksum, which allows to find the exact end of the valid
+// part of the file. A file that was truncated or corrupted, e.g. on power
+// loss, is read up to its first invalid entry, and the invalid tail is
+// dropped before new entries are appended.
+type fileV2 struct {
+	f  *os.File
+	br *bufio.Reader
+
+	// path, size and sizes are set when writing. size is the size of the
+	// valid prefix of the file, which is remembered in sizes if not nil.
+	path  string
+	size  int64
+	sizes UnsentSizes
+
+	// err is returned by the next read instead of reading. After a corrupt
+	// entry it is io.EOF, since nothing after it can be trusted.
+	err error
+}
+
+// OpenWritableV2 opens for writing the unsent or archived orders file at a given path.
+// If the file is new, the file header is written. If the file has a corrupted
+// tail, it is truncated to its valid prefix.
+func OpenWritableV2(path string, satelliteID storj.NodeID, creationTime time.Time) (Writable, error) {
+	return openWritableV2(path, satelliteID, creationTime, nil)
+}
+
+// openWritableV2 opens the file for writing like OpenWritableV2. The file is
+// only validated when sizes doesn't know the size of its valid prefix, or
+// when the file was modified since.
+func openWritableV2(path string, satelliteID storj.NodeID, creationTime time.Time, sizes UnsentSizes) (Writable, error) {
+	// create file if not exists or append
+	f, err := os.OpenFile(path, os.O_CREATE|os.O_RDWR, 0644)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	of := &fileV2{
+		f:     f,
+		path:  path,
+		sizes: sizes,
+	}
+
+	validSize, known := sizes[path]
+	if known {
+		stat, err := f.Stat()
+		if err != nil {
+			return nil, errs.Combine(Error.Wrap(err), f.Close())
+		}
+		known = stat.Size() == validSize
+	}
+
+	if !known {
+		validSize, err = ValidSizeV2(f)
+		if err != nil {
+			return nil, errs.Combine(err, f.Close())
+		}
+		if validSize == 0 {
+			// the header is missing or corrupt, none of the entries can be read.
+			if err := of.writeHeader(satelliteID, creationTime); err != nil {
+				return nil, errs.Combine(err, f.Close())
+			}
+			validSize = int64(headerSizeV2)
+		}
+
+		if err := f.Truncate(validSize); err != nil {
+			return nil, errs.Combine(Error.Wrap(err), f.Close())
+		}
+	}
+	if _, err := f.Seek(validSize, io.SeekStart); err != nil {
+		return nil, errs.Combine(Error.Wrap(err), f.Close())
+	}
+
+	of.setSize(validSize)
+	return of, nil
+}
+
+// setSize records the size of the valid prefix of the file.
+func (of *fileV2) setSize(size int64) {
+	of.size = size
+	if of.sizes != nil {
+		of.sizes[of.path] = size
+	}
+}
+
+// writeHeader writes file header as [fileMagicV2][satellite ID][creation hour][checksum].
+func (of *fileV2) writeHeader(satelliteID storj.NodeID, creationTime time.Time) error {
+	toWrite := make([]byte, headerSizeV2)
+	copy(toWrite, fileMagicV2[:])
+	copy(toWrite[len(fileMagicV2):], satelliteID.Bytes())
+	checksumAt := headerSizeV2 - 4
+	binary.LittleEndian.PutUint64(toWrite[checksumAt-8:], uint64(date.TruncateToHourInNano(creationTime)))
+	binary.LittleEndian.PutUint32(toWrite[checksumAt:], crc32.Checksum(toWrite[:checksumAt], castagnoli))
+
+	if _, err := of.f.WriteAt(toWrite, 0); err != nil {
+		return Error.New("Couldn't write file header: %w", err)
+	}
+	return nil
+}
+
+// OpenReadableV2 opens for reading the unsent or archived orders file at a given path.
+func OpenReadableV2(path string) (Readable, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	of := &fileV2{
+		f:  f,
+		br: bufio.NewReader(f),
+	}
+	// if the header is corrupt, there is nothing to read.
+	of.err = readHeaderV2(of.br)
+	return of, nil
+}
+
+// Append writes limit and order to the file as
+// [payloadSize][checksum][limitSize][limitBytes][orderSize][orderBytes].
+func (of *fileV2) Append(info *Info) error {
+	limitSerialized, err := pb.Marshal(info.Limit)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	orderSerialized, err := pb.Marshal(info.Order)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	if len(limitSerialized) > orderLimitSizeCap.Int() || len(orderSerialized) > orderSizeCap.Int() {
+		return Error.New("order limit or order is too large")
+	}
+
+	payloadSize := 2 + len(limitSerialized) + 2 + len(orderSerialized)
+	toWrite := make([]byte, entryFrameSizeV2+payloadSize)
+	payload := toWrite[entryFrameSizeV2:]
+	binary.LittleEndian.PutUint16(payload, uint16(len(limitSerialized)))
+	copy(payload[2:], limitSerialized)
+	binary.LittleEndian.PutUint16(payload[2+len(limitSerialized):], uint16(len(orderSerialized)))
+	copy(payload[2+len(limitSerialized)+2:], orderSerialized)
+
+	binary.LittleEndian.PutUint32(toWrite[0:4], uint32(payloadSize))
+	binary.LittleEndian.PutUint32(toWrite[4:8], crc32.Checksum(payload, castagnoli))
+
+	// the entry is written with a single call, so that a partial write can
+	// only ever affect the tail of the file.
+	if _, err = of.f.Write(toWrite); err != nil {
+		// the tail may be partially written, so the file is validated again
+		// the next time it's opened.
+		delete(of.sizes, of.path)
+		return Error.New("Couldn't write serialized order and limit: %w", err)
+	}
+	of.setSize(of.size + int64(len(toWrite)))
+
+	return nil
+}
+
+// ReadOne reads one entry from the file.
+// It returns ErrEntryCorrupt upon finding a corrupt entry. Since entries
+// can't be located after a corrupt one, all later calls return io.EOF.
+func (of *fileV2) ReadOne() (info *Info, err error) {
+	if of.err != nil {
+		err, of.err = of.err, io.EOF
+		return nil, err
+	}
+
+	info, _, err = readEntryV2(of.br)
+	if err != nil {
+		of.err = io.EOF
+	}
+	return info, err
+}
+
+// Close closes the file.
+func (of *fileV2) Close() error {
+	return of.f.Close()
+}
+
+// ValidSizeV2 returns the size of the valid prefix of a V2 orders file,
+// i.e. the header and all entries up to the first corrupt one. It returns 0
+// when the header is missing or corrupt.
+func ValidSizeV2(f io.ReadSeeker) (_ int64, err error) {
+	if _, err := f.Seek(0, io.SeekStart); err != nil {
+		return 0, Error.Wrap(err)
+	}
+
+	br := bufio.NewReader(f)
+	if err := readHeaderV2(br); err != nil {
+		return 0, nil //nolint: nilerr // a corrupt header means there's no valid prefix
+	}
+
+	size := int64(headerSizeV2)
+	for {
+		_, entrySize, err := readEntryV2(br)
+		if err != nil {
+			return size, nil //nolint: nilerr // the valid prefix ends at the first corrupt entry
+		}
+		size += entrySize
+	}
+}
+
+// readHeaderV2 reads and verifies the file header.
+func readHeaderV2(r io.Reader) error {
+	header := make([]byte, headerSizeV2)
+	if _, err := io.ReadFull(r, header); err != nil {
+		return ErrEntryCorrupt.New("unable to read file header: %w", err)
+	}
+	if !bytes.Equal(header[:len(fileMagicV2)], fileMagicV2[:]) {
+		return ErrEntryCorrupt.New("file magic does not match")
+	}
+	checksumAt := headerSizeV2 - 4
+	if crc32.Checksum(header[:checksumAt], castagnoli) != binary.LittleEndian.Uint32(header[checksumAt:]) {
+		return ErrEntryCorrupt.New("header checksum does not match")
+	}
+	return nil
+}
+
+// readEntryV2 reads and verifies an entry, returning the number of bytes it
+// occupied in the file.
+func readEntryV2(r io.Reader) (_ *Info, size int64, err error) {
+	frame := [entryFrameSizeV2]byte{}
+	if _, err := io.ReadFull(r, frame[:]); err != nil {
+		if errors.Is(err, io.EOF) {
+			return nil, 0, io.EOF
+		}
+		return nil, 0, ErrEntryCorrupt.Wrap(err)
+	}
+	payloadSize := binary.LittleEndian.Uint32(frame[:4])
+	expectedChecksum := binary.LittleEndian.Uint32(frame[4:])
+	if payloadSize < 4 || payloadSize > uint32(entryPayloadCapV2) {
+		return nil, 0, ErrEntryCorrupt.New("invalid entry size: %d", payloadSize)
+	}
+
+	payload := make([]byte, payloadSize)
+	if _, err := io.ReadFull(r, payload); err != nil {
+		return nil, 0, ErrEntryCorrupt.Wrap(err)
+	}
+	if crc32.Checksum(payload, castagnoli) != expectedChecksum {
+		return nil, 0, ErrEntryCorrupt.New("checksum does not match")
+	}
+
+	limitSize := int(binary.LittleEndian.Uint16(payload))
+	if 2+limitSize+2 > len(payload) {
+		return nil, 0, ErrEntryCorrupt.New("invalid limit size: %d", limitSize)
+	}
+	limitSerialized := payload[2 : 2+limitSize]
+	orderSize := int(binary.LittleEndian.Uint16(payload[2+limitSize:]))
+	if 2+limitSize+2+orderSize != len(payload) {
+		return nil, 0, ErrEntryCorrupt.New("invalid order size: %d", orderSize)
+	}
+	orderSerialized := payload[2+limitSize+2:]
+
+	limit := &pb.OrderLimit{}
+	if err := pb.Unmarshal(limitSerialized, limit); err != nil {
+		return nil, 0, ErrEntryCorrupt.Wrap(err)
+	}
+	order := &pb.Order{}
+	if err := pb.Unmarshal(orderSerialized, order); err != nil {
+		return nil, 0, ErrEntryCorrupt.Wrap(err)
+	}
+
+	return &Info{
+		Limit: limit,
+		Order: order,
+	}, int64(entryFrameSizeV2) + int64(payloadSize), nil
+}
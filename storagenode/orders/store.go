@@ -45,6 +45,8 @@ type FileStore struct {
 
 	// mutex for unsent directory
 	unsentMu sync.Mutex
+	// sizes of the valid prefix of the unsent files, guarded by unsentMu
+	unsentSizes ordersfile.UnsentSizes
 	// mutex for archive directory
 	archiveMu sync.Mutex
 
@@ -60,6 +62,7 @@ func NewFileStore(log *zap.Logger, ordersDir string, orderLimitGracePeriod time.
 		unsentDir:             filepath.Join(ordersDir, "unsent"),
 		archiveDir:            filepath.Join(ordersDir, "archive"),
 		active:                make(map[activeWindow]int),
+		unsentSizes:           make(ordersfile.UnsentSizes),
 		orderLimitGracePeriod: orderLimitGracePeriod,
 	}
 
@@ -110,7 +113,7 @@ func (store *FileStore) BeginEnqueue(satelliteID storj.NodeID, createdAt time.Ti
 		}
 
 		// write out the data
-		of, err := ordersfile.OpenWritableUnsent(store.unsentDir, info.Limit.SatelliteId, info.Limit.OrderCreation)
+		of, err := store.unsentSizes.OpenWritableUnsent(store.unsentDir, info.Limit.SatelliteId, info.Limit.OrderCreation)
 		if err != nil {
 			return OrderError.Wrap(err)
 		}
@@ -275,6 +278,8 @@ func (store *FileStore) Archive(satelliteID storj.NodeID, unsentInfo UnsentInfo,
 	store.archiveMu.Lock()
 	defer store.archiveMu.Unlock()
 
+	store.unsentSizes.Forget(store.unsentDir, satelliteID, unsentInfo.CreatedAtHour)
+
 	return OrderError.Wrap(ordersfile.MoveUnsent(
 		store.unsentDir,
 		store.archiveDir,
@@ -395,11 +395,15 @@ func TestOrdersStore_CorruptUnsentV1(t *testing.T) {
 			Amount:       1,
 		},
 	}
-	// store sn1 and sn2 in the same window
-	require.NoError(t, ordersStore.Enqueue(info))
+	// store sn1 and sn2 in the same window using V1, so that sn3 is also stored with V1 even when Enqueue() is used
+	unsentFilePath := filepath.Join(dirName, "unsent", ordersfile.UnsentFileName(satellite, now, ordersfile.V1))
+	of, err := ordersfile.OpenWritableV1(unsentFilePath, satellite, now)
+	require.NoError(t, err)
+	require.NoError(t, of.Append(info))
 	info.Limit.SerialNumber = sn2
 	info.Order.SerialNumber = sn2
-	require.NoError(t, ordersStore.Enqueue(info))
+	require.NoError(t, of.Append(info))
+	require.NoError(t, of.Close())
 
 	// check that we can see both orders tomorrow
 	unsent, err = ordersStore.ListUnsentBySatellite(ctx, tomorrow)
@@ -440,7 +444,126 @@ func TestOrdersStore_CorruptUnsentV1(t *testing.T) {
 	require.EqualValues(t, sn3, unsent[satellite].InfoList[1].Order.SerialNumber)
 }
 
-func TestOrdersStore_V0ToV1(t *testing.T) {
+func TestOrdersStore_CorruptUnsentV2(t *testing.T) {
+	ctx := testcontext.New(t)
+	defer ctx.Cleanup()
+	dirName := ctx.Dir("test-orders")
+	now := time.Now()
+	satellite := testrand.NodeID()
+	tomorrow := now.Add(24 * time.Hour)
+
+	// make order limit grace period 1 hour
+	ordersStore, err := orders.NewFileStore(zaptest.NewLogger(t), dirName, time.Hour)
+	require.NoError(t, err)
+
+	sn1 := testrand.SerialNumber()
+	sn2 := testrand.SerialNumber()
+	sn3 := testrand.SerialNumber()
+	info := &ordersfile.Info{
+		Limit: &pb.OrderLimit{
+			SerialNumber:  sn1,
+			SatelliteId:   satellite,
+			Action:        pb.PieceAction_GET,
+			OrderCreation: now,
+		},
+		Order: &pb.Order{
+			SerialNumber: sn1,
+			Amount:       1,
+		},
+	}
+	// store sn1 and sn2 in the same window
+	require.NoError(t, ordersStore.Enqueue(info))
+	info.Limit.SerialNumber = sn2
+	info.Order.SerialNumber = sn2
+	require.NoError(t, ordersStore.Enqueue(info))
+
+	unsentFilePath := filepath.Join(dirName, "unsent", ordersfile.UnsentFileName(satellite, now, ordersfile.V2))
+	stat, err := os.Stat(unsentFilePath)
+	require.NoError(t, err)
+
+	// corrupt unsent orders file by removing the last byte, as if the node lost power while writing sn2
+	require.NoError(t, os.Truncate(unsentFilePath, stat.Size()-1))
+
+	// the valid prefix of the file is still readable
+	unsent, err := ordersStore.ListUnsentBySatellite(ctx, tomorrow)
+	require.NoError(t, err)
+	require.Len(t, unsent, 1)
+	require.Len(t, unsent[satellite].InfoList, 1)
+	require.EqualValues(t, sn1, unsent[satellite].InfoList[0].Order.SerialNumber)
+
+	// add another order, sn3, to the same window, which drops the corrupted tail
+	info.Limit.SerialNumber = sn3
+	info.Order.SerialNumber = sn3
+	require.NoError(t, ordersStore.Enqueue(info))
+
+	unsent, err = ordersStore.ListUnsentBySatellite(ctx, tomorrow)
+	require.NoError(t, err)
+	require.Len(t, unsent, 1)
+	require.Len(t, unsent[satellite].InfoList, 2)
+	require.Equal(t, ordersfile.V2, unsent[satellite].Version)
+	require.EqualValues(t, sn1, unsent[satellite].InfoList[0].Order.SerialNumber)
+	require.EqualValues(t, sn3, unsent[satellite].InfoList[1].Order.SerialNumber)
+}
+
+func TestOrdersStore_UnsentV2ValidatedOnce(t *testing.T) {
+	ctx := testcontext.New(t)
+	defer ctx.Cleanup()
+	dirName := ctx.Dir("test-orders")
+	now := time.Now()
+	satellite := testrand.NodeID()
+
+	// make order limit grace period 1 hour
+	ordersStore, err := orders.NewFileStore(zaptest.NewLogger(t), dirName, time.Hour)
+	require.NoError(t, err)
+
+	info := &ordersfile.Info{
+		Limit: &pb.OrderLimit{
+			SerialNumber:  testrand.SerialNumber(),
+			SatelliteId:   satellite,
+			Action:        pb.PieceAction_GET,
+			OrderCreation: now,
+		},
+		Order: &pb.Order{
+			Amount: 1,
+		},
+	}
+	require.NoError(t, ordersStore.Enqueue(info))
+
+	unsentFilePath := filepath.Join(dirName, "unsent", ordersfile.UnsentFileName(satellite, now, ordersfile.V2))
+	before, err := os.Stat(unsentFilePath)
+	require.NoError(t, err)
+
+	// corrupt the last byte of the entry without changing the size of the file.
+	f, err := os.OpenFile(unsentFilePath, os.O_RDWR, 0644)
+	require.NoError(t, err)
+	last := make([]byte, 1)
+	_, err = f.ReadAt(last, before.Size()-1)
+	require.NoError(t, err)
+	_, err = f.WriteAt([]byte{^last[0]}, before.Size()-1)
+	require.NoError(t, err)
+	require.NoError(t, f.Close())
+
+	// the file isn't read again, since its size matches the one known from
+	// the previous enqueue, so the entry is appended after the corrupted one.
+	info.Limit.SerialNumber = testrand.SerialNumber()
+	require.NoError(t, ordersStore.Enqueue(info))
+
+	after, err := os.Stat(unsentFilePath)
+	require.NoError(t, err)
+	require.Greater(t, after.Size(), before.Size())
+
+	// a new store doesn't know the file and drops its corrupted tail.
+	ordersStore, err = orders.NewFileStore(zaptest.NewLogger(t), dirName, time.Hour)
+	require.NoError(t, err)
+	require.NoError(t, ordersStore.Enqueue(info))
+
+	unsent, err := ordersStore.ListUnsentBySatellite(ctx, now.Add(24*time.Hour))
+	require.NoError(t, err)
+	require.Len(t, unsent[satellite].InfoList, 1)
+	require.Equal(t, info.Limit.SerialNumber, unsent[satellite].InfoList[0].Limit.SerialNumber)
+}
+
+func TestOrdersStore_V0ToV2(t *testing.T) {
 	ctx := testcontext.New(t)
 	defer ctx.Cleanup()
 	dirName := ctx.Dir("test-orders")
@@ -494,14 +617,14 @@ func TestOrdersStore_V0ToV1(t *testing.T) {
 
 	// archive file to free up window
 	require.NoError(t, ordersStore.Archive(satellite, unsent[satellite], time.Now(), pb.SettlementWithWindowResponse_ACCEPTED))
-	// new file should be created with version V1
+	// new file should be created with version V2
 	require.NoError(t, ordersStore.Enqueue(info))
 
 	unsent, err = ordersStore.ListUnsentBySatellite(ctx, tomorrow)
 	require.NoError(t, err)
 	require.Len(t, unsent, 1)
 	require.Len(t, unsent[satellite].InfoList, 1)
-	require.Equal(t, ordersfile.V1, unsent[satellite].Version)
+	require.Equal(t, ordersfile.V2, unsent[satellite].Version)
 }
 
 func verifyInfosEqual(t *testing.T, a, b *ordersfile.Info) {
@@ -5,8 +5,6 @@ package payouts
 
 import (
 	"context"
-	"database/sql"
-	"errors"
 	"fmt"
 	"strconv"
 	"strings"
@@ -240,7 +238,6 @@ func (service *Service) AllSatellitesPayoutPeriod(ctx context.Context, period st
 
 	satelliteIDs = append(satelliteIDs, service.stefanSatellite)
 	for i := 0; i < len(satelliteIDs); i++ {
-		var payoutForPeriod SatellitePayoutForPeriod
 		paystub, err := service.db.GetPayStub(ctx, satelliteIDs[i], period)
 		if err != nil {
 			if ErrNoPayStubForPeriod.Has(err) {
@@ -249,71 +246,123 @@ func (service *Service) AllSatellitesPayoutPeriod(ctx context.Context, period st
 			return nil, ErrPayoutService.Wrap(err)
 		}
 
-		receipt, err := service.db.GetReceipt(ctx, satelliteIDs[i], period)
-		if err != nil {
-			if !ErrNoPayStubForPeriod.Has(err) {
+		var satelliteURL string
+		if satelliteIDs[i] != service.stefanSatellite {
+			url, err := service.trust.GetNodeURL(ctx, satelliteIDs[i])
+			if err != nil {
 				return nil, ErrPayoutService.Wrap(err)
 			}
+
+			satelliteURL = url.Address
 		}
 
-		stats, err := service.reputationDB.Get(ctx, satelliteIDs[i])
+		payoutForPeriod, err := service.payoutForPeriod(ctx, *paystub, satelliteURL)
 		if err != nil {
-			return nil, ErrPayoutService.Wrap(err)
+			return nil, err
 		}
 
-		satellite, err := service.satellitesDB.GetSatellite(ctx, satelliteIDs[i])
-		if err != nil {
-			if errors.Is(err, sql.ErrNoRows) {
-				payoutForPeriod.IsExitComplete = false
-			}
+		result = append(result, payoutForPeriod)
+	}
 
-			return nil, ErrPayoutService.Wrap(err)
-		}
+	return result, nil
+}
 
-		if satelliteIDs[i] != service.stefanSatellite {
-			url, err := service.trust.GetNodeURL(ctx, satelliteIDs[i])
-			if err != nil {
-				return nil, ErrPayoutService.Wrap(err)
-			}
+// StoredPayoutsPeriod retrieves paystub and payment receipt for specific month
+// from all satellites with a stored paystub, including the satellites which
+// aren't trusted anymore.
+func (service *Service) StoredPayoutsPeriod(ctx context.Context, period string) (result []SatellitePayoutForPeriod, err error) {
+	defer mon.Task()(&ctx)(&err)
 
-			payoutForPeriod.SatelliteURL = url.Address
-		}
+	paystubs, err := service.db.AllPayStubs(ctx, period)
+	if err != nil {
+		return nil, ErrPayoutService.Wrap(err)
+	}
+	if len(paystubs) == 0 {
+		return nil, nil
+	}
+
+	// the satellites which aren't trusted anymore only have the stored address.
+	storedURLs, err := service.satellitesDB.GetSatellitesUrls(ctx)
+	if err != nil {
+		return nil, ErrPayoutService.Wrap(err)
+	}
+	addresses := make(map[storj.NodeID]string, len(storedURLs))
+	for _, url := range storedURLs {
+		addresses[url.ID] = url.Address
+	}
 
-		if satellite.Status == satellites.ExitSucceeded {
-			payoutForPeriod.IsExitComplete = true
+	for _, paystub := range paystubs {
+		satelliteURL := addresses[paystub.SatelliteID]
+		if url, err := service.trust.GetNodeURL(ctx, paystub.SatelliteID); err == nil {
+			satelliteURL = url.Address
 		}
 
-		if paystub.SurgePercent == 0 {
-			paystub.SurgePercent = 100
+		payoutForPeriod, err := service.payoutForPeriod(ctx, paystub, satelliteURL)
+		if err != nil {
+			return nil, err
 		}
 
-		earned, surge := paystub.GetEarnedWithSurge()
+		result = append(result, payoutForPeriod)
+	}
+
+	return result, nil
+}
 
-		periodTime := Period(paystub.Period)
+// payoutForPeriod combines the paystub with the payment receipt, the
+// reputation and the exit status of its satellite.
+func (service *Service) payoutForPeriod(ctx context.Context, paystub PayStub, satelliteURL string) (payoutForPeriod SatellitePayoutForPeriod, err error) {
+	satelliteID := paystub.SatelliteID
 
-		heldPeriod, err := periodTime.Time()
-		if err != nil {
-			return nil, ErrPayoutService.Wrap(err)
+	receipt, err := service.db.GetReceipt(ctx, satelliteID, paystub.Period)
+	if err != nil {
+		if !ErrNoPayStubForPeriod.Has(err) {
+			return SatellitePayoutForPeriod{}, ErrPayoutService.Wrap(err)
 		}
+	}
 
-		heldPercent := GetHeldRate(stats.JoinedAt, heldPeriod)
-		payoutForPeriod.Held = paystub.Held
-		payoutForPeriod.Receipt = receipt
-		payoutForPeriod.Surge = surge
-		payoutForPeriod.AfterHeld = surge - paystub.Held
-		payoutForPeriod.Age = int64(date.MonthsCountSince(stats.JoinedAt))
-		payoutForPeriod.Disposed = paystub.Disposed
-		payoutForPeriod.Earned = earned
-		payoutForPeriod.SatelliteID = satelliteIDs[i].String()
-		payoutForPeriod.SurgePercent = paystub.SurgePercent
-		payoutForPeriod.Paid = paystub.Paid
-		payoutForPeriod.HeldPercent = heldPercent
-		payoutForPeriod.Distributed = paystub.Distributed
+	stats, err := service.reputationDB.Get(ctx, satelliteID)
+	if err != nil {
+		return SatellitePayoutForPeriod{}, ErrPayoutService.Wrap(err)
+	}
 
-		result = append(result, payoutForPeriod)
+	satellite, err := service.satellitesDB.GetSatellite(ctx, satelliteID)
+	if err != nil {
+		return SatellitePayoutForPeriod{}, ErrPayoutService.Wrap(err)
 	}
 
-	return result, nil
+	if satellite.Status == satellites.ExitSucceeded {
+		payoutForPeriod.IsExitComplete = true
+	}
+
+	if paystub.SurgePercent == 0 {
+		paystub.SurgePercent = 100
+	}
+
+	earned, surge := paystub.GetEarnedWithSurge()
+
+	periodTime := Period(paystub.Period)
+
+	heldPeriod, err := periodTime.Time()
+	if err != nil {
+		return SatellitePayoutForPeriod{}, ErrPayoutService.Wrap(err)
+	}
+
+	heldPercent := GetHeldRate(stats.JoinedAt, heldPeriod)
+	payoutForPeriod.SatelliteURL = satelliteURL
+	payoutForPeriod.Held = paystub.Held
+	payoutForPeriod.Receipt = receipt
+	payoutForPeriod.Surge = surge
+	payoutForPeriod.AfterHeld = surge - paystub.Held
+	payoutForPeriod.Age = int64(date.MonthsCountSince(stats.JoinedAt))
+	payoutForPeriod.Disposed = paystub.Disposed
+	payoutForPeriod.Earned = earned
+	payoutForPeriod.SatelliteID = satelliteID.String()
+	payoutForPeriod.SurgePercent = paystub.SurgePercent
+	payoutForPeriod.Paid = paystub.Paid
+	payoutForPeriod.HeldPercent = heldPercent
+	payoutForPeriod.Distributed = paystub.Distributed
+
+	return payoutForPeriod, nil
 }
 
 // HeldAmountHistory retrieves held amount history for all satellites.
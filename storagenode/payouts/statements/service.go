@@ -0,0 +1,218 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package statements
+
+import (
+	"context"
+	"math"
+	"time"
+
+	"github.com/spacemonkeygo/monkit/v3"
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/common/storj"
+	"storj.io/storj/private/date"
+	"storj.io/storj/storagenode/payouts"
+	"storj.io/storj/storagenode/payouts/estimatedpayouts"
+	"storj.io/storj/storagenode/reputation"
+	"storj.io/storj/storagenode/trust"
+)
+
+var (
+	// Error is the default error class for payout statements.
+	Error = errs.Class("payout statements")
+
+	mon = monkit.Package()
+)
+
+// maxPeriods limits the number of periods of a report.
+const maxPeriods = 120
+
+// Service generates payout statements from the paystubs, and from the
+// estimations for the periods without paystub yet.
+//
+// architecture: Service
+type Service struct {
+	log *zap.Logger
+
+	payouts      *payouts.Service
+	estimation   *estimatedpayouts.Service
+	reputationDB reputation.DB
+	trust        *trust.Pool
+}
+
+// NewService creates a new payout statements service.
+func NewService(log *zap.Logger, payouts *payouts.Service, estimation *estimatedpayouts.Service, reputationDB reputation.DB, trust *trust.Pool) *Service {
+	return &Service{
+		log:          log,
+		payouts:      payouts,
+		estimation:   estimation,
+		reputationDB: reputationDB,
+		trust:        trust,
+	}
+}
+
+// Report returns the statements of the periods between periodStart and
+// periodEnd, both included and formatted as yyyy-mm. When satelliteID isn't
+// zero, only the statements of that satellite are returned.
+//
+// Every stored paystub is reported, also those of the satellites which aren't
+// trusted anymore. The current and the previous period usually don't have a
+// paystub yet, the trusted satellites without one get an estimated statement
+// for them.
+func (service *Service) Report(ctx context.Context, satelliteID storj.NodeID, periodStart, periodEnd string, now time.Time) (report Report, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	periods, err := periodRange(periodStart, periodEnd)
+	if err != nil {
+		return Report{}, err
+	}
+
+	report.Start, report.End = periodStart, periodEnd
+	report.Statements = []Statement{}
+
+	currentMonth := date.UTCBeginOfMonth(now)
+	estimated := map[string]func(estimatedpayouts.EstimatedPayout) estimatedpayouts.PayoutMonthly{
+		currentMonth.Format("2006-01"): func(payout estimatedpayouts.EstimatedPayout) estimatedpayouts.PayoutMonthly {
+			return payout.CurrentMonth
+		},
+		currentMonth.AddDate(0, -1, 0).Format("2006-01"): func(payout estimatedpayouts.EstimatedPayout) estimatedpayouts.PayoutMonthly {
+			return payout.PreviousMonth
+		},
+	}
+
+	for _, period := range periods {
+		payoutsForPeriod, err := service.payouts.StoredPayoutsPeriod(ctx, period)
+		if err != nil {
+			return Report{}, Error.Wrap(err)
+		}
+
+		withPaystub := make(map[string]struct{}, len(payoutsForPeriod))
+		for _, payout := range payoutsForPeriod {
+			withPaystub[payout.SatelliteID] = struct{}{}
+			if !satelliteID.IsZero() && payout.SatelliteID != satelliteID.String() {
+				continue
+			}
+
+			report.Statements = append(report.Statements, Statement{
+				Period:         period,
+				SatelliteID:    payout.SatelliteID,
+				SatelliteURL:   payout.SatelliteURL,
+				Earned:         payout.Earned,
+				SurgePercent:   payout.SurgePercent,
+				Surge:          payout.Surge,
+				HeldPercent:    payout.HeldPercent,
+				Held:           payout.Held,
+				Disposed:       payout.Disposed,
+				Paid:           payout.Paid,
+				Distributed:    payout.Distributed,
+				Receipt:        payout.Receipt,
+				TransactionURL: TransactionURL(payout.Receipt),
+			})
+		}
+
+		monthly, ok := estimated[period]
+		if !ok {
+			continue
+		}
+
+		estimations, err := service.estimate(ctx, satelliteID, withPaystub, period, monthly, now)
+		if err != nil {
+			return Report{}, err
+		}
+		report.Statements = append(report.Statements, estimations...)
+	}
+
+	report.YearlyTotals, err = YearlyTotals(report.Statements)
+	if err != nil {
+		return Report{}, err
+	}
+
+	return report, nil
+}
+
+// estimate returns the estimated statements of the trusted satellites which
+// didn't send the paystub of the period yet.
+func (service *Service) estimate(ctx context.Context, satelliteID storj.NodeID, withPaystub map[string]struct{}, period string, monthly func(estimatedpayouts.EstimatedPayout) estimatedpayouts.PayoutMonthly, now time.Time) (_ []Statement, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var statements []Statement
+	for _, id := range service.trust.GetSatellites(ctx) {
+		if !satelliteID.IsZero() && id != satelliteID {
+			continue
+		}
+		if _, ok := withPaystub[id.String()]; ok {
+			continue
+		}
+
+		stats, err := service.reputationDB.Get(ctx, id)
+		if err != nil {
+			return nil, Error.Wrap(err)
+		}
+		if stats.DisqualifiedAt != nil {
+			continue
+		}
+
+		estimation, err := service.estimation.GetSatelliteEstimatedPayout(ctx, id, now)
+		if err != nil {
+			return nil, Error.Wrap(err)
+		}
+		payout := monthly(estimation)
+
+		earned := fromCents(payout.EgressBandwidthPayout + payout.EgressRepairAuditPayout + payout.DiskSpacePayout)
+		if earned == 0 {
+			continue
+		}
+
+		var url string
+		if nodeURL, err := service.trust.GetNodeURL(ctx, id); err == nil {
+			url = nodeURL.Address
+		}
+
+		statements = append(statements, Statement{
+			Period:       period,
+			SatelliteID:  id.String(),
+			SatelliteURL: url,
+			Earned:       earned,
+			SurgePercent: 100,
+			Surge:        earned,
+			HeldPercent:  payout.HeldRate,
+			Held:         fromCents(payout.Held),
+			Estimated:    true,
+		})
+	}
+
+	return statements, nil
+}
+
+// periodRange returns the periods between start and end, both included.
+func periodRange(periodStart, periodEnd string) ([]string, error) {
+	start, err := payouts.Period(periodStart).Time()
+	if err != nil {
+		return nil, payouts.ErrBadPeriod.New("period start has wrong format")
+	}
+	end, err := payouts.Period(periodEnd).Time()
+	if err != nil {
+		return nil, payouts.ErrBadPeriod.New("period end has wrong format")
+	}
+	if end.Before(start) {
+		return nil, payouts.ErrBadPeriod.New("period end is before period start")
+	}
+
+	var periods []string
+	for period := start; !period.After(end); period = period.AddDate(0, 1, 0) {
+		if len(periods) >= maxPeriods {
+			return nil, payouts.ErrBadPeriod.New("period range is longer than %d months", maxPeriods)
+		}
+		periods = append(periods, period.Format("2006-01"))
+	}
+	return periods, nil
+}
+
+// fromCents converts the cents of the estimations to the micro dollars of the
+// paystubs.
+func fromCents(cents float64) int64 {
+	return int64(math.Round(cents * 1e4))
+}
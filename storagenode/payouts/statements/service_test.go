@@ -0,0 +1,128 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package statements_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+
+	"storj.io/common/identity"
+	"storj.io/common/pb"
+	"storj.io/common/storj"
+	"storj.io/common/testcontext"
+	"storj.io/common/testrand"
+	"storj.io/storj/storagenode"
+	"storj.io/storj/storagenode/payouts"
+	"storj.io/storj/storagenode/payouts/estimatedpayouts"
+	"storj.io/storj/storagenode/payouts/statements"
+	"storj.io/storj/storagenode/pricing"
+	"storj.io/storj/storagenode/storagenodedb/storagenodedbtest"
+	"storj.io/storj/storagenode/trust"
+)
+
+func TestServiceReport(t *testing.T) {
+	storagenodedbtest.Run(t, func(ctx *testcontext.Context, t *testing.T, db storagenode.DB) {
+		log := zaptest.NewLogger(t)
+		now := time.Date(2023, 3, 15, 0, 0, 0, 0, time.UTC)
+
+		withPaystub, withEstimation, untrusted := testrand.NodeID(), testrand.NodeID(), testrand.NodeID()
+
+		pool, err := trust.NewPool(log, fakeIdentityResolver{}, trust.Config{
+			Sources: []trust.Source{fakeSource{entries: []trust.Entry{
+				{SatelliteURL: trust.SatelliteURL{ID: withPaystub, Host: "paystub.test", Port: 7777}},
+				{SatelliteURL: trust.SatelliteURL{ID: withEstimation, Host: "estimation.test", Port: 7777}},
+			}}},
+			CachePath: ctx.File("trust-cache.json"),
+		}, db.Satellites())
+		require.NoError(t, err)
+		require.NoError(t, pool.Refresh(ctx))
+
+		// the satellite isn't trusted anymore, but its paystub is stored.
+		require.NoError(t, db.Satellites().SetAddress(ctx, untrusted, "untrusted.test:7777"))
+
+		for _, paystub := range []payouts.PayStub{
+			{SatelliteID: untrusted, Period: "2023-01", CompGet: 1000, SurgePercent: 100, Paid: 1000},
+			{SatelliteID: withPaystub, Period: "2023-02", CompGet: 2000, SurgePercent: 100, Paid: 2000},
+		} {
+			require.NoError(t, db.Payout().StorePayStub(ctx, paystub))
+		}
+
+		for _, id := range []storj.NodeID{withPaystub, withEstimation} {
+			require.NoError(t, db.Pricing().Store(ctx, pricing.Pricing{SatelliteID: id, EgressBandwidth: 2000}))
+			// the previous period has a paystub or no usage, so it isn't estimated.
+			require.NoError(t, db.Bandwidth().Add(ctx, id, pb.PieceAction_GET, 1e12, now.AddDate(0, 0, -5)))
+		}
+
+		payoutsService, err := payouts.NewService(log, db.Payout(), db.Reputation(), db.Satellites(), pool)
+		require.NoError(t, err)
+		estimation := estimatedpayouts.NewService(db.Bandwidth(), db.Reputation(), db.StorageUsage(), db.Pricing(), db.Satellites(), pool)
+		service := statements.NewService(log, payoutsService, estimation, db.Reputation(), pool)
+
+		report, err := service.Report(ctx, storj.NodeID{}, "2023-01", "2023-03", now)
+		require.NoError(t, err)
+
+		type key struct {
+			period      string
+			satelliteID storj.NodeID
+		}
+		byKey := map[key]statements.Statement{}
+		for _, statement := range report.Statements {
+			id, err := storj.NodeIDFromString(statement.SatelliteID)
+			require.NoError(t, err)
+			byKey[key{statement.Period, id}] = statement
+		}
+		require.Len(t, byKey, 4)
+
+		statement := byKey[key{"2023-01", untrusted}]
+		require.False(t, statement.Estimated)
+		require.Equal(t, "untrusted.test:7777", statement.SatelliteURL)
+		require.EqualValues(t, 1000, statement.Earned)
+
+		statement = byKey[key{"2023-02", withPaystub}]
+		require.False(t, statement.Estimated)
+		require.Equal(t, "paystub.test:7777", statement.SatelliteURL)
+		require.EqualValues(t, 2000, statement.Earned)
+
+		// the paystub of the current period isn't there yet, so both
+		// trusted satellites are estimated.
+		for _, id := range []storj.NodeID{withPaystub, withEstimation} {
+			statement = byKey[key{"2023-03", id}]
+			require.True(t, statement.Estimated)
+			require.EqualValues(t, 20e6, statement.Earned)
+		}
+
+		// the estimations aren't part of the totals.
+		require.Equal(t, []statements.YearlyTotal{
+			{Year: 2023, Earned: 3000, Surge: 3000, Paid: 3000},
+		}, report.YearlyTotals)
+
+		report, err = service.Report(ctx, untrusted, "2023-01", "2023-03", now)
+		require.NoError(t, err)
+		require.Len(t, report.Statements, 1)
+		require.Equal(t, untrusted.String(), report.Statements[0].SatelliteID)
+	})
+}
+
+type fakeSource struct {
+	entries []trust.Entry
+}
+
+func (fakeSource) String() string { return "fake" }
+
+func (fakeSource) Static() bool { return true }
+
+func (source fakeSource) FetchEntries(context.Context) ([]trust.Entry, error) {
+	return source.entries, nil
+}
+
+type fakeIdentityResolver struct{}
+
+func (fakeIdentityResolver) ResolveIdentity(ctx context.Context, url storj.NodeURL) (*identity.PeerIdentity, error) {
+	return nil, errors.New("no identity")
+}
@@ -0,0 +1,178 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package statements
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Report contains the payout statements of a range of periods and their
+// yearly totals.
+//
+// Amounts are in micro dollars, like in the paystubs. The CSV format presents
+// them in dollars.
+type Report struct {
+	Start        string        `json:"start"`
+	End          string        `json:"end"`
+	Statements   []Statement   `json:"statements"`
+	YearlyTotals []YearlyTotal `json:"yearlyTotals"`
+}
+
+// Statement is the payout of a satellite for a period.
+type Statement struct {
+	Period         string  `json:"period"`
+	SatelliteID    string  `json:"satelliteID"`
+	SatelliteURL   string  `json:"satelliteURL"`
+	Earned         int64   `json:"earned"`
+	SurgePercent   int64   `json:"surgePercent"`
+	Surge          int64   `json:"surge"`
+	HeldPercent    float64 `json:"heldPercent"`
+	Held           int64   `json:"held"`
+	Disposed       int64   `json:"disposed"`
+	Paid           int64   `json:"paid"`
+	Distributed    int64   `json:"distributed"`
+	Receipt        string  `json:"receipt"`
+	TransactionURL string  `json:"transactionURL"`
+	// Estimated is set when the satellite didn't send the paystub of the
+	// period yet and the amounts are estimated from the local usage.
+	Estimated bool `json:"estimated"`
+}
+
+// YearlyTotal sums the statements of a year, estimations excluded.
+type YearlyTotal struct {
+	Year        int   `json:"year"`
+	Earned      int64 `json:"earned"`
+	Surge       int64 `json:"surge"`
+	Held        int64 `json:"held"`
+	Disposed    int64 `json:"disposed"`
+	Paid        int64 `json:"paid"`
+	Distributed int64 `json:"distributed"`
+}
+
+// YearlyTotals sums the statements per year. Estimated statements aren't
+// counted since nothing was paid for them yet.
+func YearlyTotals(statements []Statement) ([]YearlyTotal, error) {
+	totals := make(map[int]*YearlyTotal)
+	for _, statement := range statements {
+		if statement.Estimated {
+			continue
+		}
+
+		yearPart, _, _ := strings.Cut(statement.Period, "-")
+		year, err := strconv.Atoi(yearPart)
+		if err != nil {
+			return nil, Error.New("invalid period %q", statement.Period)
+		}
+
+		total, ok := totals[year]
+		if !ok {
+			total = &YearlyTotal{Year: year}
+			totals[year] = total
+		}
+		total.Earned += statement.Earned
+		total.Surge += statement.Surge
+		total.Held += statement.Held
+		total.Disposed += statement.Disposed
+		total.Paid += statement.Paid
+		total.Distributed += statement.Distributed
+	}
+
+	result := make([]YearlyTotal, 0, len(totals))
+	for _, total := range totals {
+		result = append(result, *total)
+	}
+	sort.Slice(result, func(i, k int) bool {
+		return result[i].Year < result[k].Year
+	})
+	return result, nil
+}
+
+// TransactionURL returns the link to the blockchain explorer of the
+// transaction of a payment receipt. Receipts of unknown networks are returned
+// as they are.
+func TransactionURL(receipt string) string {
+	prefixed := func(hash string) string {
+		if !strings.HasPrefix(hash, "0x") {
+			return "0x" + hash
+		}
+		return hash
+	}
+
+	network, hash, ok := strings.Cut(receipt, ":")
+	if !ok {
+		return receipt
+	}
+
+	switch network {
+	case "eth":
+		return "https://etherscan.io/tx/" + prefixed(hash)
+	case "zksync", "zkwithdraw":
+		return "https://zkscan.io/explorer/transactions/" + prefixed(hash)
+	case "polygon":
+		return "https://polygonscan.com/tx/" + prefixed(hash)
+	default:
+		return receipt
+	}
+}
+
+// WriteCSV writes the statements of the report followed by its yearly totals
+// as CSV.
+func WriteCSV(w io.Writer, report Report) error {
+	out := csv.NewWriter(w)
+
+	err := out.Write([]string{
+		"Type", "Period", "Satellite ID", "Satellite URL",
+		"Earned (USD)", "Surge Percent", "Surge (USD)", "Held Percent", "Held (USD)",
+		"Disposed (USD)", "Paid (USD)", "Distributed (USD)", "Receipt", "Transaction URL",
+	})
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	for _, statement := range report.Statements {
+		kind := "statement"
+		if statement.Estimated {
+			kind = "estimation"
+		}
+		err := out.Write([]string{
+			kind, statement.Period, statement.SatelliteID, statement.SatelliteURL,
+			formatUSD(statement.Earned), strconv.FormatInt(statement.SurgePercent, 10), formatUSD(statement.Surge),
+			strconv.FormatFloat(statement.HeldPercent, 'f', -1, 64), formatUSD(statement.Held),
+			formatUSD(statement.Disposed), formatUSD(statement.Paid), formatUSD(statement.Distributed),
+			statement.Receipt, statement.TransactionURL,
+		})
+		if err != nil {
+			return Error.Wrap(err)
+		}
+	}
+
+	for _, total := range report.YearlyTotals {
+		err := out.Write([]string{
+			"yearly total", strconv.Itoa(total.Year), "", "",
+			formatUSD(total.Earned), "", formatUSD(total.Surge), "", formatUSD(total.Held),
+			formatUSD(total.Disposed), formatUSD(total.Paid), formatUSD(total.Distributed), "", "",
+		})
+		if err != nil {
+			return Error.Wrap(err)
+		}
+	}
+
+	out.Flush()
+	return Error.Wrap(out.Error())
+}
+
+// formatUSD formats an amount of micro dollars in dollars.
+func formatUSD(micro int64) string {
+	sign := ""
+	if micro < 0 {
+		sign = "-"
+		micro = -micro
+	}
+	return fmt.Sprintf("%s%d.%06d", sign, micro/1e6, micro%1e6)
+}
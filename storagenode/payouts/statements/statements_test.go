@@ -0,0 +1,69 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package statements_test
+
+import (
+	"bytes"
+	"encoding/csv"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"storj.io/storj/storagenode/payouts/statements"
+)
+
+func TestTransactionURL(t *testing.T) {
+	for receipt, expected := range map[string]string{
+		"":                   "",
+		"eth:0xabc":          "https://etherscan.io/tx/0xabc",
+		"eth:abc":            "https://etherscan.io/tx/0xabc",
+		"zksync:0xabc":       "https://zkscan.io/explorer/transactions/0xabc",
+		"zkwithdraw:abc":     "https://zkscan.io/explorer/transactions/0xabc",
+		"polygon:0xabc":      "https://polygonscan.com/tx/0xabc",
+		"manual transaction": "manual transaction",
+		"other:0xabc":        "other:0xabc",
+	} {
+		require.Equal(t, expected, statements.TransactionURL(receipt), receipt)
+	}
+}
+
+func TestYearlyTotals(t *testing.T) {
+	totals, err := statements.YearlyTotals([]statements.Statement{
+		{Period: "2022-12", Earned: 10, Surge: 20, Held: 5, Paid: 15, Distributed: 15},
+		{Period: "2023-01", Earned: 1, Surge: 1, Held: 1, Disposed: 3},
+		{Period: "2022-11", Earned: 2, Surge: 2, Paid: 2, Distributed: 1},
+		{Period: "2023-02", Earned: 100, Surge: 100, Estimated: true},
+	})
+	require.NoError(t, err)
+	require.Equal(t, []statements.YearlyTotal{
+		{Year: 2022, Earned: 12, Surge: 22, Held: 5, Paid: 17, Distributed: 16},
+		{Year: 2023, Earned: 1, Surge: 1, Held: 1, Disposed: 3},
+	}, totals)
+
+	_, err = statements.YearlyTotals([]statements.Statement{{Period: "invalid"}})
+	require.Error(t, err)
+}
+
+func TestWriteCSV(t *testing.T) {
+	var buf bytes.Buffer
+	err := statements.WriteCSV(&buf, statements.Report{
+		Start: "2022-12",
+		End:   "2023-01",
+		Statements: []statements.Statement{
+			{Period: "2022-12", SatelliteID: "sat", Earned: 1500000, SurgePercent: 100, Surge: 1500000, Held: -250, Receipt: "eth:abc", TransactionURL: "https://etherscan.io/tx/0xabc"},
+			{Period: "2023-01", SatelliteID: "sat", Earned: 10, Estimated: true},
+		},
+		YearlyTotals: []statements.YearlyTotal{{Year: 2022, Earned: 1500000}},
+	})
+	require.NoError(t, err)
+
+	records, err := csv.NewReader(&buf).ReadAll()
+	require.NoError(t, err)
+	require.Len(t, records, 4)
+	require.Equal(t, "Type", records[0][0])
+	require.Equal(t, []string{"statement", "2022-12", "sat", "", "1.500000", "100", "1.500000", "0", "-0.000250", "0.000000", "0.000000", "0.000000", "eth:abc", "https://etherscan.io/tx/0xabc"}, records[1])
+	require.Equal(t, "estimation", records[2][0])
+	require.Equal(t, []string{"yearly total", "2022", "", ""}, records[3][:4])
+	require.Equal(t, "1.500000", records[3][4])
+}
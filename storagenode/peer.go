@@ -26,8 +26,10 @@ import (
 	"storj.io/common/storj"
 	"storj.io/private/debug"
 	"storj.io/private/version"
+	"storj.io/storj/private/auditpb"
 	"storj.io/storj/private/lifecycle"
 	"storj.io/storj/private/multinodepb"
+	"storj.io/storj/private/nodetag"
 	"storj.io/storj/private/server"
 	"storj.io/storj/private/version/checker"
 	"storj.io/storj/storage"
@@ -42,6 +44,7 @@ import (
 	"storj.io/storj/storagenode/healthcheck"
 	"storj.io/storj/storagenode/inspector"
 	"storj.io/storj/storagenode/internalpb"
+	"storj.io/storj/storagenode/maintenance"
 	"storj.io/storj/storagenode/monitor"
 	"storj.io/storj/storagenode/multinode"
 	"storj.io/storj/storagenode/nodestats"
@@ -50,8 +53,12 @@ import (
 	"storj.io/storj/storagenode/orders"
 	"storj.io/storj/storagenode/payouts"
 	"storj.io/storj/storagenode/payouts/estimatedpayouts"
+	"storj.io/storj/storagenode/payouts/statements"
+	"storj.io/storj/storagenode/piecemigration"
 	"storj.io/storj/storagenode/pieces"
 	"storj.io/storj/storagenode/piecestore"
+	"storj.io/storj/storagenode/piecestore/admission"
+	"storj.io/storj/storagenode/piecestore/shaping"
 	"storj.io/storj/storagenode/piecestore/usedserials"
 	"storj.io/storj/storagenode/piecetransfer"
 	"storj.io/storj/storagenode/preflight"
@@ -131,6 +138,12 @@ type Config struct {
 	Bandwidth bandwidth.Config
 
 	GracefulExit gracefulexit.Config
+
+	Maintenance maintenance.Config
+
+	Notifications notifications.Config
+
+	StorageMigration piecemigration.Config
 }
 
 // DatabaseConfig returns the storagenodedb.Config that should be used with this Config.
@@ -228,6 +241,8 @@ type Peer struct {
 		LocalTime *preflight.LocalTime
 	}
 
+	Maintenance *maintenance.Service
+
 	Contact struct {
 		Service   *contact.Service
 		Chore     *contact.Chore
@@ -249,6 +264,9 @@ type Peer struct {
 		CacheService  *pieces.CacheService
 		RetainService *retain.Service
 		PieceDeleter  *pieces.Deleter
+		Migration     *piecemigration.Service
+		Admission     *admission.Limiter
+		Shaper        *shaping.Shaper
 		Endpoint      *piecestore.Endpoint
 		Inspector     *inspector.Endpoint
 		Monitor       *monitor.Service
@@ -285,8 +303,9 @@ type Peer struct {
 	}
 
 	Payout struct {
-		Service  *payouts.Service
-		Endpoint *payouts.Endpoint
+		Service    *payouts.Service
+		Endpoint   *payouts.Endpoint
+		Statements *statements.Service
 	}
 
 	Bandwidth *bandwidth.Service
@@ -313,7 +332,15 @@ func New(log *zap.Logger, full *identity.FullIdentity, db DB, revocationDB exten
 	}
 
 	{ // setup notification service.
-		peer.Notifications.Service = notifications.NewService(peer.Log, peer.DB.Notifications())
+		channels, err := notifications.NewChannels(config.Notifications)
+		if err != nil {
+			return nil, errs.Combine(err, peer.Close())
+		}
+		peer.Notifications.Service = notifications.NewService(peer.Log, peer.DB.Notifications(), config.Notifications.RateLimit, channels...)
+		peer.Services.Add(lifecycle.Item{
+			Name: "notifications",
+			Run:  peer.Notifications.Service.Run,
+		})
 	}
 
 	{ // setup debug
@@ -423,6 +450,10 @@ func New(log *zap.Logger, full *identity.FullIdentity, db DB, revocationDB exten
 		if err != nil {
 			return nil, errs.Combine(err, peer.Close())
 		}
+		tags, err := nodetag.Decode(c.Tags)
+		if err != nil {
+			return nil, errs.Combine(err, peer.Close())
+		}
 		self := contact.NodeInfo{
 			ID:      peer.ID(),
 			Address: c.ExternalAddress,
@@ -433,10 +464,19 @@ func New(log *zap.Logger, full *identity.FullIdentity, db DB, revocationDB exten
 			},
 			Version:             *pbVersion,
 			NoiseKeyAttestation: noiseKeyAttestation,
+			Tags:                tags,
 		}
+		peer.Maintenance, err = maintenance.NewService(peer.Log.Named("maintenance"), config.Maintenance, func(ctx context.Context) {
+			// let the satellites know about the changed capacity as soon as possible.
+			peer.Contact.Chore.Trigger(ctx)
+		})
+		if err != nil {
+			return nil, errs.Combine(err, peer.Close())
+		}
+
 		peer.Contact.PingStats = new(contact.PingStats)
 		peer.Contact.QUICStats = contact.NewQUICStats(peer.Server.IsQUICEnabled())
-		peer.Contact.Service = contact.NewService(peer.Log.Named("contact:service"), peer.Dialer, self, peer.Storage2.Trust, peer.Contact.QUICStats)
+		peer.Contact.Service = contact.NewService(peer.Log.Named("contact:service"), peer.Dialer, self, peer.Storage2.Trust, peer.Contact.QUICStats, peer.Maintenance)
 
 		peer.Contact.Chore = contact.NewChore(peer.Log.Named("contact:chore"), config.Contact.Interval, peer.Contact.Service)
 		peer.Services.Add(lifecycle.Item{
@@ -462,6 +502,17 @@ func New(log *zap.Logger, full *identity.FullIdentity, db DB, revocationDB exten
 			config.Pieces,
 		)
 
+		peer.Storage2.Migration, err = piecemigration.NewService(peer.Log.Named("piecemigration"), config.StorageMigration, config.Storage.Path)
+		if err != nil {
+			return nil, errs.Combine(err, peer.Close())
+		}
+		peer.Storage2.Store.AddObserver(peer.Storage2.Migration)
+		peer.Services.Add(lifecycle.Item{
+			Name:  "piecemigration",
+			Run:   peer.Storage2.Migration.Run,
+			Close: peer.Storage2.Migration.Close,
+		})
+
 		peer.Storage2.PieceDeleter = pieces.NewDeleter(log.Named("piecedeleter"), peer.Storage2.Store, config.Storage2.DeleteWorkers, config.Storage2.DeleteQueueSize)
 		peer.Services.Add(lifecycle.Item{
 			Name:  "PieceDeleter",
@@ -497,6 +548,11 @@ func New(log *zap.Logger, full *identity.FullIdentity, db DB, revocationDB exten
 		peer.Debug.Server.Panel.Add(
 			debug.Cycle("Piecestore Cache", peer.Storage2.CacheService.Loop))
 
+		peer.Storage2.Shaper, err = shaping.NewShaper(config.Storage2.Shaping)
+		if err != nil {
+			return nil, errs.Combine(err, peer.Close())
+		}
+
 		peer.Storage2.Monitor = monitor.NewService(
 			log.Named("piecestore:monitor"),
 			peer.Storage2.Store,
@@ -506,6 +562,8 @@ func New(log *zap.Logger, full *identity.FullIdentity, db DB, revocationDB exten
 			// TODO: use config.Storage.Monitor.Interval, but for some reason is not set
 			config.Storage.KBucketRefreshInterval,
 			peer.Contact.Chore.Trigger,
+			peer.Storage2.Shaper,
+			peer.Notifications.Service,
 			config.Storage2.Monitor,
 		)
 		peer.Services.Add(lifecycle.Item{
@@ -528,6 +586,7 @@ func New(log *zap.Logger, full *identity.FullIdentity, db DB, revocationDB exten
 		})
 
 		peer.UsedSerials = usedserials.NewTable(config.Storage2.MaxUsedSerialsSize)
+		peer.Storage2.Admission = admission.NewLimiter(config.Storage2.Admission, config.Storage2.MaxConcurrentRequests)
 
 		peer.OrdersStore, err = orders.NewFileStore(
 			peer.Log.Named("ordersfilestore"),
@@ -551,6 +610,9 @@ func New(log *zap.Logger, full *identity.FullIdentity, db DB, revocationDB exten
 			peer.OrdersStore,
 			peer.DB.Bandwidth(),
 			peer.UsedSerials,
+			peer.Storage2.Admission,
+			peer.Storage2.Shaper,
+			peer.Maintenance,
 			config.Storage2,
 		)
 		if err != nil {
@@ -563,6 +625,9 @@ func New(log *zap.Logger, full *identity.FullIdentity, db DB, revocationDB exten
 		if err := pb.DRPCRegisterReplaySafePiecestore(peer.Server.ReplaySafeDRPC(), peer.Storage2.Endpoint); err != nil {
 			return nil, errs.Combine(err, peer.Close())
 		}
+		if err := auditpb.DRPCRegisterPieceAudit(peer.Server.DRPC(), peer.Storage2.Endpoint); err != nil {
+			return nil, errs.Combine(err, peer.Close())
+		}
 
 		// TODO workaround for custom timeout for order sending request (read/write)
 		sc := config.Server
@@ -663,6 +728,14 @@ func New(log *zap.Logger, full *identity.FullIdentity, db DB, revocationDB exten
 			peer.DB.Satellites(),
 			peer.Storage2.Trust,
 		)
+
+		peer.Payout.Statements = statements.NewService(
+			peer.Log.Named("payouts:statements"),
+			peer.Payout.Service,
+			peer.Estimation.Service,
+			peer.DB.Reputation(),
+			peer.Storage2.Trust,
+		)
 	}
 
 	{ // setup storage node operator dashboard
@@ -687,6 +760,8 @@ func New(log *zap.Logger, full *identity.FullIdentity, db DB, revocationDB exten
 			config.Operator.WalletFeatures,
 			port,
 			peer.Contact.QUICStats,
+			peer.Storage2.Admission,
+			peer.Storage2.PieceDeleter,
 		)
 		if err != nil {
 			return nil, errs.Combine(err, peer.Close())
@@ -712,6 +787,10 @@ func New(log *zap.Logger, full *identity.FullIdentity, db DB, revocationDB exten
 			peer.Notifications.Service,
 			peer.Console.Service,
 			peer.Payout.Service,
+			peer.Payout.Statements,
+			peer.Maintenance,
+			peer.Storage2.Migration,
+			config.Console.UpdaterSettingsPath,
 			peer.Console.Listener,
 		)
 
@@ -739,6 +818,26 @@ func New(log *zap.Logger, full *identity.FullIdentity, db DB, revocationDB exten
 		}
 	}
 
+	{ // setup healthcheck probes
+		checks := map[string]healthcheck.CheckFunc{
+			healthcheck.CheckDatabase:   healthcheck.DatabaseCheck(peer.DB.Reputation()),
+			healthcheck.CheckStorageDir: healthcheck.StorageDirCheck(peer.Storage2.Store, peer.Identity.ID),
+			healthcheck.CheckIdentity:   healthcheck.IdentityCheck(peer.Identity),
+			healthcheck.CheckContact:    healthcheck.ContactCheck(peer.Contact.Service.LastCheckIn, config.Healthcheck.MaxCheckInAge),
+			healthcheck.CheckDiskSpace:  healthcheck.DiskSpaceCheck(peer.Storage2.Monitor.AvailableSpace, config.Healthcheck.MinDiskSpace),
+		}
+
+		liveness, err := healthcheck.NewProbe(config.Healthcheck.LivenessChecks, checks, config.Healthcheck.CheckTimeout)
+		if err != nil {
+			return nil, errs.Combine(err, peer.Close())
+		}
+		readiness, err := healthcheck.NewProbe(config.Healthcheck.ReadinessChecks, checks, config.Healthcheck.CheckTimeout)
+		if err != nil {
+			return nil, errs.Combine(err, peer.Close())
+		}
+		peer.Healthcheck.Endpoint.SetProbes(liveness, readiness)
+	}
+
 	{ // setup piecetransfer service
 		peer.PieceTransfer.Service = piecetransfer.NewService(
 			peer.Log.Named("piecetransfer"),
@@ -767,6 +866,7 @@ func New(log *zap.Logger, full *identity.FullIdentity, db DB, revocationDB exten
 			peer.DB.Satellites(),
 			peer.Dialer,
 			peer.Storage2.BlobsCache,
+			peer.GracefulExit.Service,
 		)
 		if err := internalpb.DRPCRegisterNodeGracefulExit(peer.Server.PrivateDRPC(), peer.GracefulExit.Endpoint); err != nil {
 			return nil, errs.Combine(err, peer.Close())
@@ -0,0 +1,607 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+// Package piecemigration implements moving the stored pieces to a new storage
+// directory while the storage node keeps running.
+package piecemigration
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/spacemonkeygo/monkit/v3"
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/common/fpath"
+	"storj.io/common/storj"
+	"storj.io/common/sync2"
+	"storj.io/storj/storage"
+	"storj.io/storj/storage/filestore"
+)
+
+var (
+	mon = monkit.Package()
+
+	// Error is the default error class for piecemigration package.
+	Error = errs.Class("piecemigration")
+)
+
+// Config contains configurable values for moving the pieces to a new storage directory.
+type Config struct {
+	StatePath string        `help:"path to the file tracking the progress of moving the pieces to a new storage directory" default:"$CONFDIR/storage-migration.json"`
+	Interval  time.Duration `help:"how often the changes made while moving the pieces are copied to the new storage directory" default:"10s"`
+}
+
+// Status is the status of a migration.
+type Status string
+
+const (
+	// StatusCopying means the pieces are being copied to the destination.
+	StatusCopying = Status("copying")
+	// StatusSynced means all the pieces were copied and the changes are
+	// copied as they happen. The migration completes when the node stops.
+	StatusSynced = Status("synced")
+	// StatusCompleted means the destination is a complete copy of the source
+	// and the node uses it after restart.
+	StatusCompleted = Status("completed")
+)
+
+// State is the persisted state of a migration.
+type State struct {
+	Source      string    `json:"source"`
+	Destination string    `json:"destination"`
+	Status      Status    `json:"status"`
+	StartedAt   time.Time `json:"startedAt"`
+	CompletedAt time.Time `json:"completedAt"`
+}
+
+// Progress describes a migration in progress.
+type Progress struct {
+	State
+
+	CopiedFiles    int64  `json:"copiedFiles"`
+	CopiedBytes    int64  `json:"copiedBytes"`
+	PendingChanges int    `json:"pendingChanges"`
+	LastError      string `json:"lastError,omitempty"`
+}
+
+type pieceRef struct {
+	satellite storj.NodeID
+	pieceID   storj.PieceID
+}
+
+// Service copies the pieces to a new storage directory while the node is running.
+//
+// The blobs and the trash are copied in the background, while every change
+// made to the pieces in the meantime is recorded through the pieces.Store
+// hooks and copied afterwards. When the node stops, Finalize copies the last
+// changes together with the databases and the verification file and marks the
+// migration as completed. On the next start StoragePath points the node to
+// the new storage directory.
+//
+// architecture: Service
+type Service struct {
+	log        *zap.Logger
+	config     Config
+	sourcePath string
+
+	Loop *sync2.Cycle
+
+	mu                sync.Mutex
+	state             *State
+	source            *filestore.Dir
+	destination       *filestore.Dir
+	tracking          bool
+	copied            bool // all the pieces were copied since the node started
+	changedPieces     map[pieceRef]struct{}
+	changedSatellites map[storj.NodeID]struct{}
+	copiedFiles       int64
+	copiedBytes       int64
+	lastError         error
+}
+
+// NewService loads the persisted migration state and returns a new service
+// for moving the pieces stored at sourcePath.
+func NewService(log *zap.Logger, config Config, sourcePath string) (*Service, error) {
+	state, err := loadState(config.StatePath)
+	if err != nil {
+		return nil, err
+	}
+
+	service := &Service{
+		log:        log,
+		config:     config,
+		sourcePath: filepath.Clean(sourcePath),
+		Loop:       sync2.NewCycle(config.Interval),
+
+		changedPieces:     map[pieceRef]struct{}{},
+		changedSatellites: map[storj.NodeID]struct{}{},
+	}
+
+	if state != nil && state.Status != StatusCompleted {
+		if filepath.Clean(state.Source) != service.sourcePath {
+			return nil, Error.New("migration in progress from %q, but the storage directory is %q", state.Source, sourcePath)
+		}
+		if err := service.open(state); err != nil {
+			return nil, err
+		}
+		log.Info("Resuming the migration of the pieces to a new storage directory.", zap.String("Destination", state.Destination))
+	}
+	service.state = state
+
+	return service, nil
+}
+
+// StoragePath returns the storage directory the node should use. It's the
+// destination of a completed migration from path, or path otherwise.
+func StoragePath(config Config, path string) (string, error) {
+	state, err := loadState(config.StatePath)
+	if err != nil {
+		return "", err
+	}
+	if state == nil || state.Status != StatusCompleted || filepath.Clean(state.Source) != filepath.Clean(path) {
+		return path, nil
+	}
+	return state.Destination, nil
+}
+
+// Run copies the pieces and the changes made to them in the background.
+func (service *Service) Run(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	return service.Loop.Run(ctx, func(ctx context.Context) error {
+		err := service.sync(ctx)
+		service.mu.Lock()
+		service.lastError = err
+		service.mu.Unlock()
+		if err != nil && !errs.Is(err, context.Canceled) {
+			service.log.Error("failed to copy pieces to the new storage directory", zap.Error(err))
+		}
+		return nil
+	})
+}
+
+// Close stops the service.
+func (service *Service) Close() error {
+	service.Loop.Close()
+	return nil
+}
+
+// Start starts moving the pieces to the destination directory.
+func (service *Service) Start(ctx context.Context, destination string) (_ Progress, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if !filepath.IsAbs(destination) {
+		return Progress{}, Error.New("destination must be an absolute path")
+	}
+	destination = filepath.Clean(destination)
+	if isSubPath(service.sourcePath, destination) || isSubPath(destination, service.sourcePath) {
+		return Progress{}, Error.New("destination %q overlaps with the storage directory %q", destination, service.sourcePath)
+	}
+
+	progress, err := service.start(destination)
+	if err != nil {
+		return Progress{}, err
+	}
+
+	service.log.Info("Started moving the pieces to a new storage directory.", zap.String("Destination", destination))
+	service.Loop.Trigger()
+
+	return progress, nil
+}
+
+func (service *Service) start(destination string) (_ Progress, err error) {
+	service.mu.Lock()
+	defer service.mu.Unlock()
+
+	if service.state != nil && service.state.Status != StatusCompleted {
+		return Progress{}, Error.New("migration to %q is already in progress", service.state.Destination)
+	}
+
+	state := &State{
+		Source:      service.sourcePath,
+		Destination: destination,
+		Status:      StatusCopying,
+		StartedAt:   time.Now().UTC(),
+	}
+	if err := service.open(state); err != nil {
+		return Progress{}, err
+	}
+	if err := saveState(service.config.StatePath, state); err != nil {
+		return Progress{}, err
+	}
+	service.state = state
+	service.copied = false
+
+	return service.progress(), nil
+}
+
+// Progress returns the progress of the migration, or false when there is none.
+func (service *Service) Progress() (_ Progress, ok bool) {
+	service.mu.Lock()
+	defer service.mu.Unlock()
+
+	if service.state == nil {
+		return Progress{}, false
+	}
+	return service.progress(), true
+}
+
+func (service *Service) progress() Progress {
+	progress := Progress{
+		State:          *service.state,
+		CopiedFiles:    service.copiedFiles,
+		CopiedBytes:    service.copiedBytes,
+		PendingChanges: len(service.changedPieces) + len(service.changedSatellites),
+	}
+	if service.lastError != nil {
+		progress.LastError = service.lastError.Error()
+	}
+	return progress
+}
+
+// PieceChanged implements pieces.PieceObserver.
+func (service *Service) PieceChanged(satellite storj.NodeID, pieceID storj.PieceID) {
+	service.mu.Lock()
+	defer service.mu.Unlock()
+	if service.tracking {
+		service.changedPieces[pieceRef{satellite: satellite, pieceID: pieceID}] = struct{}{}
+	}
+}
+
+// SatelliteChanged implements pieces.PieceObserver.
+func (service *Service) SatelliteChanged(satellite storj.NodeID) {
+	service.mu.Lock()
+	defer service.mu.Unlock()
+	if service.tracking {
+		service.changedSatellites[satellite] = struct{}{}
+	}
+}
+
+// Finalize copies the last changes, the databases and the verification file
+// and marks the migration as completed. It must be called after the node
+// stopped and its databases were closed. When not all the pieces were copied
+// yet, the migration continues on the next start.
+func (service *Service) Finalize(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	service.mu.Lock()
+	state, copied := service.state, service.copied
+	service.mu.Unlock()
+
+	if state == nil || state.Status == StatusCompleted {
+		return nil
+	}
+	if !copied {
+		service.log.Info("Pieces are still being copied to the new storage directory, the migration continues on the next start.")
+		return nil
+	}
+
+	service.log.Info("Completing the migration of the pieces to a new storage directory.")
+
+	if err := service.copyChanges(ctx); err != nil {
+		return Error.Wrap(err)
+	}
+
+	// the databases and the verification file are stored next to the blobs.
+	entries, err := os.ReadDir(service.source.Path())
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	for _, entry := range entries {
+		if !entry.Type().IsRegular() {
+			continue
+		}
+		err := service.copyFile(filepath.Join(service.source.Path(), entry.Name()), filepath.Join(service.destination.Path(), entry.Name()))
+		if err != nil {
+			return Error.Wrap(err)
+		}
+	}
+
+	completed := *state
+	completed.Status = StatusCompleted
+	completed.CompletedAt = time.Now().UTC()
+	if err := saveState(service.config.StatePath, &completed); err != nil {
+		return err
+	}
+
+	service.mu.Lock()
+	service.state = &completed
+	service.tracking = false
+	service.mu.Unlock()
+
+	service.log.Info("Pieces were moved to the new storage directory, it will be used after the restart. Update storage.path in the config file.",
+		zap.String("Destination", completed.Destination))
+	return nil
+}
+
+// open opens the source and destination directories of the migration.
+func (service *Service) open(state *State) (err error) {
+	service.source, err = filestore.OpenDir(service.log.Named("source"), state.Source)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	service.destination, err = filestore.NewDir(service.log.Named("destination"), state.Destination)
+	return Error.Wrap(err)
+}
+
+// sync copies all the pieces, when not done yet, and the changes made to them since.
+func (service *Service) sync(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	service.mu.Lock()
+	state, copied := service.state, service.copied
+	// changes must be recorded before the copy starts, otherwise the changes
+	// made to the already copied files would be missed.
+	if state != nil && state.Status != StatusCompleted {
+		service.tracking = true
+	}
+	service.mu.Unlock()
+
+	if state == nil || state.Status == StatusCompleted {
+		return nil
+	}
+
+	if !copied {
+		if err := service.mirror(ctx, service.source.BlobsPath(), service.destination.BlobsPath()); err != nil {
+			return err
+		}
+		if err := service.mirror(ctx, service.source.TrashPath(), service.destination.TrashPath()); err != nil {
+			return err
+		}
+
+		synced := *state
+		synced.Status = StatusSynced
+		if err := saveState(service.config.StatePath, &synced); err != nil {
+			return err
+		}
+
+		service.mu.Lock()
+		service.copied = true
+		service.state = &synced
+		service.mu.Unlock()
+
+		service.log.Info("All pieces were copied to the new storage directory, restart the node to complete the migration.")
+	}
+
+	return service.copyChanges(ctx)
+}
+
+// copyChanges copies the pieces which changed since the last call.
+func (service *Service) copyChanges(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	service.mu.Lock()
+	changedPieces, changedSatellites := service.changedPieces, service.changedSatellites
+	service.changedPieces = map[pieceRef]struct{}{}
+	service.changedSatellites = map[storj.NodeID]struct{}{}
+	service.mu.Unlock()
+
+	defer func() {
+		if err == nil {
+			return
+		}
+		// keep the changes which were not copied for the next attempt.
+		service.mu.Lock()
+		for ref := range changedPieces {
+			service.changedPieces[ref] = struct{}{}
+		}
+		for satellite := range changedSatellites {
+			service.changedSatellites[satellite] = struct{}{}
+		}
+		service.mu.Unlock()
+	}()
+
+	for satellite := range changedSatellites {
+		err := service.mirror(ctx, service.source.NamespacePath(satellite.Bytes()), service.destination.NamespacePath(satellite.Bytes()))
+		if err != nil {
+			return err
+		}
+		delete(changedSatellites, satellite)
+	}
+
+	for ref := range changedPieces {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		blobRef := storage.BlobRef{Namespace: ref.satellite.Bytes(), Key: ref.pieceID.Bytes()}
+		sourcePaths, err := service.source.BlobPaths(blobRef)
+		if err != nil {
+			return err
+		}
+		destinationPaths, err := service.destination.BlobPaths(blobRef)
+		if err != nil {
+			return err
+		}
+		for i := range sourcePaths {
+			if err := service.copyFile(sourcePaths[i], destinationPaths[i]); err != nil {
+				return err
+			}
+		}
+		delete(changedPieces, ref)
+	}
+
+	return nil
+}
+
+// mirror makes the destination directory tree identical to the source tree.
+func (service *Service) mirror(ctx context.Context, source, destination string) error {
+	err := filepath.WalkDir(source, func(path string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			if errs.IsFunc(err, os.IsNotExist) {
+				// deleted while walking.
+				return nil
+			}
+			return err
+		}
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		rel, err := filepath.Rel(source, path)
+		if err != nil {
+			return err
+		}
+		target := filepath.Join(destination, rel)
+
+		switch {
+		case entry.IsDir():
+			return os.MkdirAll(target, 0700)
+		case entry.Type().IsRegular():
+			return service.copyFile(path, target)
+		default:
+			return nil
+		}
+	})
+	if err != nil {
+		return err
+	}
+
+	// remove what doesn't exist in the source anymore.
+	return filepath.WalkDir(destination, func(path string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			if errs.IsFunc(err, os.IsNotExist) {
+				return nil
+			}
+			return err
+		}
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		rel, err := filepath.Rel(destination, path)
+		if err != nil {
+			return err
+		}
+		_, err = os.Lstat(filepath.Join(source, rel))
+		if err == nil || !errs.IsFunc(err, os.IsNotExist) {
+			return err
+		}
+
+		if err := os.RemoveAll(path); err != nil {
+			return err
+		}
+		if entry.IsDir() {
+			return filepath.SkipDir
+		}
+		return nil
+	})
+}
+
+// copyFile copies the source file to the target unless it has already been
+// copied. When the source doesn't exist, the target is removed.
+func (service *Service) copyFile(source, target string) (err error) {
+	file, err := os.Open(source)
+	if err != nil {
+		if errs.IsFunc(err, os.IsNotExist) {
+			err = os.Remove(target)
+			if errs.IsFunc(err, os.IsNotExist) {
+				return nil
+			}
+		}
+		return err
+	}
+	defer func() { err = errs.Combine(err, file.Close()) }()
+
+	info, err := file.Stat()
+	if err != nil {
+		return err
+	}
+
+	if targetInfo, err := os.Stat(target); err == nil {
+		if targetInfo.Size() == info.Size() && targetInfo.ModTime().Equal(info.ModTime()) {
+			return nil
+		}
+	}
+
+	if err := os.MkdirAll(filepath.Dir(target), 0700); err != nil {
+		return err
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(target), ".migrate-*")
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err != nil {
+			err = errs.Combine(err, tmp.Close(), os.Remove(tmp.Name()))
+		}
+	}()
+
+	written, err := io.Copy(tmp, file)
+	if err != nil {
+		return err
+	}
+	if err := tmp.Sync(); err != nil {
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	if err := os.Chmod(tmp.Name(), info.Mode().Perm()); err != nil {
+		return err
+	}
+	// the modification time is used to detect whether the file has already
+	// been copied, and also to tell how long a piece has been in the trash.
+	if err := os.Chtimes(tmp.Name(), info.ModTime(), info.ModTime()); err != nil {
+		return err
+	}
+	if err := os.Rename(tmp.Name(), target); err != nil {
+		return err
+	}
+
+	service.mu.Lock()
+	service.copiedFiles++
+	service.copiedBytes += written
+	service.mu.Unlock()
+	return nil
+}
+
+// isSubPath returns true when path is equal to or inside of parent.
+func isSubPath(parent, path string) bool {
+	rel, err := filepath.Rel(parent, path)
+	return err == nil && rel != ".." && !strings.HasPrefix(rel, ".."+string(filepath.Separator))
+}
+
+// loadState loads the migration state, it returns nil when there is none.
+func loadState(path string) (*State, error) {
+	if path == "" {
+		return nil, Error.New("state path cannot be empty")
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		if errs.IsFunc(err, os.IsNotExist) {
+			return nil, nil
+		}
+		return nil, Error.Wrap(err)
+	}
+
+	var state State
+	if err := json.Unmarshal(data, &state); err != nil {
+		return nil, Error.New("malformed state file: %w", err)
+	}
+	return &state, nil
+}
+
+// saveState persists the migration state, replacing the previous one atomically.
+func saveState(path string, state *State) error {
+	if err := os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+		return Error.New("unable to make state parent directory: %w", err)
+	}
+
+	data, err := json.MarshalIndent(state, "", "  ")
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	return Error.Wrap(fpath.AtomicWriteFile(path, data, 0644))
+}
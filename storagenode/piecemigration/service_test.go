@@ -0,0 +1,122 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package piecemigration_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/zeebo/errs"
+	"go.uber.org/zap/zaptest"
+
+	"storj.io/common/pb"
+	"storj.io/common/storj"
+	"storj.io/common/testcontext"
+	"storj.io/common/testrand"
+	"storj.io/storj/storage"
+	"storj.io/storj/storage/filestore"
+	"storj.io/storj/storagenode"
+	"storj.io/storj/storagenode/piecemigration"
+	"storj.io/storj/storagenode/pieces"
+	"storj.io/storj/storagenode/storagenodedb/storagenodedbtest"
+)
+
+func TestMigration(t *testing.T) {
+	storagenodedbtest.Run(t, func(ctx *testcontext.Context, t *testing.T, db storagenode.DB) {
+		log := zaptest.NewLogger(t)
+
+		sourcePath := ctx.Dir("source")
+		destinationPath := ctx.Dir("destination")
+
+		dir, err := filestore.NewDir(log, sourcePath)
+		require.NoError(t, err)
+		blobs := filestore.New(log, dir, filestore.DefaultConfig)
+		defer ctx.Check(blobs.Close)
+
+		store := pieces.NewStore(log, blobs, db.V0PieceInfo(), db.PieceExpirationDB(), db.PieceSpaceUsedDB(), pieces.DefaultConfig)
+
+		satelliteID := testrand.NodeID()
+		writePiece := func(pieceID storj.PieceID) {
+			writer, err := store.Writer(ctx, satelliteID, pieceID, pb.PieceHashAlgorithm_SHA256)
+			require.NoError(t, err)
+			_, err = writer.Write(testrand.Bytes(1024))
+			require.NoError(t, err)
+			require.NoError(t, writer.Commit(ctx, &pb.PieceHeader{}))
+		}
+
+		kept, deleted, trashed := testrand.PieceID(), testrand.PieceID(), testrand.PieceID()
+		writePiece(kept)
+		writePiece(deleted)
+		writePiece(trashed)
+		require.NoError(t, os.WriteFile(filepath.Join(sourcePath, "info.db"), []byte("database"), 0644))
+
+		config := piecemigration.Config{
+			StatePath: ctx.File("storage-migration.json"),
+			Interval:  time.Hour,
+		}
+		service, err := piecemigration.NewService(log, config, sourcePath)
+		require.NoError(t, err)
+		store.AddObserver(service)
+
+		ctx.Go(func() error { return service.Run(ctx) })
+
+		_, err = service.Start(ctx, sourcePath+"/nested")
+		require.Error(t, err)
+
+		progress, err := service.Start(ctx, destinationPath)
+		require.NoError(t, err)
+		require.Equal(t, piecemigration.StatusCopying, progress.Status)
+
+		service.Loop.TriggerWait()
+
+		progress, ok := service.Progress()
+		require.True(t, ok)
+		require.Equal(t, piecemigration.StatusSynced, progress.Status)
+		require.EqualValues(t, 3, progress.CopiedFiles)
+
+		// changes after the copy are only copied when finalizing.
+		added := testrand.PieceID()
+		writePiece(added)
+		require.NoError(t, store.Delete(ctx, satelliteID, deleted))
+		require.NoError(t, store.Trash(ctx, satelliteID, trashed))
+
+		progress, _ = service.Progress()
+		require.Equal(t, 3, progress.PendingChanges)
+
+		require.NoError(t, service.Close())
+		require.NoError(t, service.Finalize(ctx))
+
+		progress, _ = service.Progress()
+		require.Equal(t, piecemigration.StatusCompleted, progress.Status)
+
+		storagePath, err := piecemigration.StoragePath(config, sourcePath)
+		require.NoError(t, err)
+		require.Equal(t, destinationPath, storagePath)
+
+		data, err := os.ReadFile(filepath.Join(destinationPath, "info.db"))
+		require.NoError(t, err)
+		require.Equal(t, "database", string(data))
+
+		destinationDir, err := filestore.OpenDir(log, destinationPath)
+		require.NoError(t, err)
+		destinationBlobs := filestore.New(log, destinationDir, filestore.DefaultConfig)
+		defer ctx.Check(destinationBlobs.Close)
+
+		stat := func(pieceID storj.PieceID) error {
+			_, err := destinationBlobs.Stat(ctx, storage.BlobRef{Namespace: satelliteID.Bytes(), Key: pieceID.Bytes()})
+			return err
+		}
+		require.NoError(t, stat(kept))
+		require.NoError(t, stat(added))
+		require.True(t, errs.IsFunc(stat(deleted), os.IsNotExist))
+		require.True(t, errs.IsFunc(stat(trashed), os.IsNotExist))
+
+		keysRestored, err := destinationBlobs.RestoreTrash(ctx, satelliteID.Bytes())
+		require.NoError(t, err)
+		require.Equal(t, [][]byte{trashed.Bytes()}, keysRestored)
+	})
+}
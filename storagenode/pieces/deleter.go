@@ -106,6 +106,11 @@ func (d *Deleter) Enqueue(ctx context.Context, satelliteID storj.NodeID, pieceID
 	return 0
 }
 
+// QueueLength returns the number of delete requests waiting to be processed.
+func (d *Deleter) QueueLength() int {
+	return len(d.ch)
+}
+
 func (d *Deleter) checkDone(delta int) {
 	d.mu.Lock()
 	d.testToDelete += delta
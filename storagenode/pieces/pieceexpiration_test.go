@@ -18,7 +18,7 @@ import (
 )
 
 func TestPieceExpirationDB(t *testing.T) {
-	// test GetExpired, SetExpiration, DeleteExpiration, DeleteFailed
+	// test GetExpired, GetAll, SetExpiration, DeleteExpiration, DeleteFailed
 	storagenodedbtest.Run(t, func(ctx *testcontext.Context, t *testing.T, db storagenode.DB) {
 		expireDB := db.PieceExpirationDB()
 
@@ -60,6 +60,11 @@ func TestPieceExpirationDB(t *testing.T) {
 		require.Len(t, expiredPieceIDs, 1)
 		assert.Equal(t, expiredPieceIDs[0], expectedExpireInfo)
 
+		// GetAll returns the entry regardless of the expiration time
+		allPieceIDs, err := expireDB.GetAll(ctx)
+		require.NoError(t, err)
+		require.Equal(t, []pieces.ExpiredInfo{expectedExpireInfo}, allPieceIDs)
+
 		deleteFailedAt := expireAt.Add(2 * time.Microsecond)
 
 		// DeleteFailed normal usage
@@ -84,5 +89,8 @@ func TestPieceExpirationDB(t *testing.T) {
 		expiredPieceIDs, err = expireDB.GetExpired(ctx, expireAt.Add(365*24*time.Hour), 1000)
 		require.NoError(t, err)
 		require.Len(t, expiredPieceIDs, 0)
+		allPieceIDs, err = expireDB.GetAll(ctx)
+		require.NoError(t, err)
+		require.Len(t, allPieceIDs, 0)
 	})
 }
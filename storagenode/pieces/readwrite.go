@@ -9,6 +9,7 @@ import (
 	"errors"
 	"hash"
 	"io"
+	"time"
 
 	"github.com/zeebo/errs"
 	"go.uber.org/zap"
@@ -69,6 +70,12 @@ type Writer struct {
 	blobs     storage.Blobs
 	satellite storj.NodeID
 	closed    bool
+
+	writeCount     int64
+	writeDuration  time.Duration // total time spent writing to the blob
+	commitDuration time.Duration // time spent committing the blob, including fsync
+
+	onCommit func() // called after the blob was committed successfully
 }
 
 // NewWriter creates a new writer for storage.BlobWriter.
@@ -99,7 +106,10 @@ func NewWriter(log *zap.Logger, blobWriter storage.BlobWriter, blobs storage.Blo
 
 // Write writes data to the blob and calculates the hash.
 func (w *Writer) Write(data []byte) (int, error) {
+	start := time.Now()
 	n, err := w.blob.Write(data)
+	w.writeDuration += time.Since(start)
+	w.writeCount++
 	w.pieceSize += int64(n)
 	_, _ = w.hash.Write(data[:n]) // guaranteed not to return an error
 	if errors.Is(err, io.EOF) {
@@ -115,6 +125,18 @@ func (w *Writer) Size() int64 { return w.pieceSize }
 // Hash returns the hash of data written so far.
 func (w *Writer) Hash() []byte { return w.hash.Sum(nil) }
 
+// WriteLatency returns the average time spent in a single write to the underlying blob.
+func (w *Writer) WriteLatency() time.Duration {
+	if w.writeCount == 0 {
+		return 0
+	}
+	return w.writeDuration / time.Duration(w.writeCount)
+}
+
+// CommitLatency returns the time it took to commit the blob to permanent storage,
+// including syncing it to disk. It is zero until Commit has been called.
+func (w *Writer) CommitLatency() time.Duration { return w.commitDuration }
+
 // Commit commits piece to permanent storage.
 func (w *Writer) Commit(ctx context.Context, pieceHeader *pb.PieceHeader) (err error) {
 	defer mon.Task()(&ctx)(&err)
@@ -128,7 +150,12 @@ func (w *Writer) Commit(ctx context.Context, pieceHeader *pb.PieceHeader) (err e
 		if err != nil {
 			err = Error.Wrap(errs.Combine(err, w.blob.Cancel(ctx)))
 		} else {
+			start := time.Now()
 			err = Error.Wrap(w.blob.Commit(ctx))
+			w.commitDuration = time.Since(start)
+			if err == nil && w.onCommit != nil {
+				w.onCommit()
+			}
 		}
 	}()
 
@@ -8,6 +8,7 @@ import (
 	"database/sql"
 	"io"
 	"os"
+	"sync"
 	"time"
 
 	"github.com/spacemonkeygo/monkit/v3"
@@ -57,6 +58,8 @@ type ExpiredInfo struct {
 type PieceExpirationDB interface {
 	// GetExpired gets piece IDs that expire or have expired before the given time
 	GetExpired(ctx context.Context, expiresBefore time.Time, limit int64) ([]ExpiredInfo, error)
+	// GetAll gets piece IDs of all pieces with an expiration which are not in the trash
+	GetAll(ctx context.Context) ([]ExpiredInfo, error)
 	// SetExpiration sets an expiration time for the given piece ID on the given satellite
 	SetExpiration(ctx context.Context, satellite storj.NodeID, pieceID storj.PieceID, expiresAt time.Time) error
 	// DeleteExpiration removes an expiration record for the given piece ID on the given satellite
@@ -175,6 +178,17 @@ type Store struct {
 	v0PieceInfo    V0PieceInfoDB
 	expirationInfo PieceExpirationDB
 	spaceUsedDB    PieceSpaceUsedDB
+
+	observersMu sync.RWMutex
+	observers   []PieceObserver
+}
+
+// PieceObserver is notified when the stored pieces change.
+type PieceObserver interface {
+	// PieceChanged is called after a piece was written, deleted, trashed or restored from the trash.
+	PieceChanged(satellite storj.NodeID, pieceID storj.PieceID)
+	// SatelliteChanged is called after all the pieces of a satellite were deleted.
+	SatelliteChanged(satellite storj.NodeID)
 }
 
 // StoreForTest is a wrapper around Store to be used only in test scenarios. It enables writing
@@ -197,6 +211,31 @@ func NewStore(log *zap.Logger, blobs storage.Blobs, v0PieceInfo V0PieceInfoDB,
 	}
 }
 
+// AddObserver registers an observer which is notified about every change of the stored pieces.
+func (store *Store) AddObserver(observer PieceObserver) {
+	store.observersMu.Lock()
+	defer store.observersMu.Unlock()
+	store.observers = append(store.observers, observer)
+}
+
+// notifyPieceChanged notifies the observers about a change of a piece.
+func (store *Store) notifyPieceChanged(satellite storj.NodeID, pieceID storj.PieceID) {
+	store.observersMu.RLock()
+	defer store.observersMu.RUnlock()
+	for _, observer := range store.observers {
+		observer.PieceChanged(satellite, pieceID)
+	}
+}
+
+// notifySatelliteChanged notifies the observers about a change of all pieces of a satellite.
+func (store *Store) notifySatelliteChanged(satellite storj.NodeID) {
+	store.observersMu.RLock()
+	defer store.observersMu.RUnlock()
+	for _, observer := range store.observers {
+		observer.SatelliteChanged(satellite)
+	}
+}
+
 // CreateVerificationFile creates a file to be used for storage directory verification.
 func (store *Store) CreateVerificationFile(ctx context.Context, id storj.NodeID) error {
 	return store.blobs.CreateVerificationFile(ctx, id)
@@ -220,7 +259,11 @@ func (store *Store) Writer(ctx context.Context, satellite storj.NodeID, pieceID
 	}
 
 	writer, err := NewWriter(store.log.Named("blob-writer"), blobWriter, store.blobs, satellite, hashAlgorithm)
-	return writer, Error.Wrap(err)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	writer.onCommit = func() { store.notifyPieceChanged(satellite, pieceID) }
+	return writer, nil
 }
 
 // WriterForFormatVersion allows opening a piece writer with a specified storage format version.
@@ -254,7 +297,11 @@ func (store StoreForTest) WriterForFormatVersion(ctx context.Context, satellite
 		return nil, Error.Wrap(err)
 	}
 	writer, err := NewWriter(store.log.Named("blob-writer"), blobWriter, store.blobs, satellite, hashAlgorithm)
-	return writer, Error.Wrap(err)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	writer.onCommit = func() { store.notifyPieceChanged(satellite, pieceID) }
+	return writer, nil
 }
 
 // Reader returns a new piece reader.
@@ -304,6 +351,7 @@ func (store *Store) Delete(ctx context.Context, satellite storj.NodeID, pieceID
 	if err != nil {
 		return Error.Wrap(err)
 	}
+	store.notifyPieceChanged(satellite, pieceID)
 
 	// delete expired piece records
 	err = store.DeleteExpired(ctx, satellite, pieceID)
@@ -335,6 +383,7 @@ func (store *Store) DeleteSatelliteBlobs(ctx context.Context, satellite storj.No
 	defer mon.Task()(&ctx)(&err)
 
 	err = store.blobs.DeleteNamespace(ctx, satellite.Bytes())
+	store.notifySatelliteChanged(satellite)
 	return Error.Wrap(err)
 }
 
@@ -370,6 +419,7 @@ func (store *Store) Trash(ctx context.Context, satellite storj.NodeID, pieceID s
 		Namespace: satellite.Bytes(),
 		Key:       pieceID.Bytes(),
 	}))
+	store.notifyPieceChanged(satellite, pieceID)
 
 	return Error.Wrap(err)
 }
@@ -388,6 +438,7 @@ func (store *Store) EmptyTrash(ctx context.Context, satelliteID storj.NodeID, tr
 		if pieceIDErr != nil {
 			return Error.Wrap(pieceIDErr)
 		}
+		store.notifyPieceChanged(satelliteID, pieceID)
 		_, deleteErr := store.expirationInfo.DeleteExpiration(ctx, satelliteID, pieceID)
 		err = errs.Combine(err, deleteErr)
 	}
@@ -398,7 +449,12 @@ func (store *Store) EmptyTrash(ctx context.Context, satelliteID storj.NodeID, tr
 func (store *Store) RestoreTrash(ctx context.Context, satelliteID storj.NodeID) (err error) {
 	defer mon.Task()(&ctx)(&err)
 
-	_, err = store.blobs.RestoreTrash(ctx, satelliteID.Bytes())
+	keysRestored, err := store.blobs.RestoreTrash(ctx, satelliteID.Bytes())
+	for _, key := range keysRestored {
+		if pieceID, err := storj.PieceIDFromBytes(key); err == nil {
+			store.notifyPieceChanged(satelliteID, pieceID)
+		}
+	}
 	if err != nil {
 		return Error.Wrap(err)
 	}
@@ -455,6 +511,7 @@ func (store *Store) MigrateV0ToV1(ctx context.Context, satelliteID storj.NodeID,
 		Namespace: satelliteID.Bytes(),
 		Key:       pieceID.Bytes(),
 	}, filestore.FormatV0)
+	store.notifyPieceChanged(satelliteID, pieceID)
 
 	if store.v0PieceInfo != nil {
 		err = errs.Combine(err, store.v0PieceInfo.Delete(ctx, satelliteID, pieceID))
@@ -0,0 +1,180 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package admission
+
+import (
+	"math"
+	"sync"
+	"time"
+
+	"github.com/spacemonkeygo/monkit/v3"
+)
+
+var mon = monkit.Package()
+
+// latencySmoothing is the weight of a new observation in the moving average of latencies.
+const latencySmoothing = 0.2
+
+// Config defines parameters for adaptive upload admission control.
+type Config struct {
+	Enabled             bool          `help:"if true, the number of admitted uploads is adjusted based on observed disk latency instead of using max-concurrent-requests" default:"false"`
+	MinLimit            int           `help:"lower bound of the adaptive request limit" default:"5"`
+	MaxLimit            int           `help:"upper bound of the adaptive request limit" default:"200"`
+	InitialLimit        int           `help:"request limit used before any disk latency was observed" default:"40"`
+	TargetWriteLatency  time.Duration `help:"average latency of a single piece write above which the request limit is decreased" default:"50ms"`
+	TargetCommitLatency time.Duration `help:"piece commit (including fsync) latency above which the request limit is decreased" default:"500ms"`
+	DecreaseFactor      float64       `help:"multiplicative factor applied to the request limit when the disk is too slow" default:"0.75"`
+	DecreaseCooldown    time.Duration `help:"minimum amount of time between two consecutive request limit decreases" default:"1s"`
+}
+
+// Stats contains the current state of the admission control.
+type Stats struct {
+	Adaptive      bool          `json:"adaptive"`
+	Limit         int           `json:"limit"`
+	Rejected      int64         `json:"rejected"`
+	WriteLatency  time.Duration `json:"writeLatency"`
+	CommitLatency time.Duration `json:"commitLatency"`
+}
+
+// Limiter decides how many concurrent requests are allowed before uploads are rejected.
+//
+// When adaptive admission control is enabled the limit follows an AIMD
+// (additive increase, multiplicative decrease) scheme: every upload that
+// completes while the disk is fast enough raises the limit by 1/limit, i.e.
+// roughly by one for every full window of uploads, and the limit is cut by
+// DecreaseFactor whenever the smoothed write or commit latency exceeds its
+// target.
+//
+// architecture: Service
+type Limiter struct {
+	config      Config
+	staticLimit int
+
+	mu            sync.Mutex
+	limit         float64
+	rejected      int64
+	writeLatency  float64
+	commitLatency float64
+	lastDecrease  time.Time
+
+	// now is used for testing.
+	now func() time.Time
+}
+
+// NewLimiter creates a new admission limiter. staticLimit is the limit used
+// when adaptive admission control is disabled, 0 represents unlimited.
+func NewLimiter(config Config, staticLimit int) *Limiter {
+	if config.MinLimit < 1 {
+		config.MinLimit = 1
+	}
+	if config.MaxLimit < config.MinLimit {
+		config.MaxLimit = config.MinLimit
+	}
+	if config.DecreaseFactor <= 0 || config.DecreaseFactor >= 1 {
+		config.DecreaseFactor = 0.75
+	}
+
+	initial := config.InitialLimit
+	if initial < config.MinLimit {
+		initial = config.MinLimit
+	}
+	if initial > config.MaxLimit {
+		initial = config.MaxLimit
+	}
+
+	return &Limiter{
+		config:      config,
+		staticLimit: staticLimit,
+		limit:       float64(initial),
+		now:         time.Now,
+	}
+}
+
+// Limit returns the number of concurrent requests allowed at this moment.
+// 0 represents unlimited.
+func (limiter *Limiter) Limit() int {
+	if !limiter.config.Enabled {
+		return limiter.staticLimit
+	}
+
+	limiter.mu.Lock()
+	defer limiter.mu.Unlock()
+	return int(limiter.limit)
+}
+
+// Reject records that an upload was rejected because of the limit.
+func (limiter *Limiter) Reject() {
+	mon.Counter("admission_rejected_count").Inc(1)
+
+	limiter.mu.Lock()
+	defer limiter.mu.Unlock()
+	limiter.rejected++
+}
+
+// Observe adjusts the limit based on the disk latencies of a finished upload.
+// live is the number of requests in progress at the time of the observation.
+func (limiter *Limiter) Observe(live int, writeLatency, commitLatency time.Duration) {
+	if !limiter.config.Enabled {
+		return
+	}
+
+	limiter.mu.Lock()
+	defer limiter.mu.Unlock()
+
+	limiter.writeLatency = smooth(limiter.writeLatency, float64(writeLatency))
+	limiter.commitLatency = smooth(limiter.commitLatency, float64(commitLatency))
+
+	slow := limiter.writeLatency > float64(limiter.config.TargetWriteLatency) ||
+		limiter.commitLatency > float64(limiter.config.TargetCommitLatency)
+
+	switch {
+	case slow:
+		now := limiter.now()
+		if now.Sub(limiter.lastDecrease) < limiter.config.DecreaseCooldown {
+			break
+		}
+		limiter.lastDecrease = now
+		limiter.limit = math.Max(float64(limiter.config.MinLimit), math.Floor(limiter.limit*limiter.config.DecreaseFactor))
+		mon.Counter("admission_limit_decrease_count").Inc(1)
+	case float64(live) >= limiter.limit/2:
+		// only grow the limit when it is actually being used, otherwise it
+		// would drift up to the maximum while the node is mostly idle.
+		limiter.limit = math.Min(float64(limiter.config.MaxLimit), limiter.limit+1/limiter.limit)
+	}
+
+	mon.IntVal("admission_limit").Observe(int64(limiter.limit))
+}
+
+// Stats returns the current state of the limiter.
+func (limiter *Limiter) Stats() Stats {
+	limiter.mu.Lock()
+	defer limiter.mu.Unlock()
+
+	stats := Stats{
+		Adaptive:      limiter.config.Enabled,
+		Limit:         limiter.staticLimit,
+		Rejected:      limiter.rejected,
+		WriteLatency:  time.Duration(limiter.writeLatency),
+		CommitLatency: time.Duration(limiter.commitLatency),
+	}
+	if limiter.config.Enabled {
+		stats.Limit = int(limiter.limit)
+	}
+	return stats
+}
+
+// TestSetNow overrides the clock used by the limiter.
+func (limiter *Limiter) TestSetNow(now func() time.Time) {
+	limiter.mu.Lock()
+	defer limiter.mu.Unlock()
+	limiter.now = now
+}
+
+// smooth returns the exponentially weighted moving average of current and observed.
+func smooth(current, observed float64) float64 {
+	if current == 0 {
+		return observed
+	}
+	return current + latencySmoothing*(observed-current)
+}
@@ -0,0 +1,82 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package admission_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"storj.io/storj/storagenode/piecestore/admission"
+)
+
+func TestLimiterStatic(t *testing.T) {
+	limiter := admission.NewLimiter(admission.Config{Enabled: false}, 7)
+	require.Equal(t, 7, limiter.Limit())
+
+	// observations don't change a static limit.
+	limiter.Observe(7, time.Second, time.Second)
+	require.Equal(t, 7, limiter.Limit())
+
+	limiter.Reject()
+	limiter.Reject()
+	stats := limiter.Stats()
+	require.False(t, stats.Adaptive)
+	require.Equal(t, 7, stats.Limit)
+	require.EqualValues(t, 2, stats.Rejected)
+}
+
+func TestLimiterAdaptive(t *testing.T) {
+	config := admission.Config{
+		Enabled:             true,
+		MinLimit:            2,
+		MaxLimit:            12,
+		InitialLimit:        10,
+		TargetWriteLatency:  10 * time.Millisecond,
+		TargetCommitLatency: 100 * time.Millisecond,
+		DecreaseFactor:      0.5,
+		DecreaseCooldown:    time.Second,
+	}
+
+	now := time.Now()
+	limiter := admission.NewLimiter(config, 0)
+	limiter.TestSetNow(func() time.Time { return now })
+	require.Equal(t, 10, limiter.Limit())
+
+	// a mostly idle node does not grow its limit.
+	for i := 0; i < 100; i++ {
+		limiter.Observe(1, time.Millisecond, time.Millisecond)
+	}
+	require.Equal(t, 10, limiter.Limit())
+
+	// a busy node with a fast disk grows up to the maximum.
+	for i := 0; i < 100; i++ {
+		limiter.Observe(limiter.Limit(), time.Millisecond, time.Millisecond)
+	}
+	require.Equal(t, 12, limiter.Limit())
+
+	// a slow disk decreases the limit, but only once per cooldown.
+	limiter.Observe(12, time.Second, time.Millisecond)
+	require.Equal(t, 6, limiter.Limit())
+	limiter.Observe(12, time.Second, time.Millisecond)
+	require.Equal(t, 6, limiter.Limit())
+
+	// slow commits are treated the same way as slow writes.
+	now = now.Add(time.Second)
+	limiter.Observe(12, time.Millisecond, 10*time.Second)
+	require.Equal(t, 3, limiter.Limit())
+
+	// the limit never falls below the minimum.
+	for i := 0; i < 10; i++ {
+		now = now.Add(time.Second)
+		limiter.Observe(12, time.Second, 10*time.Second)
+	}
+	require.Equal(t, 2, limiter.Limit())
+
+	stats := limiter.Stats()
+	require.True(t, stats.Adaptive)
+	require.Equal(t, 2, stats.Limit)
+	require.Greater(t, stats.WriteLatency, config.TargetWriteLatency)
+}
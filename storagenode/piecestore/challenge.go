@@ -0,0 +1,109 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package piecestore
+
+import (
+	"context"
+	"io"
+	"os"
+
+	"go.uber.org/zap"
+
+	"storj.io/common/errs2"
+	"storj.io/common/identity"
+	"storj.io/common/memory"
+	"storj.io/common/pkcrypto"
+	"storj.io/common/rpc/rpcstatus"
+	"storj.io/common/storj"
+	"storj.io/storj/private/auditpb"
+)
+
+const (
+	// maxChallenges is the maximum number of pieces challenged by a request.
+	maxChallenges = 1024
+	// maxChallengeSize is the maximum number of bytes hashed for a request.
+	maxChallengeSize = 4 * memory.MiB
+)
+
+// Challenge hashes the nonce of the request followed by the requested ranges
+// of the pieces, letting the satellite audit many pieces in one round trip.
+func (endpoint *Endpoint) Challenge(ctx context.Context, req *auditpb.ChallengeRequest) (_ *auditpb.ChallengeResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	peer, err := identity.PeerIdentityFromContext(ctx)
+	if err != nil {
+		return nil, rpcstatus.Wrap(rpcstatus.Unauthenticated, err)
+	}
+
+	err = endpoint.trust.VerifySatelliteID(ctx, peer.ID)
+	if err != nil {
+		return nil, rpcstatus.Error(rpcstatus.PermissionDenied, "piecestore.challenge called with untrusted ID")
+	}
+
+	if len(req.Challenges) > maxChallenges {
+		return nil, rpcstatus.Errorf(rpcstatus.InvalidArgument,
+			"too many challenges, requested=%d maximum=%d", len(req.Challenges), maxChallenges)
+	}
+
+	var size int64
+	for _, challenge := range req.Challenges {
+		if challenge.Offset < 0 || challenge.Length < 0 {
+			return nil, rpcstatus.Errorf(rpcstatus.InvalidArgument,
+				"invalid range, offset=%d length=%d", challenge.Offset, challenge.Length)
+		}
+		size += challenge.Length
+	}
+	if size > maxChallengeSize.Int64() {
+		return nil, rpcstatus.Errorf(rpcstatus.InvalidArgument,
+			"challenged too much data, requested=%d maximum=%d", size, maxChallengeSize.Int64())
+	}
+
+	hash := pkcrypto.NewHash()
+	_, _ = hash.Write(req.Nonce)
+	for _, challenge := range req.Challenges {
+		if err := endpoint.hashChallenge(ctx, peer.ID, challenge, hash); err != nil {
+			return nil, err
+		}
+	}
+
+	mon.IntVal("challenge_pieces").Observe(int64(len(req.Challenges)))
+	mon.IntVal("challenge_size").Observe(size)
+
+	return &auditpb.ChallengeResponse{Hash: hash.Sum(nil)}, nil
+}
+
+// hashChallenge writes the requested range of the piece to w.
+func (endpoint *Endpoint) hashChallenge(ctx context.Context, satelliteID storj.NodeID, challenge *auditpb.PieceChallenge, w io.Writer) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	pieceReader, err := endpoint.store.Reader(ctx, satelliteID, challenge.PieceId)
+	if err != nil {
+		if os.IsNotExist(err) {
+			endpoint.monitor.VerifyDirReadableLoop.TriggerWait()
+			return rpcstatus.Wrap(rpcstatus.NotFound, err)
+		}
+		return rpcstatus.Wrap(rpcstatus.Internal, err)
+	}
+	defer func() {
+		err := pieceReader.Close()
+		if err != nil {
+			if errs2.IsCanceled(err) {
+				return
+			}
+			endpoint.log.Error("failed to close piece reader", zap.Error(err))
+		}
+	}()
+
+	if challenge.Offset+challenge.Length > pieceReader.Size() {
+		return rpcstatus.Errorf(rpcstatus.InvalidArgument,
+			"requested more data than available, requesting=%v available=%v",
+			challenge.Offset+challenge.Length, pieceReader.Size())
+	}
+
+	_, err = io.Copy(w, io.NewSectionReader(pieceReader, challenge.Offset, challenge.Length))
+	if err != nil {
+		return rpcstatus.Wrap(rpcstatus.Internal, err)
+	}
+	return nil
+}
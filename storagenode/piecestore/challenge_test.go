@@ -0,0 +1,99 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package piecestore_test
+
+import (
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"storj.io/common/errs2"
+	"storj.io/common/memory"
+	"storj.io/common/pkcrypto"
+	"storj.io/common/rpc/rpcstatus"
+	"storj.io/common/testcontext"
+	"storj.io/common/testrand"
+	"storj.io/storj/private/auditpb"
+	"storj.io/storj/private/testplanet"
+)
+
+func TestChallenge(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 1, UplinkCount: 1,
+		Reconfigure: testplanet.Reconfigure{
+			Satellite: testplanet.ReconfigureRS(1, 1, 1, 1),
+		},
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		satellite := planet.Satellites[0]
+		node := planet.StorageNodes[0]
+
+		err := planet.Uplinks[0].Upload(ctx, satellite, "testbucket", "test/path", testrand.Bytes(10*memory.KiB))
+		require.NoError(t, err)
+
+		segments, err := satellite.Metabase.DB.TestingAllSegments(ctx)
+		require.NoError(t, err)
+		require.Len(t, segments, 1)
+		segment := segments[0]
+		pieceID := segment.RootPieceID.Derive(node.ID(), int32(segment.Pieces[0].Number))
+
+		reader, err := node.Storage2.Store.Reader(ctx, satellite.ID(), pieceID)
+		require.NoError(t, err)
+		data, err := io.ReadAll(reader)
+		require.NoError(t, err)
+		require.NoError(t, reader.Close())
+
+		nonce := testrand.Bytes(32)
+		challenges := []*auditpb.PieceChallenge{
+			{PieceId: pieceID, Offset: 0, Length: 256},
+			{PieceId: pieceID, Offset: 1024, Length: 512},
+		}
+
+		// use non satellite dialer to check if request will be rejected
+		conn, err := planet.Uplinks[0].Dialer.DialNodeURL(ctx, node.NodeURL())
+		require.NoError(t, err)
+		defer ctx.Check(conn.Close)
+
+		_, err = auditpb.NewDRPCPieceAuditClient(conn).Challenge(ctx, &auditpb.ChallengeRequest{
+			Nonce:      nonce,
+			Challenges: challenges,
+		})
+		require.True(t, errs2.IsRPC(err, rpcstatus.PermissionDenied))
+
+		conn, err = satellite.Dialer.DialNodeURL(ctx, node.NodeURL())
+		require.NoError(t, err)
+		defer ctx.Check(conn.Close)
+		client := auditpb.NewDRPCPieceAuditClient(conn)
+
+		response, err := client.Challenge(ctx, &auditpb.ChallengeRequest{
+			Nonce:      nonce,
+			Challenges: challenges,
+		})
+		require.NoError(t, err)
+
+		hash := pkcrypto.NewHash()
+		_, _ = hash.Write(nonce)
+		_, _ = hash.Write(data[0:256])
+		_, _ = hash.Write(data[1024:1536])
+		require.Equal(t, hash.Sum(nil), response.Hash)
+
+		// a missing piece fails the challenge.
+		_, err = client.Challenge(ctx, &auditpb.ChallengeRequest{
+			Nonce: nonce,
+			Challenges: []*auditpb.PieceChallenge{
+				{PieceId: testrand.PieceID(), Offset: 0, Length: 256},
+			},
+		})
+		require.True(t, errs2.IsRPC(err, rpcstatus.NotFound))
+
+		// a range beyond the end of the piece is rejected.
+		_, err = client.Challenge(ctx, &auditpb.ChallengeRequest{
+			Nonce: nonce,
+			Challenges: []*auditpb.PieceChallenge{
+				{PieceId: pieceID, Offset: int64(len(data)), Length: 1},
+			},
+		})
+		require.True(t, errs2.IsRPC(err, rpcstatus.InvalidArgument))
+	})
+}
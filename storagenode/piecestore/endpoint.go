@@ -32,10 +32,13 @@ import (
 	"storj.io/drpc"
 	"storj.io/drpc/drpcctx"
 	"storj.io/storj/storagenode/bandwidth"
+	"storj.io/storj/storagenode/maintenance"
 	"storj.io/storj/storagenode/monitor"
 	"storj.io/storj/storagenode/orders"
 	"storj.io/storj/storagenode/orders/ordersfile"
 	"storj.io/storj/storagenode/pieces"
+	"storj.io/storj/storagenode/piecestore/admission"
+	"storj.io/storj/storagenode/piecestore/shaping"
 	"storj.io/storj/storagenode/piecestore/usedserials"
 	"storj.io/storj/storagenode/retain"
 	"storj.io/storj/storagenode/trust"
@@ -76,8 +79,10 @@ type Config struct {
 
 	Trust trust.Config
 
-	Monitor monitor.Config
-	Orders  orders.Config
+	Admission admission.Config
+	Shaping   shaping.Config
+	Monitor   monitor.Config
+	Orders    orders.Config
 }
 
 type pingStatsSource interface {
@@ -105,12 +110,15 @@ type Endpoint struct {
 	usage        bandwidth.DB
 	usedSerials  *usedserials.Table
 	pieceDeleter *pieces.Deleter
+	admission    *admission.Limiter
+	shaper       *shaping.Shaper
+	maintenance  *maintenance.Service
 
 	liveRequests int32
 }
 
 // NewEndpoint creates a new piecestore endpoint.
-func NewEndpoint(log *zap.Logger, ident *identity.FullIdentity, trust *trust.Pool, monitor *monitor.Service, retain *retain.Service, pingStats pingStatsSource, store *pieces.Store, trashChore *pieces.TrashChore, pieceDeleter *pieces.Deleter, ordersStore *orders.FileStore, usage bandwidth.DB, usedSerials *usedserials.Table, config Config) (*Endpoint, error) {
+func NewEndpoint(log *zap.Logger, ident *identity.FullIdentity, trust *trust.Pool, monitor *monitor.Service, retain *retain.Service, pingStats pingStatsSource, store *pieces.Store, trashChore *pieces.TrashChore, pieceDeleter *pieces.Deleter, ordersStore *orders.FileStore, usage bandwidth.DB, usedSerials *usedserials.Table, admission *admission.Limiter, shaper *shaping.Shaper, maintenance *maintenance.Service, config Config) (*Endpoint, error) {
 	return &Endpoint{
 		log:    log,
 		config: config,
@@ -127,6 +135,9 @@ func NewEndpoint(log *zap.Logger, ident *identity.FullIdentity, trust *trust.Poo
 		usage:        usage,
 		usedSerials:  usedSerials,
 		pieceDeleter: pieceDeleter,
+		admission:    admission,
+		shaper:       shaper,
+		maintenance:  maintenance,
 
 		liveRequests: 0,
 	}, nil
@@ -271,12 +282,17 @@ func (endpoint *Endpoint) Upload(stream pb.DRPCPiecestore_UploadStream) (err err
 
 	endpoint.pingStats.WasPinged(time.Now())
 
-	if endpoint.config.MaxConcurrentRequests > 0 && int(liveRequests) > endpoint.config.MaxConcurrentRequests {
+	if endpoint.maintenance.Enabled() {
+		return rpcstatus.Error(rpcstatus.Unavailable, "storage node is in maintenance mode, new uploads are not accepted")
+	}
+
+	if requestLimit := endpoint.admission.Limit(); requestLimit > 0 && int(liveRequests) > requestLimit {
+		endpoint.admission.Reject()
 		endpoint.log.Error("upload rejected, too many requests",
 			zap.Int32("live requests", liveRequests),
-			zap.Int("requestLimit", endpoint.config.MaxConcurrentRequests),
+			zap.Int("requestLimit", requestLimit),
 		)
-		errMsg := fmt.Sprintf("storage node overloaded, request limit: %d", endpoint.config.MaxConcurrentRequests)
+		errMsg := fmt.Sprintf("storage node overloaded, request limit: %d", requestLimit)
 		return rpcstatus.Error(rpcstatus.Unavailable, errMsg)
 	}
 
@@ -310,7 +326,7 @@ func (endpoint *Endpoint) Upload(stream pb.DRPCPiecestore_UploadStream) (err err
 		return err
 	}
 
-	availableSpace, err := endpoint.monitor.AvailableSpace(ctx)
+	availableSpace, err := endpoint.monitor.AvailableSpaceForSatellite(ctx, limit.SatelliteId)
 	if err != nil {
 		return rpcstatus.Wrap(rpcstatus.Internal, err)
 	}
@@ -458,6 +474,9 @@ func (endpoint *Endpoint) Upload(stream pb.DRPCPiecestore_UploadStream) (err err
 			if availableSpace < 0 {
 				return rpcstatus.Error(rpcstatus.Internal, "out of space")
 			}
+			if err := endpoint.shaper.WaitIngress(ctx, len(message.Chunk.Data)); err != nil {
+				return rpcstatus.Wrap(rpcstatus.Canceled, err)
+			}
 			if _, err := pieceWriter.Write(message.Chunk.Data); err != nil {
 				return rpcstatus.Wrap(rpcstatus.Internal, err)
 			}
@@ -490,6 +509,7 @@ func (endpoint *Endpoint) Upload(stream pb.DRPCPiecestore_UploadStream) (err err
 					return rpcstatus.Wrap(rpcstatus.Internal, err)
 				}
 				committed = true
+				endpoint.admission.Observe(int(atomic.LoadInt32(&endpoint.liveRequests)), pieceWriter.WriteLatency(), pieceWriter.CommitLatency())
 				if !limit.PieceExpiration.IsZero() {
 					err := endpoint.store.SetExpiration(ctx, limit.SatelliteId, limit.PieceId, limit.PieceExpiration)
 					if err != nil {
@@ -526,11 +546,11 @@ func (endpoint *Endpoint) Upload(stream pb.DRPCPiecestore_UploadStream) (err err
 }
 
 // isCongested identifies state of congestion. If the total number of
-// connections is above 80% of the MaxConcurrentRequests, then it is defined
+// connections is above 80% of the current request limit, then it is defined
 // as congestion.
 func (endpoint *Endpoint) isCongested() bool {
 
-	requestCongestionThreshold := int32(float64(endpoint.config.MaxConcurrentRequests) * endpoint.config.MinUploadSpeedCongestionThreshold)
+	requestCongestionThreshold := int32(float64(endpoint.admission.Limit()) * endpoint.config.MinUploadSpeedCongestionThreshold)
 
 	connectionCount := atomic.LoadInt32(&endpoint.liveRequests)
 	return connectionCount > requestCongestionThreshold
@@ -696,6 +716,10 @@ func (endpoint *Endpoint) Download(stream pb.DRPCPiecestore_DownloadStream) (err
 				return nil //nolint: nilerr // We don't need to return an error when client cancels.
 			}
 
+			if err := endpoint.shaper.WaitEgress(ctx, int(chunkSize)); err != nil {
+				return rpcstatus.Wrap(rpcstatus.Canceled, err)
+			}
+
 			chunkData := make([]byte, chunkSize)
 			_, err = pieceReader.Seek(currentOffset, io.SeekStart)
 			if err != nil {
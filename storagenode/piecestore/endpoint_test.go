@@ -310,6 +310,58 @@ func TestUploadOverAvailable(t *testing.T) {
 	})
 }
 
+func TestUploadMaintenanceMode(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 1, UplinkCount: 1,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		node := planet.StorageNodes[0]
+
+		client, err := planet.Uplinks[0].DialPiecestore(ctx, node)
+		require.NoError(t, err)
+		defer ctx.Check(client.Close)
+
+		upload := func(pieceID storj.PieceID, action pb.PieceAction) error {
+			data := testrand.Bytes(10 * memory.KiB)
+			orderLimit, piecePrivateKey := GenerateOrderLimit(
+				t,
+				planet.Satellites[0].ID(),
+				node.ID(),
+				pieceID,
+				action,
+				testrand.SerialNumber(),
+				24*time.Hour,
+				24*time.Hour,
+				int64(len(data)),
+			)
+			signer := signing.SignerFromFullIdentity(planet.Satellites[0].Identity)
+			orderLimit, err = signing.SignOrderLimit(ctx, signer, orderLimit)
+			require.NoError(t, err)
+
+			_, err = client.UploadReader(ctx, orderLimit, piecePrivateKey, bytes.NewReader(data))
+			return err
+		}
+
+		require.NoError(t, upload(storj.PieceID{1}, pb.PieceAction_PUT))
+
+		_, err = node.Maintenance.SetEnabled(ctx, true)
+		require.NoError(t, err)
+
+		err = upload(storj.PieceID{2}, pb.PieceAction_PUT)
+		require.Error(t, err)
+		require.True(t, errs2.IsRPC(err, rpcstatus.Unavailable))
+		require.Contains(t, err.Error(), "maintenance mode")
+
+		// pieces uploaded before are still available.
+		_, err = node.Storage2.Store.Stat(ctx, planet.Satellites[0].ID(), storj.PieceID{1})
+		require.NoError(t, err)
+
+		_, err = node.Maintenance.SetEnabled(ctx, false)
+		require.NoError(t, err)
+
+		require.NoError(t, upload(storj.PieceID{3}, pb.PieceAction_PUT))
+	})
+}
+
 func TestDownload(t *testing.T) {
 	testplanet.Run(t, testplanet.Config{
 		SatelliteCount: 1, StorageNodeCount: 1, UplinkCount: 1,
@@ -0,0 +1,181 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package shaping
+
+import (
+	"strconv"
+	"strings"
+	"time"
+
+	"storj.io/common/memory"
+)
+
+var weekdays = map[string]time.Weekday{
+	"sun": time.Sunday,
+	"mon": time.Monday,
+	"tue": time.Tuesday,
+	"wed": time.Wednesday,
+	"thu": time.Thursday,
+	"fri": time.Friday,
+	"sat": time.Saturday,
+}
+
+// Rule overrides the bandwidth limits during a time window on some days of the week.
+type Rule struct {
+	Days [7]bool
+	// Start and End are offsets from midnight. When End is not after Start,
+	// the window continues past midnight into the next day.
+	Start time.Duration
+	End   time.Duration
+
+	HasIngress bool
+	Ingress    memory.Size
+	HasEgress  bool
+	Egress     memory.Size
+}
+
+// Matches returns true when the rule applies at the specified time.
+func (rule *Rule) Matches(now time.Time) bool {
+	sinceMidnight := time.Duration(now.Hour())*time.Hour + time.Duration(now.Minute())*time.Minute + time.Duration(now.Second())*time.Second
+	day := now.Weekday()
+	if rule.Start < rule.End {
+		return rule.Days[day] && rule.Start <= sinceMidnight && sinceMidnight < rule.End
+	}
+	previous := (day + 6) % 7
+	return (rule.Days[day] && rule.Start <= sinceMidnight) || (rule.Days[previous] && sinceMidnight < rule.End)
+}
+
+// Schedule is a weekly schedule of bandwidth limits. The first matching rule wins.
+type Schedule []Rule
+
+// ParseSchedule parses a schedule such as
+//
+//	mon-fri 09:00-17:00 egress=1MB ingress=2MB; sat,sun 22:00-06:00 egress=10MB
+//
+// Rules are separated by semicolons. Every rule lists the days of the week,
+// the time window in local time and the limits per second which apply in the
+// window. A limit of 0 means unlimited.
+func ParseSchedule(s string) (Schedule, error) {
+	var schedule Schedule
+	for _, text := range strings.Split(s, ";") {
+		text = strings.TrimSpace(text)
+		if text == "" {
+			continue
+		}
+		rule, err := parseRule(text)
+		if err != nil {
+			return nil, Error.New("invalid rule %q: %w", text, err)
+		}
+		schedule = append(schedule, rule)
+	}
+	return schedule, nil
+}
+
+// Limits returns the limits which apply at the specified time.
+func (schedule Schedule) Limits(now time.Time, ingress, egress memory.Size) (memory.Size, memory.Size) {
+	for i := range schedule {
+		rule := &schedule[i]
+		if !rule.Matches(now) {
+			continue
+		}
+		if rule.HasIngress {
+			ingress = rule.Ingress
+		}
+		if rule.HasEgress {
+			egress = rule.Egress
+		}
+		break
+	}
+	return ingress, egress
+}
+
+func parseRule(text string) (rule Rule, err error) {
+	fields := strings.Fields(text)
+	if len(fields) < 3 {
+		return rule, Error.New("expected days, time window and limits")
+	}
+
+	rule.Days, err = parseDays(fields[0])
+	if err != nil {
+		return rule, err
+	}
+
+	start, end, ok := strings.Cut(fields[1], "-")
+	if !ok {
+		return rule, Error.New("invalid time window %q", fields[1])
+	}
+	if rule.Start, err = parseTimeOfDay(start); err != nil {
+		return rule, err
+	}
+	if rule.End, err = parseTimeOfDay(end); err != nil {
+		return rule, err
+	}
+
+	for _, field := range fields[2:] {
+		direction, value, ok := strings.Cut(field, "=")
+		if !ok {
+			return rule, Error.New("invalid limit %q", field)
+		}
+		// memory.ParseString doesn't handle values without digits.
+		if value == "" || value[0] < '0' || value[0] > '9' {
+			return rule, Error.New("invalid limit %q", field)
+		}
+		size, err := memory.ParseString(value)
+		if err != nil {
+			return rule, Error.New("invalid limit %q: %w", field, err)
+		}
+		switch strings.ToLower(direction) {
+		case "ingress":
+			rule.HasIngress, rule.Ingress = true, memory.Size(size)
+		case "egress":
+			rule.HasEgress, rule.Egress = true, memory.Size(size)
+		default:
+			return rule, Error.New("unknown direction %q, expected ingress or egress", direction)
+		}
+	}
+	return rule, nil
+}
+
+func parseDays(text string) (days [7]bool, err error) {
+	for _, item := range strings.Split(strings.ToLower(text), ",") {
+		first, last, isRange := strings.Cut(item, "-")
+		from, ok := weekdays[first]
+		if !ok {
+			return days, Error.New("unknown day %q", first)
+		}
+		to := from
+		if isRange {
+			to, ok = weekdays[last]
+			if !ok {
+				return days, Error.New("unknown day %q", last)
+			}
+		}
+		for day := from; ; day = (day + 1) % 7 {
+			days[day] = true
+			if day == to {
+				break
+			}
+		}
+	}
+	return days, nil
+}
+
+func parseTimeOfDay(text string) (time.Duration, error) {
+	hours, minutes, ok := strings.Cut(text, ":")
+	if !ok {
+		return 0, Error.New("invalid time %q, expected HH:MM", text)
+	}
+	h, err := strconv.Atoi(hours)
+	if err != nil {
+		return 0, Error.New("invalid time %q, expected HH:MM", text)
+	}
+	m, err := strconv.Atoi(minutes)
+	if err != nil {
+		return 0, Error.New("invalid time %q, expected HH:MM", text)
+	}
+	if h < 0 || m < 0 || m > 59 || h > 24 || (h == 24 && m > 0) {
+		return 0, Error.New("time %q out of range", text)
+	}
+	return time.Duration(h)*time.Hour + time.Duration(m)*time.Minute, nil
+}
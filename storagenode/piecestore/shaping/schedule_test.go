@@ -0,0 +1,75 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package shaping_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"storj.io/common/memory"
+	"storj.io/storj/storagenode/piecestore/shaping"
+)
+
+func TestParseSchedule(t *testing.T) {
+	schedule, err := shaping.ParseSchedule("mon-fri 09:00-17:00 egress=1MB ingress=2MB; sat,sun 22:00-06:00 egress=10MB")
+	require.NoError(t, err)
+	require.Len(t, schedule, 2)
+
+	require.Equal(t, [7]bool{false, true, true, true, true, true, false}, schedule[0].Days)
+	require.Equal(t, 9*time.Hour, schedule[0].Start)
+	require.Equal(t, 17*time.Hour, schedule[0].End)
+	require.True(t, schedule[0].HasIngress)
+	require.Equal(t, 2*memory.MB, schedule[0].Ingress)
+	require.True(t, schedule[0].HasEgress)
+	require.Equal(t, 1*memory.MB, schedule[0].Egress)
+
+	require.Equal(t, [7]bool{true, false, false, false, false, false, true}, schedule[1].Days)
+	require.False(t, schedule[1].HasIngress)
+
+	schedule, err = shaping.ParseSchedule("")
+	require.NoError(t, err)
+	require.Empty(t, schedule)
+
+	for _, invalid := range []string{
+		"mon-fri 09:00-17:00",
+		"someday 09:00-17:00 egress=1MB",
+		"mon 09:00 egress=1MB",
+		"mon 09:00-25:00 egress=1MB",
+		"mon 09:00-17:00 sideways=1MB",
+		"mon 09:00-17:00 egress=lots",
+	} {
+		_, err := shaping.ParseSchedule(invalid)
+		require.Error(t, err, invalid)
+	}
+}
+
+func TestScheduleLimits(t *testing.T) {
+	schedule, err := shaping.ParseSchedule("mon-fri 09:00-17:00 egress=1MB ingress=2MB; sat,sun 22:00-06:00 egress=10MB; fri-mon 00:00-24:00 ingress=5MB")
+	require.NoError(t, err)
+
+	// 2023-01-02 is a Monday.
+	at := func(day, hour, minute int) time.Time {
+		return time.Date(2023, 1, 2+day, hour, minute, 0, 0, time.Local)
+	}
+
+	for _, tt := range []struct {
+		now             time.Time
+		ingress, egress memory.Size
+	}{
+		{now: at(0, 8, 59), ingress: 5 * memory.MB, egress: 0},
+		{now: at(0, 9, 0), ingress: 2 * memory.MB, egress: 1 * memory.MB},
+		{now: at(2, 16, 59), ingress: 2 * memory.MB, egress: 1 * memory.MB},
+		{now: at(2, 17, 0), ingress: 0, egress: 0},
+		{now: at(5, 23, 0), ingress: 0, egress: 10 * memory.MB},
+		{now: at(6, 5, 59), ingress: 0, egress: 10 * memory.MB},
+		{now: at(6, 6, 0), ingress: 5 * memory.MB, egress: 0},
+		{now: at(7, 5, 0), ingress: 0, egress: 10 * memory.MB},
+	} {
+		ingress, egress := schedule.Limits(tt.now, 0, 0)
+		require.Equal(t, tt.ingress, ingress, tt.now)
+		require.Equal(t, tt.egress, egress, tt.now)
+	}
+}
@@ -0,0 +1,152 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+// Package shaping implements limiting the bandwidth used by a storage node
+// according to a weekly schedule.
+package shaping
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/spacemonkeygo/monkit/v3"
+	"github.com/zeebo/errs"
+	"golang.org/x/time/rate"
+
+	"storj.io/common/memory"
+)
+
+var (
+	mon = monkit.Package()
+
+	// Error is the default error class for shaping package.
+	Error = errs.Class("shaping")
+)
+
+// Config defines parameters for bandwidth shaping.
+type Config struct {
+	Ingress        memory.Size `help:"maximum ingress (upload) bandwidth per second, 0 means unlimited" default:"0B"`
+	Egress         memory.Size `help:"maximum egress (download) bandwidth per second, 0 means unlimited" default:"0B"`
+	Schedule       string      `help:"weekly schedule overriding the bandwidth limits in local time, e.g. \"mon-fri 09:00-17:00 egress=1MB ingress=2MB; sat,sun 22:00-06:00 egress=10MB\"" default:""`
+	MinimumIngress memory.Size `help:"ingress limit below which the node reports no free space to satellites, so it isn't selected for uploads while throttled" default:"1MB"`
+}
+
+// Shaper limits the ingress and egress bandwidth shared by all the piece transfers.
+//
+// architecture: Service
+type Shaper struct {
+	config   Config
+	schedule Schedule
+
+	mu      sync.Mutex
+	ingress direction
+	egress  direction
+
+	// now is used for testing.
+	now func() time.Time
+}
+
+// direction is the limiter of a single transfer direction.
+type direction struct {
+	limit   memory.Size
+	limiter *rate.Limiter
+}
+
+// NewShaper creates a new bandwidth shaper.
+func NewShaper(config Config) (*Shaper, error) {
+	schedule, err := ParseSchedule(config.Schedule)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Shaper{
+		config:   config,
+		schedule: schedule,
+		ingress:  direction{limiter: rate.NewLimiter(rate.Inf, 0)},
+		egress:   direction{limiter: rate.NewLimiter(rate.Inf, 0)},
+		now:      time.Now,
+	}, nil
+}
+
+// Limits returns the limits which currently apply, 0 means unlimited.
+func (shaper *Shaper) Limits() (ingress, egress memory.Size) {
+	shaper.mu.Lock()
+	defer shaper.mu.Unlock()
+	return shaper.limits()
+}
+
+func (shaper *Shaper) limits() (ingress, egress memory.Size) {
+	return shaper.schedule.Limits(shaper.now(), shaper.config.Ingress, shaper.config.Egress)
+}
+
+// Throttled returns true when ingress is currently limited below the configured minimum.
+func (shaper *Shaper) Throttled() bool {
+	ingress, _ := shaper.Limits()
+	return ingress > 0 && ingress < shaper.config.MinimumIngress
+}
+
+// WaitIngress blocks until n bytes may be received.
+func (shaper *Shaper) WaitIngress(ctx context.Context, n int) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	shaper.mu.Lock()
+	ingress, _ := shaper.limits()
+	limiter := shaper.ingress.update(ingress)
+	shaper.mu.Unlock()
+
+	return wait(ctx, limiter, n)
+}
+
+// WaitEgress blocks until n bytes may be sent.
+func (shaper *Shaper) WaitEgress(ctx context.Context, n int) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	shaper.mu.Lock()
+	_, egress := shaper.limits()
+	limiter := shaper.egress.update(egress)
+	shaper.mu.Unlock()
+
+	return wait(ctx, limiter, n)
+}
+
+// TestSetNow replaces the time source, it's only intended for testing.
+func (shaper *Shaper) TestSetNow(now func() time.Time) {
+	shaper.mu.Lock()
+	defer shaper.mu.Unlock()
+	shaper.now = now
+}
+
+// update adjusts the limiter to the new limit.
+func (dir *direction) update(limit memory.Size) *rate.Limiter {
+	if limit == dir.limit {
+		return dir.limiter
+	}
+	dir.limit = limit
+	if limit <= 0 {
+		dir.limiter = rate.NewLimiter(rate.Inf, 0)
+		return dir.limiter
+	}
+	// allow bursts of a second worth of traffic. Transfers which are already
+	// waiting keep using the previous limiter.
+	dir.limiter = rate.NewLimiter(rate.Limit(limit), int(limit))
+	return dir.limiter
+}
+
+// wait waits for n tokens, in steps no larger than the burst of the limiter.
+func wait(ctx context.Context, limiter *rate.Limiter, n int) error {
+	for n > 0 {
+		if limiter.Limit() == rate.Inf {
+			return nil
+		}
+		step := n
+		if burst := limiter.Burst(); step > burst {
+			step = burst
+		}
+		if err := limiter.WaitN(ctx, step); err != nil {
+			return err
+		}
+		n -= step
+	}
+	return nil
+}
@@ -0,0 +1,45 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package shaping_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"storj.io/common/memory"
+	"storj.io/common/testcontext"
+	"storj.io/storj/storagenode/piecestore/shaping"
+)
+
+func TestShaper(t *testing.T) {
+	ctx := testcontext.New(t)
+
+	shaper, err := shaping.NewShaper(shaping.Config{
+		Egress:         10 * memory.KiB,
+		Schedule:       "mon-sun 00:00-00:00 ingress=100KiB",
+		MinimumIngress: 1 * memory.MB,
+	})
+	require.NoError(t, err)
+
+	ingress, egress := shaper.Limits()
+	require.Equal(t, 100*memory.KiB, ingress)
+	require.Equal(t, 10*memory.KiB, egress)
+	require.True(t, shaper.Throttled())
+
+	// the first second worth of traffic is allowed immediately.
+	require.NoError(t, shaper.WaitEgress(ctx, 10*memory.KiB.Int()))
+
+	// anything more has to wait.
+	timeoutCtx, cancel := context.WithTimeout(ctx, 100*time.Millisecond)
+	defer cancel()
+	require.Error(t, shaper.WaitEgress(timeoutCtx, 10*memory.KiB.Int()))
+
+	unlimited, err := shaping.NewShaper(shaping.Config{MinimumIngress: 1 * memory.MB})
+	require.NoError(t, err)
+	require.False(t, unlimited.Throttled())
+	require.NoError(t, unlimited.WaitIngress(timeoutCtx, 100*memory.MiB.Int()))
+}
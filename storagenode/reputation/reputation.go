@@ -21,6 +21,13 @@ type DB interface {
 	Get(ctx context.Context, satelliteID storj.NodeID) (*Stats, error)
 	// All retrieves all stats from DB
 	All(ctx context.Context) ([]Stats, error)
+
+	// StoreSnapshot appends reputation snapshot to the history.
+	StoreSnapshot(ctx context.Context, snapshot Snapshot) error
+	// History retrieves reputation snapshots of a satellite taken between from (inclusive) and to (exclusive).
+	History(ctx context.Context, satelliteID storj.NodeID, from, to time.Time) ([]Snapshot, error)
+	// DeleteHistoryBefore deletes reputation snapshots taken before the given time.
+	DeleteHistoryBefore(ctx context.Context, before time.Time) (deleted int64, err error)
 }
 
 // Stats consist of reputation metrics.
@@ -41,6 +48,34 @@ type Stats struct {
 	JoinedAt  time.Time
 }
 
+// Snapshot is the reputation of the node on a satellite at a point in time.
+type Snapshot struct {
+	SatelliteID storj.NodeID `json:"satelliteID"`
+	Timestamp   time.Time    `json:"timestamp"`
+
+	AuditScore      float64 `json:"auditScore"`
+	SuspensionScore float64 `json:"suspensionScore"`
+	OnlineScore     float64 `json:"onlineScore"`
+
+	DisqualifiedAt     *time.Time `json:"disqualifiedAt"`
+	SuspendedAt        *time.Time `json:"suspendedAt"`
+	OfflineSuspendedAt *time.Time `json:"offlineSuspendedAt"`
+}
+
+// NewSnapshot creates a snapshot of the reputation stats taken at timestamp.
+func NewSnapshot(stats Stats, timestamp time.Time) Snapshot {
+	return Snapshot{
+		SatelliteID:        stats.SatelliteID,
+		Timestamp:          timestamp,
+		AuditScore:         stats.Audit.Score,
+		SuspensionScore:    stats.Audit.UnknownScore,
+		OnlineScore:        stats.OnlineScore,
+		DisqualifiedAt:     stats.DisqualifiedAt,
+		SuspendedAt:        stats.SuspendedAt,
+		OfflineSuspendedAt: stats.OfflineSuspendedAt,
+	}
+}
+
 // Metric encapsulates storagenode reputation metrics.
 type Metric struct {
 	TotalCount   int64 `json:"totalCount"`
@@ -184,7 +184,7 @@ func TestServiceStore(t *testing.T) {
 		reputationDB := db.Reputation()
 		notificationsDB := db.Notifications()
 		log := zaptest.NewLogger(t)
-		notificationService := notifications.NewService(log, notificationsDB)
+		notificationService := notifications.NewService(log, notificationsDB, time.Hour)
 		reputationService := reputation.NewService(log, reputationDB, storj.NodeID{}, notificationService)
 
 		id := testrand.NodeID()
@@ -294,3 +294,55 @@ func TestServiceStore(t *testing.T) {
 		require.Equal(t, amount, 5)
 	})
 }
+
+func TestReputationHistory(t *testing.T) {
+	storagenodedbtest.Run(t, func(ctx *testcontext.Context, t *testing.T, db storagenode.DB) {
+		reputationDB := db.Reputation()
+
+		satelliteID := testrand.NodeID()
+		otherSatelliteID := testrand.NodeID()
+		start := time.Date(2023, 3, 1, 0, 0, 0, 0, time.UTC)
+		disqualifiedAt := start.Add(48 * time.Hour)
+
+		for day := 0; day < 5; day++ {
+			stats := reputation.Stats{
+				SatelliteID: satelliteID,
+				Audit:       reputation.Metric{Score: 1 - float64(day)/10, UnknownScore: 1},
+				OnlineScore: 0.9,
+			}
+			if day >= 2 {
+				stats.DisqualifiedAt = &disqualifiedAt
+			}
+			require.NoError(t, reputationDB.StoreSnapshot(ctx, reputation.NewSnapshot(stats, start.AddDate(0, 0, day))))
+		}
+		require.NoError(t, reputationDB.StoreSnapshot(ctx, reputation.Snapshot{
+			SatelliteID: otherSatelliteID,
+			Timestamp:   start,
+		}))
+
+		history, err := reputationDB.History(ctx, satelliteID, start.AddDate(0, 0, 1), start.AddDate(0, 0, 3))
+		require.NoError(t, err)
+		require.Len(t, history, 2)
+		require.Equal(t, satelliteID, history[0].SatelliteID)
+		require.True(t, start.AddDate(0, 0, 1).Equal(history[0].Timestamp))
+		require.Equal(t, 0.9, history[0].AuditScore)
+		require.Equal(t, 1.0, history[0].SuspensionScore)
+		require.Equal(t, 0.9, history[0].OnlineScore)
+		require.Nil(t, history[0].DisqualifiedAt)
+		require.True(t, start.AddDate(0, 0, 2).Equal(history[1].Timestamp))
+		require.NotNil(t, history[1].DisqualifiedAt)
+		require.True(t, disqualifiedAt.Equal(*history[1].DisqualifiedAt))
+
+		deleted, err := reputationDB.DeleteHistoryBefore(ctx, start.AddDate(0, 0, 3))
+		require.NoError(t, err)
+		require.EqualValues(t, 4, deleted)
+
+		history, err = reputationDB.History(ctx, satelliteID, start, start.AddDate(0, 1, 0))
+		require.NoError(t, err)
+		require.Len(t, history, 2)
+
+		history, err = reputationDB.History(ctx, otherSatelliteID, start, start.AddDate(0, 1, 0))
+		require.NoError(t, err)
+		require.Empty(t, history)
+	})
+}
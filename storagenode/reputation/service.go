@@ -46,6 +46,11 @@ func (s *Service) Store(ctx context.Context, stats Stats, satelliteID storj.Node
 		return err
 	}
 
+	err = s.db.StoreSnapshot(ctx, NewSnapshot(stats, time.Now()))
+	if err != nil {
+		return err
+	}
+
 	if stats.DisqualifiedAt == nil && isSuspended(stats, *rep) {
 		notification := newSuspensionNotification(satelliteID, s.nodeID, *stats.OfflineSuspendedAt)
 
@@ -58,6 +63,11 @@ func (s *Service) Store(ctx context.Context, stats Stats, satelliteID storj.Node
 	return nil
 }
 
+// History returns reputation snapshots of a satellite taken between from (inclusive) and to (exclusive).
+func (s *Service) History(ctx context.Context, satelliteID storj.NodeID, from, to time.Time) ([]Snapshot, error) {
+	return s.db.History(ctx, satelliteID, from, to)
+}
+
 // isSuspended returns if there's new downtime suspension.
 func isSuspended(new, old Stats) bool {
 	if new.OfflineSuspendedAt == nil {
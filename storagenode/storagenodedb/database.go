@@ -2040,6 +2040,24 @@ func (db *DB) Migration(ctx context.Context) *migrate.Migration {
 					return errs.Wrap(err)
 				}),
 			},
+			{
+				DB:          &db.reputationDB.DB,
+				Description: "Add reputation_history table to keep snapshots of the reputation",
+				Version:     55,
+				Action: migrate.SQL{
+					`CREATE TABLE reputation_history (
+						satellite_id BLOB NOT NULL,
+						timestamp TIMESTAMP NOT NULL,
+						audit_score REAL NOT NULL,
+						suspension_score REAL NOT NULL,
+						online_score REAL NOT NULL,
+						disqualified_at TIMESTAMP,
+						suspended_at TIMESTAMP,
+						offline_suspended_at TIMESTAMP,
+						PRIMARY KEY (satellite_id, timestamp)
+					)`,
+				},
+			},
 		},
 	}
 }
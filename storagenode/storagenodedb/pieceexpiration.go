@@ -56,6 +56,36 @@ func (db *pieceExpirationDB) GetExpired(ctx context.Context, expiresBefore time.
 	return expiredPieceIDs, rows.Err()
 }
 
+// GetAll gets piece IDs of all pieces with an expiration which are not in the trash.
+func (db *pieceExpirationDB) GetAll(ctx context.Context) (infos []pieces.ExpiredInfo, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	rows, err := db.QueryContext(ctx, `
+		SELECT satellite_id, piece_id
+			FROM piece_expirations
+			WHERE trash = 0
+	`)
+	if err != nil {
+		return nil, ErrPieceExpiration.Wrap(err)
+	}
+	defer func() { err = errs.Combine(err, rows.Close()) }()
+
+	for rows.Next() {
+		var satelliteID storj.NodeID
+		var pieceID storj.PieceID
+		err = rows.Scan(&satelliteID, &pieceID)
+		if err != nil {
+			return nil, ErrPieceExpiration.Wrap(err)
+		}
+		infos = append(infos, pieces.ExpiredInfo{
+			SatelliteID: satelliteID,
+			PieceID:     pieceID,
+			InPieceInfo: false,
+		})
+	}
+	return infos, rows.Err()
+}
+
 // SetExpiration sets an expiration time for the given piece ID on the given satellite.
 func (db *pieceExpirationDB) SetExpiration(ctx context.Context, satellite storj.NodeID, pieceID storj.PieceID, expiresAt time.Time) (err error) {
 	defer mon.Task()(&ctx)(&err)
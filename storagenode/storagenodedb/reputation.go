@@ -7,6 +7,7 @@ import (
 	"context"
 	"database/sql"
 	"errors"
+	"time"
 
 	"github.com/zeebo/errs"
 
@@ -229,3 +230,100 @@ func (db *reputationDB) All(ctx context.Context) (_ []reputation.Stats, err erro
 
 	return statsList, rows.Err()
 }
+
+// StoreSnapshot appends reputation snapshot to the history.
+func (db *reputationDB) StoreSnapshot(ctx context.Context, snapshot reputation.Snapshot) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	query := `INSERT OR REPLACE INTO reputation_history (
+			satellite_id,
+			timestamp,
+			audit_score,
+			suspension_score,
+			online_score,
+			disqualified_at,
+			suspended_at,
+			offline_suspended_at
+		) VALUES(?,?,?,?,?,?,?,?)`
+
+	_, err = db.ExecContext(ctx, query,
+		snapshot.SatelliteID,
+		snapshot.Timestamp.UTC(),
+		snapshot.AuditScore,
+		snapshot.SuspensionScore,
+		snapshot.OnlineScore,
+		utcOrNil(snapshot.DisqualifiedAt),
+		utcOrNil(snapshot.SuspendedAt),
+		utcOrNil(snapshot.OfflineSuspendedAt),
+	)
+
+	return ErrReputation.Wrap(err)
+}
+
+// History retrieves reputation snapshots of a satellite taken between from (inclusive) and to (exclusive).
+func (db *reputationDB) History(ctx context.Context, satelliteID storj.NodeID, from, to time.Time) (_ []reputation.Snapshot, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	query := `SELECT timestamp,
+			audit_score,
+			suspension_score,
+			online_score,
+			disqualified_at,
+			suspended_at,
+			offline_suspended_at
+		FROM reputation_history
+		WHERE satellite_id = ? AND timestamp >= ? AND timestamp < ?
+		ORDER BY timestamp`
+
+	rows, err := db.QueryContext(ctx, query, satelliteID, from.UTC(), to.UTC())
+	if err != nil {
+		return nil, ErrReputation.Wrap(err)
+	}
+	defer func() { err = errs.Combine(err, rows.Close()) }()
+
+	var history []reputation.Snapshot
+	for rows.Next() {
+		snapshot := reputation.Snapshot{
+			SatelliteID: satelliteID,
+		}
+
+		err := rows.Scan(
+			&snapshot.Timestamp,
+			&snapshot.AuditScore,
+			&snapshot.SuspensionScore,
+			&snapshot.OnlineScore,
+			&snapshot.DisqualifiedAt,
+			&snapshot.SuspendedAt,
+			&snapshot.OfflineSuspendedAt,
+		)
+		if err != nil {
+			return nil, ErrReputation.Wrap(err)
+		}
+
+		history = append(history, snapshot)
+	}
+
+	return history, ErrReputation.Wrap(rows.Err())
+}
+
+// DeleteHistoryBefore deletes reputation snapshots taken before the given time.
+func (db *reputationDB) DeleteHistoryBefore(ctx context.Context, before time.Time) (deleted int64, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	result, err := db.ExecContext(ctx, `DELETE FROM reputation_history WHERE timestamp < ?`, before.UTC())
+	if err != nil {
+		return 0, ErrReputation.Wrap(err)
+	}
+
+	deleted, err = result.RowsAffected()
+	return deleted, ErrReputation.Wrap(err)
+}
+
+// utcOrNil converts t to UTC keeping nil values.
+func utcOrNil(t *time.Time) *time.Time {
+	if t == nil {
+		return nil
+	}
+	utc := t.UTC()
+	return &utc
+}
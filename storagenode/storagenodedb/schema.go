@@ -618,6 +618,52 @@ func Schema() map[string]*dbschema.Schema {
 						},
 					},
 				},
+				{
+					Name:       "reputation_history",
+					PrimaryKey: []string{"satellite_id", "timestamp"},
+					Columns: []*dbschema.Column{
+						{
+							Name:       "audit_score",
+							Type:       "REAL",
+							IsNullable: false,
+						},
+						{
+							Name:       "disqualified_at",
+							Type:       "TIMESTAMP",
+							IsNullable: true,
+						},
+						{
+							Name:       "offline_suspended_at",
+							Type:       "TIMESTAMP",
+							IsNullable: true,
+						},
+						{
+							Name:       "online_score",
+							Type:       "REAL",
+							IsNullable: false,
+						},
+						{
+							Name:       "satellite_id",
+							Type:       "BLOB",
+							IsNullable: false,
+						},
+						{
+							Name:       "suspended_at",
+							Type:       "TIMESTAMP",
+							IsNullable: true,
+						},
+						{
+							Name:       "suspension_score",
+							Type:       "REAL",
+							IsNullable: false,
+						},
+						{
+							Name:       "timestamp",
+							Type:       "TIMESTAMP",
+							IsNullable: false,
+						},
+					},
+				},
 			},
 		},
 		"satellites": {
@@ -69,6 +69,7 @@ var States = MultiDBStates{
 		&v52,
 		&v53,
 		&v54,
+		&v55,
 	},
 }
 
@@ -5,6 +5,7 @@ package trust
 
 import (
 	"bufio"
+	"bytes"
 	"context"
 	"io"
 	"net"
@@ -20,15 +21,28 @@ var (
 	ErrHTTPSource = errs.Class("HTTP source")
 )
 
+// signedPrefix is the scheme prefix which marks a HTTP source as requiring
+// a detached signature, e.g. signed+https://example.test/satellites.
+const signedPrefix = "signed+"
+
+// signatureSuffix is appended to the path of the list URL to get the URL of
+// the detached signature.
+const signatureSuffix = ".sig"
+
 // HTTPSource represents a trust source at a http:// or https:// URL.
 type HTTPSource struct {
-	url *url.URL
+	url              *url.URL
+	requireSignature bool
 }
 
 // NewHTTPSource constructs a new HTTPSource from a URL. The URL must be
-// an http:// or https:// URL. The fragment cannot be set.
+// an http:// or https:// URL. The fragment cannot be set. The scheme can be
+// prefixed with "signed+" to require the list to have a detached signature
+// (located at the list URL with a ".sig" suffix) from one of the configured
+// signers.
 func NewHTTPSource(httpURL string) (*HTTPSource, error) {
-	u, err := url.Parse(httpURL)
+	requireSignature := strings.HasPrefix(httpURL, signedPrefix)
+	u, err := url.Parse(strings.TrimPrefix(httpURL, signedPrefix))
 	if err != nil {
 		return nil, ErrHTTPSource.New("%q: not a URL: %w", httpURL, err)
 	}
@@ -41,42 +55,61 @@ func NewHTTPSource(httpURL string) (*HTTPSource, error) {
 	if u.Fragment != "" {
 		return nil, ErrHTTPSource.New("%q: fragment is not allowed", httpURL)
 	}
-	return &HTTPSource{url: u}, nil
+	return &HTTPSource{url: u, requireSignature: requireSignature}, nil
 }
 
 // String implements the Source interface and returns the URL.
 func (source *HTTPSource) String() string {
+	if source.requireSignature {
+		return signedPrefix + source.url.String()
+	}
 	return source.url.String()
 }
 
 // Static implements the Source interface. It returns false for this source.
 func (source *HTTPSource) Static() bool { return false }
 
+// RequiresSignature implements the SignedSource interface. It returns true
+// if the source was configured with the "signed+" prefix.
+func (source *HTTPSource) RequiresSignature() bool { return source.requireSignature }
+
 // FetchEntries implements the Source interface and returns entries parsed from
 // the list retrieved over HTTP(S). The entries returned are only authoritative
 // if the entry URL has a host that matches or is a subdomain of the source URL.
-func (source *HTTPSource) FetchEntries(ctx context.Context) (_ []Entry, err error) {
+//
+// Sources requiring a signature always fail, since there are no signers to
+// verify the signature against. Use FetchSignedEntries instead.
+func (source *HTTPSource) FetchEntries(ctx context.Context) ([]Entry, error) {
+	return source.FetchSignedEntries(ctx, nil)
+}
+
+// FetchSignedEntries implements the SignedSource interface. It works like
+// FetchEntries, but if the source requires a signature, the detached
+// signature of the list is retrieved and verified against the signers
+// before the list is parsed.
+func (source *HTTPSource) FetchSignedEntries(ctx context.Context, signers Signers) (_ []Entry, err error) {
 	defer mon.Task()(&ctx)(&err)
 
-	req, err := http.NewRequestWithContext(ctx, "GET", source.url.String(), nil)
+	list, err := source.fetch(ctx, source.url)
 	if err != nil {
-		return nil, ErrHTTPSource.Wrap(err)
+		return nil, err
 	}
 
-	resp, err := http.DefaultClient.Do(req)
-	if err != nil {
-		return nil, ErrHTTPSource.Wrap(err)
-	}
-	defer func() {
-		// Errors closing the response body can be ignored since they don't
-		// impact the correctness of the function.
-		_ = resp.Body.Close()
-	}()
-	if resp.StatusCode != http.StatusOK {
-		return nil, ErrHTTPSource.New("%q: unexpected status code %d: %q", source.url, resp.StatusCode, tryReadLine(resp.Body))
+	if source.requireSignature {
+		signatureURL := *source.url
+		signatureURL.Path += signatureSuffix
+		signatureURL.RawPath = ""
+
+		signature, err := source.fetch(ctx, &signatureURL)
+		if err != nil {
+			return nil, ErrSignature.New("unable to fetch signature for %q: %w", source.url, err)
+		}
+		if err := signers.Verify(list, signature); err != nil {
+			return nil, ErrSignature.New("list at %q was rejected: %w", source.url, errs.Unwrap(err))
+		}
 	}
 
-	urls, err := ParseSatelliteURLList(ctx, resp.Body)
+	urls, err := ParseSatelliteURLList(ctx, bytes.NewReader(list))
 	if err != nil {
 		return nil, ErrHTTPSource.New("cannot parse list at %q: %w", source.url, err)
 	}
@@ -93,6 +126,35 @@ func (source *HTTPSource) FetchEntries(ctx context.Context) (_ []Entry, err erro
 	return entries, nil
 }
 
+// fetch retrieves the body at the URL.
+func (source *HTTPSource) fetch(ctx context.Context, u *url.URL) (_ []byte, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	req, err := http.NewRequestWithContext(ctx, "GET", u.String(), nil)
+	if err != nil {
+		return nil, ErrHTTPSource.Wrap(err)
+	}
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, ErrHTTPSource.Wrap(err)
+	}
+	defer func() {
+		// Errors closing the response body can be ignored since they don't
+		// impact the correctness of the function.
+		_ = resp.Body.Close()
+	}()
+	if resp.StatusCode != http.StatusOK {
+		return nil, ErrHTTPSource.New("%q: unexpected status code %d: %q", u, resp.StatusCode, tryReadLine(resp.Body))
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, ErrHTTPSource.Wrap(err)
+	}
+	return body, nil
+}
+
 // URLMatchesHTTPSourceHost takes the Satellite URL host and the host of the
 // HTTPSource URL and determines if the SatelliteURL matches or is in the
 // same domain as the HTTPSource URL.
@@ -147,6 +147,84 @@ func TestHTTPSourceFetchEntries(t *testing.T) {
 	}
 }
 
+func TestHTTPSourceFetchSignedEntries(t *testing.T) {
+	url1 := makeSatelliteURL("127.0.0.1")
+	list := url1.String() + "\n"
+
+	publicKey, sign := newEd25519Signer(t)
+	_, signOther := newEd25519Signer(t)
+
+	var signers trust.Signers
+	require.NoError(t, signers.Set(publicKey))
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/signed", "/unsigned", "/forged":
+			fmt.Fprint(w, list)
+		case "/signed.sig":
+			_, _ = w.Write(sign([]byte(list)))
+		case "/forged.sig":
+			_, _ = w.Write(signOther([]byte(list)))
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer server.Close()
+
+	signedURL := "signed+" + server.URL + "/signed"
+	unsignedURL := "signed+" + server.URL + "/unsigned"
+	forgedURL := "signed+" + server.URL + "/forged"
+
+	for _, tt := range []struct {
+		name    string
+		httpURL string
+		signers trust.Signers
+		err     string
+	}{
+		{
+			name:    "signed list is accepted",
+			httpURL: signedURL,
+			signers: signers,
+		},
+		{
+			name:    "signature is not required",
+			httpURL: server.URL + "/unsigned",
+		},
+		{
+			name:    "unsigned list is rejected",
+			httpURL: unsignedURL,
+			signers: signers,
+			err:     fmt.Sprintf(`trust list signature: unable to fetch signature for %q: HTTP source: %q: unexpected status code 404: "404 page not found"`, server.URL+"/unsigned", server.URL+"/unsigned.sig"),
+		},
+		{
+			name:    "list signed by other key is rejected",
+			httpURL: forgedURL,
+			signers: signers,
+			err:     fmt.Sprintf(`trust list signature: list at %q was rejected: signature does not match any of the configured signers`, server.URL+"/forged"),
+		},
+		{
+			name:    "signed list is rejected without signers",
+			httpURL: signedURL,
+			err:     fmt.Sprintf(`trust list signature: list at %q was rejected: no signers configured`, server.URL+"/signed"),
+		},
+	} {
+		tt := tt // quiet linting
+		t.Run(tt.name, func(t *testing.T) {
+			source, err := trust.NewHTTPSource(tt.httpURL)
+			require.NoError(t, err)
+			require.Equal(t, tt.httpURL, source.String())
+
+			entries, err := source.FetchSignedEntries(context.Background(), tt.signers)
+			if tt.err != "" {
+				require.EqualError(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, []trust.Entry{{SatelliteURL: url1, Authoritative: true}}, entries)
+		})
+	}
+}
+
 func TestURLMatchesHTTPSourceHost(t *testing.T) {
 	for _, tt := range []struct {
 		name       string
@@ -16,11 +16,13 @@ type List struct {
 	log     *zap.Logger
 	sources Sources
 	rules   Rules
+	signers Signers
 	cache   *Cache
 }
 
-// NewList takes one or more sources, optional rules, and a cache and returns a new List.
-func NewList(log *zap.Logger, sources []Source, rules Rules, cache *Cache) (*List, error) {
+// NewList takes one or more sources, optional rules, optional signers for
+// sources requiring signed lists, and a cache and returns a new List.
+func NewList(log *zap.Logger, sources []Source, rules Rules, signers Signers, cache *Cache) (*List, error) {
 	// TODO: ideally we'd ensure there was at least one source configured since
 	// it doesn't make sense to run a storage node that doesn't trust any
 	// satellites, but unfortunately the check causes the backcompat tests to
@@ -35,6 +37,7 @@ func NewList(log *zap.Logger, sources []Source, rules Rules, cache *Cache) (*Lis
 		log:     log,
 		sources: sources,
 		rules:   rules,
+		signers: signers,
 		cache:   cache,
 	}, nil
 }
@@ -87,8 +90,12 @@ func (list *List) fetchEntries(ctx context.Context) (_ []Entry, err error) {
 	for _, source := range list.sources {
 		sourceLog := list.log.With(zap.String("source", source.String()))
 
-		entries, err := source.FetchEntries(ctx)
+		entries, err := list.fetchSourceEntries(ctx, source)
 		if err != nil {
+			if ErrSignature.Has(err) {
+				sourceLog.Error("Rejected list from source", zap.Error(err))
+			}
+
 			var ok bool
 			entries, ok = list.lookupCache(source)
 			if !ok {
@@ -110,6 +117,16 @@ func (list *List) fetchEntries(ctx context.Context) (_ []Entry, err error) {
 	return allEntries, nil
 }
 
+// fetchSourceEntries fetches the entries from the source, verifying the list
+// signature for sources which require one. Previously cached entries are
+// never replaced with an unverified list.
+func (list *List) fetchSourceEntries(ctx context.Context, source Source) ([]Entry, error) {
+	if signed, ok := source.(SignedSource); ok && signed.RequiresSignature() {
+		return signed.FetchSignedEntries(ctx, list.signers)
+	}
+	return source.FetchEntries(ctx)
+}
+
 func (list *List) lookupCache(source Source) ([]Entry, bool) {
 	// Static sources are not cached
 	if source.Static() {
@@ -53,7 +53,7 @@ func TestNewList(t *testing.T) {
 	} {
 		tt := tt // quiet linting
 		t.Run(tt.name, func(t *testing.T) {
-			list, err := trust.NewList(tt.log, nil, nil, tt.cache)
+			list, err := trust.NewList(tt.log, nil, nil, nil, tt.cache)
 			if tt.err != "" {
 				require.EqualError(t, err, tt.err)
 				require.Nil(t, list)
@@ -163,7 +163,7 @@ func TestListAgainstSpec(t *testing.T) {
 	}
 
 	log := zaptest.NewLogger(t)
-	list, err := trust.NewList(log, sources, rules, cache)
+	list, err := trust.NewList(log, sources, rules, nil, cache)
 	require.NoError(t, err)
 
 	urls, err := list.FetchURLs(context.Background())
@@ -224,6 +224,14 @@ func TestListCacheInteraction(t *testing.T) {
 		err:    errors.New("ohno"),
 	}
 
+	rejectedSigned := &fakeSignedSource{
+		fakeSource: fakeSource{
+			name:    "signed",
+			static:  false,
+			entries: []trust.Entry{entry2},
+		},
+	}
+
 	badFixed := &fakeSource{
 		name:   "static",
 		static: true,
@@ -280,6 +288,17 @@ func TestListCacheInteraction(t *testing.T) {
 				"normal": {entry1},
 			},
 		},
+		{
+			name:    "rejected entries are not cached for signed sources",
+			sources: []trust.Source{rejectedSigned},
+			cacheBefore: map[string][]trust.Entry{
+				"signed": {entry1},
+			},
+			urls: []storj.NodeURL{url1},
+			cacheAfter: map[string][]trust.Entry{
+				"signed": {entry1},
+			},
+		},
 		{
 			name:    "fetch fails on failure for static source",
 			sources: []trust.Source{badFixed},
@@ -300,7 +319,7 @@ func TestListCacheInteraction(t *testing.T) {
 			cache := newTestCache(t, ctx.Dir(), tt.cacheBefore)
 
 			log := zaptest.NewLogger(t)
-			list, err := trust.NewList(log, tt.sources, nil, cache)
+			list, err := trust.NewList(log, tt.sources, nil, nil, cache)
 			require.NoError(t, err)
 
 			if tt.killCacheEarly {
@@ -357,6 +376,23 @@ func (s *fakeSource) FetchEntries(context.Context) ([]trust.Entry, error) {
 	return s.entries, s.err
 }
 
+// fakeSignedSource is a source which requires a signature, but its list
+// doesn't have one.
+type fakeSignedSource struct {
+	fakeSource
+}
+
+func (s *fakeSignedSource) RequiresSignature() bool {
+	return true
+}
+
+func (s *fakeSignedSource) FetchSignedEntries(ctx context.Context, signers trust.Signers) ([]trust.Entry, error) {
+	if err := signers.Verify([]byte(s.name), nil); err != nil {
+		return nil, err
+	}
+	return s.fakeSource.FetchEntries(ctx)
+}
+
 func makeTestID(x byte) storj.NodeID {
 	var id storj.NodeID
 	copy(id[:], bytes.Repeat([]byte{x}, len(id)))
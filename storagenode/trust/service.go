@@ -92,7 +92,7 @@ func NewPool(log *zap.Logger, resolver IdentityResolver, config Config, satellit
 		return nil, err
 	}
 
-	list, err := NewList(log, config.Sources, config.Exclusions.Rules, cache)
+	list, err := NewList(log, config.Sources, config.Exclusions.Rules, config.Signers, cache)
 	if err != nil {
 		return nil, err
 	}
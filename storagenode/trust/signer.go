@@ -0,0 +1,132 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package trust
+
+import (
+	"bytes"
+	"crypto"
+	"crypto/ed25519"
+	"encoding/base64"
+	"strings"
+
+	"github.com/zeebo/errs"
+
+	"storj.io/common/identity"
+	"storj.io/common/pkcrypto"
+)
+
+var (
+	// ErrSignature is an error class for trust list signature errors.
+	ErrSignature = errs.Class("trust list signature")
+)
+
+// Signer is a public key which is trusted to sign trust lists.
+//
+// A signer is configured either as "ed25519:<base64 public key>" or as
+// "identity:<path to identity certificate>". In the latter case the list must
+// be signed with the key of the identity, i.e. the key matching the leaf
+// certificate.
+type Signer struct {
+	config string
+	key    crypto.PublicKey
+}
+
+// NewSigner takes a configuration string and returns a Signer for that string.
+func NewSigner(config string) (Signer, error) {
+	kind, value, ok := strings.Cut(config, ":")
+	if !ok || value == "" {
+		return Signer{}, ErrSignature.New("%q: expected ed25519:<key> or identity:<path>", config)
+	}
+
+	switch kind {
+	case "ed25519":
+		key, err := base64.StdEncoding.DecodeString(value)
+		if err != nil {
+			return Signer{}, ErrSignature.New("%q: invalid base64 key: %w", config, err)
+		}
+		if len(key) != ed25519.PublicKeySize {
+			return Signer{}, ErrSignature.New("%q: invalid key length %d", config, len(key))
+		}
+		return Signer{config: config, key: ed25519.PublicKey(key)}, nil
+	case "identity":
+		ident, err := identity.PeerConfig{CertPath: value}.Load()
+		if err != nil {
+			return Signer{}, ErrSignature.New("%q: unable to load identity: %w", config, err)
+		}
+		return Signer{config: config, key: ident.Leaf.PublicKey}, nil
+	default:
+		return Signer{}, ErrSignature.New("%q: unsupported signer type %q", config, kind)
+	}
+}
+
+// String returns the string representation of the signer.
+func (signer Signer) String() string {
+	return signer.config
+}
+
+// Verify checks whether signature is a valid signature of data by the signer.
+func (signer Signer) Verify(data, signature []byte) bool {
+	if key, ok := signer.key.(ed25519.PublicKey); ok {
+		return ed25519.Verify(key, data, signature)
+	}
+	return pkcrypto.HashAndVerifySignature(signer.key, data, signature) == nil
+}
+
+// Signers is a list of signers that implements pflag.Value.
+type Signers []Signer
+
+// String returns the string representation of the config.
+func (signers Signers) String() string {
+	s := make([]string, 0, len(signers))
+	for _, signer := range signers {
+		s = append(s, signer.String())
+	}
+	return strings.Join(s, ",")
+}
+
+// Set implements pflag.Value by parsing a comma separated list of signers.
+func (signers *Signers) Set(value string) error {
+	var entries []string
+	if value != "" {
+		entries = strings.Split(value, ",")
+	}
+
+	var toSet []Signer
+	for _, entry := range entries {
+		signer, err := NewSigner(entry)
+		if err != nil {
+			return Error.New("invalid signer %q: %w", entry, errs.Unwrap(err))
+		}
+		toSet = append(toSet, signer)
+	}
+
+	*signers = toSet
+	return nil
+}
+
+// Type returns the type of the pflag.Value.
+func (signers Signers) Type() string {
+	return "trust-signers"
+}
+
+// Verify checks the detached signature of a trust list. The signature is
+// expected to be base64 encoded and has to be valid for at least one of the
+// signers.
+func (signers Signers) Verify(list, signature []byte) error {
+	if len(signers) == 0 {
+		return ErrSignature.New("no signers configured")
+	}
+
+	decoded, err := base64.StdEncoding.DecodeString(string(bytes.TrimSpace(signature)))
+	if err != nil {
+		return ErrSignature.New("signature is not valid base64: %w", err)
+	}
+
+	for _, signer := range signers {
+		if signer.Verify(list, decoded) {
+			return nil
+		}
+	}
+	return ErrSignature.New("signature does not match any of the configured signers")
+}
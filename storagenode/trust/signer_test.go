@@ -0,0 +1,132 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package trust_test
+
+import (
+	"crypto/ed25519"
+	"encoding/base64"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"storj.io/common/identity"
+	"storj.io/common/identity/testidentity"
+	"storj.io/common/pkcrypto"
+	"storj.io/common/storj"
+	"storj.io/common/testcontext"
+	"storj.io/storj/storagenode/trust"
+)
+
+func TestNewSigner(t *testing.T) {
+	ctx := testcontext.New(t)
+	defer ctx.Cleanup()
+
+	publicKey, _ := newEd25519Signer(t)
+
+	for _, tt := range []struct {
+		name   string
+		config string
+		err    string
+	}{
+		{
+			name:   "missing type",
+			config: "abc",
+			err:    `trust list signature: "abc": expected ed25519:<key> or identity:<path>`,
+		},
+		{
+			name:   "unsupported type",
+			config: "rsa:abc",
+			err:    `trust list signature: "rsa:abc": unsupported signer type "rsa"`,
+		},
+		{
+			name:   "invalid key length",
+			config: "ed25519:YWJj",
+			err:    `trust list signature: "ed25519:YWJj": invalid key length 3`,
+		},
+		{
+			name:   "ed25519 key",
+			config: publicKey,
+		},
+	} {
+		tt := tt // quiet linting
+		t.Run(tt.name, func(t *testing.T) {
+			signer, err := trust.NewSigner(tt.config)
+			if tt.err != "" {
+				require.EqualError(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.config, signer.String())
+		})
+	}
+
+	_, err := trust.NewSigner("identity:" + ctx.File("missing.cert"))
+	require.Error(t, err)
+	require.True(t, trust.ErrSignature.Has(err))
+}
+
+func TestSignersConfig(t *testing.T) {
+	publicKey1, _ := newEd25519Signer(t)
+	publicKey2, _ := newEd25519Signer(t)
+
+	var signers trust.Signers
+	assert.Equal(t, "trust-signers", signers.Type())
+	assert.Equal(t, "", signers.String())
+
+	require.NoError(t, signers.Set(publicKey1+","+publicKey2))
+	assert.Equal(t, publicKey1+","+publicKey2, signers.String())
+
+	// Assert that a failure to set does not modify the current signers
+	require.Error(t, signers.Set("ed25519:"))
+	assert.Equal(t, publicKey1+","+publicKey2, signers.String())
+	assert.Len(t, signers, 2)
+}
+
+func TestSignersVerify(t *testing.T) {
+	ctx := testcontext.New(t)
+	defer ctx.Cleanup()
+
+	list := []byte("121RTSDpyNZVcEU84Ticf2L1ntiuUimbWgfATz21tuvgk3vzoA6@domain.test:7777\n")
+
+	publicKey, sign := newEd25519Signer(t)
+	otherKey, signOther := newEd25519Signer(t)
+
+	ident, err := testidentity.PregeneratedIdentity(0, storj.LatestIDVersion())
+	require.NoError(t, err)
+	certPath := ctx.File("identity.cert")
+	require.NoError(t, identity.PeerConfig{CertPath: certPath}.Save(ident.PeerIdentity()))
+
+	identitySignature, err := pkcrypto.HashAndSign(ident.Key, list)
+	require.NoError(t, err)
+
+	var signers trust.Signers
+	require.NoError(t, signers.Set(publicKey+",identity:"+certPath))
+
+	// Signatures of any of the signers are accepted.
+	require.NoError(t, signers.Verify(list, sign(list)))
+	require.NoError(t, signers.Verify(list, []byte(base64.StdEncoding.EncodeToString(identitySignature)+"\n")))
+
+	// Signatures of other keys or of other data are rejected.
+	require.EqualError(t, signers.Verify(list, signOther(list)), "trust list signature: signature does not match any of the configured signers")
+	require.Error(t, signers.Verify([]byte("other"), sign(list)))
+	require.Error(t, signers.Verify(list, []byte("not base64!")))
+
+	// Without signers nothing can be verified.
+	require.EqualError(t, trust.Signers(nil).Verify(list, sign(list)), "trust list signature: no signers configured")
+
+	require.NoError(t, signers.Set(otherKey))
+	require.NoError(t, signers.Verify(list, signOther(list)))
+}
+
+// newEd25519Signer returns a signer config string and a function to create
+// base64 encoded detached signatures for it.
+func newEd25519Signer(t *testing.T) (string, func([]byte) []byte) {
+	publicKey, privateKey, err := ed25519.GenerateKey(nil)
+	require.NoError(t, err)
+
+	return "ed25519:" + base64.StdEncoding.EncodeToString(publicKey), func(data []byte) []byte {
+		return []byte(base64.StdEncoding.EncodeToString(ed25519.Sign(privateKey, data)))
+	}
+}
@@ -33,12 +33,26 @@ type Source interface {
 	FetchEntries(context.Context) ([]Entry, error)
 }
 
+// SignedSource is a trust source whose lists can be protected by a detached
+// signature.
+type SignedSource interface {
+	Source
+
+	// RequiresSignature returns true if the entries must be signed by one of
+	// the configured signers.
+	RequiresSignature() bool
+
+	// FetchSignedEntries returns the list of trust entries from the source,
+	// verifying the signature against the signers when it is required.
+	FetchSignedEntries(context.Context, Signers) ([]Entry, error)
+}
+
 // NewSource takes a configuration string returns a Source for that string.
 func NewSource(config string) (Source, error) {
 	schema, ok := isReserved(config)
 	if ok {
 		switch schema {
-		case "http", "https":
+		case "http", "https", "signed+http", "signed+https":
 			return NewHTTPSource(config)
 		case "storj":
 			return NewStaticURLSource(config)
@@ -54,10 +68,11 @@ func NewSource(config string) (Source, error) {
 	return NewFileSource(config), nil
 }
 
-var reReserved = regexp.MustCompile(`^([a-zA-Z]{2,})://`)
+var reReserved = regexp.MustCompile(`^((?:[a-zA-Z]{2,}\+)?[a-zA-Z]{2,})://`)
 
 // isReserved returns the true if the string is within the reserved namespace
-// for trust sources, i.e. things that look like a URI scheme. Single letter
+// for trust sources, i.e. things that look like a URI scheme (optionally with
+// a modifier, like signed+https). Single letter
 // schemes are not in the reserved namespace since those collide with paths
 // starting with Windows drive letters.
 func isReserved(s string) (schema string, ok bool) {
@@ -34,6 +34,16 @@ func TestNewSource(t *testing.T) {
 			config: "https://domain.test",
 			typ:    new(trust.HTTPSource),
 		},
+		{
+			name:   "signed HTTP source (using https)",
+			config: "signed+https://domain.test",
+			typ:    new(trust.HTTPSource),
+		},
+		{
+			name:   "unrecognized signed schema",
+			config: "signed+ftp://domain.test",
+			err:    `unsupported schema "signed+ftp"`,
+		},
 		{
 			name:   "relative file path",
 			config: "path.txt",